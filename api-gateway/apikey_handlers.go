@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/proto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// createAPIKey Create API Key
+// @Summary      Create an API key
+// @Description  Create a key for a machine client such as a warehouse scanner. The client sends it as X-API-Key and acts as the user, limited to the key's scopes (e.g. inventory:write, orders:read). The key is only shown in this response.
+// @Tags         API Keys
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int                         true  "User ID"
+// @Param        key  body      models.CreateAPIKeyRequest  true  "API key data"
+// @Success      201  {object}  models.APIKeyCreatedResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/{id}/api-keys [post]
+func createAPIKey(c *fiber.Ctx) error {
+	// A leaked key must not be able to mint keys that outlive its revocation
+	if callerOf(c).APIKeyID != 0 {
+		return c.Status(403).JSON(fiber.Map{"error": "API keys cannot create API keys; sign in instead"})
+	}
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+	var req models.CreateAPIKeyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "Name is required"})
+	}
+	for i, scope := range req.Scopes {
+		req.Scopes[i] = strings.ToLower(strings.TrimSpace(scope))
+	}
+	if err := checkScopes(req.Scopes); err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+	if req.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil {
+			return c.Status(400).JSON(fiber.Map{"error": "Invalid expires_at: use an RFC 3339 time"})
+		}
+		if !expiresAt.After(time.Now()) {
+			return c.Status(400).JSON(fiber.Map{"error": "expires_at must be in the future"})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.CreateApiKey(ctx, &proto.CreateApiKeyRequest{
+		UserId:    int32(id),
+		Name:      strings.TrimSpace(req.Name),
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !resp.Success {
+		switch resp.Message {
+		case "User not found":
+			return c.Status(404).JSON(fiber.Map{"error": resp.Message})
+		case "Internal error":
+			return c.Status(500).JSON(fiber.Map{"error": resp.Message})
+		}
+		return c.Status(400).JSON(fiber.Map{"error": resp.Message})
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"api_key": presentAPIKey(resp.ApiKey),
+		"key":     resp.Key,
+	})
+}
+
+// listAPIKeys List API Keys
+// @Summary      List API keys
+// @Description  Get the API keys of a user with their scopes, expiry and when they were last used. Keys themselves are never shown again.
+// @Tags         API Keys
+// @Accept       json
+// @Produce      json
+// @Param        id               path      int   true   "User ID"
+// @Param        include_revoked  query     bool  false  "Include revoked keys"
+// @Success      200              {object}  models.APIKeysListResponse
+// @Failure      400              {object}  models.ErrorResponse
+// @Failure      401              {object}  models.ErrorResponse
+// @Failure      403              {object}  models.ErrorResponse
+// @Failure      500              {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/{id}/api-keys [get]
+func listAPIKeys(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.ListApiKeys(ctx, &proto.ListApiKeysRequest{
+		UserId:         int32(id),
+		IncludeRevoked: c.QueryBool("include_revoked"),
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	keys := make([]models.APIKey, 0)
+	for _, key := range resp.ApiKeys {
+		keys = append(keys, presentAPIKey(key))
+	}
+	return c.JSON(fiber.Map{
+		"api_keys": keys,
+	})
+}
+
+// revokeAPIKey Revoke API Key
+// @Summary      Revoke an API key
+// @Description  Revoke an API key; clients using it are refused from the next request on
+// @Tags         API Keys
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int  true  "User ID"
+// @Param        keyId  path      int  true  "API key ID"
+// @Success      200    {object}  models.APIKeyResponse
+// @Failure      400    {object}  models.ErrorResponse
+// @Failure      401    {object}  models.ErrorResponse
+// @Failure      403    {object}  models.ErrorResponse
+// @Failure      404    {object}  models.ErrorResponse
+// @Failure      500    {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/{id}/api-keys/{keyId} [delete]
+func revokeAPIKey(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+	keyID, err := strconv.Atoi(c.Params("keyId"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid API key ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.RevokeApiKey(ctx, &proto.RevokeApiKeyRequest{
+		UserId: int32(id),
+		Id:     int32(keyID),
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !resp.Success {
+		if resp.Message == "API key not found" {
+			return c.Status(404).JSON(fiber.Map{"error": resp.Message})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": resp.Message})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"api_key": presentAPIKey(resp.ApiKey),
+	})
+}
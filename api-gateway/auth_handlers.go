@@ -0,0 +1,364 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/proto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// sendTokens answers a login or refresh with the tokens of the session
+func sendTokens(c *fiber.Ctx, resp *proto.AuthTokensResponse) error {
+	if !resp.Success {
+		status := 401
+		if resp.Message == "Internal error" {
+			status = 500
+		}
+		return c.Status(status).JSON(fiber.Map{"error": resp.Message})
+	}
+	return c.JSON(fiber.Map{
+		"success":                  resp.Success,
+		"message":                  resp.Message,
+		"user":                     presentUser(resp.User),
+		"access_token":             resp.AccessToken,
+		"access_token_expires_at":  resp.AccessTokenExpiresAt,
+		"refresh_token":            resp.RefreshToken,
+		"refresh_token_expires_at": resp.RefreshTokenExpiresAt,
+		"token_type":               "Bearer",
+	})
+}
+
+// sendAuthAction answers a verification or password reset step
+func sendAuthAction(c *fiber.Ctx, resp *proto.AuthActionResponse) error {
+	if !resp.Success {
+		status := 400
+		if resp.Message == "Internal error" {
+			status = 500
+		}
+		return c.Status(status).JSON(fiber.Map{"error": resp.Message})
+	}
+	result := fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+	}
+	if resp.User != nil {
+		result["user"] = presentUser(resp.User)
+	}
+	return c.JSON(result)
+}
+
+// signup Sign Up
+// @Summary      Sign up
+// @Description  Create an account with a password. The new user is a customer and receives an email with a link to verify their address.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        user  body      models.SignupRequest  true  "Account data"
+// @Success      201   {object}  models.AuthActionResponse
+// @Failure      400   {object}  models.ErrorResponse
+// @Failure      409   {object}  models.ErrorResponse
+// @Failure      500   {object}  models.ErrorResponse
+// @Router       /auth/signup [post]
+func signup(c *fiber.Ctx) error {
+	var req models.SignupRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if strings.TrimSpace(req.Name) == "" || strings.TrimSpace(req.Email) == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "Name and email are required"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.Signup(ctx, &proto.SignupRequest{
+		Name:     req.Name,
+		Email:    strings.TrimSpace(req.Email),
+		Age:      req.Age,
+		Password: req.Password,
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !resp.Success {
+		switch resp.Message {
+		case "Email already exists":
+			return c.Status(409).JSON(fiber.Map{"error": resp.Message})
+		case "Internal error":
+			return c.Status(500).JSON(fiber.Map{"error": resp.Message})
+		}
+		return c.Status(400).JSON(fiber.Map{"error": resp.Message})
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"user":    presentUser(resp.User),
+	})
+}
+
+// login Log In
+// @Summary      Log in
+// @Description  Exchange an email and password for an access token, sent as "Authorization: Bearer <token>" on later requests, and a refresh token to renew it
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        credentials  body      models.LoginRequest  true  "Email and password"
+// @Success      200          {object}  models.AuthTokensResponse
+// @Failure      400          {object}  models.ErrorResponse
+// @Failure      401          {object}  models.ErrorResponse
+// @Failure      500          {object}  models.ErrorResponse
+// @Router       /auth/login [post]
+func login(c *fiber.Ctx) error {
+	var req models.LoginRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.Login(ctx, &proto.LoginRequest{
+		Email:    strings.TrimSpace(req.Email),
+		Password: req.Password,
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	return sendTokens(c, resp)
+}
+
+// refreshTokens Refresh Tokens
+// @Summary      Refresh tokens
+// @Description  Exchange a refresh token for a new access and refresh token. Each refresh token works once; presenting one a second time revokes the whole session.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        token  body      models.RefreshTokenRequest  true  "Refresh token"
+// @Success      200    {object}  models.AuthTokensResponse
+// @Failure      400    {object}  models.ErrorResponse
+// @Failure      401    {object}  models.ErrorResponse
+// @Failure      500    {object}  models.ErrorResponse
+// @Router       /auth/refresh [post]
+func refreshTokens(c *fiber.Ctx) error {
+	var req models.RefreshTokenRequest
+	if err := c.BodyParser(&req); err != nil || req.RefreshToken == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "refresh_token is required"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.RefreshToken(ctx, &proto.RefreshTokenRequest{RefreshToken: req.RefreshToken})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	return sendTokens(c, resp)
+}
+
+// logout Log Out
+// @Summary      Log out
+// @Description  Revoke the session of the access token, or of refresh_token when given. all_sessions signs the user out everywhere.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        logout  body      models.LogoutRequest  false  "Session to end"
+// @Success      200     {object}  models.LogoutResponse
+// @Failure      400     {object}  models.ErrorResponse
+// @Failure      401     {object}  models.ErrorResponse
+// @Failure      500     {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /auth/logout [post]
+func logout(c *fiber.Ctx) error {
+	var req models.LogoutRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+		}
+	}
+	token := req.RefreshToken
+	if token == "" {
+		token = callerOf(c).Token
+	}
+	if token == "" {
+		return c.Status(401).JSON(fiber.Map{"error": "Send an access token or a refresh_token"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.Logout(ctx, &proto.LogoutRequest{
+		Token:       token,
+		AllSessions: req.AllSessions,
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !resp.Success {
+		if resp.Message == "Internal error" {
+			return c.Status(500).JSON(fiber.Map{"error": resp.Message})
+		}
+		return c.Status(401).JSON(fiber.Map{"error": resp.Message})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"revoked": resp.Revoked,
+	})
+}
+
+// getCurrentUser Get Current User
+// @Summary      Get the signed-in user
+// @Description  Get the user the access token belongs to, with the permissions of their roles
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.CurrentUserResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /auth/me [get]
+func getCurrentUser(c *fiber.Ctx) error {
+	who := callerOf(c)
+	if who.ID == 0 {
+		return denied(c, who, "")
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.GetUser(ctx, &proto.GetUserRequest{UserId: who.ID})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !resp.Found {
+		return c.Status(401).JSON(fiber.Map{"error": "User not found"})
+	}
+
+	user := presentUser(resp.User)
+	user.Roles = who.Roles
+	return c.JSON(fiber.Map{
+		"user":        user,
+		"permissions": policy.Permissions(who.Roles),
+	})
+}
+
+// verifyEmail Verify Email
+// @Summary      Verify an email address
+// @Description  Confirm a user's email address with the token from the verification email. This is the link the email points to.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        token  query     string  true  "Verification token"
+// @Success      200    {object}  models.AuthActionResponse
+// @Failure      400    {object}  models.ErrorResponse
+// @Failure      500    {object}  models.ErrorResponse
+// @Router       /auth/verify-email [get]
+func verifyEmail(c *fiber.Ctx) error {
+	token := strings.TrimSpace(c.Query("token"))
+	if token == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "token is required"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.VerifyEmail(ctx, &proto.VerifyEmailRequest{Token: token})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	return sendAuthAction(c, resp)
+}
+
+// resendVerification Resend Verification
+// @Summary      Resend the verification email
+// @Description  Mail a new verification link. The answer is the same whether or not the email belongs to an unverified user.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        email  body      models.EmailRequest  true  "Email address"
+// @Success      200    {object}  models.AuthActionResponse
+// @Failure      400    {object}  models.ErrorResponse
+// @Failure      500    {object}  models.ErrorResponse
+// @Router       /auth/verify-email/resend [post]
+func resendVerification(c *fiber.Ctx) error {
+	var req models.EmailRequest
+	if err := c.BodyParser(&req); err != nil || strings.TrimSpace(req.Email) == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "email is required"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.RequestEmailVerification(ctx, &proto.RequestEmailVerificationRequest{
+		Email: strings.TrimSpace(req.Email),
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	return sendAuthAction(c, resp)
+}
+
+// requestPasswordReset Request Password Reset
+// @Summary      Request a password reset
+// @Description  Mail a password reset token. The answer is the same whether or not the email belongs to a user.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        email  body      models.EmailRequest  true  "Email address"
+// @Success      200    {object}  models.AuthActionResponse
+// @Failure      400    {object}  models.ErrorResponse
+// @Failure      500    {object}  models.ErrorResponse
+// @Router       /auth/password-reset/request [post]
+func requestPasswordReset(c *fiber.Ctx) error {
+	var req models.EmailRequest
+	if err := c.BodyParser(&req); err != nil || strings.TrimSpace(req.Email) == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "email is required"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.RequestPasswordReset(ctx, &proto.RequestPasswordResetRequest{
+		Email: strings.TrimSpace(req.Email),
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	return sendAuthAction(c, resp)
+}
+
+// resetPassword Reset Password
+// @Summary      Reset a password
+// @Description  Set a new password with the token from the password reset email. Every session of the user is revoked.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        reset  body      models.ResetPasswordRequest  true  "Reset token and new password"
+// @Success      200    {object}  models.AuthActionResponse
+// @Failure      400    {object}  models.ErrorResponse
+// @Failure      500    {object}  models.ErrorResponse
+// @Router       /auth/password-reset/confirm [post]
+func resetPassword(c *fiber.Ctx) error {
+	var req models.ResetPasswordRequest
+	if err := c.BodyParser(&req); err != nil || req.Token == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "token and new_password are required"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.ResetPassword(ctx, &proto.ResetPasswordRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	return sendAuthAction(c, resp)
+}
@@ -0,0 +1,353 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/proto"
+	"api-gateway/rbac"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// caller is the user a request is made by; ID is 0 for guests
+type caller struct {
+	ID    int32
+	Roles []string
+	// Token is the access token the request was authenticated with
+	Token string
+	// APIKeyID is set when the request was authenticated with an API key,
+	// which limits the caller to Scopes
+	APIKeyID int32
+	Scopes   []string
+	// Tenant is the storefront the user belongs to
+	Tenant string
+}
+
+// headerAPIKey carries the API key of machine clients
+const headerAPIKey = "X-API-Key"
+
+// ownerFunc returns the ID of the user owning the record a request targets
+type ownerFunc func(ctx context.Context, c *fiber.Ctx) (int32, error)
+
+// identifyCaller resolves the bearer access token or API key of a request to
+// a user and their roles. Requests without either are made by a guest.
+func identifyCaller(c *fiber.Ctx) error {
+	header := strings.TrimSpace(c.Get(fiber.HeaderAuthorization))
+	apiKey := strings.TrimSpace(c.Get(headerAPIKey))
+	if header != "" && apiKey != "" {
+		return c.Status(400).JSON(fiber.Map{"error": "Send either an access token or an API key, not both"})
+	}
+	if apiKey != "" {
+		return identifyAPIKey(c, apiKey)
+	}
+	if header == "" {
+		c.Locals("caller", &caller{Roles: []string{rbac.Guest}})
+		return c.Next()
+	}
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	token = strings.TrimSpace(token)
+	if !ok || token == "" {
+		return c.Status(401).JSON(fiber.Map{"error": "Authorization header must be Bearer <access token>"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.AuthenticateToken(ctx, &proto.AuthenticateTokenRequest{AccessToken: token})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !resp.Valid {
+		return c.Status(401).JSON(fiber.Map{"error": resp.Message})
+	}
+
+	c.Locals("caller", &caller{
+		ID:     resp.User.Id,
+		Roles:  userRoles(resp.User),
+		Token:  token,
+		Tenant: resp.User.TenantId,
+	})
+	return c.Next()
+}
+
+// identifyAPIKey resolves an API key to the user it acts as, limited to the
+// key's scopes
+func identifyAPIKey(c *fiber.Ctx, key string) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.AuthenticateApiKey(ctx, &proto.AuthenticateApiKeyRequest{Key: key})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !resp.Valid {
+		return c.Status(401).JSON(fiber.Map{"error": resp.Message})
+	}
+
+	c.Locals("caller", &caller{
+		ID:       resp.User.Id,
+		Roles:    userRoles(resp.User),
+		APIKeyID: resp.ApiKey.Id,
+		Scopes:   resp.ApiKey.Scopes,
+		Tenant:   resp.User.TenantId,
+	})
+	return c.Next()
+}
+
+// userRoles returns the roles of a user, with admin for ADMIN_USER_IDS. The
+// roles are copied so the user message is left as it is.
+func userRoles(user *proto.User) []string {
+	roles := append([]string(nil), user.Roles...)
+	if slices.Contains(cfg.AdminUserIDs, user.Id) && !slices.Contains(roles, rbac.Admin) {
+		roles = append(roles, rbac.Admin)
+	}
+	return roles
+}
+
+// callerOf returns the caller identified for the request
+func callerOf(c *fiber.Ctx) *caller {
+	if who, ok := c.Locals("caller").(*caller); ok {
+		return who
+	}
+	return &caller{Roles: []string{rbac.Guest}}
+}
+
+// actorOf names the caller in audit trails such as the stock ledger: the
+// user, and the API key when the request was made with one
+func actorOf(c *fiber.Ctx) string {
+	who := callerOf(c)
+	if who.APIKeyID != 0 {
+		return fmt.Sprintf("user:%d/api-key:%d", who.ID, who.APIKeyID)
+	}
+	return fmt.Sprintf("user:%d", who.ID)
+}
+
+// seesDeletedProducts reports whether the caller may see the soft-deleted
+// products of a seller: product managers see every seller's, sellers their
+// own. A zero sellerID asks about every seller.
+func seesDeletedProducts(who *caller, sellerID int32) bool {
+	if policy.Allows(who.Roles, rbac.ProductsManageAny) {
+		return true
+	}
+	return sellerID != 0 && sellerID == who.ID && policy.Allows(who.Roles, rbac.ProductsManageOwn)
+}
+
+// denied answers 401 to guests, who may be allowed once they identify, and
+// 403 to users
+func denied(c *fiber.Ctx, who *caller, message string) error {
+	if who.ID == 0 {
+		return c.Status(401).JSON(fiber.Map{"error": "Sign in required: send an access token as Authorization: Bearer <token> or an API key as X-API-Key"})
+	}
+	return c.Status(403).JSON(fiber.Map{"error": message})
+}
+
+// requireScope limits API key callers to the areas their scopes cover: reads
+// need the area's read or write scope, any other method its write scope.
+// Callers without an API key are not limited.
+func requireScope(area string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		who := callerOf(c)
+		if who.APIKeyID == 0 {
+			return c.Next()
+		}
+		write := c.Method() != fiber.MethodGet && c.Method() != fiber.MethodHead
+		if rbac.ScopesAllow(who.Scopes, area, write) {
+			return c.Next()
+		}
+		scope := rbac.ReadScope(area)
+		if write {
+			scope = rbac.WriteScope(area)
+		}
+		return c.Status(403).JSON(fiber.Map{"error": fmt.Sprintf("API key lacks the %s scope", scope)})
+	}
+}
+
+// authorize allows the request when the caller holds any of the permissions
+func authorize(perms ...rbac.Permission) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		who := callerOf(c)
+		for _, perm := range perms {
+			if policy.Allows(who.Roles, perm) {
+				return c.Next()
+			}
+		}
+		return denied(c, who, "Permission denied")
+	}
+}
+
+// authorizeOwned allows holders of the any permission, and holders of the own
+// permission when the record the request targets belongs to them
+func authorizeOwned(own, any rbac.Permission, owner ownerFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		who := callerOf(c)
+		if policy.Allows(who.Roles, any) {
+			return c.Next()
+		}
+		if !policy.Allows(who.Roles, own) {
+			return denied(c, who, "Permission denied")
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+		defer cancel()
+
+		ownerID, err := owner(ctx, c)
+		if err != nil {
+			if e, ok := err.(*fiber.Error); ok {
+				return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+			}
+			return inventoryError(c, err)
+		}
+		if ownerID != who.ID {
+			return denied(c, who, "Permission denied: not your own")
+		}
+		return c.Next()
+	}
+}
+
+// userParam owns requests for the user in the id path parameter
+func userParam(ctx context.Context, c *fiber.Ctx) (int32, error) {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "Invalid user ID")
+	}
+	return int32(id), nil
+}
+
+// userQuery owns requests for the user in the user_id query parameter
+func userQuery(ctx context.Context, c *fiber.Ctx) (int32, error) {
+	id, _ := strconv.Atoi(c.Query("user_id", "0"))
+	return int32(id), nil
+}
+
+// userBody owns requests whose JSON body names a user_id
+func userBody(ctx context.Context, c *fiber.Ctx) (int32, error) {
+	var body struct {
+		UserID int32 `json:"user_id"`
+	}
+	if err := c.BodyParser(&body); err != nil {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+	return body.UserID, nil
+}
+
+// productOwner returns the seller of a product, deleted or not
+func productOwner(ctx context.Context, productID int32) (int32, error) {
+	resp, err := clients.ProductClient.GetProduct(ctx, &proto.GetProductRequest{
+		ProductId:      productID,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !resp.Found {
+		return 0, fiber.NewError(fiber.StatusNotFound, "Product not found")
+	}
+	return resp.Product.UserId, nil
+}
+
+// productParam owns requests for the product in the id path parameter
+func productParam(ctx context.Context, c *fiber.Ctx) (int32, error) {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "Invalid product ID")
+	}
+	return productOwner(ctx, int32(id))
+}
+
+// productBody owns requests whose JSON body names a product by product_id or
+// by the SKU of one of its variants
+func productBody(ctx context.Context, c *fiber.Ctx) (int32, error) {
+	var body struct {
+		ProductID int32  `json:"product_id"`
+		SKU       string `json:"sku"`
+	}
+	if err := c.BodyParser(&body); err != nil {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+	if body.ProductID == 0 && strings.TrimSpace(body.SKU) != "" {
+		variant, err := resolveVariant(ctx, 0, 0, body.SKU)
+		if err != nil {
+			return 0, err
+		}
+		body.ProductID = variant.ProductId
+	}
+	if body.ProductID <= 0 {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "product_id is required")
+	}
+	return productOwner(ctx, body.ProductID)
+}
+
+// inventoryParam owns requests for the inventory item in the id path
+// parameter through the product it stocks
+func inventoryParam(ctx context.Context, c *fiber.Ctx) (int32, error) {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "Invalid inventory item ID")
+	}
+	resp, err := clients.InventoryClient.GetInventoryItem(ctx, &proto.GetInventoryItemRequest{Id: int32(id)})
+	if err != nil {
+		return 0, err
+	}
+	return productOwner(ctx, resp.Item.ProductId)
+}
+
+// orderParam owns requests for the order in the id path parameter
+func orderParam(ctx context.Context, c *fiber.Ctx) (int32, error) {
+	resp, err := clients.OrderClient.GetOrder(ctx, &proto.GetOrderRequest{Id: c.Params("id")})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Order.UserId, nil
+}
+
+// reviewParam owns requests for the review in the reviewId path parameter
+// through its author
+func reviewParam(ctx context.Context, c *fiber.Ctx) (int32, error) {
+	review, err := productReview(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	return review.UserId, nil
+}
+
+// checkRole returns a 400 error unless the role can be granted
+func checkRole(role string) error {
+	if !policy.Has(role) || role == rbac.Guest {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Unknown role %q: use one of %s", role, strings.Join(grantableRoles(), ", ")))
+	}
+	return nil
+}
+
+// checkScopes returns a 400 error unless every scope exists
+func checkScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "At least one scope is required")
+	}
+	for _, scope := range scopes {
+		if !rbac.ValidScope(scope) {
+			names := make([]string, 0)
+			for _, known := range rbac.Scopes() {
+				names = append(names, string(known))
+			}
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Unknown scope %q: use one of %s", scope, strings.Join(names, ", ")))
+		}
+	}
+	return nil
+}
+
+// grantableRoles lists the roles users can hold; guest is implied by not
+// identifying
+func grantableRoles() []string {
+	var roles []string
+	for _, role := range policy.Roles() {
+		if role != rbac.Guest {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/proto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// categoryResponse renders a category with its breadcrumbs and direct children
+func categoryResponse(c *fiber.Ctx, status int, resp *proto.CategoryResponse) error {
+	return c.Status(status).JSON(fiber.Map{
+		"success":     resp.Success,
+		"message":     resp.Message,
+		"category":    presentCategory(resp.Category),
+		"breadcrumbs": presentCategories(resp.Breadcrumbs),
+		"children":    presentCategories(resp.Children),
+	})
+}
+
+// createCategory Create Category
+// @Summary      Create a category
+// @Description  Create a product category, at the root of the tree or below parent_id. The slug is derived from the name when omitted and must be unique.
+// @Tags         Categories
+// @Accept       json
+// @Produce      json
+// @Param        category  body      models.CreateCategoryRequest  true  "Category data"
+// @Success      201       {object}  models.CategoryResponse
+// @Failure      400       {object}  models.ErrorResponse
+// @Failure      404       {object}  models.ErrorResponse
+// @Failure      409       {object}  models.ErrorResponse
+// @Failure      500       {object}  models.ErrorResponse
+// @Router       /categories [post]
+func createCategory(c *fiber.Ctx) error {
+	var req models.CreateCategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "Category name is required"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.CreateCategory(ctx, &proto.CreateCategoryRequest{
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+		ParentId:    req.ParentID,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return categoryResponse(c, 201, resp)
+}
+
+// listCategories List Categories
+// @Summary      Get the category tree
+// @Description  Get every category nested under its parent, root categories first
+// @Tags         Categories
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.CategoryTreeResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /categories [get]
+func listCategories(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.ListCategories(ctx, &proto.ListCategoriesRequest{})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"categories": presentCategoryTree(resp.Categories),
+	})
+}
+
+// getCategory Get Category
+// @Summary      Get category by ID
+// @Description  Get a category with its breadcrumbs from the root and its direct subcategories
+// @Tags         Categories
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Category ID"
+// @Success      200  {object}  models.CategoryResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /categories/{id} [get]
+func getCategory(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid category ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.GetCategory(ctx, &proto.GetCategoryRequest{CategoryId: int32(id)})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return categoryResponse(c, 200, resp)
+}
+
+// updateCategory Update Category
+// @Summary      Update a category
+// @Description  Change the name, slug or description of a category; omitted fields are left unchanged. Use the move endpoint to change its parent.
+// @Tags         Categories
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int                           true  "Category ID"
+// @Param        category  body      models.UpdateCategoryRequest  true  "Category update"
+// @Success      200       {object}  models.CategoryResponse
+// @Failure      400       {object}  models.ErrorResponse
+// @Failure      404       {object}  models.ErrorResponse
+// @Failure      409       {object}  models.ErrorResponse
+// @Failure      500       {object}  models.ErrorResponse
+// @Router       /categories/{id} [put]
+func updateCategory(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid category ID"})
+	}
+
+	var req models.UpdateCategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.UpdateCategory(ctx, &proto.UpdateCategoryRequest{
+		CategoryId:  int32(id),
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return categoryResponse(c, 200, resp)
+}
+
+// moveCategory Move Category
+// @Summary      Move a category
+// @Description  Move a category and its whole subtree below another category, or to the root with parent_id 0. A category cannot be moved into its own subtree.
+// @Tags         Categories
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                         true  "Category ID"
+// @Param        move  body      models.MoveCategoryRequest  true  "New parent"
+// @Success      200   {object}  models.CategoryResponse
+// @Failure      400   {object}  models.ErrorResponse
+// @Failure      404   {object}  models.ErrorResponse
+// @Failure      409   {object}  models.ErrorResponse
+// @Failure      500   {object}  models.ErrorResponse
+// @Router       /categories/{id}/move [put]
+func moveCategory(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid category ID"})
+	}
+
+	var req models.MoveCategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.ParentID < 0 {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid parent category ID"})
+	}
+	if req.ParentID == int32(id) {
+		return c.Status(409).JSON(fiber.Map{"error": "Cannot move a category into its own subtree"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.MoveCategory(ctx, &proto.MoveCategoryRequest{
+		CategoryId: int32(id),
+		ParentId:   req.ParentID,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return categoryResponse(c, 200, resp)
+}
+
+// deleteCategory Delete Category
+// @Summary      Delete a category
+// @Description  Delete a category without subcategories. Its products stay in the catalog and are only removed from the category.
+// @Tags         Categories
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Category ID"
+// @Success      200  {object}  models.SuccessResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /categories/{id} [delete]
+func deleteCategory(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid category ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.DeleteCategory(ctx, &proto.DeleteCategoryRequest{CategoryId: int32(id)})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+	})
+}
+
+// listCategoryProducts List Category Products
+// @Summary      List the products in a category
+// @Description  Get a paginated list of the products in a category and, unless include_descendants is false, in all of its subcategories
+// @Tags         Categories
+// @Accept       json
+// @Produce      json
+// @Param        id                   path      int     true   "Category ID"
+// @Param        include_descendants  query     bool    false  "Include products in subcategories"  default(true)
+// @Param        page                 query     int     false  "Page number"  default(1)
+// @Param        limit                query     int     false  "Items per page"  default(10)
+// @Param        currency             query     string  false  "Currency to render prices in (overrides Accept-Currency)"
+// @Param        Accept-Currency      header    string  false  "Currency to render prices in"
+// @Success      200                  {object}  models.CategoryProductsResponse
+// @Failure      400                  {object}  models.ErrorResponse
+// @Failure      404                  {object}  models.ErrorResponse
+// @Failure      500                  {object}  models.ErrorResponse
+// @Router       /categories/{id}/products [get]
+func listCategoryProducts(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid category ID"})
+	}
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+
+	display, err := displayCurrency(c)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	category, err := clients.ProductClient.GetCategory(ctx, &proto.GetCategoryRequest{CategoryId: int32(id)})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	resp, err := clients.ProductClient.ListProducts(ctx, &proto.ListProductsRequest{
+		Page:               int32(page),
+		Limit:              int32(limit),
+		CategoryId:         int32(id),
+		IncludeDescendants: c.QueryBool("include_descendants", true),
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"category": presentCategory(category.Category),
+		"products": presentProducts(resp.Products, display),
+		"total":    resp.Total,
+		"page":     resp.Page,
+		"limit":    resp.Limit,
+	})
+}
@@ -0,0 +1,211 @@
+// Package config loads API Gateway settings from the environment.
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds the API Gateway settings
+type Config struct {
+	// DefaultCurrency is the ISO 4217 code catalog prices are stored in
+	// and the base currency of the exchange rate table
+	DefaultCurrency string
+	// ExchangeRatesFile is the JSON file exchange rates are loaded from
+	ExchangeRatesFile string
+	// PromotionsFile is the JSON file promotions and their usage are kept in
+	PromotionsFile string
+	// TaxRatesFile is the JSON file tax rates per region and category are read from
+	TaxRatesFile string
+	// InvoiceDir is where issued invoices are stored
+	InvoiceDir string
+	// PrivacyAuditFile is the JSON file data export and erasure requests are
+	// recorded in
+	PrivacyAuditFile string
+	// Seller details printed on invoices
+	SellerName    string
+	SellerAddress string
+	SellerEmail   string
+	SellerTaxID   string
+	// LowStockCheckInterval is how often inventory is scanned for items at
+	// their reorder point; 0 disables the scan
+	LowStockCheckInterval time.Duration
+	// LowStockWebhookURL receives low-stock alerts when set
+	LowStockWebhookURL string
+	// PriceScheduleInterval is how often due price schedules are applied;
+	// 0 disables the scheduler
+	PriceScheduleInterval time.Duration
+	// UserDeleteProducts and UserDeleteOrders are the default policies for
+	// the products and orders of a deleted user: block, soft-cascade or reassign
+	UserDeleteProducts string
+	UserDeleteOrders   string
+	// DeletedRetention is how long soft-deleted users and products are kept
+	// before they are purged; 0 keeps them forever
+	DeletedRetention time.Duration
+	// PurgeInterval is how often records past their retention are purged;
+	// 0 disables the purge
+	PurgeInterval time.Duration
+	// MediaStorage selects where product images are stored: local or s3
+	MediaStorage string
+	// MediaDir is where local media storage keeps its files
+	MediaDir string
+	// MediaBaseURL prefixes storage keys in media URLs; the default points
+	// at the gateway's own /media route
+	MediaBaseURL string
+	// MediaMaxBytes is the largest accepted upload
+	MediaMaxBytes int
+	// MediaThumbnailSize is the longest side of generated thumbnails in pixels
+	MediaThumbnailSize int
+	// S3 bucket used when MediaStorage is s3; any S3-compatible store works
+	S3Endpoint  string
+	S3Bucket    string
+	S3Region    string
+	S3AccessKey string
+	S3SecretKey string
+	// RBACPolicyFile is an optional JSON file mapping roles to permissions;
+	// the built-in policy applies when it is empty or missing
+	RBACPolicyFile string
+	// AdminUserIDs always hold the admin role, so a fresh installation has
+	// someone who can grant roles
+	AdminUserIDs []int32
+	// OIDCProvidersFile is the JSON file external identity providers users
+	// can sign in with are configured in; none are offered when it is missing
+	OIDCProvidersFile string
+	// TenantsFile is the JSON file storefronts are configured in; without it
+	// the gateway serves the default tenant only
+	TenantsFile string
+	// DefaultTenant serves requests no host, path prefix or signed-in user
+	// assigns to another storefront
+	DefaultTenant string
+	// WebhooksFile is the JSON file webhooks and their outbox of deliveries
+	// are kept in
+	WebhooksFile string
+	// WebhookDispatchInterval is how often due webhook deliveries are
+	// attempted, besides right after events; 0 disables deliveries
+	WebhookDispatchInterval time.Duration
+	// WebhookTimeout is how long an endpoint may take to answer
+	WebhookTimeout time.Duration
+	// WebhookMaxAttempts is how many attempts a delivery gets before it is
+	// dead-lettered
+	WebhookMaxAttempts int
+	// WebhookRetryBaseDelay is the wait after a first failed attempt; it
+	// doubles with every further failure up to WebhookRetryMaxDelay
+	WebhookRetryBaseDelay time.Duration
+	WebhookRetryMaxDelay  time.Duration
+	// WebhookLogRetention is how long successful deliveries stay in the
+	// delivery log; 0 keeps them
+	WebhookLogRetention time.Duration
+	// WebhookKafkaPollInterval is how often events consumed from Kafka are
+	// fetched from the inventory service for webhooks; 0 disables the relay
+	WebhookKafkaPollInterval time.Duration
+}
+
+// Load reads the configuration from environment variables, falling back to
+// development defaults
+func Load() *Config {
+	return &Config{
+		DefaultCurrency:   strings.ToUpper(getEnv("DEFAULT_CURRENCY", "USD")),
+		ExchangeRatesFile: getEnv("EXCHANGE_RATES_FILE", "exchange_rates.json"),
+		PromotionsFile:    getEnv("PROMOTIONS_FILE", "promotions.json"),
+		TaxRatesFile:      getEnv("TAX_RATES_FILE", "tax_rates.json"),
+		InvoiceDir:        getEnv("INVOICE_DIR", "invoices"),
+		PrivacyAuditFile:  getEnv("PRIVACY_AUDIT_FILE", "privacy_requests.json"),
+		SellerName:        getEnv("SELLER_NAME", "Product Management Inc."),
+		SellerAddress:     getEnv("SELLER_ADDRESS", ""),
+		SellerEmail:       getEnv("SELLER_EMAIL", ""),
+		SellerTaxID:       getEnv("SELLER_TAX_ID", ""),
+
+		LowStockCheckInterval: getDuration("LOW_STOCK_CHECK_INTERVAL", 5*time.Minute),
+		LowStockWebhookURL:    getEnv("LOW_STOCK_WEBHOOK_URL", ""),
+		PriceScheduleInterval: getDuration("PRICE_SCHEDULE_INTERVAL", time.Minute),
+
+		UserDeleteProducts: strings.ToLower(getEnv("USER_DELETE_PRODUCTS", "soft-cascade")),
+		UserDeleteOrders:   strings.ToLower(getEnv("USER_DELETE_ORDERS", "soft-cascade")),
+		DeletedRetention:   getDuration("DELETED_RETENTION", 30*24*time.Hour),
+		PurgeInterval:      getDuration("PURGE_INTERVAL", time.Hour),
+
+		MediaStorage:       strings.ToLower(getEnv("MEDIA_STORAGE", "local")),
+		MediaDir:           getEnv("MEDIA_DIR", "media"),
+		MediaBaseURL:       strings.TrimRight(getEnv("MEDIA_BASE_URL", "/media"), "/"),
+		MediaMaxBytes:      getInt("MEDIA_MAX_BYTES", 10<<20),
+		MediaThumbnailSize: getInt("MEDIA_THUMBNAIL_SIZE", 320),
+		S3Endpoint:         getEnv("S3_ENDPOINT", ""),
+		S3Bucket:           getEnv("S3_BUCKET", ""),
+		S3Region:           getEnv("S3_REGION", "us-east-1"),
+		S3AccessKey:        getEnv("S3_ACCESS_KEY", ""),
+		S3SecretKey:        getEnv("S3_SECRET_KEY", ""),
+
+		RBACPolicyFile: getEnv("RBAC_POLICY_FILE", ""),
+		AdminUserIDs:   getIDs("ADMIN_USER_IDS"),
+
+		OIDCProvidersFile: getEnv("OIDC_PROVIDERS_FILE", "oidc_providers.json"),
+
+		TenantsFile:   getEnv("TENANTS_FILE", "tenants.json"),
+		DefaultTenant: strings.ToLower(getEnv("DEFAULT_TENANT", "default")),
+
+		WebhooksFile:             getEnv("WEBHOOKS_FILE", "webhooks.json"),
+		WebhookDispatchInterval:  getDuration("WEBHOOK_DISPATCH_INTERVAL", 5*time.Second),
+		WebhookTimeout:           getDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+		WebhookMaxAttempts:       getInt("WEBHOOK_MAX_ATTEMPTS", 8),
+		WebhookRetryBaseDelay:    getDuration("WEBHOOK_RETRY_BASE_DELAY", 30*time.Second),
+		WebhookRetryMaxDelay:     getDuration("WEBHOOK_RETRY_MAX_DELAY", 6*time.Hour),
+		WebhookLogRetention:      getDuration("WEBHOOK_LOG_RETENTION", 7*24*time.Hour),
+		WebhookKafkaPollInterval: getDuration("WEBHOOK_KAFKA_POLL_INTERVAL", 10*time.Second),
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getInt(key string, fallback int) int {
+	value := getEnv(key, "")
+	if value == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		log.Printf("invalid %s %q, using %d", key, value, fallback)
+		return fallback
+	}
+	return n
+}
+
+func getDuration(key string, fallback time.Duration) time.Duration {
+	value := getEnv(key, "")
+	if value == "" {
+		return fallback
+	}
+	if value == "0" {
+		return 0
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		log.Printf("invalid %s %q, using %s", key, value, fallback)
+		return fallback
+	}
+	return d
+}
+
+func getIDs(key string) []int32 {
+	var ids []int32
+	for _, field := range strings.Split(getEnv(key, ""), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		id, err := strconv.Atoi(field)
+		if err != nil || id <= 0 {
+			log.Printf("invalid ID %q in %s, ignoring it", field, key)
+			continue
+		}
+		ids = append(ids, int32(id))
+	}
+	return ids
+}
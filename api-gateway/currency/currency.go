@@ -0,0 +1,195 @@
+// Package currency converts money between currencies using exchange rates
+// loaded from a local JSON file or replaced at runtime by an administrator.
+package currency
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"sync"
+
+	"api-gateway/money"
+	"api-gateway/store"
+)
+
+var (
+	// ErrUnsupportedCurrency is returned when no rate is known for a currency
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	// ErrInvalidRate is returned when a rate is not a positive decimal
+	ErrInvalidRate = errors.New("invalid exchange rate")
+)
+
+var codePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ValidCode reports whether code looks like an ISO 4217 currency code
+func ValidCode(code string) bool {
+	return codePattern.MatchString(code)
+}
+
+// Rates is a table of exchange rates relative to a base currency.
+// Rates are decimal strings so that no precision is lost in JSON.
+// @Description Exchange rates relative to a base currency
+type Rates struct {
+	Base  string            `json:"base" example:"USD"`
+	Rates map[string]string `json:"rates"`
+} //@name ExchangeRates
+
+// Converter converts amounts using the current rate table
+type Converter struct {
+	mu    sync.RWMutex
+	base  string
+	rates map[string]*big.Rat
+	path  string
+}
+
+// NewConverter creates a converter that only knows the base currency.
+// When path is not empty, rates are loaded from and saved to that file.
+func NewConverter(base, path string) (*Converter, error) {
+	c := &Converter{
+		base:  strings.ToUpper(base),
+		rates: map[string]*big.Rat{strings.ToUpper(base): big.NewRat(1, 1)},
+		path:  path,
+	}
+	if path == "" {
+		return c, nil
+	}
+
+	var rates Rates
+	if err := store.Load(path, &rates); err != nil {
+		return nil, err
+	}
+	if rates.Base == "" {
+		return c, nil
+	}
+	if err := c.set(rates); err != nil {
+		return nil, fmt.Errorf("load %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Base returns the currency all rates are expressed against
+func (c *Converter) Base() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.base
+}
+
+// Supports reports whether a rate is known for the currency
+func (c *Converter) Supports(code string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	_, ok := c.rates[strings.ToUpper(code)]
+	return ok
+}
+
+// Rates returns a copy of the current rate table
+func (c *Converter) Rates() Rates {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	rates := Rates{Base: c.base, Rates: make(map[string]string, len(c.rates))}
+	for code, rate := range c.rates {
+		rates.Rates[code] = formatRate(rate)
+	}
+	return rates
+}
+
+// SetRates replaces the rate table and persists it when the converter has a file
+func (c *Converter) SetRates(rates Rates) error {
+	if err := c.set(rates); err != nil {
+		return err
+	}
+	if c.path == "" {
+		return nil
+	}
+
+	return store.Save(c.path, c.Rates())
+}
+
+func (c *Converter) set(rates Rates) error {
+	base := strings.ToUpper(rates.Base)
+	if !ValidCode(base) {
+		return fmt.Errorf("%w: %q", ErrUnsupportedCurrency, rates.Base)
+	}
+
+	table := map[string]*big.Rat{base: big.NewRat(1, 1)}
+	for code, value := range rates.Rates {
+		code = strings.ToUpper(code)
+		if !ValidCode(code) {
+			return fmt.Errorf("%w: %q", ErrUnsupportedCurrency, code)
+		}
+		rate, ok := new(big.Rat).SetString(value)
+		if !ok || rate.Sign() <= 0 {
+			return fmt.Errorf("%w: %s=%q", ErrInvalidRate, code, value)
+		}
+		if code == base && rate.Cmp(big.NewRat(1, 1)) != 0 {
+			return fmt.Errorf("%w: base currency %s must have rate 1", ErrInvalidRate, code)
+		}
+		table[code] = rate
+	}
+
+	c.mu.Lock()
+	c.base = base
+	c.rates = table
+	c.mu.Unlock()
+	return nil
+}
+
+// Rate returns how many units of to one unit of from is worth
+func (c *Converter) Rate(from, to string) (*big.Rat, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	fromRate, ok := c.rates[from]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCurrency, from)
+	}
+	toRate, ok := c.rates[to]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCurrency, to)
+	}
+	return new(big.Rat).Quo(toRate, fromRate), nil
+}
+
+// Convert converts an amount into another currency at the current rate
+func (c *Converter) Convert(m money.Money, to string) (money.Money, error) {
+	rate, err := c.Rate(m.Currency, to)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return ConvertAt(m, to, rate)
+}
+
+// ConvertAt converts an amount into another currency at a fixed rate,
+// rounding half away from zero into the target currency's minor unit
+func ConvertAt(m money.Money, to string, rate *big.Rat) (money.Money, error) {
+	return money.FromRat(new(big.Rat).Mul(m.Rat(), rate), to)
+}
+
+// ParseRate parses a rate previously produced by FormatRate
+func ParseRate(s string) (*big.Rat, error) {
+	rate, ok := new(big.Rat).SetString(s)
+	if !ok || rate.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidRate, s)
+	}
+	return rate, nil
+}
+
+// FormatRate formats a rate as a decimal string with up to 10 decimals
+func FormatRate(rate *big.Rat) string {
+	return formatRate(rate)
+}
+
+func formatRate(rate *big.Rat) string {
+	s := rate.FloatString(10)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"strings"
+
+	"api-gateway/currency"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// displayCurrency returns the currency the caller wants prices rendered in,
+// taken from the currency query parameter or the Accept-Currency header, or
+// else the storefront's currency. An empty string means prices are rendered
+// in their original currency.
+func displayCurrency(c *fiber.Ctx) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(c.Query("currency", c.Get("Accept-Currency"))))
+	if code == "" {
+		code = storefrontCurrency(c)
+	}
+	if code == "" {
+		return "", nil
+	}
+	if !converter.Supports(code) {
+		return "", fiber.NewError(fiber.StatusBadRequest, "Unsupported currency: "+code)
+	}
+	return code, nil
+}
+
+// storefrontCurrency returns the storefront's currency while an exchange
+// rate is known for it, and an empty string otherwise
+func storefrontCurrency(c *fiber.Ctx) string {
+	if code := tenantOf(c).Currency; converter.Supports(code) {
+		return code
+	}
+	return ""
+}
+
+// getExchangeRates Get Exchange Rates
+// @Summary      Get exchange rates
+// @Description  Get the exchange rates used to render prices in other currencies
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  currency.Rates
+// @Router       /admin/exchange-rates [get]
+func getExchangeRates(c *fiber.Ctx) error {
+	return c.JSON(converter.Rates())
+}
+
+// updateExchangeRates Update Exchange Rates
+// @Summary      Replace exchange rates
+// @Description  Replace the exchange rate table. Rates are decimal strings relative to the base currency and are saved to the rates file.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        rates  body      currency.Rates  true  "Exchange rates"
+// @Success      200    {object}  currency.Rates
+// @Failure      400    {object}  models.ErrorResponse
+// @Failure      500    {object}  models.ErrorResponse
+// @Router       /admin/exchange-rates [put]
+func updateExchangeRates(c *fiber.Ctx) error {
+	var req currency.Rates
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	if !strings.EqualFold(req.Base, cfg.DefaultCurrency) {
+		return c.Status(400).JSON(fiber.Map{"error": "Base currency must be " + cfg.DefaultCurrency})
+	}
+
+	if err := converter.SetRates(req); err != nil {
+		if errors.Is(err, currency.ErrUnsupportedCurrency) || errors.Is(err, currency.ErrInvalidRate) {
+			return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(converter.Rates())
+}
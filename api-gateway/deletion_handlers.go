@@ -0,0 +1,325 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/proto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Policies for the products and orders of a deleted user
+const (
+	// policyBlock refuses to delete a user who still owns products or orders
+	policyBlock = "block"
+	// policySoftCascade soft deletes the products and archives the orders
+	// together with the user, so restoring the user brings them back
+	policySoftCascade = "soft-cascade"
+	// policyReassign hands the products or orders over to another user
+	policyReassign = "reassign"
+)
+
+func parseDeletePolicy(field, value string) (string, error) {
+	switch policy := strings.ToLower(strings.TrimSpace(value)); policy {
+	case policyBlock, policySoftCascade, policyReassign:
+		return policy, nil
+	}
+	return "", fiber.NewError(fiber.StatusBadRequest, "Invalid "+field+" policy "+value+": use block, soft-cascade or reassign")
+}
+
+// checkDeleteBlocked returns a 409 error when a block policy applies and the
+// user still owns products or orders
+func checkDeleteBlocked(ctx context.Context, userID int32, productPolicy, orderPolicy string) error {
+	if productPolicy == policyBlock {
+		products, err := clients.ProductClient.GetProductsByUser(ctx, &proto.GetProductsByUserRequest{UserId: userID})
+		if err != nil {
+			return err
+		}
+		if products.Total > 0 {
+			return fiber.NewError(fiber.StatusConflict, fmt.Sprintf("User still owns %d products", products.Total))
+		}
+	}
+	if orderPolicy == policyBlock {
+		orders, err := clients.OrderClient.ListOrders(ctx, &proto.ListOrdersRequest{UserId: userID, Limit: 1})
+		if err != nil {
+			return err
+		}
+		if orders.Total > 0 {
+			return fiber.NewError(fiber.StatusConflict, fmt.Sprintf("User still has %d orders", orders.Total))
+		}
+	}
+	return nil
+}
+
+// reassignUserRecords hands the products and orders of a user over to
+// another user where the reassign policy applies. Products handed over
+// before the orders fail stay with their new owner.
+func reassignUserRecords(ctx context.Context, userID, newUserID int32, products, orders *models.DeletionCascade) error {
+	if products.Policy == policyReassign {
+		resp, err := clients.ProductClient.ReassignUserProducts(ctx, &proto.ReassignUserProductsRequest{
+			UserId:    userID,
+			NewUserId: newUserID,
+		})
+		if err != nil {
+			return err
+		}
+		products.Count = resp.Count
+		products.ReassignedTo = newUserID
+	}
+	if orders.Policy == policyReassign {
+		resp, err := clients.OrderClient.ReassignUserOrders(ctx, &proto.ReassignUserOrdersRequest{
+			UserId:    userID,
+			NewUserId: newUserID,
+		})
+		if err != nil {
+			return err
+		}
+		orders.Count = resp.Count
+		orders.ReassignedTo = newUserID
+	}
+	return nil
+}
+
+// cascadeUserDeletion soft deletes the products and archives the orders of a
+// deleted user where the soft-cascade policy applies. They are stamped with
+// the user's deleted_at, which is how restoreUser finds them again.
+func cascadeUserDeletion(ctx context.Context, userID int32, deletedAt string, products, orders *models.DeletionCascade) error {
+	if products.Policy == policySoftCascade {
+		resp, err := clients.ProductClient.DeleteUserProducts(ctx, &proto.UserProductsRequest{
+			UserId:    userID,
+			DeletedAt: deletedAt,
+		})
+		if err != nil {
+			return err
+		}
+		products.Count = resp.Count
+	}
+	if orders.Policy == policySoftCascade {
+		resp, err := clients.OrderClient.ArchiveUserOrders(ctx, &proto.UserOrdersRequest{
+			UserId:     userID,
+			ArchivedAt: deletedAt,
+		})
+		if err != nil {
+			return err
+		}
+		orders.Count = resp.Count
+	}
+	return nil
+}
+
+// undoUserDeletion restores a user whose deletion failed part way, with the
+// products and orders cascaded so far. It runs even when the request's
+// context has expired; failures are logged, since the request already failed.
+func undoUserDeletion(ctx context.Context, userID int32, deletedAt string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	if _, err := clients.ProductClient.RestoreUserProducts(ctx, &proto.UserProductsRequest{
+		UserId:    userID,
+		DeletedAt: deletedAt,
+	}); err != nil {
+		log.Printf("rollback of user %d: restoring products failed: %v", userID, err)
+	}
+	if _, err := clients.OrderClient.RestoreUserOrders(ctx, &proto.UserOrdersRequest{
+		UserId:     userID,
+		ArchivedAt: deletedAt,
+	}); err != nil {
+		log.Printf("rollback of user %d: restoring orders failed: %v", userID, err)
+	}
+	resp, err := clients.UserClient.RestoreUser(ctx, &proto.RestoreUserRequest{UserId: userID})
+	if err == nil && !resp.Success {
+		err = errors.New(resp.Message)
+	}
+	if err != nil {
+		log.Printf("rollback of user %d: restoring the user failed: %v", userID, err)
+	}
+}
+
+// restoreUser Restore User
+// @Summary      Restore a deleted user
+// @Description  Undo the soft delete of a user before it is purged. Products and orders deleted together with the user are restored too; reassigned ones stay with their new owner.
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Success      200  {object}  models.RestoreUserResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /users/{id}/restore [post]
+func restoreUser(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	current, err := clients.UserClient.GetUser(ctx, &proto.GetUserRequest{
+		UserId:         int32(id),
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !current.Found {
+		return c.Status(404).JSON(fiber.Map{"error": "User not found"})
+	}
+	deletedAt := current.User.DeletedAt
+	if deletedAt == "" {
+		return c.Status(409).JSON(fiber.Map{"error": "User is not deleted"})
+	}
+
+	resp, err := clients.UserClient.RestoreUser(ctx, &proto.RestoreUserRequest{UserId: int32(id)})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !resp.Success {
+		return c.Status(500).JSON(fiber.Map{"error": resp.Message})
+	}
+
+	products, err := clients.ProductClient.RestoreUserProducts(ctx, &proto.UserProductsRequest{
+		UserId:    int32(id),
+		DeletedAt: deletedAt,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+	orders, err := clients.OrderClient.RestoreUserOrders(ctx, &proto.UserOrdersRequest{
+		UserId:     int32(id),
+		ArchivedAt: deletedAt,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success":           resp.Success,
+		"message":           resp.Message,
+		"user":              resp.User,
+		"restored_products": products.Count,
+		"restored_orders":   orders.Count,
+	})
+}
+
+// deleteProduct Delete Product
+// @Summary      Delete a product
+// @Description  Soft delete a product. It disappears from the catalog and can no longer be ordered, but can be restored until it is purged after the retention period.
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Product ID"
+// @Success      200  {object}  models.SuccessResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /products/{id} [delete]
+func deleteProduct(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.DeleteProduct(ctx, &proto.DeleteProductRequest{ProductId: int32(id)})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+	})
+}
+
+// restoreProduct Restore Product
+// @Summary      Restore a deleted product
+// @Description  Undo the soft delete of a product before it is purged
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Product ID"
+// @Success      200  {object}  models.ProductResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /products/{id}/restore [post]
+func restoreProduct(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.RestoreProduct(ctx, &proto.RestoreProductRequest{ProductId: int32(id)})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"product": presentProduct(resp.Product, ""),
+	})
+}
+
+// startPurgeJob hard-deletes users and products that have been soft deleted
+// for longer than the retention period. Archived orders are kept as records.
+func startPurgeJob() {
+	if cfg.PurgeInterval <= 0 || cfg.DeletedRetention <= 0 {
+		log.Println("Purge of deleted records disabled")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(cfg.PurgeInterval)
+		defer ticker.Stop()
+		for {
+			if err := purgeDeleted(); err != nil {
+				log.Printf("purging deleted records failed: %v", err)
+			}
+			<-ticker.C
+		}
+	}()
+	log.Printf("Purging records deleted more than %s ago every %s", cfg.DeletedRetention, cfg.PurgeInterval)
+}
+
+func purgeDeleted() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cutoff := time.Now().UTC().Add(-cfg.DeletedRetention).Format(time.RFC3339)
+
+	users, err := clients.UserClient.PurgeDeletedUsers(ctx, &proto.PurgeDeletedUsersRequest{DeletedBefore: cutoff})
+	if err != nil {
+		return err
+	}
+	products, err := clients.ProductClient.PurgeDeletedProducts(ctx, &proto.PurgeDeletedProductsRequest{DeletedBefore: cutoff})
+	if err != nil {
+		return err
+	}
+	for _, key := range products.StorageKeys {
+		if err := mediaStore.Delete(ctx, key); err != nil {
+			log.Printf("cannot delete media %s of purged product: %v", key, err)
+		}
+	}
+
+	if len(users.UserIds) > 0 || len(products.ProductIds) > 0 {
+		log.Printf("purged %d users and %d products deleted before %s", len(users.UserIds), len(products.ProductIds), cutoff)
+	}
+	return nil
+}
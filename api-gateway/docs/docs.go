@@ -24,32 +24,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/health": {
-            "get": {
-                "description": "Check the health status of the API Gateway and connected services",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Health"
-                ],
-                "summary": "Health check endpoint",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/HealthResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/inventory": {
+        "/admin/exchange-rates": {
             "get": {
-                "description": "Get a paginated list of all inventory items",
+                "description": "Get the exchange rates used to render prices in other currencies",
                 "consumes": [
                     "application/json"
                 ],
@@ -57,42 +34,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Inventory"
-                ],
-                "summary": "List inventory items with pagination",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    }
+                    "Admin"
                 ],
+                "summary": "Get exchange rates",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/InventoryItemsListResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/ErrorResponse"
+                            "$ref": "#/definitions/ExchangeRates"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "Create a new inventory item for a product",
+            "put": {
+                "description": "Replace the exchange rate table. Rates are decimal strings relative to the base currency and are saved to the rates file.",
                 "consumes": [
                     "application/json"
                 ],
@@ -100,25 +55,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Inventory"
+                    "Admin"
                 ],
-                "summary": "Create a new inventory item",
+                "summary": "Replace exchange rates",
                 "parameters": [
                     {
-                        "description": "Inventory item data",
-                        "name": "item",
+                        "description": "Exchange rates",
+                        "name": "rates",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/CreateInventoryItemRequest"
+                            "$ref": "#/definitions/ExchangeRates"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/InventoryItemResponse"
+                            "$ref": "#/definitions/ExchangeRates"
                         }
                     },
                     "400": {
@@ -136,9 +91,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/inventory/check-stock": {
-            "post": {
-                "description": "Check if sufficient stock is available for a product",
+        "/admin/privacy-requests": {
+            "get": {
+                "description": "Get the audit trail of data exports and erasures made on the storefront, newest first, optionally for one user",
                 "consumes": [
                     "application/json"
                 ],
@@ -146,25 +101,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Inventory"
+                    "Admin"
                 ],
-                "summary": "Check stock availability",
+                "summary": "List privacy requests",
                 "parameters": [
                     {
-                        "description": "Stock check data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CheckStockRequest"
-                        }
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/CheckStockResponse"
+                            "$ref": "#/definitions/PrivacyRequestsListResponse"
                         }
                     },
                     "400": {
@@ -172,19 +124,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/ErrorResponse"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/inventory/release-stock": {
-            "post": {
-                "description": "Release stock that was previously reserved",
+        "/admin/promotions": {
+            "get": {
+                "description": "List the promotions of the storefront with their usage",
                 "consumes": [
                     "application/json"
                 ],
@@ -192,45 +138,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Inventory"
-                ],
-                "summary": "Release reserved stock",
-                "parameters": [
-                    {
-                        "description": "Stock release data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/ReleaseStockRequest"
-                        }
-                    }
+                    "Admin"
                 ],
+                "summary": "List promotions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ReleaseStockResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/ErrorResponse"
+                            "$ref": "#/definitions/PromotionsListResponse"
                         }
                     }
                 }
-            }
-        },
-        "/inventory/reserve-stock": {
+            },
             "post": {
-                "description": "Reserve stock for a specific order",
+                "description": "Create a percentage, fixed or buy-X-get-Y promotion on the storefront. Promotions without a code apply automatically. Coupon codes are unique within a storefront.",
                 "consumes": [
                     "application/json"
                 ],
@@ -238,25 +159,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Inventory"
+                    "Admin"
                 ],
-                "summary": "Reserve stock for an order",
+                "summary": "Create a promotion",
                 "parameters": [
                     {
-                        "description": "Stock reservation data",
-                        "name": "request",
+                        "description": "Promotion settings",
+                        "name": "promotion",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/ReserveStockRequest"
+                            "$ref": "#/definitions/PromotionRules"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/ReserveStockResponse"
+                            "$ref": "#/definitions/PromotionResponse"
                         }
                     },
                     "400": {
@@ -265,6 +186,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -274,9 +201,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/inventory/{id}": {
+        "/admin/promotions/{id}": {
             "get": {
-                "description": "Get an inventory item by its ID",
+                "description": "Get a promotion of the storefront and its usage by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -284,13 +211,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Inventory"
+                    "Admin"
                 ],
-                "summary": "Get inventory item by ID",
+                "summary": "Get promotion by ID",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Inventory Item ID",
+                        "type": "string",
+                        "description": "Promotion ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -300,13 +227,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/InventoryItemResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/ErrorResponse"
+                            "$ref": "#/definitions/PromotionResponse"
                         }
                     },
                     "404": {
@@ -314,17 +235,11 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/ErrorResponse"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/ErrorResponse"
-                        }
                     }
                 }
             },
             "put": {
-                "description": "Update an inventory item's quantity and location",
+                "description": "Replace the settings of a promotion. Its usage is kept.",
                 "consumes": [
                     "application/json"
                 ],
@@ -332,24 +247,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Inventory"
+                    "Admin"
                 ],
-                "summary": "Update inventory item",
+                "summary": "Update a promotion",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Inventory Item ID",
+                        "type": "string",
+                        "description": "Promotion ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Inventory item update data",
-                        "name": "item",
+                        "description": "Promotion settings",
+                        "name": "promotion",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/UpdateInventoryItemRequest"
+                            "$ref": "#/definitions/PromotionRules"
                         }
                     }
                 ],
@@ -357,7 +272,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/InventoryItemResponse"
+                            "$ref": "#/definitions/PromotionResponse"
                         }
                     },
                     "400": {
@@ -366,6 +281,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -373,11 +300,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/orders": {
-            "get": {
-                "description": "Get a paginated list of orders, optionally filtered by user",
+            },
+            "delete": {
+                "description": "Delete a promotion and its usage history. Orders keep their recorded discounts.",
                 "consumes": [
                     "application/json"
                 ],
@@ -385,36 +310,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Admin"
                 ],
-                "summary": "List orders with pagination",
+                "summary": "Delete a promotion",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID filter",
-                        "name": "user_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/OrdersListResponse"
+                            "$ref": "#/definitions/SuccessResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
                         }
                     },
                     "500": {
@@ -424,9 +342,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new order with items",
+            }
+        },
+        "/admin/roles": {
+            "get": {
+                "description": "Get the roles users can hold and the permissions each grants. Permissions ending in :own only cover the caller's own records.",
                 "consumes": [
                     "application/json"
                 ],
@@ -434,35 +354,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
-                ],
-                "summary": "Create a new order",
-                "parameters": [
-                    {
-                        "description": "Order data",
-                        "name": "order",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CreateOrderRequest"
-                        }
-                    }
+                    "Admin"
                 ],
+                "summary": "List roles",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/OrderResponse"
+                            "$ref": "#/definitions/RolesListResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/ErrorResponse"
                         }
@@ -470,9 +379,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders/{id}": {
+        "/admin/users/{id}/roles": {
             "get": {
-                "description": "Get an order by its ID",
+                "description": "Get the roles a user holds and the permissions they add up to",
                 "consumes": [
                     "application/json"
                 ],
@@ -480,13 +389,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Admin"
                 ],
-                "summary": "Get order by ID",
+                "summary": "Get the roles of a user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Order ID",
+                        "type": "integer",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -496,7 +405,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/OrderResponse"
+                            "$ref": "#/definitions/UserRolesResponse"
                         }
                     },
                     "400": {
@@ -505,6 +414,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -518,11 +439,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/orders/{id}/status": {
-            "put": {
-                "description": "Update the status of an order",
+            },
+            "post": {
+                "description": "Add a role such as seller or admin to a user. Granting a role the user already holds changes nothing.",
                 "consumes": [
                     "application/json"
                 ],
@@ -530,24 +449,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Admin"
                 ],
-                "summary": "Update order status",
+                "summary": "Grant a role to a user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Order ID",
+                        "type": "integer",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Status update data",
-                        "name": "status",
+                        "description": "Role to grant",
+                        "name": "role",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/UpdateOrderStatusRequest"
+                            "$ref": "#/definitions/GrantRoleRequest"
                         }
                     }
                 ],
@@ -555,7 +474,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/OrderResponse"
+                            "$ref": "#/definitions/UserRolesResponse"
                         }
                     },
                     "400": {
@@ -564,6 +483,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -573,9 +510,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/products": {
-            "get": {
-                "description": "Get a paginated list of all products",
+        "/admin/users/{id}/roles/{role}": {
+            "delete": {
+                "description": "Remove a role from a user. Admins cannot revoke their own admin role, so there is always someone left to grant roles.",
                 "consumes": [
                     "application/json"
                 ],
@@ -583,30 +520,60 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Admin"
                 ],
-                "summary": "List all products with pagination",
+                "summary": "Revoke a role from a user",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Role",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ProductsListResponse"
+                            "$ref": "#/definitions/UserRolesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
                         }
                     },
                     "500": {
@@ -616,9 +583,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/login": {
             "post": {
-                "description": "Create a new product with name, description, price and user_id",
+                "description": "Exchange an email and password for an access token, sent as \"Authorization: Bearer \u003ctoken\u003e\" on later requests, and a refresh token to renew it",
                 "consumes": [
                     "application/json"
                 ],
@@ -626,17 +595,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Auth"
                 ],
-                "summary": "Create a new product",
+                "summary": "Log in",
                 "parameters": [
                     {
-                        "description": "Product creation request",
-                        "name": "product",
+                        "description": "Email and password",
+                        "name": "credentials",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/CreateProductRequest"
+                            "$ref": "#/definitions/LoginRequest"
                         }
                     }
                 ],
@@ -644,7 +613,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ProductResponse"
+                            "$ref": "#/definitions/AuthTokensResponse"
                         }
                     },
                     "400": {
@@ -653,6 +622,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -662,9 +637,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/{id}": {
-            "get": {
-                "description": "Get a product by its ID",
+        "/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke the session of the access token, or of refresh_token when given. all_sessions signs the user out everywhere.",
                 "consumes": [
                     "application/json"
                 ],
@@ -672,23 +652,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Auth"
                 ],
-                "summary": "Get product by ID",
+                "summary": "Log out",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Product ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Session to end",
+                        "name": "logout",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/LogoutRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Product"
+                            "$ref": "#/definitions/LogoutResponse"
                         }
                     },
                     "400": {
@@ -697,8 +678,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/ErrorResponse"
                         }
@@ -712,9 +693,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/auth/me": {
             "get": {
-                "description": "Get a paginated list of all users",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the user the access token belongs to, with the permissions of their roles",
                 "consumes": [
                     "application/json"
                 ],
@@ -722,30 +708,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
-                ],
-                "summary": "List all users with pagination",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    }
+                    "Auth"
                 ],
+                "summary": "Get the signed-in user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/UsersListResponse"
+                            "$ref": "#/definitions/CurrentUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
                         }
                     },
                     "500": {
@@ -755,9 +731,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new user with name, email, and age",
+            }
+        },
+        "/auth/oidc": {
+            "get": {
+                "description": "Get the external identity providers users can sign in with",
                 "consumes": [
                     "application/json"
                 ],
@@ -765,25 +743,62 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Auth"
                 ],
-                "summary": "Create a new user",
-                "parameters": [
-                    {
-                        "description": "User creation request",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
+                "summary": "List identity providers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/CreateUserRequest"
+                            "$ref": "#/definitions/OIDCProvidersResponse"
                         }
                     }
+                }
+            }
+        },
+        "/auth/oidc/{provider}/callback": {
+            "get": {
+                "description": "The identity provider redirects here after sign-in. The code is exchanged and the ID token verified; the user is linked by the provider's subject, created on first login at the storefront the sign-in started at, and holds the roles the provider's groups map to.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Complete a sign-in with an identity provider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider name",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "State of the sign-in",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Error reported by the provider",
+                        "name": "error",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/UserResponse"
+                            "$ref": "#/definitions/AuthTokensResponse"
                         }
                     },
                     "400": {
@@ -792,6 +807,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -801,40 +834,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
+        "/auth/oidc/{provider}/login": {
             "get": {
-                "description": "Get a user by their ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Redirect to the identity provider to sign in with the authorization code flow and PKCE. The provider sends the user back to the callback.",
                 "tags": [
-                    "Users"
+                    "Auth"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Sign in with an identity provider",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
+                        "type": "string",
+                        "description": "Provider name",
+                        "name": "provider",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/User"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/ErrorResponse"
-                        }
+                    "302": {
+                        "description": "Found"
                     },
                     "404": {
                         "description": "Not Found",
@@ -842,16 +860,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "502": {
+                        "description": "Bad Gateway",
                         "schema": {
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a user's information by ID",
+            }
+        },
+        "/auth/password-reset/confirm": {
+            "post": {
+                "description": "Set a new password with the token from the password reset email. Every session of the user is revoked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -859,24 +879,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Auth"
                 ],
-                "summary": "Update an existing user",
+                "summary": "Reset a password",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "User update request",
-                        "name": "user",
+                        "description": "Reset token and new password",
+                        "name": "reset",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/UpdateUserRequest"
+                            "$ref": "#/definitions/ResetPasswordRequest"
                         }
                     }
                 ],
@@ -884,7 +897,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/UserResponse"
+                            "$ref": "#/definitions/AuthActionResponse"
                         }
                     },
                     "400": {
@@ -900,9 +913,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a user by their ID",
+            }
+        },
+        "/auth/password-reset/request": {
+            "post": {
+                "description": "Mail a password reset token. The answer is the same whether or not the email belongs to a user.",
                 "consumes": [
                     "application/json"
                 ],
@@ -910,23 +925,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Auth"
                 ],
-                "summary": "Delete a user",
+                "summary": "Request a password reset",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Email address",
+                        "name": "email",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/EmailRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/SuccessResponse"
+                            "$ref": "#/definitions/AuthActionResponse"
                         }
                     },
                     "400": {
@@ -944,9 +961,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}/products": {
-            "get": {
-                "description": "Get all products belonging to a specific user",
+        "/auth/refresh": {
+            "post": {
+                "description": "Exchange a refresh token for a new access and refresh token. Each refresh token works once; presenting one a second time revokes the whole session.",
                 "consumes": [
                     "application/json"
                 ],
@@ -954,13 +971,292 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Auth"
                 ],
-                "summary": "Get products by user ID",
+                "summary": "Refresh tokens",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/AuthTokensResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/signup": {
+            "post": {
+                "description": "Create an account with a password. The new user is a customer and receives an email with a link to verify their address.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Sign up",
+                "parameters": [
+                    {
+                        "description": "Account data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SignupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/AuthActionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/verify-email": {
+            "get": {
+                "description": "Confirm a user's email address with the token from the verification email. This is the link the email points to.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Verify an email address",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Verification token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/AuthActionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/verify-email/resend": {
+            "post": {
+                "description": "Mail a new verification link. The answer is the same whether or not the email belongs to an unverified user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Resend the verification email",
+                "parameters": [
+                    {
+                        "description": "Email address",
+                        "name": "email",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/EmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/AuthActionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "description": "Get every category nested under its parent, root categories first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Get the category tree",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CategoryTreeResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a product category, at the root of the tree or below parent_id. The slug is derived from the name when omitted and must be unique.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Create a category",
+                "parameters": [
+                    {
+                        "description": "Category data",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{id}": {
+            "get": {
+                "description": "Get a category with its breadcrumbs from the root and its direct subcategories",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Get category by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
+                        "description": "Category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -970,7 +1266,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/UserProductsResponse"
+                            "$ref": "#/definitions/CategoryResponse"
                         }
                     },
                     "400": {
@@ -979,6 +1275,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -986,119 +1288,8184 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "CheckStockRequest": {
-            "description": "Request body for checking stock availability",
+            },
+            "put": {
+                "description": "Change the name, slug or description of a category; omitted fields are left unchanged. Use the move endpoint to change its parent.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Update a category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Category update",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a category without subcategories. Its products stay in the catalog and are only removed from the category.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Delete a category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{id}/move": {
+            "put": {
+                "description": "Move a category and its whole subtree below another category, or to the root with parent_id 0. A category cannot be moved into its own subtree.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Move a category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New parent",
+                        "name": "move",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MoveCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{id}/products": {
+            "get": {
+                "description": "Get a paginated list of the products in a category and, unless include_descendants is false, in all of its subcategories",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "List the products in a category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Include products in subcategories",
+                        "name": "include_descendants",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in (overrides Accept-Currency)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in",
+                        "name": "Accept-Currency",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CategoryProductsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Check the health status of the API Gateway and connected services",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Health check endpoint",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory": {
+            "get": {
+                "description": "Get a paginated list of inventory items, optionally limited to one warehouse, product or variant",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "List inventory items with pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only items stored in this warehouse",
+                        "name": "warehouse_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only items of this product",
+                        "name": "product_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only items of this variant",
+                        "name": "variant_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only items of the variant with this SKU",
+                        "name": "sku",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only items at or below their reorder point",
+                        "name": "low_stock",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/InventoryItemsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add stock of a product to a warehouse, given by warehouse_id or by location code. Unknown location codes create a new warehouse. Stock of a product variant is given by variant_id or sku.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Create a new inventory item",
+                "parameters": [
+                    {
+                        "description": "Inventory item data",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateInventoryItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/InventoryItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/check-stock": {
+            "post": {
+                "description": "Check if sufficient stock is available for a product, or a variant given by variant_id or sku, across all active warehouses, with the stock level of each warehouse",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Check stock availability",
+                "parameters": [
+                    {
+                        "description": "Stock check data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CheckStockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CheckStockResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/release-batch": {
+            "post": {
+                "description": "Release every active reservation held for an order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Release all reservations of an order",
+                "parameters": [
+                    {
+                        "description": "Order to release",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ReleaseBatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReleaseBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/release-stock": {
+            "post": {
+                "description": "Release stock that was previously reserved",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Release reserved stock",
+                "parameters": [
+                    {
+                        "description": "Stock release data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ReleaseStockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReleaseStockResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/reorder-suggestions": {
+            "get": {
+                "description": "Suggest what to reorder from current stock, reorder settings and the sales velocity of recent orders. Products, and each variant on its own, are listed when stock on hand and in transit is at or below their reorder point, or would sell out before a delivery ordered today arrives.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Suggest purchase quantities",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Days of order history to average sales over",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 7,
+                        "description": "Days a purchase order takes to arrive",
+                        "name": "lead_time_days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Days a delivery should last",
+                        "name": "coverage_days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReorderSuggestionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/reservations": {
+            "get": {
+                "description": "Get a paginated list of active stock reservations, soonest to expire first, optionally filtered by order, product or how soon they expire. Released, expired and fulfilled reservations are included with include_inactive.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "List stock reservations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "order_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only reservations expiring within this duration, e.g. 10m",
+                        "name": "expiring_within",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include released, expired and fulfilled reservations",
+                        "name": "include_inactive",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReservationsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/reservations/{id}/extend": {
+            "post": {
+                "description": "Push back the expiry of an active reservation by extend_seconds, counted from its current expiry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Extend a stock reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Extension",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ExtendReservationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/reserve-batch": {
+            "post": {
+                "description": "Reserve every line of an order in one transaction: either all lines are reserved or none are. When stock is short, success is false and shortfalls lists each line that could not be covered. Strategy, shipping_location and ttl_seconds apply to every line as in reserve-stock. Lines name a product variant by variant_id or sku.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Reserve stock for several products at once",
+                "parameters": [
+                    {
+                        "description": "Order lines to reserve",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ReserveBatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReserveBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/reserve-stock": {
+            "post": {
+                "description": "Reserve stock for a specific order. The reservation holds the stock for ttl_seconds (30 minutes by default) and is released automatically when it expires unless the order ships, it is released or it is extended first. The strategy picks the warehouses to draw from: nearest ships from the single warehouse closest to shipping_location, largest_stock from the single warehouse with the most stock, and split (the default) draws from several warehouses, nearest first when shipping_location is given. Stock of a product variant is reserved by variant_id or sku.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Reserve stock for an order",
+                "parameters": [
+                    {
+                        "description": "Stock reservation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ReserveStockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReserveStockResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/transfers": {
+            "get": {
+                "description": "Get a paginated list of stock transfers, newest first, optionally filtered by status, warehouse (source or destination) or product",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "List stock transfers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "REQUESTED",
+                            "IN_TRANSIT",
+                            "RECEIVED",
+                            "CANCELLED"
+                        ],
+                        "type": "string",
+                        "description": "Transfer status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Source or destination warehouse",
+                        "name": "warehouse_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TransfersListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Request moving stock of a product, or a variant given by variant_id or sku, from one warehouse to another. The quantity is held at the source until the transfer is dispatched.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Request a stock transfer",
+                "parameters": [
+                    {
+                        "description": "Transfer data",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/transfers/{id}": {
+            "get": {
+                "description": "Get a stock transfer with its full audit trail",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Get stock transfer by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TransferResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/transfers/{id}/status": {
+            "put": {
+                "description": "IN_TRANSIT takes the stock out of the source warehouse, RECEIVED adds it to the destination. Cancelling a requested transfer releases the hold; cancelling one in transit returns the stock to the source.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Dispatch, receive or cancel a stock transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status update data",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateTransferStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/{id}": {
+            "get": {
+                "description": "Get an inventory item by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Get inventory item by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inventory Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/InventoryItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Adjust an inventory item's quantity by a relative amount (\"+5\", \"-3\") with a mandatory reason, and optionally move it to another warehouse. Every change is recorded in the item's history. Stock with active reservations cannot be moved.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Adjust inventory item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inventory Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Inventory item update data",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateInventoryItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/InventoryItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory/{id}/history": {
+            "get": {
+                "description": "Get the ledger of an inventory item, newest first. Every change to its quantity or reserved quantity is recorded with type, reason, actor, delta and resulting balance.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Get the stock history of an inventory item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inventory Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/InventoryHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders": {
+            "get": {
+                "description": "Get a paginated list of orders, optionally filtered by user. Orders archived with a deleted user are only listed with include_archived=true.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "List orders with pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID filter",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include orders archived with their deleted user",
+                        "name": "include_archived",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in (overrides Accept-Currency)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in",
+                        "name": "Accept-Currency",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/OrdersListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new order with items. Unit prices and totals are computed from the product catalog; a submitted price that differs from the catalog is rejected with 409. Automatic promotions and the given coupon codes are applied; a coupon that does not apply is rejected with 422. Tax is calculated for tax_region, or the default region when omitted. Stock is reserved for every line; lines beyond the available stock are rejected with 409 unless the product is backorderable or pre-orderable, in which case they are accepted as BACKORDERED and allocated first come first served when stock arrives.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Create a new order",
+                "parameters": [
+                    {
+                        "description": "Order data",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateOrderRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in (overrides Accept-Currency)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in",
+                        "name": "Accept-Currency",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}": {
+            "get": {
+                "description": "Get an order by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Get order by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in (overrides Accept-Currency)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in",
+                        "name": "Accept-Currency",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/invoice.html": {
+            "get": {
+                "description": "Issue the invoice of an order on first request and return it as an HTML page. Later requests return the same stored document.",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Get order invoice as HTML",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/invoice.pdf": {
+            "get": {
+                "description": "Issue the invoice of an order on first request and return it as PDF. Later downloads return the same stored document.",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Download order invoice as PDF",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/status": {
+            "put": {
+                "description": "Update the status of an order. Shipping consumes the stock reserved for the order and is refused with 409 while lines are backordered; cancelling releases it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Update order status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status update data",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateOrderStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "get": {
+                "description": "Get a paginated list of all products. Filter by category_id to list a category, including its subcategories when include_descendants is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "List all products with pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only products in this category",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Also include products in subcategories of category_id",
+                        "name": "include_descendants",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted products; only for product managers",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in (overrides Accept-Currency)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in",
+                        "name": "Accept-Currency",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProductsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new product with name, description, price and user_id. Set stock_policy to backorder or preorder to accept orders beyond the available stock, with the expected_restock_date shown to customers. Options such as size and color define the variants the product can be sold in.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Create a new product",
+                "parameters": [
+                    {
+                        "description": "Product creation request",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/sku/{sku}": {
+            "get": {
+                "description": "Get the variant with the given SKU and the product it belongs to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Look up a product by SKU",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Variant SKU",
+                        "name": "sku",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in (overrides Accept-Currency)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in",
+                        "name": "Accept-Currency",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SKULookupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}": {
+            "get": {
+                "description": "Get a product by its ID, with its average rating and rating histogram",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Get product by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also find a soft-deleted product; only for its seller and product managers",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in (overrides Accept-Currency)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in",
+                        "name": "Accept-Currency",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Product"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update the given fields of a product; omitted fields are left unchanged. Options replace the product's options when given and must still fit its existing variants.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Update a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Product update request",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft delete a product. It disappears from the catalog and can no longer be ordered, but can be restored until it is purged after the retention period.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Delete a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/media": {
+            "get": {
+                "description": "Get the images of a product in display order with their URLs and thumbnails",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "List the images of a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProductMediaListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Upload a JPEG, PNG or GIF image as multipart form field file. The type is checked against the file contents and the size against MEDIA_MAX_BYTES. A thumbnail is generated, and the image is added after the product's existing media; the first image, or one uploaded with primary=true, becomes the primary image.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Upload a product image",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alternative text",
+                        "name": "alt_text",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Make this the primary image",
+                        "name": "primary",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ProductMediaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/media/{mediaId}": {
+            "put": {
+                "description": "Move an image to another position, make it the primary image or change its alt text; omitted fields are left unchanged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Reorder or describe a product image",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Media ID",
+                        "name": "mediaId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Media update",
+                        "name": "media",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateProductMediaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProductMediaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove an image and its thumbnail. When it was the primary image, the next image becomes primary.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Delete a product image",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Media ID",
+                        "name": "mediaId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/price-history": {
+            "get": {
+                "description": "List every change to the price of a product and its variants, newest first, with the price version it produced. Orders record the version their lines were priced at.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Prices"
+                ],
+                "summary": "Get the price history of a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PriceHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/price-schedules": {
+            "get": {
+                "description": "Get the upcoming and running price schedules of a product in start order, or all of them with include_finished=true",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Prices"
+                ],
+                "summary": "List the price schedules of a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include completed and cancelled schedules",
+                        "name": "include_finished",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PriceSchedulesListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Set the product price from starts_at, for example for a sale. With ends_at the earlier price is restored at that time, unless the price was changed by hand in the meantime. Schedules of a product may not overlap.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Prices"
+                ],
+                "summary": "Schedule a price change",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Scheduled price",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreatePriceScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/PriceScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/price-schedules/{scheduleId}": {
+            "delete": {
+                "description": "Cancel an upcoming price schedule, or end a running one early and restore the price it replaced",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Prices"
+                ],
+                "summary": "Cancel a price schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "scheduleId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PriceScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/restore": {
+            "post": {
+                "description": "Undo the soft delete of a product before it is purged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Restore a deleted product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/reviews": {
+            "get": {
+                "description": "Get a page of the approved reviews of a product with its rating summary. Moderators pass status PENDING, REJECTED or ALL to see other reviews.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "List the reviews of a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest",
+                            "highest",
+                            "lowest"
+                        ],
+                        "type": "string",
+                        "default": "newest",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "APPROVED",
+                            "PENDING",
+                            "REJECTED",
+                            "ALL"
+                        ],
+                        "type": "string",
+                        "default": "APPROVED",
+                        "description": "Moderation status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReviewsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Rate a product from 1 to 5 stars with an optional title and text. Only users with a delivered order containing the product can review it, once per product; the review is published right away.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Review a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review",
+                        "name": "review",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/reviews/{reviewId}": {
+            "put": {
+                "description": "Change the rating, title or text of a review; only its author and moderators can. A rejected review goes back to moderation once its author changes it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Update a review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "reviewId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review update",
+                        "name": "review",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a review; only its author and moderators can. Moderators usually hide reviews by rejecting them instead.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Delete a review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "reviewId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/reviews/{reviewId}/moderation": {
+            "put": {
+                "description": "Approve, reject or hold a review for moderation. Only approved reviews are listed publicly and count towards the product rating.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Moderate a review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "reviewId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderation decision",
+                        "name": "moderation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ModerateReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/variants": {
+            "get": {
+                "description": "Get every variant of a product with its SKU, options and effective price",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "List the variants of a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in (overrides Accept-Currency)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in",
+                        "name": "Accept-Currency",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/VariantsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a variant with its own SKU for one combination of the product's options, such as size M in red. Options must give one allowed value for every option of the product. Price overrides the product price for this variant.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Add a variant to a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Variant data",
+                        "name": "variant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateVariantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/VariantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/variants/{variantId}": {
+            "put": {
+                "description": "Change the SKU, options or price override of a variant; omitted fields are left unchanged. Set clear_price to sell the variant at the product price again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Update a product variant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Variant ID",
+                        "name": "variantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Variant update",
+                        "name": "variant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateVariantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/VariantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a variant from a product. Its stock records are kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Delete a product variant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Variant ID",
+                        "name": "variantId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/promotions/evaluate": {
+            "post": {
+                "description": "Price a cart from the catalog, apply automatic promotions and coupon codes and calculate tax without placing an order. Coupons that do not apply are listed with the reason.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Preview discounts on a cart",
+                "parameters": [
+                    {
+                        "description": "Cart to evaluate",
+                        "name": "cart",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/EvaluatePromotionsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to settle the cart in (overrides Accept-Currency)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to settle the cart in",
+                        "name": "Accept-Currency",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PromotionEvaluationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/storefront": {
+            "get": {
+                "description": "Get the storefront the request is served for, chosen by the /t/{tenant} path prefix, the host or the signed-in user, with its default currency and the features it offers",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Storefront"
+                ],
+                "summary": "Get the storefront",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Storefront"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "Get a paginated list of all users, including soft-deleted ones with include_deleted=true",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List all users with pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted users",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/UsersListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new user with name, email, and age",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "User creation request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "description": "Get a user by their ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also find a soft-deleted user",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a user's information by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update an existing user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User update request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft delete a user. The products and orders policies decide what happens to what the user owns: block refuses the delete while there are any, soft-cascade deletes the products and archives the orders with the user, and reassign hands them to reassign_to, which only admins can choose. The defaults come from USER_DELETE_PRODUCTS and USER_DELETE_ORDERS. If a step fails the deletion is rolled back. The user can be restored until it is purged after the retention period.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "block",
+                            "soft-cascade",
+                            "reassign"
+                        ],
+                        "type": "string",
+                        "description": "Policy for the user's products",
+                        "name": "products",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "block",
+                            "soft-cascade",
+                            "reassign"
+                        ],
+                        "type": "string",
+                        "description": "Policy for the user's orders",
+                        "name": "orders",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User receiving reassigned products and orders",
+                        "name": "reassign_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/DeleteUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the API keys of a user with their scopes, expiry and when they were last used. Keys themselves are never shown again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "List API keys",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include revoked keys",
+                        "name": "include_revoked",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/APIKeysListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a key for a machine client such as a warehouse scanner. The client sends it as X-API-Key and acts as the user, limited to the key's scopes (e.g. inventory:write, orders:read). The key is only shown in this response.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Create an API key",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "API key data",
+                        "name": "key",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateAPIKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/APIKeyCreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/api-keys/{keyId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke an API key; clients using it are refused from the next request on",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Revoke an API key",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "API key ID",
+                        "name": "keyId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/APIKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/data-export": {
+            "get": {
+                "description": "Download everything held about a user: the profile, their products and orders including deleted and archived ones, the reviews they wrote, the invoices issued to them and their earlier privacy requests. format=zip returns one JSON file per section plus the invoice PDFs. Every export is recorded in the privacy audit log.",
+                "produces": [
+                    "application/json",
+                    "application/zip"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Export a user's data",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "zip"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/UserDataExport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/erase": {
+            "post": {
+                "description": "Anonymize the name, email and age of a user, whether active or soft deleted, and delete the reviews they wrote. Orders and issued invoices are kept as accounting records and stay linked to the anonymized user. Every erasure is recorded in the privacy audit log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Erase a user's personal data",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/EraseUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/products": {
+            "get": {
+                "description": "Get all products belonging to a specific user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get products by user ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted products; only for the user and product managers",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in (overrides Accept-Currency)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency to render prices in",
+                        "name": "Accept-Currency",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/UserProductsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/restore": {
+            "post": {
+                "description": "Undo the soft delete of a user before it is purged. Products and orders deleted together with the user are restored too; reassigned ones stay with their new owner.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Restore a deleted user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/RestoreUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/warehouses": {
+            "get": {
+                "description": "Get all active warehouses, or all warehouses with include_inactive=true",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Warehouses"
+                ],
+                "summary": "List warehouses",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include deactivated warehouses",
+                        "name": "include_inactive",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WarehousesListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a stock location. Coordinates are optional and enable nearest-warehouse allocation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Warehouses"
+                ],
+                "summary": "Create a warehouse",
+                "parameters": [
+                    {
+                        "description": "Warehouse data",
+                        "name": "warehouse",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWarehouseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/WarehouseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/warehouses/{id}": {
+            "get": {
+                "description": "Get a warehouse by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Warehouses"
+                ],
+                "summary": "Get warehouse by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Warehouse ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WarehouseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a warehouse. Omitted fields keep their value; deactivated warehouses are skipped by stock checks and reservations.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Warehouses"
+                ],
+                "summary": "Update warehouse",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Warehouse ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Warehouse update data",
+                        "name": "warehouse",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateWarehouseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WarehouseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a warehouse. Warehouses that still hold stock cannot be deleted; deactivate them instead.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Warehouses"
+                ],
+                "summary": "Delete warehouse",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Warehouse ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "description": "List the webhook endpoints registered on the storefront, without their secrets",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WebhooksListResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register an endpoint to be notified of the storefront's events. Deliveries are POSTed as JSON and signed: X-Webhook-Signature is sha256=\u003chex HMAC-SHA256 of \"\u003cX-Webhook-Timestamp\u003e.\u003cbody\u003e\"\u003e keyed with the returned secret, which is not shown again. Failed deliveries are retried with exponential backoff and dead-lettered after WEBHOOK_MAX_ATTEMPTS attempts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Register a webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook to register",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/events": {
+            "get": {
+                "description": "List the events webhooks can subscribe to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook event types",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WebhookEventTypesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "description": "Get a webhook endpoint of the storefront, without its secret",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhook by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WebhookResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update the URL, events, description or active state of a webhook; omitted fields are left unchanged. Deliveries queued while a webhook is inactive are sent once it is active again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook changes",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a webhook endpoint with its pending deliveries and delivery log",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SuccessResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "description": "Get the delivery log of a webhook, newest first: every event queued for it with the outcome of each attempt. Dead deliveries are the dead letters; successful ones are kept for WEBHOOK_LOG_RETENTION.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List a webhook's deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "PENDING",
+                            "DELIVERED",
+                            "DEAD"
+                        ],
+                        "type": "string",
+                        "description": "Only deliveries with this status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WebhookDeliveriesListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries/{deliveryId}/retry": {
+            "post": {
+                "description": "Queue a dead-lettered delivery again. It gets one more attempt right away and is dead again if that fails too.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Retry a dead delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Delivery ID",
+                        "name": "deliveryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WebhookDeliveryResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/rotate-secret": {
+            "post": {
+                "description": "Replace the signing secret of a webhook. The new secret is returned once and signs every later attempt, including retries of earlier deliveries.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Rotate a webhook's secret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WebhookResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/test": {
+            "post": {
+                "description": "Send a webhook.test event to the endpoint right away, whatever its event filter and even while it is inactive, and return the delivery with the outcome. A failed test is retried like any other delivery.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Send a test event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WebhookDeliveryResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "APIKey": {
+            "description": "API key information",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T12:00:00Z"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2024-03-01T08:30:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Warehouse scanner 3"
+                },
+                "prefix": {
+                    "description": "Prefix is the start of the key, to tell keys apart",
+                    "type": "string",
+                    "example": "mk_Q2x9Lw0a"
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "example": "2024-06-01T12:00:00Z"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "inventory:write",
+                        "orders:read"
+                    ]
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "APIKeyCreatedResponse": {
+            "description": "API key created response",
+            "type": "object",
+            "properties": {
+                "api_key": {
+                    "$ref": "#/definitions/APIKey"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "mk_Q2x9Lw0aV7c..."
+                },
+                "message": {
+                    "type": "string",
+                    "example": "API key created; store it now, it is not shown again"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "APIKeyResponse": {
+            "description": "API key response",
+            "type": "object",
+            "properties": {
+                "api_key": {
+                    "$ref": "#/definitions/APIKey"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "API key revoked successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "APIKeysListResponse": {
+            "description": "API keys list response",
+            "type": "object",
+            "properties": {
+                "api_keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/APIKey"
+                    }
+                }
+            }
+        },
+        "AuthActionResponse": {
+            "description": "Account action response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Email verified successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "user": {
+                    "$ref": "#/definitions/User"
+                }
+            }
+        },
+        "AuthTokensResponse": {
+            "description": "Access and refresh tokens",
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string",
+                    "example": "q3Jx0c9u..."
+                },
+                "access_token_expires_at": {
+                    "type": "string",
+                    "example": "2024-01-01T12:15:00Z"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Logged in successfully"
+                },
+                "refresh_token": {
+                    "type": "string",
+                    "example": "Zk4mT1b8..."
+                },
+                "refresh_token_expires_at": {
+                    "type": "string",
+                    "example": "2024-01-31T12:00:00Z"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "token_type": {
+                    "type": "string",
+                    "example": "Bearer"
+                },
+                "user": {
+                    "$ref": "#/definitions/User"
+                }
+            }
+        },
+        "Category": {
+            "description": "Product category",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Phones and accessories"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Smartphones"
+                },
+                "parent_id": {
+                    "description": "ParentID is omitted for root categories",
+                    "type": "integer",
+                    "example": 3
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "smartphones"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                }
+            }
+        },
+        "CategoryProductsResponse": {
+            "description": "Category products response",
+            "type": "object",
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/Category"
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Product"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 50
+                }
+            }
+        },
+        "CategoryResponse": {
+            "description": "Category response",
+            "type": "object",
+            "properties": {
+                "breadcrumbs": {
+                    "description": "Breadcrumbs is the path from the root down to the category itself",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Category"
+                    }
+                },
+                "category": {
+                    "$ref": "#/definitions/Category"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Category"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Category created successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "CategoryTreeResponse": {
+            "description": "Category tree response",
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CategoryNode"
+                    }
+                }
+            }
+        },
+        "CheckStockRequest": {
+            "description": "Request body for checking stock availability",
+            "type": "object",
+            "required": [
+                "product_id",
+                "required_quantity"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "required_quantity": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "TSHIRT-M-RED"
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "CheckStockResponse": {
+            "description": "Stock availability response",
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "available_quantity": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "in_transit_quantity": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "locations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LocationStock"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Stock is available"
+                }
+            }
+        },
+        "CreateAPIKeyRequest": {
+            "description": "Request body for creating an API key",
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_at": {
+                    "description": "ExpiresAt is an RFC 3339 time; keys without it never expire",
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Warehouse scanner 3"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "inventory:write",
+                        "orders:read"
+                    ]
+                }
+            }
+        },
+        "CreateCategoryRequest": {
+            "description": "Request body for creating a category",
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Phones and accessories"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Smartphones"
+                },
+                "parent_id": {
+                    "description": "ParentID places the category below another one; omit it for a root category",
+                    "type": "integer",
+                    "example": 3
+                },
+                "slug": {
+                    "description": "Slug is derived from the name when omitted",
+                    "type": "string",
+                    "example": "smartphones"
+                }
+            }
+        },
+        "CreateInventoryItemRequest": {
+            "description": "Request body for creating an inventory item",
+            "type": "object",
+            "required": [
+                "product_id",
+                "quantity"
+            ],
+            "properties": {
+                "location": {
+                    "type": "string",
+                    "example": "WH-EAST"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "PO-2023-117"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "reorder_point": {
+                    "description": "Reorder settings of a new item; 0 disables low-stock alerts",
+                    "type": "integer",
+                    "example": 10
+                },
+                "reorder_quantity": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "TSHIRT-M-RED"
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "CreateOrderItem": {
+            "description": "Order line in a create order request",
+            "type": "object",
+            "required": [
+                "quantity"
+            ],
+            "properties": {
+                "price": {
+                    "type": "number",
+                    "example": 999.99
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "TSHIRT-M-RED"
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "CreateOrderRequest": {
+            "description": "Request body for creating an order",
+            "type": "object",
+            "required": [
+                "items",
+                "user_id"
+            ],
+            "properties": {
+                "coupon_codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "SUMMER10"
+                    ]
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CreateOrderItem"
+                    }
+                },
+                "tax_region": {
+                    "description": "TaxRegion is the region the order ships to, such as \"US-CA\" or \"DE\"",
+                    "type": "string",
+                    "example": "US-CA"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "CreatePriceScheduleRequest": {
+            "description": "Request body for scheduling a price change",
+            "type": "object",
+            "required": [
+                "price",
+                "starts_at"
+            ],
+            "properties": {
+                "ends_at": {
+                    "description": "EndsAt restores the previous price at that time; omit it for a permanent change",
+                    "type": "string",
+                    "example": "2024-12-02T00:00:00Z"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Black Friday"
+                },
+                "price": {
+                    "description": "Price in the product currency",
+                    "type": "number",
+                    "example": 799.99
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2024-11-29T00:00:00Z"
+                }
+            }
+        },
+        "CreateProductRequest": {
+            "description": "Request body for creating a product",
+            "type": "object",
+            "required": [
+                "description",
+                "name",
+                "price",
+                "user_id"
+            ],
+            "properties": {
+                "category_ids": {
+                    "description": "CategoryIDs are the categories to list the product in",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        3,
+                        7
+                    ]
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Latest iPhone model"
+                },
+                "expected_restock_date": {
+                    "type": "string",
+                    "example": "2024-03-01"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "iPhone 15"
+                },
+                "options": {
+                    "description": "Options the product's variants choose from",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProductOption"
+                    }
+                },
+                "price": {
+                    "type": "number",
+                    "example": 999.99
+                },
+                "stock_policy": {
+                    "description": "StockPolicy lets orders exceed the available stock: backorder for\nrestocks, preorder for products not released yet",
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "backorder",
+                        "preorder"
+                    ],
+                    "example": "backorder"
+                },
+                "tax_category": {
+                    "type": "string",
+                    "example": "standard"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "CreateReviewRequest": {
+            "description": "Request body for creating a review",
+            "type": "object",
+            "required": [
+                "rating",
+                "user_id"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "Fast and the battery lasts all day."
+                },
+                "rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 5
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Great phone"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "CreateTransferRequest": {
+            "description": "Request body for creating a stock transfer",
+            "type": "object",
+            "required": [
+                "destination_warehouse_id",
+                "product_id",
+                "quantity",
+                "source_warehouse_id"
+            ],
+            "properties": {
+                "destination_warehouse_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Rebalance before holiday sale"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "TSHIRT-M-RED"
+                },
+                "source_warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "CreateUserRequest": {
+            "description": "Request body for creating a user",
+            "type": "object",
+            "required": [
+                "age",
+                "email",
+                "name"
+            ],
+            "properties": {
+                "age": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                }
+            }
+        },
+        "CreateVariantRequest": {
+            "description": "Request body for creating a product variant",
+            "type": "object",
+            "required": [
+                "options",
+                "sku"
+            ],
+            "properties": {
+                "options": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "price": {
+                    "description": "Price overrides the product price for this variant, in the product currency",
+                    "type": "number",
+                    "example": 24.99
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "TSHIRT-M-RED"
+                }
+            }
+        },
+        "CreateWarehouseRequest": {
+            "description": "Request body for creating a warehouse",
+            "type": "object",
+            "required": [
+                "code",
+                "name"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "1 Dock Rd, Newark, NJ"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "WH-EAST"
+                },
+                "location": {
+                    "$ref": "#/definitions/GeoLocation"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "East Coast DC"
+                }
+            }
+        },
+        "CreateWebhookRequest": {
+            "description": "Request body for registering a webhook",
+            "type": "object",
+            "required": [
+                "events",
+                "url"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Fulfilment partner"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order.created",
+                        "order.status_changed"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://partner.example.com/hooks/orders"
+                }
+            }
+        },
+        "CurrentUserResponse": {
+            "description": "Current user response",
+            "type": "object",
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "catalog:read",
+                        "orders:create:own"
+                    ]
+                },
+                "user": {
+                    "$ref": "#/definitions/User"
+                }
+            }
+        },
+        "DeleteUserResponse": {
+            "description": "Delete user response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "User deleted successfully"
+                },
+                "orders": {
+                    "$ref": "#/definitions/DeletionCascade"
+                },
+                "products": {
+                    "$ref": "#/definitions/DeletionCascade"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "user": {
+                    "$ref": "#/definitions/User"
+                }
+            }
+        },
+        "DeletionCascade": {
+            "description": "Cascade of a user deletion",
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Count is the number of products or orders deleted, archived or reassigned",
+                    "type": "integer",
+                    "example": 3
+                },
+                "policy": {
+                    "type": "string",
+                    "enum": [
+                        "block",
+                        "soft-cascade",
+                        "reassign"
+                    ],
+                    "example": "soft-cascade"
+                },
+                "reassigned_to": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "DiscountLine": {
+            "description": "Discount applied by a promotion",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/Money"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "SUMMER10"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "10% off"
+                },
+                "promotion_id": {
+                    "type": "string",
+                    "example": "5f0c6b1e-8c1d-4a4e-9a51-2f6f3a7d9b10"
+                }
+            }
+        },
+        "EmailRequest": {
+            "description": "Request body with an email address",
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                }
+            }
+        },
+        "EraseUserResponse": {
+            "description": "Erase user response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "User erased successfully"
+                },
+                "request": {
+                    "$ref": "#/definitions/privacy.Request"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "user": {
+                    "$ref": "#/definitions/User"
+                }
+            }
+        },
+        "ErrorResponse": {
+            "description": "Error response",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "error": {
+                    "type": "string",
+                    "example": "Invalid request"
+                }
+            }
+        },
+        "EvaluatePromotionsRequest": {
+            "description": "Request body for evaluating promotions on a cart",
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "coupon_codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "SUMMER10"
+                    ]
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CreateOrderItem"
+                    }
+                },
+                "tax_region": {
+                    "type": "string",
+                    "example": "US-CA"
+                },
+                "user_id": {
+                    "description": "UserID is optional; without it per-user limits are not checked",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "ExchangeRates": {
+            "description": "Exchange rates relative to a base currency",
+            "type": "object",
+            "properties": {
+                "base": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "rates": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "ExportedInvoice": {
+            "description": "Invoice of an exported order",
+            "type": "object",
+            "properties": {
+                "number": {
+                    "type": "string",
+                    "example": "INV-000042"
+                },
+                "order_id": {
+                    "type": "string",
+                    "example": "3f2a9c1e-8d4b-4e6f-9a1b-2c3d4e5f6a7b"
+                }
+            }
+        },
+        "ExtendReservationRequest": {
+            "description": "Request body for extending a reservation",
+            "type": "object",
+            "required": [
+                "extend_seconds"
+            ],
+            "properties": {
+                "extend_seconds": {
+                    "type": "integer",
+                    "example": 600
+                }
+            }
+        },
+        "GeoLocation": {
+            "description": "Geographic coordinates",
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "type": "number",
+                    "example": 40.7128
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": -74.006
+                }
+            }
+        },
+        "GrantRoleRequest": {
+            "description": "Request body for granting a role",
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "example": "seller"
+                }
+            }
+        },
+        "HealthResponse": {
+            "description": "Health check response",
+            "type": "object",
+            "properties": {
+                "services": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "healthy"
+                },
+                "time": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                }
+            }
+        },
+        "InventoryHistoryResponse": {
+            "description": "Inventory item history, newest first",
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/InventoryLedgerEntry"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "InventoryItem": {
+            "description": "Inventory item information",
+            "type": "object",
+            "properties": {
+                "available_quantity": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "in_transit_quantity": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "location": {
+                    "type": "string",
+                    "example": "WH-EAST"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "reorder_point": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "reorder_quantity": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "reserved_quantity": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "InventoryItemResponse": {
+            "description": "Inventory item response",
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/InventoryItem"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Inventory item created successfully"
+                }
+            }
+        },
+        "InventoryItemsListResponse": {
+            "description": "Inventory items list response",
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/InventoryItem"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 50
+                }
+            }
+        },
+        "InventoryLedgerEntry": {
+            "description": "Immutable inventory ledger entry",
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string",
+                    "example": "user:12"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "inventory_item_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Forklift accident"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity_after": {
+                    "type": "integer",
+                    "example": 97
+                },
+                "quantity_delta": {
+                    "type": "integer",
+                    "example": -3
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "DAMAGED"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "ord_123456"
+                },
+                "reserved_after": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "reserved_delta": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "CREATE",
+                        "ADJUSTMENT",
+                        "RESERVATION",
+                        "RELEASE",
+                        "FULFILLMENT",
+                        "RETURN",
+                        "TRANSFER_OUT",
+                        "TRANSFER_IN",
+                        "MOVE"
+                    ],
+                    "example": "ADJUSTMENT"
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "LineShortfall": {
+            "description": "Stock missing for one line of a batch reservation",
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "requested": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "shortfall": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "LocationStock": {
+            "description": "Stock level of a product in one warehouse",
+            "type": "object",
+            "properties": {
+                "available_quantity": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "in_transit_quantity": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "reserved_quantity": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "warehouse_code": {
+                    "type": "string",
+                    "example": "WH-EAST"
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "warehouse_name": {
+                    "type": "string",
+                    "example": "East Coast DC"
+                }
+            }
+        },
+        "LoginRequest": {
+            "description": "Request body for logging in",
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "correct horse battery"
+                }
+            }
+        },
+        "LogoutRequest": {
+            "description": "Request body for logging out",
+            "type": "object",
+            "properties": {
+                "all_sessions": {
+                    "description": "AllSessions ends every session of the user",
+                    "type": "boolean",
+                    "example": false
+                },
+                "refresh_token": {
+                    "description": "RefreshToken names the session to end; defaults to the session of the access token",
+                    "type": "string",
+                    "example": "Zk4mT1b8..."
+                }
+            }
+        },
+        "LogoutResponse": {
+            "description": "Logout response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Logged out successfully"
+                },
+                "revoked": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "ModerateReviewRequest": {
+            "description": "Request body for moderating a review",
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "example": "Contains personal data"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "PENDING",
+                        "APPROVED",
+                        "REJECTED"
+                    ],
+                    "example": "REJECTED"
+                }
+            }
+        },
+        "Money": {
+            "description": "Monetary amount in minor units",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 199998
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                }
+            }
+        },
+        "MoveCategoryRequest": {
+            "description": "Request body for moving a category",
+            "type": "object",
+            "properties": {
+                "parent_id": {
+                    "description": "ParentID is the new parent; 0 moves the category to the root",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "OIDCProvider": {
+            "description": "External identity provider",
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string",
+                    "example": "Corporate SSO"
+                },
+                "login_url": {
+                    "description": "LoginURL starts the sign-in; it redirects to the provider",
+                    "type": "string",
+                    "example": "/api/auth/oidc/corp/login"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "corp"
+                }
+            }
+        },
+        "OIDCProvidersResponse": {
+            "description": "Identity providers response",
+            "type": "object",
+            "properties": {
+                "providers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/OIDCProvider"
+                    }
+                }
+            }
+        },
+        "Order": {
+            "description": "Order information",
+            "type": "object",
+            "properties": {
+                "archived_at": {
+                    "description": "ArchivedAt is set when the order was archived with its deleted user",
+                    "type": "string",
+                    "example": "2023-06-01T12:00:00Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "currency": {
+                    "description": "Currency is the currency the amounts below are rendered in",
+                    "type": "string",
+                    "example": "USD"
+                },
+                "discount": {
+                    "$ref": "#/definitions/Money"
+                },
+                "discounts": {
+                    "description": "Discounts break the discount down by promotion",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/OrderDiscount"
+                    }
+                },
+                "exchange_rate": {
+                    "type": "string",
+                    "example": "0.92"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "ord_123456"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/OrderItem"
+                    }
+                },
+                "original_currency": {
+                    "description": "OriginalCurrency is the currency the order was priced in",
+                    "type": "string",
+                    "example": "USD"
+                },
+                "original_total": {
+                    "$ref": "#/definitions/Money"
+                },
+                "prices_include_tax": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "settlement_currency": {
+                    "description": "SettlementCurrency is the currency the customer pays in, converted\nfrom the original total at ExchangeRate when the order was placed",
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "settlement_total": {
+                    "$ref": "#/definitions/Money"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "PENDING"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/Money"
+                },
+                "tax": {
+                    "$ref": "#/definitions/Money"
+                },
+                "tax_lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/OrderTaxLine"
+                    }
+                },
+                "tax_region": {
+                    "description": "TaxLines break the tax down by rate. When PricesIncludeTax is set the\ntax is part of the subtotal rather than added to the total.",
+                    "type": "string",
+                    "example": "DE"
+                },
+                "total": {
+                    "$ref": "#/definitions/Money"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "OrderDiscount": {
+            "description": "Discount applied to an order",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/Money"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "SUMMER10"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "10% off"
+                },
+                "promotion_id": {
+                    "type": "string",
+                    "example": "5f0c6b1e-8c1d-4a4e-9a51-2f6f3a7d9b10"
+                }
+            }
+        },
+        "OrderItem": {
+            "description": "Order item information",
+            "type": "object",
+            "properties": {
+                "expected_restock_date": {
+                    "type": "string",
+                    "example": "2024-03-01"
+                },
+                "price_version": {
+                    "description": "PriceVersion is the product price version the line was priced at",
+                    "type": "integer",
+                    "example": 3
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "TSHIRT-M-RED"
+                },
+                "status": {
+                    "description": "Status is BACKORDERED while the line waits for stock",
+                    "type": "string",
+                    "enum": [
+                        "ALLOCATED",
+                        "BACKORDERED"
+                    ],
+                    "example": "ALLOCATED"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/Money"
+                },
+                "unit_price": {
+                    "$ref": "#/definitions/Money"
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "OrderResponse": {
+            "description": "Order response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Order created successfully"
+                },
+                "order": {
+                    "$ref": "#/definitions/Order"
+                }
+            }
+        },
+        "OrderTaxLine": {
+            "description": "Tax charged on an order",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/Money"
+                },
+                "category": {
+                    "type": "string",
+                    "example": "standard"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "VAT"
+                },
+                "rate": {
+                    "type": "string",
+                    "example": "0.19"
+                },
+                "region": {
+                    "type": "string",
+                    "example": "DE"
+                },
+                "taxable": {
+                    "$ref": "#/definitions/Money"
+                }
+            }
+        },
+        "OrdersListResponse": {
+            "description": "Orders list response",
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Order"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 25
+                }
+            }
+        },
+        "PriceChange": {
+            "description": "Product price change",
+            "type": "object",
+            "properties": {
+                "changed_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "previous_price": {
+                    "$ref": "#/definitions/Money"
+                },
+                "price": {
+                    "description": "Price is omitted when a variant's price override was removed",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Money"
+                        }
+                    ]
+                },
+                "reason": {
+                    "type": "string",
+                    "enum": [
+                        "CREATE",
+                        "MANUAL",
+                        "SCHEDULE_START",
+                        "SCHEDULE_END"
+                    ],
+                    "example": "MANUAL"
+                },
+                "schedule_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "variant_id": {
+                    "description": "VariantID is set when a variant's price override changed",
+                    "type": "integer",
+                    "example": 7
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "PriceHistoryResponse": {
+            "description": "Product price history response",
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PriceChange"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "PriceSchedule": {
+            "description": "Scheduled price change",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-11-01T09:00:00Z"
+                },
+                "ends_at": {
+                    "description": "EndsAt is omitted for a permanent price change",
+                    "type": "string",
+                    "example": "2024-12-02T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Black Friday"
+                },
+                "previous_price": {
+                    "description": "PreviousPrice is the price the schedule replaced, restored when it ends",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Money"
+                        }
+                    ]
+                },
+                "price": {
+                    "$ref": "#/definitions/Money"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2024-11-29T00:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "SCHEDULED",
+                        "ACTIVE",
+                        "COMPLETED",
+                        "CANCELLED"
+                    ],
+                    "example": "SCHEDULED"
+                }
+            }
+        },
+        "PriceScheduleResponse": {
+            "description": "Price schedule response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Price change scheduled"
+                },
+                "schedule": {
+                    "$ref": "#/definitions/PriceSchedule"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "PriceSchedulesListResponse": {
+            "description": "Price schedules list response",
+            "type": "object",
+            "properties": {
+                "schedules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PriceSchedule"
+                    }
+                }
+            }
+        },
+        "PricedLine": {
+            "description": "Priced order line",
+            "type": "object",
+            "properties": {
+                "price_version": {
+                    "description": "PriceVersion is the product price version UnitPrice was taken from",
+                    "type": "integer",
+                    "example": 3
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "TSHIRT-M-RED"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/Money"
+                },
+                "tax_category": {
+                    "description": "TaxCategory selects the tax rate of the product",
+                    "type": "string",
+                    "example": "standard"
+                },
+                "unit_price": {
+                    "$ref": "#/definitions/Money"
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "PrivacyRequestsListResponse": {
+            "description": "Privacy requests list response",
+            "type": "object",
+            "properties": {
+                "requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/privacy.Request"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "Product": {
+            "description": "Product information",
+            "type": "object",
+            "properties": {
+                "category_ids": {
+                    "description": "CategoryIDs are the categories the product is listed in",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "deleted_at": {
+                    "description": "DeletedAt is set while the product is soft deleted",
+                    "type": "string",
+                    "example": "2023-06-01T12:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Latest iPhone model"
+                },
+                "expected_restock_date": {
+                    "type": "string",
+                    "example": "2024-03-01"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "image_url": {
+                    "description": "ImageURL is the primary image, omitted for products without media",
+                    "type": "string",
+                    "example": "/media/products/1/9b2f6c1e.jpg"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProductMedia"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "iPhone 15"
+                },
+                "options": {
+                    "description": "Options are the axes the product varies along, such as size and\ncolor; each variant picks one value of every option",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProductOption"
+                    }
+                },
+                "original_price": {
+                    "description": "OriginalPrice is the catalog price when Price was converted into the\ncaller's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Money"
+                        }
+                    ]
+                },
+                "price": {
+                    "$ref": "#/definitions/Money"
+                },
+                "price_version": {
+                    "description": "PriceVersion counts the changes to the price of the product and its\nvariants; orders record the version they were priced at",
+                    "type": "integer",
+                    "example": 3
+                },
+                "rating": {
+                    "description": "Rating summarizes the approved reviews; only single-product responses include it",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ReviewSummary"
+                        }
+                    ]
+                },
+                "stock_policy": {
+                    "description": "StockPolicy says whether orders may exceed the available stock",
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "backorder",
+                        "preorder"
+                    ],
+                    "example": "none"
+                },
+                "tax_category": {
+                    "type": "string",
+                    "example": "standard"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProductVariant"
+                    }
+                }
+            }
+        },
+        "ProductMedia": {
+            "description": "Product image with its thumbnail",
+            "type": "object",
+            "properties": {
+                "alt_text": {
+                    "type": "string",
+                    "example": "Front view"
+                },
+                "content_type": {
+                    "type": "string",
+                    "example": "image/jpeg"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "height": {
+                    "type": "integer",
+                    "example": 900
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "is_primary": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "position": {
+                    "description": "Position is the 1-based display order",
+                    "type": "integer",
+                    "example": 1
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 204800
+                },
+                "thumbnail_url": {
+                    "type": "string",
+                    "example": "/media/products/1/9b2f6c1e_thumb.jpg"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "/media/products/1/9b2f6c1e.jpg"
+                },
+                "width": {
+                    "type": "integer",
+                    "example": 1200
+                }
+            }
+        },
+        "ProductMediaListResponse": {
+            "description": "Product images list response",
+            "type": "object",
+            "properties": {
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProductMedia"
+                    }
+                }
+            }
+        },
+        "ProductMediaResponse": {
+            "description": "Product image response",
+            "type": "object",
+            "properties": {
+                "media": {
+                    "$ref": "#/definitions/ProductMedia"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Media added successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "ProductOption": {
+            "description": "Product option and its allowed values",
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "size"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "S",
+                        "M",
+                        "L"
+                    ]
+                }
+            }
+        },
+        "ProductResponse": {
+            "description": "Product response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Product created successfully"
+                },
+                "product": {
+                    "$ref": "#/definitions/Product"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "ProductVariant": {
+            "description": "Product variant with its own SKU and price",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "options": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "price": {
+                    "description": "Price is the variant's own price when PriceOverride is set, otherwise\nthe product price",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Money"
+                        }
+                    ]
+                },
+                "price_override": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "TSHIRT-M-RED"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                }
+            }
+        },
+        "ProductsListResponse": {
+            "description": "Products list response",
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Product"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 50
+                }
+            }
+        },
+        "Promotion": {
+            "description": "Promotion information",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "amount": {
+                    "description": "Amount is the amount off for fixed promotions",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Money"
+                        }
+                    ]
+                },
+                "buy_quantity": {
+                    "description": "BuyQuantity and GetQuantity configure buy-X-get-Y promotions",
+                    "type": "integer",
+                    "example": 2
+                },
+                "code": {
+                    "description": "Code is the coupon code; promotions without a code apply automatically",
+                    "type": "string",
+                    "example": "SUMMER10"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "get_quantity": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "string",
+                    "example": "5f0c6b1e-8c1d-4a4e-9a51-2f6f3a7d9b10"
+                },
+                "min_order_amount": {
+                    "$ref": "#/definitions/Money"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Summer sale"
+                },
+                "per_user_limit": {
+                    "description": "PerUserLimit caps redemptions by a single user; 0 means unlimited",
+                    "type": "integer",
+                    "example": 1
+                },
+                "percent": {
+                    "description": "Percent is the percentage off for percentage promotions",
+                    "type": "string",
+                    "example": "10"
+                },
+                "product_ids": {
+                    "description": "ProductIDs limits the promotion to these products; empty means all",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "tenant": {
+                    "description": "Tenant is the storefront the promotion is offered on",
+                    "type": "string",
+                    "example": "default"
+                },
+                "type": {
+                    "enum": [
+                        "percentage",
+                        "fixed",
+                        "buy_x_get_y"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/promotions.Type"
+                        }
+                    ],
+                    "example": "percentage"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "usage_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "usage_limit": {
+                    "description": "UsageLimit caps redemptions across all users; 0 means unlimited",
+                    "type": "integer",
+                    "example": 1000
+                }
+            }
+        },
+        "PromotionEvaluationResponse": {
+            "description": "Cart preview with applied promotions",
+            "type": "object",
+            "properties": {
+                "quote": {
+                    "$ref": "#/definitions/Quote"
+                },
+                "rejected": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/RejectedCoupon"
+                    }
+                }
+            }
+        },
+        "PromotionResponse": {
+            "description": "Promotion response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Promotion created successfully"
+                },
+                "promotion": {
+                    "$ref": "#/definitions/Promotion"
+                }
+            }
+        },
+        "PromotionRules": {
+            "description": "Promotion settings",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "amount": {
+                    "description": "Amount is the amount off for fixed promotions",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Money"
+                        }
+                    ]
+                },
+                "buy_quantity": {
+                    "description": "BuyQuantity and GetQuantity configure buy-X-get-Y promotions",
+                    "type": "integer",
+                    "example": 2
+                },
+                "code": {
+                    "description": "Code is the coupon code; promotions without a code apply automatically",
+                    "type": "string",
+                    "example": "SUMMER10"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "get_quantity": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "min_order_amount": {
+                    "$ref": "#/definitions/Money"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Summer sale"
+                },
+                "per_user_limit": {
+                    "description": "PerUserLimit caps redemptions by a single user; 0 means unlimited",
+                    "type": "integer",
+                    "example": 1
+                },
+                "percent": {
+                    "description": "Percent is the percentage off for percentage promotions",
+                    "type": "string",
+                    "example": "10"
+                },
+                "product_ids": {
+                    "description": "ProductIDs limits the promotion to these products; empty means all",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "type": {
+                    "enum": [
+                        "percentage",
+                        "fixed",
+                        "buy_x_get_y"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/promotions.Type"
+                        }
+                    ],
+                    "example": "percentage"
+                },
+                "usage_limit": {
+                    "description": "UsageLimit caps redemptions across all users; 0 means unlimited",
+                    "type": "integer",
+                    "example": 1000
+                }
+            }
+        },
+        "PromotionsListResponse": {
+            "description": "Promotions list response",
+            "type": "object",
+            "properties": {
+                "promotions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Promotion"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "Quote": {
+            "description": "Order price breakdown",
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "discount": {
+                    "$ref": "#/definitions/Money"
+                },
+                "discounts": {
+                    "description": "Discounts break the discount down by promotion",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/DiscountLine"
+                    }
+                },
+                "exchange_rate": {
+                    "type": "string",
+                    "example": "0.92"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PricedLine"
+                    }
+                },
+                "prices_include_tax": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "settlement_currency": {
+                    "description": "Settlement is the currency the customer pays in. The exchange rate is\nrecorded so the settlement total can be reproduced later.",
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "settlement_total": {
+                    "$ref": "#/definitions/Money"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/Money"
+                },
+                "tax": {
+                    "$ref": "#/definitions/Money"
+                },
+                "tax_lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TaxLine"
+                    }
+                },
+                "tax_region": {
+                    "description": "TaxLines break the tax down by region, category and rate. When prices\ninclude tax, the tax is already part of the subtotal and not added again.",
+                    "type": "string",
+                    "example": "DE"
+                },
+                "total": {
+                    "$ref": "#/definitions/Money"
+                }
+            }
+        },
+        "RefreshTokenRequest": {
+            "description": "Request body for refreshing tokens",
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "Zk4mT1b8..."
+                }
+            }
+        },
+        "RejectedCoupon": {
+            "description": "Coupon code that could not be applied",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "SUMMER10"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "minimum order amount is 50.00 USD"
+                }
+            }
+        },
+        "ReleaseBatchRequest": {
+            "description": "Request body for releasing the reservations of an order",
+            "type": "object",
+            "required": [
+                "order_id"
+            ],
+            "properties": {
+                "order_id": {
+                    "type": "string",
+                    "example": "ord_123456"
+                }
+            }
+        },
+        "ReleaseBatchResponse": {
+            "description": "Batch release response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Released 2 reservations successfully"
+                },
+                "reservation_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "ReleaseStockRequest": {
+            "description": "Request body for releasing stock",
+            "type": "object",
+            "required": [
+                "reservation_id"
+            ],
+            "properties": {
+                "reservation_id": {
+                    "type": "string",
+                    "example": "res_123456"
+                }
+            }
+        },
+        "ReleaseStockResponse": {
+            "description": "Stock release response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Stock released successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "ReorderSuggestion": {
+            "description": "Suggested purchase quantity for a product or variant",
+            "type": "object",
+            "properties": {
+                "available_quantity": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "daily_velocity": {
+                    "type": "number",
+                    "example": 2
+                },
+                "days_of_cover": {
+                    "type": "number",
+                    "example": 2
+                },
+                "in_transit_quantity": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reason": {
+                    "type": "string",
+                    "enum": [
+                        "below_reorder_point",
+                        "projected_stockout"
+                    ],
+                    "example": "below_reorder_point"
+                },
+                "reorder_point": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "reorder_quantity": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "suggested_quantity": {
+                    "type": "integer",
+                    "example": 70
+                },
+                "units_sold": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "ReorderSuggestionsResponse": {
+            "description": "Suggested purchase quantities, most urgent first",
+            "type": "object",
+            "properties": {
+                "coverage_days": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "lead_time_days": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ReorderSuggestion"
+                    }
+                },
+                "window_days": {
+                    "type": "integer",
+                    "example": 30
+                }
+            }
+        },
+        "Reservation": {
+            "description": "Stock reservation information",
+            "type": "object",
+            "properties": {
+                "allocations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ReservationAllocation"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:30:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "res_123456"
+                },
+                "order_id": {
+                    "type": "string",
+                    "example": "ord_123456"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "released_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:30:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ACTIVE",
+                        "RELEASED",
+                        "EXPIRED",
+                        "FULFILLED"
+                    ],
+                    "example": "ACTIVE"
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "ReservationAllocation": {
+            "description": "Quantity reserved in one warehouse",
+            "type": "object",
+            "properties": {
+                "quantity": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "warehouse_code": {
+                    "type": "string",
+                    "example": "WH-EAST"
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "ReservationLine": {
+            "description": "Product and quantity to reserve",
+            "type": "object",
+            "required": [
+                "product_id",
+                "quantity"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "TSHIRT-M-RED"
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "ReservationResponse": {
+            "description": "Reservation response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Reservation extended successfully"
+                },
+                "reservation": {
+                    "$ref": "#/definitions/Reservation"
+                }
+            }
+        },
+        "ReservationsListResponse": {
+            "description": "Paginated list of reservations",
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reservations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Reservation"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "ReserveBatchRequest": {
+            "description": "Request body for reserving several products atomically",
+            "type": "object",
+            "required": [
+                "lines",
+                "order_id"
+            ],
+            "properties": {
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ReservationLine"
+                    }
+                },
+                "order_id": {
+                    "type": "string",
+                    "example": "ord_123456"
+                },
+                "shipping_location": {
+                    "$ref": "#/definitions/GeoLocation"
+                },
+                "strategy": {
+                    "type": "string",
+                    "enum": [
+                        "nearest",
+                        "largest_stock",
+                        "split"
+                    ],
+                    "example": "split"
+                },
+                "ttl_seconds": {
+                    "type": "integer",
+                    "example": 900
+                }
+            }
+        },
+        "ReserveBatchResponse": {
+            "description": "Batch reservation response: every line reserved, or shortfalls and nothing reserved",
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:30:00Z"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Reserved 2 lines successfully"
+                },
+                "reservations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Reservation"
+                    }
+                },
+                "shortfalls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LineShortfall"
+                    }
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "ReserveStockRequest": {
+            "description": "Request body for reserving stock",
+            "type": "object",
+            "required": [
+                "order_id",
+                "product_id",
+                "quantity"
+            ],
+            "properties": {
+                "order_id": {
+                    "type": "string",
+                    "example": "ord_123456"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "shipping_location": {
+                    "$ref": "#/definitions/GeoLocation"
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "TSHIRT-M-RED"
+                },
+                "strategy": {
+                    "type": "string",
+                    "enum": [
+                        "nearest",
+                        "largest_stock",
+                        "split"
+                    ],
+                    "example": "nearest"
+                },
+                "ttl_seconds": {
+                    "type": "integer",
+                    "example": 900
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "ReserveStockResponse": {
+            "description": "Stock reservation response",
+            "type": "object",
+            "properties": {
+                "allocations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ReservationAllocation"
+                    }
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:30:00Z"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Stock reserved successfully"
+                },
+                "reservation_id": {
+                    "type": "string",
+                    "example": "res_123456"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "ResetPasswordRequest": {
+            "description": "Request body for resetting a password",
+            "type": "object",
+            "required": [
+                "new_password",
+                "token"
+            ],
+            "properties": {
+                "new_password": {
+                    "type": "string",
+                    "example": "another horse battery"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "b5Yw2kq..."
+                }
+            }
+        },
+        "RestoreUserResponse": {
+            "description": "Restore user response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "User restored successfully"
+                },
+                "restored_orders": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "restored_products": {
+                    "description": "RestoredProducts and RestoredOrders count the records deleted\ntogether with the user and brought back with it",
+                    "type": "integer",
+                    "example": 3
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "user": {
+                    "$ref": "#/definitions/User"
+                }
+            }
+        },
+        "Review": {
+            "description": "Product review",
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "Fast and the battery lasts all day."
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "moderation_note": {
+                    "type": "string",
+                    "example": "Contains personal data"
+                },
+                "order_id": {
+                    "description": "OrderID is the delivered order the purchase was verified with",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 5
+                },
+                "status": {
+                    "description": "Status is PENDING, APPROVED or REJECTED; only approved reviews are public",
+                    "type": "string",
+                    "enum": [
+                        "PENDING",
+                        "APPROVED",
+                        "REJECTED"
+                    ],
+                    "example": "APPROVED"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Great phone"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "ReviewResponse": {
+            "description": "Review response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Review created successfully"
+                },
+                "review": {
+                    "$ref": "#/definitions/Review"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "ReviewSummary": {
+            "description": "Product rating summary",
+            "type": "object",
+            "properties": {
+                "average_rating": {
+                    "type": "number",
+                    "example": 4.25
+                },
+                "histogram": {
+                    "description": "Histogram maps each star rating from 1 to 5 to its number of reviews",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "review_count": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "ReviewsListResponse": {
+            "description": "Reviews list response",
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reviews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Review"
+                    }
+                },
+                "summary": {
+                    "$ref": "#/definitions/ReviewSummary"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "Role": {
+            "description": "Role",
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "seller"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "products:manage:own",
+                        "inventory:manage:own"
+                    ]
+                }
+            }
+        },
+        "RolesListResponse": {
+            "description": "Roles list response",
+            "type": "object",
+            "properties": {
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Role"
+                    }
+                }
+            }
+        },
+        "SKULookupResponse": {
+            "description": "SKU lookup response",
+            "type": "object",
+            "properties": {
+                "product": {
+                    "$ref": "#/definitions/Product"
+                },
+                "variant": {
+                    "$ref": "#/definitions/ProductVariant"
+                }
+            }
+        },
+        "SignupRequest": {
+            "description": "Request body for signing up",
             "type": "object",
             "required": [
-                "product_id",
-                "required_quantity"
+                "age",
+                "email",
+                "name",
+                "password"
             ],
             "properties": {
+                "age": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "correct horse battery"
+                }
+            }
+        },
+        "StockTransfer": {
+            "description": "Stock transfer with its audit trail",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "destination_warehouse_code": {
+                    "type": "string",
+                    "example": "WH-WEST"
+                },
+                "destination_warehouse_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TransferEvent"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "4b0f7c1e-2d7a-4d8e-9a51-0f6c1d2e3b4a"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Rebalance before holiday sale"
+                },
                 "product_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "required_quantity": {
+                "quantity": {
                     "type": "integer",
-                    "example": 10
+                    "example": 20
+                },
+                "source_warehouse_code": {
+                    "type": "string",
+                    "example": "WH-EAST"
+                },
+                "source_warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "REQUESTED",
+                        "IN_TRANSIT",
+                        "RECEIVED",
+                        "CANCELLED"
+                    ],
+                    "example": "IN_TRANSIT"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 7
                 }
             }
         },
-        "CheckStockResponse": {
-            "description": "Stock availability response",
+        "Storefront": {
+            "description": "Storefront the request is served for",
             "type": "object",
             "properties": {
-                "available": {
+                "currency": {
+                    "description": "Currency prices are shown in by default; empty for each product's own",
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "features": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "acme"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Acme Outdoor"
+                }
+            }
+        },
+        "SuccessResponse": {
+            "description": "Success response",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Operation completed successfully"
+                },
+                "success": {
                     "type": "boolean",
                     "example": true
+                }
+            }
+        },
+        "TaxLine": {
+            "description": "Tax charged at one rate",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/Money"
                 },
-                "available_quantity": {
-                    "type": "integer",
-                    "example": 100
+                "category": {
+                    "type": "string",
+                    "example": "standard"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "VAT"
+                },
+                "rate": {
+                    "type": "string",
+                    "example": "0.19"
+                },
+                "region": {
+                    "type": "string",
+                    "example": "DE"
+                },
+                "taxable": {
+                    "$ref": "#/definitions/Money"
+                }
+            }
+        },
+        "TransferEvent": {
+            "description": "Status change of a stock transfer",
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string",
+                    "example": "user:12"
                 },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Picked up by carrier"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "IN_TRANSIT"
+                }
+            }
+        },
+        "TransferResponse": {
+            "description": "Stock transfer response",
+            "type": "object",
+            "properties": {
                 "message": {
                     "type": "string",
-                    "example": "Stock is available"
+                    "example": "Transfer requested successfully"
+                },
+                "transfer": {
+                    "$ref": "#/definitions/StockTransfer"
                 }
             }
         },
-        "CreateInventoryItemRequest": {
-            "description": "Request body for creating an inventory item",
+        "TransfersListResponse": {
+            "description": "Stock transfers list response",
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "transfers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/StockTransfer"
+                    }
+                }
+            }
+        },
+        "UpdateCategoryRequest": {
+            "description": "Request body for updating a category",
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Phones and accessories"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Smartphones"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "smartphones"
+                }
+            }
+        },
+        "UpdateInventoryItemRequest": {
+            "description": "Request body for adjusting an inventory item. Quantities change by a relative adjustment with a reason; absolute quantities are not accepted.",
             "type": "object",
-            "required": [
-                "location",
-                "product_id",
-                "quantity"
-            ],
             "properties": {
+                "adjustment": {
+                    "description": "Adjustment is a signed number, given as \"+5\", \"-3\" or 5",
+                    "type": "string",
+                    "example": "-3"
+                },
                 "location": {
                     "type": "string",
-                    "example": "Warehouse A"
+                    "example": "WH-EAST"
                 },
-                "product_id": {
+                "note": {
+                    "type": "string",
+                    "example": "Forklift accident"
+                },
+                "reason": {
+                    "type": "string",
+                    "enum": [
+                        "RECEIVED",
+                        "CYCLE_COUNT",
+                        "DAMAGED",
+                        "LOST",
+                        "FOUND",
+                        "CORRECTION",
+                        "CUSTOMER_RETURN"
+                    ],
+                    "example": "DAMAGED"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "ord_123456"
+                },
+                "reorder_point": {
+                    "description": "Reorder settings; omitted fields keep their value",
                     "type": "integer",
-                    "example": 1
+                    "example": 10
                 },
-                "quantity": {
+                "reorder_quantity": {
                     "type": "integer",
-                    "example": 100
+                    "example": 50
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "CreateOrderRequest": {
-            "description": "Request body for creating an order",
+        "UpdateOrderStatusRequest": {
+            "description": "Request body for updating order status",
             "type": "object",
             "required": [
-                "items",
-                "user_id"
+                "status"
             ],
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/OrderItem"
-                    }
+                "status": {
+                    "type": "string",
+                    "example": "CONFIRMED"
+                }
+            }
+        },
+        "UpdateProductMediaRequest": {
+            "description": "Request body for updating a product image",
+            "type": "object",
+            "properties": {
+                "alt_text": {
+                    "type": "string",
+                    "example": "Front view"
                 },
-                "user_id": {
+                "position": {
+                    "description": "Position moves the image, shifting the others; positions start at 1",
                     "type": "integer",
                     "example": 1
+                },
+                "primary": {
+                    "description": "Primary makes this the product's primary image",
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "CreateProductRequest": {
-            "description": "Request body for creating a product",
+        "UpdateProductRequest": {
+            "description": "Request body for updating a product",
             "type": "object",
-            "required": [
-                "description",
-                "name",
-                "price",
-                "user_id"
-            ],
             "properties": {
+                "category_ids": {
+                    "description": "CategoryIDs replaces the product's categories when present; an\nempty list removes the product from all categories",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        3,
+                        7
+                    ]
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
                 "description": {
                     "type": "string",
                     "example": "Latest iPhone model"
                 },
+                "expected_restock_date": {
+                    "type": "string",
+                    "example": "2024-03-01"
+                },
                 "name": {
                     "type": "string",
                     "example": "iPhone 15"
                 },
+                "options": {
+                    "description": "Options replaces the product's options when present; an empty list\nremoves them. Existing variants must fit the new options.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProductOption"
+                    }
+                },
                 "price": {
                     "type": "number",
                     "example": 999.99
                 },
-                "user_id": {
+                "stock_policy": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "backorder",
+                        "preorder"
+                    ],
+                    "example": "backorder"
+                },
+                "tax_category": {
+                    "type": "string",
+                    "example": "standard"
+                }
+            }
+        },
+        "UpdateReviewRequest": {
+            "description": "Request body for updating a review",
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "Fast, but the battery could be better."
+                },
+                "rating": {
                     "type": "integer",
-                    "example": 1
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 4
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Good phone"
                 }
             }
         },
-        "CreateUserRequest": {
-            "description": "Request body for creating a user",
+        "UpdateTransferStatusRequest": {
+            "description": "Request body for dispatching, receiving or cancelling a transfer",
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "example": "Picked up by carrier"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "IN_TRANSIT",
+                        "RECEIVED",
+                        "CANCELLED"
+                    ],
+                    "example": "IN_TRANSIT"
+                }
+            }
+        },
+        "UpdateUserRequest": {
+            "description": "Request body for updating a user",
             "type": "object",
             "required": [
                 "age",
@@ -1120,93 +9487,244 @@ const docTemplate = `{
                 }
             }
         },
-        "ErrorResponse": {
-            "description": "Error response",
+        "UpdateVariantRequest": {
+            "description": "Request body for updating a product variant",
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 400
+                "clear_price": {
+                    "description": "ClearPrice removes the price override so the variant sells at the product price",
+                    "type": "boolean",
+                    "example": false
                 },
-                "error": {
+                "options": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "price": {
+                    "type": "number",
+                    "example": 24.99
+                },
+                "sku": {
                     "type": "string",
-                    "example": "Invalid request"
+                    "example": "TSHIRT-M-RED"
                 }
             }
         },
-        "HealthResponse": {
-            "description": "Health check response",
+        "UpdateWarehouseRequest": {
+            "description": "Request body for updating a warehouse. Omitted fields keep their value.",
             "type": "object",
             "properties": {
-                "services": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "active": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "status": {
+                "address": {
                     "type": "string",
-                    "example": "healthy"
+                    "example": "1 Dock Rd, Newark, NJ"
                 },
-                "time": {
+                "location": {
+                    "$ref": "#/definitions/GeoLocation"
+                },
+                "name": {
                     "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "example": "East Coast DC"
                 }
             }
         },
-        "InventoryItem": {
-            "description": "Inventory item information",
+        "UpdateWebhookRequest": {
+            "description": "Request body for updating a webhook",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Fulfilment partner"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order.created",
+                        "order.status_changed",
+                        "inventory.low_stock"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://partner.example.com/hooks/orders"
+                }
+            }
+        },
+        "User": {
+            "description": "User information",
             "type": "object",
             "properties": {
+                "age": {
+                    "type": "integer",
+                    "example": 30
+                },
                 "created_at": {
                     "type": "string",
                     "example": "2023-01-01T12:00:00Z"
                 },
-                "id": {
-                    "type": "integer",
-                    "example": 1
+                "deleted_at": {
+                    "description": "DeletedAt is set while the user is soft deleted",
+                    "type": "string",
+                    "example": "2023-06-01T12:00:00Z"
                 },
-                "location": {
+                "email": {
                     "type": "string",
-                    "example": "Warehouse A"
+                    "example": "john@example.com"
                 },
-                "product_id": {
+                "email_verified_at": {
+                    "description": "EmailVerifiedAt is set once the user has confirmed their email address",
+                    "type": "string",
+                    "example": "2023-01-01T12:05:00Z"
+                },
+                "erased_at": {
+                    "description": "ErasedAt is set once the user's personal data has been erased",
+                    "type": "string",
+                    "example": "2023-07-01T12:00:00Z"
+                },
+                "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "quantity": {
-                    "type": "integer",
-                    "example": 100
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
                 },
-                "updated_at": {
+                "roles": {
+                    "description": "Roles decide what the user may do; new users are customers",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "customer"
+                    ]
+                }
+            }
+        },
+        "UserDataExport": {
+            "description": "User data export",
+            "type": "object",
+            "properties": {
+                "exported_at": {
                     "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "example": "2024-01-01T12:00:00Z"
+                },
+                "invoices": {
+                    "description": "Invoices lists the invoices issued for the user's orders",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ExportedInvoice"
+                    }
+                },
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Order"
+                    }
+                },
+                "privacy_requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/privacy.Request"
+                    }
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Product"
+                    }
+                },
+                "reviews": {
+                    "description": "Reviews the user wrote, whatever their moderation status",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Review"
+                    }
+                },
+                "user": {
+                    "$ref": "#/definitions/User"
                 }
             }
         },
-        "InventoryItemResponse": {
-            "description": "Inventory item response",
+        "UserProductsResponse": {
+            "description": "User products response",
             "type": "object",
             "properties": {
-                "item": {
-                    "$ref": "#/definitions/InventoryItem"
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Product"
+                    }
                 },
+                "total": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "UserResponse": {
+            "description": "User response",
+            "type": "object",
+            "properties": {
                 "message": {
                     "type": "string",
-                    "example": "Inventory item created successfully"
+                    "example": "User created successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "user": {
+                    "$ref": "#/definitions/User"
                 }
             }
         },
-        "InventoryItemsListResponse": {
-            "description": "Inventory items list response",
+        "UserRolesResponse": {
+            "description": "User roles response",
             "type": "object",
             "properties": {
-                "items": {
+                "permissions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/InventoryItem"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "catalog:read",
+                        "products:manage:own"
+                    ]
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "customer",
+                        "seller"
+                    ]
                 },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "UsersListResponse": {
+            "description": "Users list response",
+            "type": "object",
+            "properties": {
                 "limit": {
                     "type": "integer",
                     "example": 10
@@ -1217,152 +9735,199 @@ const docTemplate = `{
                 },
                 "total": {
                     "type": "integer",
-                    "example": 50
+                    "example": 100
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/User"
+                    }
                 }
             }
         },
-        "Order": {
-            "description": "Order information",
+        "VariantResponse": {
+            "description": "Product variant response",
             "type": "object",
             "properties": {
-                "created_at": {
+                "message": {
                     "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "example": "Variant created successfully"
                 },
-                "id": {
-                    "type": "string",
-                    "example": "ord_123456"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "items": {
+                "variant": {
+                    "$ref": "#/definitions/ProductVariant"
+                }
+            }
+        },
+        "VariantsListResponse": {
+            "description": "Product variants list response",
+            "type": "object",
+            "properties": {
+                "variants": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/OrderItem"
+                        "$ref": "#/definitions/ProductVariant"
                     }
+                }
+            }
+        },
+        "Warehouse": {
+            "description": "Warehouse information",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "status": {
+                "address": {
                     "type": "string",
-                    "example": "PENDING"
+                    "example": "1 Dock Rd, Newark, NJ"
                 },
-                "total_price": {
-                    "type": "number",
-                    "example": 1999.98
+                "code": {
+                    "type": "string",
+                    "example": "WH-EAST"
                 },
-                "updated_at": {
+                "created_at": {
                     "type": "string",
                     "example": "2023-01-01T12:00:00Z"
                 },
-                "user_id": {
+                "id": {
                     "type": "integer",
                     "example": 1
-                }
-            }
-        },
-        "OrderItem": {
-            "description": "Order item information",
-            "type": "object",
-            "properties": {
-                "price": {
-                    "type": "number",
-                    "example": 999.99
                 },
-                "product_id": {
-                    "type": "integer",
-                    "example": 1
+                "location": {
+                    "$ref": "#/definitions/GeoLocation"
                 },
-                "quantity": {
-                    "type": "integer",
-                    "example": 2
+                "name": {
+                    "type": "string",
+                    "example": "East Coast DC"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
                 }
             }
         },
-        "OrderResponse": {
-            "description": "Order response",
+        "WarehouseResponse": {
+            "description": "Warehouse response",
             "type": "object",
             "properties": {
                 "message": {
                     "type": "string",
-                    "example": "Order created successfully"
+                    "example": "Warehouse created successfully"
                 },
-                "order": {
-                    "$ref": "#/definitions/Order"
+                "warehouse": {
+                    "$ref": "#/definitions/Warehouse"
                 }
             }
         },
-        "OrdersListResponse": {
-            "description": "Orders list response",
+        "WarehousesListResponse": {
+            "description": "Warehouses list response",
             "type": "object",
             "properties": {
-                "limit": {
-                    "type": "integer",
-                    "example": 10
-                },
-                "orders": {
+                "warehouses": {
                     "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/Order"
-                    }
-                },
-                "page": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "total": {
-                    "type": "integer",
-                    "example": 25
+                    "items": {
+                        "$ref": "#/definitions/Warehouse"
+                    }
                 }
             }
         },
-        "Product": {
-            "description": "Product information",
+        "Webhook": {
+            "description": "Webhook endpoint",
             "type": "object",
             "properties": {
+                "active": {
+                    "description": "Active endpoints receive deliveries; deliveries queued for an inactive\nendpoint wait until it is activated again",
+                    "type": "boolean",
+                    "example": true
+                },
                 "created_at": {
-                    "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "description": {
                     "type": "string",
-                    "example": "Latest iPhone model"
+                    "example": "Fulfilment partner"
+                },
+                "events": {
+                    "description": "Events the endpoint is notified of",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order.created",
+                        "order.status_changed"
+                    ]
                 },
                 "id": {
-                    "type": "integer",
-                    "example": 1
+                    "type": "string",
+                    "example": "3b1f7c52-9d0e-4d8a-b6f1-0c2e4a9d7e11"
                 },
-                "name": {
+                "secret": {
+                    "description": "Secret signs deliveries; it is only shown when the webhook is created\nor its secret rotated",
                     "type": "string",
-                    "example": "iPhone 15"
+                    "example": "whsec_5d41402abc4b2a76b9719d911017c592a1b2c3d4e5f60718"
                 },
-                "price": {
-                    "type": "number",
-                    "example": 999.99
+                "tenant": {
+                    "description": "Tenant is the storefront whose events the endpoint receives",
+                    "type": "string",
+                    "example": "default"
                 },
-                "user_id": {
-                    "type": "integer",
-                    "example": 1
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "description": "URL receives deliveries as POST requests",
+                    "type": "string",
+                    "example": "https://partner.example.com/hooks/orders"
                 }
             }
         },
-        "ProductResponse": {
-            "description": "Product response",
+        "WebhookAttempt": {
+            "description": "Webhook delivery attempt",
             "type": "object",
             "properties": {
-                "message": {
+                "at": {
+                    "type": "string"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 182
+                },
+                "error": {
                     "type": "string",
-                    "example": "Product created successfully"
+                    "example": "endpoint returned 500 Internal Server Error"
                 },
-                "product": {
-                    "$ref": "#/definitions/Product"
+                "response": {
+                    "description": "Response is the start of the response body",
+                    "type": "string",
+                    "example": "upstream unavailable"
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "status_code": {
+                    "description": "StatusCode is the HTTP status the endpoint answered with; 0 when it\ncould not be reached",
+                    "type": "integer",
+                    "example": 500
                 }
             }
         },
-        "ProductsListResponse": {
-            "description": "Products list response",
+        "WebhookDeliveriesListResponse": {
+            "description": "Webhook deliveries list response",
             "type": "object",
             "properties": {
+                "deliveries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WebhookDelivery"
+                    }
+                },
                 "limit": {
                     "type": "integer",
                     "example": 10
@@ -1371,241 +9936,247 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1
                 },
-                "products": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/Product"
-                    }
-                },
                 "total": {
                     "type": "integer",
-                    "example": 50
+                    "example": 42
                 }
             }
         },
-        "ReleaseStockRequest": {
-            "description": "Request body for releasing stock",
+        "WebhookDelivery": {
+            "description": "Webhook delivery",
             "type": "object",
-            "required": [
-                "reservation_id"
-            ],
             "properties": {
-                "reservation_id": {
+                "attempts": {
+                    "description": "Attempts made so far, oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WebhookAttempt"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "dead_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event": {
+                    "$ref": "#/definitions/WebhookEvent"
+                },
+                "id": {
                     "type": "string",
-                    "example": "res_123456"
+                    "example": "c8d2f4a1-6e3b-4b7a-9f10-5a2e8d3c1b96"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "PENDING",
+                        "DELIVERED",
+                        "DEAD"
+                    ],
+                    "example": "PENDING"
+                },
+                "webhook_id": {
+                    "type": "string",
+                    "example": "3b1f7c52-9d0e-4d8a-b6f1-0c2e4a9d7e11"
                 }
             }
         },
-        "ReleaseStockResponse": {
-            "description": "Stock release response",
+        "WebhookDeliveryResponse": {
+            "description": "Webhook delivery response",
             "type": "object",
             "properties": {
+                "delivery": {
+                    "$ref": "#/definitions/WebhookDelivery"
+                },
                 "message": {
                     "type": "string",
-                    "example": "Stock released successfully"
-                },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                    "example": "Test event sent"
                 }
             }
         },
-        "ReserveStockRequest": {
-            "description": "Request body for reserving stock",
+        "WebhookEvent": {
+            "description": "Webhook event",
             "type": "object",
-            "required": [
-                "order_id",
-                "product_id",
-                "quantity"
-            ],
             "properties": {
-                "order_id": {
+                "data": {
+                    "type": "object"
+                },
+                "id": {
                     "type": "string",
-                    "example": "ord_123456"
+                    "example": "9a7e3c0d-41b2-4f59-8c3e-2d6b1f0a5e47"
                 },
-                "product_id": {
-                    "type": "integer",
-                    "example": 1
+                "occurred_at": {
+                    "type": "string"
                 },
-                "quantity": {
-                    "type": "integer",
-                    "example": 10
-                }
-            }
-        },
-        "ReserveStockResponse": {
-            "description": "Stock reservation response",
-            "type": "object",
-            "properties": {
-                "message": {
+                "tenant": {
                     "type": "string",
-                    "example": "Stock reserved successfully"
+                    "example": "default"
                 },
-                "reservation_id": {
+                "type": {
                     "type": "string",
-                    "example": "res_123456"
-                },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                    "example": "order.created"
                 }
             }
         },
-        "SuccessResponse": {
-            "description": "Success response",
+        "WebhookEventTypesResponse": {
+            "description": "Webhook event types",
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string",
-                    "example": "Operation completed successfully"
-                },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order.created",
+                        "order.status_changed",
+                        "inventory.low_stock",
+                        "product.updated"
+                    ]
                 }
             }
         },
-        "UpdateInventoryItemRequest": {
-            "description": "Request body for updating an inventory item",
+        "WebhookResponse": {
+            "description": "Webhook response",
             "type": "object",
-            "required": [
-                "location",
-                "quantity"
-            ],
             "properties": {
-                "location": {
+                "message": {
                     "type": "string",
-                    "example": "Warehouse A"
+                    "example": "Webhook created successfully"
                 },
-                "quantity": {
-                    "type": "integer",
-                    "example": 100
-                }
-            }
-        },
-        "UpdateOrderStatusRequest": {
-            "description": "Request body for updating order status",
-            "type": "object",
-            "required": [
-                "status"
-            ],
-            "properties": {
-                "status": {
-                    "type": "string",
-                    "example": "CONFIRMED"
+                "webhook": {
+                    "$ref": "#/definitions/Webhook"
                 }
             }
         },
-        "UpdateUserRequest": {
-            "description": "Request body for updating a user",
+        "WebhooksListResponse": {
+            "description": "Webhooks list response",
             "type": "object",
-            "required": [
-                "age",
-                "email",
-                "name"
-            ],
             "properties": {
-                "age": {
+                "total": {
                     "type": "integer",
-                    "example": 30
-                },
-                "email": {
-                    "type": "string",
-                    "example": "john@example.com"
+                    "example": 2
                 },
-                "name": {
-                    "type": "string",
-                    "example": "John Doe"
+                "webhooks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Webhook"
+                    }
                 }
             }
         },
-        "User": {
-            "description": "User information",
+        "models.CategoryNode": {
+            "description": "Category tree node",
             "type": "object",
             "properties": {
-                "age": {
-                    "type": "integer",
-                    "example": 30
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CategoryNode"
+                    }
                 },
                 "created_at": {
                     "type": "string",
                     "example": "2023-01-01T12:00:00Z"
                 },
-                "email": {
+                "description": {
                     "type": "string",
-                    "example": "john@example.com"
+                    "example": "Phones and accessories"
                 },
                 "id": {
                     "type": "integer",
-                    "example": 1
+                    "example": 7
                 },
                 "name": {
                     "type": "string",
-                    "example": "John Doe"
-                }
-            }
-        },
-        "UserProductsResponse": {
-            "description": "User products response",
-            "type": "object",
-            "properties": {
-                "products": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/Product"
-                    }
+                    "example": "Smartphones"
                 },
-                "total": {
+                "parent_id": {
+                    "description": "ParentID is omitted for root categories",
                     "type": "integer",
-                    "example": 5
-                }
-            }
-        },
-        "UserResponse": {
-            "description": "User response",
-            "type": "object",
-            "properties": {
-                "message": {
-                    "type": "string",
-                    "example": "User created successfully"
+                    "example": 3
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "slug": {
+                    "type": "string",
+                    "example": "smartphones"
                 },
-                "user": {
-                    "$ref": "#/definitions/User"
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
                 }
             }
         },
-        "UsersListResponse": {
-            "description": "Users list response",
+        "privacy.Request": {
             "type": "object",
             "properties": {
-                "limit": {
-                    "type": "integer",
-                    "example": 10
+                "created_at": {
+                    "type": "string"
                 },
-                "page": {
-                    "type": "integer",
-                    "example": 1
+                "detail": {
+                    "description": "Detail summarizes what was exported or erased, or why the request failed",
+                    "type": "string"
                 },
-                "total": {
-                    "type": "integer",
-                    "example": 100
+                "format": {
+                    "description": "Format is the export format, json or zip",
+                    "type": "string"
                 },
-                "users": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/User"
-                    }
+                "id": {
+                    "type": "string"
+                },
+                "requested_by": {
+                    "description": "RequestedBy identifies the caller as user:\u003cid\u003e, or by client IP for guests",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tenant": {
+                    "description": "Tenant is the storefront the request was made on",
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
+        },
+        "promotions.Type": {
+            "type": "string",
+            "enum": [
+                "percentage",
+                "fixed",
+                "buy_x_get_y"
+            ],
+            "x-enum-varnames": [
+                "Percentage",
+                "Fixed",
+                "BuyXGetY"
+            ]
         }
     },
     "securityDefinitions": {
+        "APIKeyAuth": {
+            "description": "API key of a machine client, limited to its scopes",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
         "BasicAuth": {
             "type": "basic"
+        },
+        "BearerAuth": {
+            "description": "Access token from /auth/login as \"Bearer \u003ctoken\u003e\"; requests without it are made as a guest",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
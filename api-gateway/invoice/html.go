@@ -0,0 +1,79 @@
+package invoice
+
+import (
+	"bytes"
+	"html/template"
+)
+
+var htmlTemplate = template.Must(template.New("invoice").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Invoice {{.Number}}</title>
+<style>
+  body { font-family: Helvetica, Arial, sans-serif; color: #222; margin: 40px; }
+  h1 { margin: 0 0 4px; }
+  .parties { display: flex; justify-content: space-between; margin: 24px 0; }
+  .parties div { white-space: pre-line; }
+  table { width: 100%; border-collapse: collapse; margin-top: 16px; }
+  th, td { padding: 6px 8px; border-bottom: 1px solid #ddd; text-align: left; }
+  .num { text-align: right; }
+  .totals td { border: none; }
+  .total td { font-weight: bold; border-top: 2px solid #222; }
+  .note { color: #666; font-size: 0.9em; margin-top: 16px; }
+</style>
+</head>
+<body>
+<h1>Invoice {{.Number}}</h1>
+<div>Issued {{.IssuedAt.Format "2006-01-02"}} &middot; Order {{.OrderID}}{{if .OrderDate}} placed {{.OrderDate}}{{end}}</div>
+
+<div class="parties">
+  <div><strong>From</strong>
+{{.Seller.Name}}{{if .Seller.Address}}
+{{.Seller.Address}}{{end}}{{if .Seller.Email}}
+{{.Seller.Email}}{{end}}{{if .Seller.TaxID}}
+Tax ID: {{.Seller.TaxID}}{{end}}</div>
+  <div><strong>Bill to</strong>
+{{.Customer.Name}}{{if .Customer.Email}}
+{{.Customer.Email}}{{end}}</div>
+</div>
+
+<table>
+  <thead>
+    <tr><th>Item</th><th class="num">Qty</th><th class="num">Unit price</th><th class="num">Amount</th></tr>
+  </thead>
+  <tbody>
+  {{- range .Lines}}
+    <tr><td>{{.Description}}</td><td class="num">{{.Quantity}}</td><td class="num">{{.UnitPrice}}</td><td class="num">{{.Subtotal}}</td></tr>
+  {{- end}}
+  </tbody>
+</table>
+
+<table class="totals">
+  <tr><td>Subtotal</td><td class="num">{{.Subtotal}}</td></tr>
+  {{- range .Discounts}}
+  <tr><td>{{.Description}}</td><td class="num">-{{.Amount}}</td></tr>
+  {{- end}}
+  {{- range .TaxLines}}
+  <tr><td>{{.Description}}{{if $.PricesIncludeTax}} (included){{end}}</td><td class="num">{{.Amount}}</td></tr>
+  {{- end}}
+  <tr class="total"><td>Total</td><td class="num">{{.Total}}</td></tr>
+  {{- if .Settled}}
+  <tr><td>Paid in {{.SettlementCurrency}} at {{.ExchangeRate}}</td><td class="num">{{.SettlementTotal}}</td></tr>
+  {{- end}}
+</table>
+{{- if .PricesIncludeTax}}
+<p class="note">Prices include tax.</p>
+{{- end}}
+</body>
+</html>
+`))
+
+// RenderHTML renders the invoice as a standalone HTML page
+func RenderHTML(inv *Invoice) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, inv); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,94 @@
+// Package invoice issues sequentially numbered invoices for orders and
+// renders them as HTML and PDF. Issued invoices are stored as immutable
+// snapshots so later catalog changes never alter a document.
+package invoice
+
+import (
+	"errors"
+	"time"
+
+	"api-gateway/money"
+)
+
+// ErrNotFound is returned when no invoice has been issued for an order
+var ErrNotFound = errors.New("invoice not found")
+
+// Format is a rendered document type
+type Format string
+
+const (
+	// PDF renders the invoice as a PDF document
+	PDF Format = "pdf"
+	// HTML renders the invoice as a standalone HTML page
+	HTML Format = "html"
+)
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	if f == PDF {
+		return "application/pdf"
+	}
+	return "text/html; charset=utf-8"
+}
+
+// Party is the seller or the customer on an invoice
+type Party struct {
+	Name    string `json:"name"`
+	Address string `json:"address,omitempty"`
+	Email   string `json:"email,omitempty"`
+	TaxID   string `json:"tax_id,omitempty"`
+}
+
+// Line is a product line on an invoice
+type Line struct {
+	ProductID   int32       `json:"product_id"`
+	Description string      `json:"description"`
+	Quantity    int32       `json:"quantity"`
+	UnitPrice   money.Money `json:"unit_price"`
+	Subtotal    money.Money `json:"subtotal"`
+}
+
+// Adjustment is a discount on an invoice
+type Adjustment struct {
+	Description string      `json:"description"`
+	Amount      money.Money `json:"amount"`
+}
+
+// TaxLine is the tax charged at one rate
+type TaxLine struct {
+	Description string      `json:"description"`
+	Rate        string      `json:"rate"`
+	Taxable     money.Money `json:"taxable"`
+	Amount      money.Money `json:"amount"`
+}
+
+// Invoice is the snapshot of an order an invoice is rendered from
+type Invoice struct {
+	Number    string    `json:"number"`
+	IssuedAt  time.Time `json:"issued_at"`
+	OrderID   string    `json:"order_id"`
+	OrderDate string    `json:"order_date"`
+	Seller    Party     `json:"seller"`
+	Customer  Party     `json:"customer"`
+
+	Currency         string       `json:"currency"`
+	Lines            []Line       `json:"lines"`
+	Discounts        []Adjustment `json:"discounts"`
+	TaxLines         []TaxLine    `json:"tax_lines"`
+	Subtotal         money.Money  `json:"subtotal"`
+	Discount         money.Money  `json:"discount"`
+	Tax              money.Money  `json:"tax"`
+	Total            money.Money  `json:"total"`
+	PricesIncludeTax bool         `json:"prices_include_tax"`
+
+	// Settlement is shown when the customer paid in another currency
+	SettlementCurrency string      `json:"settlement_currency"`
+	ExchangeRate       string      `json:"exchange_rate"`
+	SettlementTotal    money.Money `json:"settlement_total"`
+}
+
+// Settled reports whether the invoice was paid in a currency other than
+// the one it is priced in
+func (inv *Invoice) Settled() bool {
+	return inv.SettlementCurrency != "" && inv.SettlementCurrency != inv.Currency
+}
@@ -0,0 +1,128 @@
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// RenderPDF renders the invoice as an A4 PDF document using the core
+// Helvetica font, so no font files are needed at runtime
+func RenderPDF(inv *Invoice) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle("Invoice "+inv.Number, true)
+	pdf.SetCreator(inv.Seller.Name, true)
+	pdf.SetMargins(20, 20, 20)
+	pdf.SetAutoPageBreak(true, 20)
+	// core fonts are cp1252; translate UTF-8 text before writing it
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(0, 10, tr("Invoice "+inv.Number), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	issued := fmt.Sprintf("Issued %s  -  Order %s", inv.IssuedAt.Format("2006-01-02"), inv.OrderID)
+	if inv.OrderDate != "" {
+		issued += " placed " + inv.OrderDate
+	}
+	pdf.CellFormat(0, 6, tr(issued), "", 1, "L", false, 0, "")
+	pdf.Ln(6)
+
+	// seller and customer side by side
+	top := pdf.GetY()
+	party(pdf, tr, "From", sellerLines(inv.Seller), 20, top)
+	left := pdf.GetY()
+	party(pdf, tr, "Bill to", customerLines(inv.Customer), 115, top)
+	pdf.SetY(max(left, pdf.GetY()) + 6)
+
+	widths := []float64{85, 20, 32.5, 32.5}
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.SetFillColor(240, 240, 240)
+	for i, header := range []string{"Item", "Qty", "Unit price", "Amount"} {
+		align := "R"
+		if i == 0 {
+			align = "L"
+		}
+		pdf.CellFormat(widths[i], 8, header, "B", 0, align, true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 10)
+	for _, line := range inv.Lines {
+		pdf.CellFormat(widths[0], 7, tr(line.Description), "B", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[1], 7, fmt.Sprint(line.Quantity), "B", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[2], 7, line.UnitPrice.String(), "B", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], 7, line.Subtotal.String(), "B", 1, "R", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	total := func(label, amount string, bold bool) {
+		style := ""
+		if bold {
+			style = "B"
+		}
+		pdf.SetFont("Helvetica", style, 10)
+		pdf.CellFormat(widths[0]+widths[1]+widths[2], 7, tr(label), "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], 7, amount, "", 1, "R", false, 0, "")
+	}
+	total("Subtotal", inv.Subtotal.String(), false)
+	for _, d := range inv.Discounts {
+		total(d.Description, "-"+d.Amount.String(), false)
+	}
+	for _, t := range inv.TaxLines {
+		label := t.Description
+		if inv.PricesIncludeTax {
+			label += " (included)"
+		}
+		total(label, t.Amount.String(), false)
+	}
+	total("Total", inv.Total.String(), true)
+	if inv.Settled() {
+		total(fmt.Sprintf("Paid in %s at %s", inv.SettlementCurrency, inv.ExchangeRate), inv.SettlementTotal.String(), false)
+	}
+	if inv.PricesIncludeTax {
+		pdf.Ln(4)
+		pdf.SetFont("Helvetica", "I", 9)
+		pdf.CellFormat(0, 6, "Prices include tax.", "", 1, "L", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func party(pdf *gofpdf.Fpdf, tr func(string) string, title string, lines []string, x, y float64) {
+	pdf.SetXY(x, y)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(80, 6, title, "", 2, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	for _, line := range lines {
+		pdf.CellFormat(80, 5, tr(line), "", 2, "L", false, 0, "")
+	}
+}
+
+func sellerLines(p Party) []string {
+	lines := []string{p.Name}
+	if p.Address != "" {
+		lines = append(lines, strings.Split(p.Address, "\n")...)
+	}
+	if p.Email != "" {
+		lines = append(lines, p.Email)
+	}
+	if p.TaxID != "" {
+		lines = append(lines, "Tax ID: "+p.TaxID)
+	}
+	return lines
+}
+
+func customerLines(p Party) []string {
+	lines := []string{p.Name}
+	if p.Email != "" {
+		lines = append(lines, p.Email)
+	}
+	return lines
+}
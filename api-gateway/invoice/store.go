@@ -0,0 +1,131 @@
+package invoice
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"api-gateway/store"
+)
+
+// BuildFunc assembles the invoice contents for an order. Number and IssuedAt
+// are filled in by the store.
+type BuildFunc func() (*Invoice, error)
+
+// Store issues invoice numbers and keeps the rendered documents on disk
+type Store struct {
+	mu    sync.Mutex
+	dir   string
+	index index
+}
+
+// index is the on-disk record of issued numbers
+type index struct {
+	LastNumber int64             `json:"last_number"`
+	Orders     map[string]string `json:"orders"`
+}
+
+// NewStore opens the invoice directory, creating it when missing
+func NewStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	s := &Store{dir: dir, index: index{Orders: make(map[string]string)}}
+	if err := store.Load(s.indexPath(), &s.index); err != nil {
+		return nil, err
+	}
+	if s.index.Orders == nil {
+		s.index.Orders = make(map[string]string)
+	}
+	return s, nil
+}
+
+// Number returns the invoice number issued for an order
+func (s *Store) Number(orderID string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	number, ok := s.index.Orders[orderID]
+	return number, ok
+}
+
+// Issue returns the invoice number of an order, issuing a new invoice with
+// the next sequential number when none exists. build runs without the lock
+// held, so a concurrent request may win the race; its invoice is kept.
+func (s *Store) Issue(orderID string, build BuildFunc) (string, error) {
+	if number, ok := s.Number(orderID); ok {
+		return number, nil
+	}
+
+	inv, err := build()
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if number, ok := s.index.Orders[orderID]; ok {
+		return number, nil
+	}
+
+	inv.Number = fmt.Sprintf("INV-%06d", s.index.LastNumber+1)
+	inv.IssuedAt = time.Now().UTC()
+	inv.OrderID = orderID
+
+	htmlDoc, err := RenderHTML(inv)
+	if err != nil {
+		return "", err
+	}
+	pdfDoc, err := RenderPDF(inv)
+	if err != nil {
+		return "", err
+	}
+
+	if err := store.Save(s.path(inv.Number, "json"), inv); err != nil {
+		return "", err
+	}
+	if err := writeFile(s.path(inv.Number, string(HTML)), htmlDoc); err != nil {
+		return "", err
+	}
+	if err := writeFile(s.path(inv.Number, string(PDF)), pdfDoc); err != nil {
+		return "", err
+	}
+
+	s.index.LastNumber++
+	s.index.Orders[orderID] = inv.Number
+	if err := store.Save(s.indexPath(), s.index); err != nil {
+		s.index.LastNumber--
+		delete(s.index.Orders, orderID)
+		return "", err
+	}
+	return inv.Number, nil
+}
+
+// Document returns a stored rendering of an invoice
+func (s *Store) Document(number string, format Format) ([]byte, error) {
+	data, err := os.ReadFile(s.path(number, string(format)))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+func (s *Store) indexPath() string {
+	return filepath.Join(s.dir, "index.json")
+}
+
+func (s *Store) path(number, ext string) string {
+	return filepath.Join(s.dir, number+"."+ext)
+}
+
+// writeFile writes a document atomically
+func writeFile(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
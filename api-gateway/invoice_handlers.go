@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"api-gateway/invoice"
+	"api-gateway/proto"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errCancelledOrder = errors.New("cannot invoice a cancelled order")
+
+// buildInvoice collects the order, customer and product names for a new
+// invoice. Amounts are taken from the order as recorded, never from the
+// current catalog.
+func buildInvoice(ctx context.Context, orderID string) (*invoice.Invoice, error) {
+	resp, err := clients.OrderClient.GetOrder(ctx, &proto.GetOrderRequest{Id: orderID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Order.Status == proto.OrderStatus_CANCELLED {
+		return nil, errCancelledOrder
+	}
+	order := presentOrder(resp.Order, "")
+
+	customer := invoice.Party{Name: fmt.Sprintf("Customer #%d", order.UserID)}
+	userResp, err := clients.UserClient.GetUser(ctx, &proto.GetUserRequest{UserId: order.UserID})
+	if err != nil {
+		return nil, err
+	}
+	if userResp.Found {
+		customer.Name = userResp.User.Name
+		customer.Email = userResp.User.Email
+	}
+
+	inv := &invoice.Invoice{
+		OrderDate: order.CreatedAt,
+		Seller: invoice.Party{
+			Name:    cfg.SellerName,
+			Address: cfg.SellerAddress,
+			Email:   cfg.SellerEmail,
+			TaxID:   cfg.SellerTaxID,
+		},
+		Customer:           customer,
+		Currency:           order.Currency,
+		Subtotal:           order.Subtotal,
+		Discount:           order.Discount,
+		Tax:                order.Tax,
+		Total:              order.Total,
+		PricesIncludeTax:   order.PricesIncludeTax,
+		SettlementCurrency: order.SettlementCurrency,
+		ExchangeRate:       order.ExchangeRate,
+		SettlementTotal:    order.SettlementTotal,
+	}
+
+	names := make(map[int32]string)
+	for _, item := range order.Items {
+		name, ok := names[item.ProductID]
+		if !ok {
+			name = fmt.Sprintf("Product #%d", item.ProductID)
+			productResp, err := clients.ProductClient.GetProduct(ctx, &proto.GetProductRequest{ProductId: item.ProductID})
+			if err != nil {
+				return nil, err
+			}
+			if productResp.Found {
+				name = productResp.Product.Name
+			}
+			names[item.ProductID] = name
+		}
+		description := name
+		if item.SKU != "" {
+			description = fmt.Sprintf("%s (%s)", name, item.SKU)
+		}
+
+		inv.Lines = append(inv.Lines, invoice.Line{
+			ProductID:   item.ProductID,
+			Description: description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Subtotal:    item.Subtotal,
+		})
+	}
+	for _, d := range order.Discounts {
+		description := d.Description
+		if d.Code != "" {
+			description = fmt.Sprintf("%s (%s)", d.Description, d.Code)
+		}
+		inv.Discounts = append(inv.Discounts, invoice.Adjustment{Description: description, Amount: d.Amount})
+	}
+	for _, t := range order.TaxLines {
+		inv.TaxLines = append(inv.TaxLines, invoice.TaxLine{
+			Description: fmt.Sprintf("%s %s (%s)", t.Name, percent(t.Rate), t.Category),
+			Rate:        t.Rate,
+			Taxable:     t.Taxable,
+			Amount:      t.Amount,
+		})
+	}
+
+	return inv, nil
+}
+
+// percent formats a decimal rate such as "0.19" as "19%"
+func percent(rate string) string {
+	r, ok := new(big.Rat).SetString(rate)
+	if !ok {
+		return rate
+	}
+	s := r.Mul(r, big.NewRat(100, 1)).FloatString(4)
+	return strings.TrimRight(strings.TrimRight(s, "0"), ".") + "%"
+}
+
+// sendInvoice issues the invoice of an order on first request and returns
+// the stored document in the requested format
+func sendInvoice(c *fiber.Ctx, format invoice.Format) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid order ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	number, err := invoices.Issue(id, func() (*invoice.Invoice, error) {
+		return buildInvoice(ctx, id)
+	})
+	if err != nil {
+		switch {
+		case status.Code(err) == codes.NotFound:
+			return c.Status(404).JSON(fiber.Map{"error": "Order not found"})
+		case errors.Is(err, errCancelledOrder):
+			return c.Status(409).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	doc, err := invoices.Document(number, format)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c.Set(fiber.HeaderContentType, format.ContentType())
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`inline; filename="%s.%s"`, number, format))
+	return c.Send(doc)
+}
+
+// getInvoicePDF Get Invoice PDF
+// @Summary      Download order invoice as PDF
+// @Description  Issue the invoice of an order on first request and return it as PDF. Later downloads return the same stored document.
+// @Tags         Orders
+// @Produce      application/pdf
+// @Param        id   path      string  true  "Order ID"
+// @Success      200  {file}    file
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /orders/{id}/invoice.pdf [get]
+func getInvoicePDF(c *fiber.Ctx) error {
+	return sendInvoice(c, invoice.PDF)
+}
+
+// getInvoiceHTML Get Invoice HTML
+// @Summary      Get order invoice as HTML
+// @Description  Issue the invoice of an order on first request and return it as an HTML page. Later requests return the same stored document.
+// @Tags         Orders
+// @Produce      html
+// @Param        id   path      string  true  "Order ID"
+// @Success      200  {string}  string
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /orders/{id}/invoice.html [get]
+func getInvoiceHTML(c *fiber.Ctx) error {
+	return sendInvoice(c, invoice.HTML)
+}
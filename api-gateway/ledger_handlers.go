@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/proto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// parseAdjustment reads a relative stock adjustment given either as a JSON
+// number (5, -3) or as a signed string ("+5", "-3")
+func parseAdjustment(raw json.RawMessage) (int32, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || string(raw) == "null" {
+		return 0, nil
+	}
+
+	text := string(raw)
+	if raw[0] == '"' {
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return 0, fiber.NewError(fiber.StatusBadRequest, "Invalid adjustment")
+		}
+	}
+	delta, err := strconv.ParseInt(strings.TrimSpace(text), 10, 32)
+	if err != nil {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "Invalid adjustment "+text+": use a signed whole number such as +5 or -3")
+	}
+	return int32(delta), nil
+}
+
+// getInventoryHistory Get Inventory History
+// @Summary      Get the stock history of an inventory item
+// @Description  Get the ledger of an inventory item, newest first. Every change to its quantity or reserved quantity is recorded with type, reason, actor, delta and resulting balance.
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int  true   "Inventory Item ID"
+// @Param        page   query     int  false  "Page number"     default(1)
+// @Param        limit  query     int  false  "Items per page"  default(20)
+// @Success      200    {object}  models.InventoryHistoryResponse
+// @Failure      400    {object}  models.ErrorResponse
+// @Failure      404    {object}  models.ErrorResponse
+// @Failure      500    {object}  models.ErrorResponse
+// @Router       /inventory/{id}/history [get]
+func getInventoryHistory(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid inventory item ID"})
+	}
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "20"))
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.InventoryClient.GetInventoryHistory(ctx, &proto.GetInventoryHistoryRequest{
+		Id:    int32(id),
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"entries": presentLedgerEntries(resp.Entries),
+		"total":   resp.Total,
+		"page":    resp.Page,
+		"limit":   resp.Limit,
+	})
+}
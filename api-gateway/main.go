@@ -16,19 +16,41 @@
 
 // @securityDefinitions.basic  BasicAuth
 
+// @securityDefinitions.apikey  BearerAuth
+// @in                          header
+// @name                        Authorization
+// @description                 Access token from /auth/login as "Bearer <token>"; requests without it are made as a guest
+
+// @securityDefinitions.apikey  APIKeyAuth
+// @in                          header
+// @name                        X-API-Key
+// @description                 API key of a machine client, limited to its scopes
+
 package main
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
+	"api-gateway/config"
+	"api-gateway/currency"
+	"api-gateway/invoice"
+	"api-gateway/media"
 	"api-gateway/models"
+	"api-gateway/oidc"
+	"api-gateway/privacy"
+	"api-gateway/promotions"
 	"api-gateway/proto"
+	"api-gateway/rbac"
+	"api-gateway/tax"
+	"api-gateway/tenant"
+	"api-gateway/webhook"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gofiber/swagger"
@@ -47,7 +69,35 @@ type GrpcClients struct {
 
 var clients *GrpcClients
 
+var cfg *config.Config
+
+var converter *currency.Converter
+
+var promos *promotions.Store
+
+var taxes tax.Calculator
+
+var invoices *invoice.Store
+
+var privacyLog *privacy.AuditLog
+
+var mediaStore media.Storage
+
+var policy *rbac.Policy
+
+var providers map[string]*oidc.Provider
+
+var oidcLogins = oidc.NewLogins()
+
+var tenants *tenant.Registry
+
+var webhooks *webhook.Store
+
+var webhookDispatcher *webhook.Dispatcher
+
 func main() {
+	cfg = config.Load()
+
 	// Initialize gRPC clients
 	var err error
 	clients, err = initGrpcClients()
@@ -55,9 +105,90 @@ func main() {
 		log.Fatal("Failed to initialize gRPC clients:", err)
 	}
 
+	// Load exchange rates for multi-currency pricing
+	converter, err = currency.NewConverter(cfg.DefaultCurrency, cfg.ExchangeRatesFile)
+	if err != nil {
+		log.Fatal("Failed to load exchange rates:", err)
+	}
+
+	// Load the storefronts the gateway serves
+	tenants, err = tenant.Load(cfg.TenantsFile, cfg.DefaultTenant)
+	if err != nil {
+		log.Fatal("Failed to load tenants:", err)
+	}
+	checkTenantCurrencies()
+
+	// Load promotions and coupon usage
+	promos, err = promotions.NewStore(cfg.PromotionsFile, tenants.Default().ID)
+	if err != nil {
+		log.Fatal("Failed to load promotions:", err)
+	}
+
+	// Load webhooks and their outbox of pending deliveries
+	webhooks, err = webhook.NewStore(cfg.WebhooksFile)
+	if err != nil {
+		log.Fatal("Failed to load webhooks:", err)
+	}
+	webhookDispatcher = webhook.NewDispatcher(webhooks, webhook.RetryPolicy{
+		MaxAttempts: cfg.WebhookMaxAttempts,
+		BaseDelay:   cfg.WebhookRetryBaseDelay,
+		MaxDelay:    cfg.WebhookRetryMaxDelay,
+	}, cfg.WebhookTimeout)
+
+	// Load tax rates per region and product category
+	taxes, err = tax.LoadTable(cfg.TaxRatesFile)
+	if err != nil {
+		log.Fatal("Failed to load tax rates:", err)
+	}
+
+	// Open the invoice archive
+	invoices, err = invoice.NewStore(cfg.InvoiceDir)
+	if err != nil {
+		log.Fatal("Failed to open invoice store:", err)
+	}
+
+	// Open the audit trail of data export and erasure requests
+	privacyLog, err = privacy.NewAuditLog(cfg.PrivacyAuditFile, tenants.Default().ID)
+	if err != nil {
+		log.Fatal("Failed to load privacy audit log:", err)
+	}
+
+	// Load the role permissions requests are checked against
+	policy, err = rbac.LoadPolicy(cfg.RBACPolicyFile)
+	if err != nil {
+		log.Fatal("Failed to load RBAC policy:", err)
+	}
+
+	// Load the external identity providers users can sign in with
+	providers, err = oidc.LoadProviders(cfg.OIDCProvidersFile)
+	if err != nil {
+		log.Fatal("Failed to load OIDC providers:", err)
+	}
+	checkProviderRoles()
+
+	// Open the blob storage product images are kept in
+	mediaStore, err = openMediaStorage(cfg)
+	if err != nil {
+		log.Fatal("Failed to open media storage:", err)
+	}
+
+	// Watch inventory for items at their reorder point
+	startLowStockMonitor()
+
+	// Apply scheduled price changes as they fall due
+	startPriceScheduler()
+
+	// Purge users and products soft deleted longer than the retention period
+	startPurgeJob()
+
+	// Deliver webhooks for gateway actions and Kafka events
+	startWebhooks()
+
 	// Create Fiber app
 	app := fiber.New(fiber.Config{
 		ErrorHandler: globalErrorHandler,
+		// leave room for the multipart framing around the largest upload
+		BodyLimit: cfg.MediaMaxBytes + 1<<20,
 	})
 
 	// Middleware
@@ -65,14 +196,10 @@ func main() {
 	app.Use(logger.New(logger.Config{
 		Format: "${time} ${status} - ${method} ${path} ${latency}\n",
 	}))
-	app.Use(cors.New(cors.Config{
-		AllowOrigins:     "*",
-		AllowMethods:     "GET,POST,PUT,DELETE,OPTIONS",
-		AllowHeaders:     "Origin,Content-Type,Accept,Authorization,X-Requested-With",
-		AllowCredentials: false,
-		ExposeHeaders:    "Content-Length",
-		MaxAge:           86400,
-	}))
+	// Serve each request for the storefront its path prefix or host names,
+	// with the origins that storefront allows
+	app.Use(resolveTenant)
+	app.Use(tenantCORS())
 
 	// Swagger documentation
 	app.Get("/swagger/*", swagger.HandlerDefault)
@@ -80,46 +207,176 @@ func main() {
 	// Health check endpoint
 	app.Get("/health", healthCheck)
 
+	// Every API route is checked against the caller's roles
+	app.Use(identifyCaller)
+
+	// Other requests are served for the caller's storefront; each storefront
+	// limits the rate of its clients
+	app.Use(claimTenant)
+	app.Use(tenantRateLimit())
+
+	// Uploaded product images
+	app.Get("/media/*", requireScope(rbac.AreaProducts), authorize(rbac.CatalogRead), serveMedia)
+
 	// API routes
 	api := app.Group("/api")
 
-	// User routes
-	userRoutes := api.Group("/users")
-	userRoutes.Post("/", createUser)
-	userRoutes.Get("/", listUsers)
-	userRoutes.Get("/:id", getUser)
-	userRoutes.Put("/:id", updateUser)
-	userRoutes.Delete("/:id", deleteUser)
-	userRoutes.Get("/:id/products", getUserProducts)
-
-	// Product routes
-	productRoutes := api.Group("/products")
-	productRoutes.Post("/", createProduct)
-	productRoutes.Get("/", listProducts)
-	productRoutes.Get("/:id", getProduct)
-
-	// Inventory routes
-	inventoryRoutes := api.Group("/inventory")
-	inventoryRoutes.Post("/", createInventoryItem)
-	inventoryRoutes.Get("/:id", getInventoryItem)
-	inventoryRoutes.Put("/:id", updateInventoryItem)
-	inventoryRoutes.Get("/", listInventoryItems)
-	inventoryRoutes.Post("/check-stock", checkStock)
-	inventoryRoutes.Post("/reserve-stock", reserveStock)
-	inventoryRoutes.Post("/release-stock", releaseStock)
-
-	// Order routes
-	orderRoutes := api.Group("/orders")
-	orderRoutes.Post("/", createOrder)
-	orderRoutes.Get("/:id", getOrder)
-	orderRoutes.Get("/", listOrders)
-	orderRoutes.Put("/:id/status", updateOrderStatus)
+	// Storefront routes
+	api.Get("/storefront", getStorefront)
+
+	// Auth routes
+	authRoutes := api.Group("/auth")
+	authRoutes.Post("/signup", requireFeature(tenant.FeatureSignup), authorize(rbac.UsersCreate), signup)
+	authRoutes.Post("/login", login)
+	authRoutes.Post("/refresh", refreshTokens)
+	authRoutes.Post("/logout", logout)
+	authRoutes.Get("/me", requireScope(rbac.AreaUsers), authorize(rbac.UsersReadOwn), getCurrentUser)
+	authRoutes.Get("/verify-email", verifyEmail)
+	authRoutes.Post("/verify-email/resend", resendVerification)
+	authRoutes.Post("/password-reset/request", requestPasswordReset)
+	authRoutes.Post("/password-reset/confirm", resetPassword)
+	authRoutes.Get("/oidc", requireFeature(tenant.FeatureOIDC), listOIDCProviders)
+	authRoutes.Get("/oidc/:provider/login", requireFeature(tenant.FeatureOIDC), oidcLogin)
+	authRoutes.Get("/oidc/:provider/callback", oidcCallback)
+
+	// User routes; self-service accounts are created through /auth/signup
+	userRoutes := api.Group("/users", requireScope(rbac.AreaUsers))
+	userRoutes.Post("/", authorize(rbac.UsersManageAny), createUser)
+	userRoutes.Get("/", authorize(rbac.UsersReadAny), listUsers)
+	userRoutes.Get("/:id", authorizeOwned(rbac.UsersReadOwn, rbac.UsersReadAny, userParam), getUser)
+	userRoutes.Put("/:id", authorizeOwned(rbac.UsersManageOwn, rbac.UsersManageAny, userParam), updateUser)
+	userRoutes.Delete("/:id", authorizeOwned(rbac.UsersManageOwn, rbac.UsersManageAny, userParam), deleteUser)
+	userRoutes.Post("/:id/restore", authorizeOwned(rbac.UsersManageOwn, rbac.UsersManageAny, userParam), restoreUser)
+	userRoutes.Get("/:id/data-export", authorizeOwned(rbac.UsersManageOwn, rbac.UsersManageAny, userParam), exportUserData)
+	userRoutes.Post("/:id/erase", authorizeOwned(rbac.UsersManageOwn, rbac.UsersManageAny, userParam), eraseUser)
+	userRoutes.Get("/:id/products", authorize(rbac.CatalogRead), getUserProducts)
+	userRoutes.Post("/:id/api-keys", requireFeature(tenant.FeatureAPIKeys), authorizeOwned(rbac.APIKeysManageOwn, rbac.APIKeysManageAny, userParam), createAPIKey)
+	userRoutes.Get("/:id/api-keys", requireFeature(tenant.FeatureAPIKeys), authorizeOwned(rbac.APIKeysManageOwn, rbac.APIKeysManageAny, userParam), listAPIKeys)
+	userRoutes.Delete("/:id/api-keys/:keyId", requireFeature(tenant.FeatureAPIKeys), authorizeOwned(rbac.APIKeysManageOwn, rbac.APIKeysManageAny, userParam), revokeAPIKey)
+
+	// Product routes; sellers manage their own products
+	ownProduct := authorizeOwned(rbac.ProductsManageOwn, rbac.ProductsManageAny, productParam)
+	productRoutes := api.Group("/products", requireScope(rbac.AreaProducts))
+	productRoutes.Post("/", authorizeOwned(rbac.ProductsManageOwn, rbac.ProductsManageAny, userBody), createProduct)
+	productRoutes.Get("/", authorize(rbac.CatalogRead), listProducts)
+	productRoutes.Get("/sku/:sku", authorize(rbac.CatalogRead), getProductBySKU)
+	productRoutes.Get("/:id", authorize(rbac.CatalogRead), getProduct)
+	productRoutes.Put("/:id", ownProduct, updateProduct)
+	productRoutes.Delete("/:id", ownProduct, deleteProduct)
+	productRoutes.Post("/:id/restore", ownProduct, restoreProduct)
+	productRoutes.Post("/:id/variants", ownProduct, createVariant)
+	productRoutes.Get("/:id/variants", authorize(rbac.CatalogRead), listVariants)
+	productRoutes.Put("/:id/variants/:variantId", ownProduct, updateVariant)
+	productRoutes.Delete("/:id/variants/:variantId", ownProduct, deleteVariant)
+	productRoutes.Post("/:id/media", ownProduct, uploadProductMedia)
+	productRoutes.Get("/:id/media", authorize(rbac.CatalogRead), listProductMedia)
+	productRoutes.Put("/:id/media/:mediaId", ownProduct, updateProductMedia)
+	productRoutes.Delete("/:id/media/:mediaId", ownProduct, deleteProductMedia)
+	productRoutes.Post("/:id/reviews", requireFeature(tenant.FeatureReviews), authorizeOwned(rbac.ReviewsWriteOwn, rbac.ReviewsModerate, userBody), createReview)
+	productRoutes.Get("/:id/reviews", requireFeature(tenant.FeatureReviews), authorize(rbac.CatalogRead), listReviews)
+	productRoutes.Put("/:id/reviews/:reviewId", requireFeature(tenant.FeatureReviews), authorizeOwned(rbac.ReviewsWriteOwn, rbac.ReviewsModerate, reviewParam), updateReview)
+	productRoutes.Delete("/:id/reviews/:reviewId", requireFeature(tenant.FeatureReviews), authorizeOwned(rbac.ReviewsWriteOwn, rbac.ReviewsModerate, reviewParam), deleteReview)
+	productRoutes.Put("/:id/reviews/:reviewId/moderation", requireFeature(tenant.FeatureReviews), authorize(rbac.ReviewsModerate), moderateReview)
+	productRoutes.Get("/:id/price-history", authorize(rbac.CatalogRead), getPriceHistory)
+	productRoutes.Post("/:id/price-schedules", ownProduct, createPriceSchedule)
+	productRoutes.Get("/:id/price-schedules", authorize(rbac.CatalogRead), listPriceSchedules)
+	productRoutes.Delete("/:id/price-schedules/:scheduleId", ownProduct, cancelPriceSchedule)
+
+	// Category routes
+	categoryRoutes := api.Group("/categories", requireScope(rbac.AreaCategories))
+	categoryRoutes.Post("/", authorize(rbac.CategoriesManage), createCategory)
+	categoryRoutes.Get("/", authorize(rbac.CatalogRead), listCategories)
+	categoryRoutes.Get("/:id", authorize(rbac.CatalogRead), getCategory)
+	categoryRoutes.Put("/:id", authorize(rbac.CategoriesManage), updateCategory)
+	categoryRoutes.Put("/:id/move", authorize(rbac.CategoriesManage), moveCategory)
+	categoryRoutes.Delete("/:id", authorize(rbac.CategoriesManage), deleteCategory)
+	categoryRoutes.Get("/:id/products", authorize(rbac.CatalogRead), listCategoryProducts)
+
+	// Inventory routes; sellers manage the stock of their own products, moving
+	// stock between warehouses and reserving it is left to admins
+	inventoryRoutes := api.Group("/inventory", requireScope(rbac.AreaInventory))
+	inventoryRoutes.Post("/transfers", authorize(rbac.InventoryManageAny), createTransfer)
+	inventoryRoutes.Get("/transfers", authorize(rbac.InventoryRead), listTransfers)
+	inventoryRoutes.Get("/transfers/:id", authorize(rbac.InventoryRead), getTransfer)
+	inventoryRoutes.Put("/transfers/:id/status", authorize(rbac.InventoryManageAny), updateTransferStatus)
+	inventoryRoutes.Get("/reorder-suggestions", authorize(rbac.InventoryRead), getReorderSuggestions)
+	inventoryRoutes.Get("/reservations", authorize(rbac.InventoryRead), listReservations)
+	inventoryRoutes.Post("/reservations/:id/extend", authorize(rbac.InventoryManageAny), extendReservation)
+	inventoryRoutes.Post("/", authorizeOwned(rbac.InventoryManageOwn, rbac.InventoryManageAny, productBody), createInventoryItem)
+	inventoryRoutes.Get("/:id", authorize(rbac.InventoryRead), getInventoryItem)
+	inventoryRoutes.Put("/:id", authorizeOwned(rbac.InventoryManageOwn, rbac.InventoryManageAny, inventoryParam), updateInventoryItem)
+	inventoryRoutes.Get("/:id/history", authorize(rbac.InventoryRead), getInventoryHistory)
+	inventoryRoutes.Get("/", authorize(rbac.InventoryRead), listInventoryItems)
+	inventoryRoutes.Post("/check-stock", authorize(rbac.CatalogRead), checkStock)
+	inventoryRoutes.Post("/reserve-stock", authorize(rbac.InventoryManageAny), reserveStock)
+	inventoryRoutes.Post("/release-stock", authorize(rbac.InventoryManageAny), releaseStock)
+	inventoryRoutes.Post("/reserve-batch", authorize(rbac.InventoryManageAny), reserveStockBatch)
+	inventoryRoutes.Post("/release-batch", authorize(rbac.InventoryManageAny), releaseStockBatch)
+
+	// Warehouse routes
+	warehouseRoutes := api.Group("/warehouses", requireScope(rbac.AreaWarehouses))
+	warehouseRoutes.Post("/", authorize(rbac.WarehousesManage), createWarehouse)
+	warehouseRoutes.Get("/", authorize(rbac.InventoryRead), listWarehouses)
+	warehouseRoutes.Get("/:id", authorize(rbac.InventoryRead), getWarehouse)
+	warehouseRoutes.Put("/:id", authorize(rbac.WarehousesManage), updateWarehouse)
+	warehouseRoutes.Delete("/:id", authorize(rbac.WarehousesManage), deleteWarehouse)
+
+	// Order routes; customers place and see their own orders
+	ownOrder := authorizeOwned(rbac.OrdersReadOwn, rbac.OrdersReadAny, orderParam)
+	orderRoutes := api.Group("/orders", requireScope(rbac.AreaOrders))
+	orderRoutes.Post("/", authorizeOwned(rbac.OrdersCreateOwn, rbac.OrdersCreateAny, userBody), createOrder)
+	orderRoutes.Get("/:id", ownOrder, getOrder)
+	orderRoutes.Get("/", authorizeOwned(rbac.OrdersReadOwn, rbac.OrdersReadAny, userQuery), listOrders)
+	orderRoutes.Put("/:id/status", authorize(rbac.OrdersManage), updateOrderStatus)
+	orderRoutes.Get("/:id/invoice.pdf", ownOrder, getInvoicePDF)
+	orderRoutes.Get("/:id/invoice.html", ownOrder, getInvoiceHTML)
+
+	// Promotion routes
+	promotionRoutes := api.Group("/promotions", requireFeature(tenant.FeaturePromotions), requireScope(rbac.AreaPromotions))
+	promotionRoutes.Post("/evaluate", authorize(rbac.CatalogRead), evaluatePromotions)
+
+	// Admin routes
+	adminRoutes := api.Group("/admin", requireScope(rbac.AreaAdmin))
+	adminRoutes.Get("/exchange-rates", authorize(rbac.SettingsManage), getExchangeRates)
+	adminRoutes.Put("/exchange-rates", authorize(rbac.SettingsManage), updateExchangeRates)
+	adminRoutes.Get("/promotions", authorize(rbac.PromotionsManage), listPromotions)
+	adminRoutes.Post("/promotions", authorize(rbac.PromotionsManage), createPromotion)
+	adminRoutes.Get("/promotions/:id", authorize(rbac.PromotionsManage), getPromotion)
+	adminRoutes.Put("/promotions/:id", authorize(rbac.PromotionsManage), updatePromotion)
+	adminRoutes.Delete("/promotions/:id", authorize(rbac.PromotionsManage), deletePromotion)
+	adminRoutes.Get("/privacy-requests", authorize(rbac.PrivacyRead), listPrivacyRequests)
+	adminRoutes.Get("/roles", authorize(rbac.RolesManage), listRoles)
+	adminRoutes.Get("/users/:id/roles", authorize(rbac.RolesManage), getUserRoles)
+	adminRoutes.Post("/users/:id/roles", authorize(rbac.RolesManage), grantRole)
+	adminRoutes.Delete("/users/:id/roles/:role", authorize(rbac.RolesManage), revokeRole)
+
+	// Webhook routes; endpoints are notified of the storefront they are
+	// registered on
+	webhookRoutes := api.Group("/webhooks", requireScope(rbac.AreaWebhooks))
+	webhookRoutes.Get("/events", authorize(rbac.WebhooksManage), listWebhookEventTypes)
+	webhookRoutes.Post("/", authorize(rbac.WebhooksManage), createWebhook)
+	webhookRoutes.Get("/", authorize(rbac.WebhooksManage), listWebhooks)
+	webhookRoutes.Get("/:id", authorize(rbac.WebhooksManage), getWebhook)
+	webhookRoutes.Put("/:id", authorize(rbac.WebhooksManage), updateWebhook)
+	webhookRoutes.Delete("/:id", authorize(rbac.WebhooksManage), deleteWebhook)
+	webhookRoutes.Post("/:id/rotate-secret", authorize(rbac.WebhooksManage), rotateWebhookSecret)
+	webhookRoutes.Post("/:id/test", authorize(rbac.WebhooksManage), sendTestWebhook)
+	webhookRoutes.Get("/:id/deliveries", authorize(rbac.WebhooksManage), listWebhookDeliveries)
+	webhookRoutes.Post("/:id/deliveries/:deliveryId/retry", authorize(rbac.WebhooksManage), retryWebhookDelivery)
 
 	log.Println("🚀 API Gateway starting on port 8000")
+	log.Println("📍 Storefront: /api/storefront")
+	log.Println("📍 Auth endpoints: /api/auth")
 	log.Println("📍 User endpoints: /api/users")
 	log.Println("📍 Product endpoints: /api/products")
+	log.Println("📍 Category endpoints: /api/categories")
 	log.Println("📍 Inventory endpoints: /api/inventory")
+	log.Println("📍 Warehouse endpoints: /api/warehouses")
 	log.Println("📍 Order endpoints: /api/orders")
+	log.Println("📍 Promotion endpoints: /api/promotions")
+	log.Println("📍 Admin endpoints: /api/admin")
+	log.Println("📍 Webhook endpoints: /api/webhooks")
+	log.Println("📍 Product media: /media")
 	log.Println("📍 Health check: /health")
 	log.Println("📖 Swagger documentation: /swagger/")
 
@@ -127,20 +384,23 @@ func main() {
 }
 
 func initGrpcClients() (*GrpcClients, error) {
+	// Every call carries the storefront of the request it is made for
+	tenantCalls := grpc.WithUnaryInterceptor(tenant.UnaryClientInterceptor())
+
 	// Connect to User Service
-	userConn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	userConn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()), tenantCalls)
 	if err != nil {
 		return nil, err
 	}
 
 	// Connect to Product Service
-	productConn, err := grpc.Dial("localhost:50052", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	productConn, err := grpc.Dial("localhost:50052", grpc.WithTransportCredentials(insecure.NewCredentials()), tenantCalls)
 	if err != nil {
 		return nil, err
 	}
 
 	// Connect to Inventory Service
-	inventoryConn, err := grpc.Dial("localhost:50053", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	inventoryConn, err := grpc.Dial("localhost:50053", grpc.WithTransportCredentials(insecure.NewCredentials()), tenantCalls)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +464,7 @@ func createUser(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.UserClient.CreateUser(ctx, &proto.CreateUserRequest{
@@ -229,11 +489,12 @@ func createUser(c *fiber.Ctx) error {
 // @Tags         Users
 // @Accept       json
 // @Produce      json
-// @Param        id   path      int  true  "User ID"
-// @Success      200  {object}  models.User
-// @Failure      400  {object}  models.ErrorResponse
-// @Failure      404  {object}  models.ErrorResponse
-// @Failure      500  {object}  models.ErrorResponse
+// @Param        id               path      int   true   "User ID"
+// @Param        include_deleted  query     bool  false  "Also find a soft-deleted user"
+// @Success      200              {object}  models.User
+// @Failure      400              {object}  models.ErrorResponse
+// @Failure      404              {object}  models.ErrorResponse
+// @Failure      500              {object}  models.ErrorResponse
 // @Router       /users/{id} [get]
 func getUser(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
@@ -241,11 +502,12 @@ func getUser(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.UserClient.GetUser(ctx, &proto.GetUserRequest{
-		UserId: int32(id),
+		UserId:         int32(id),
+		IncludeDeleted: c.QueryBool("include_deleted"),
 	})
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
@@ -263,25 +525,27 @@ func getUser(c *fiber.Ctx) error {
 
 // listUsers List Users
 // @Summary      List all users with pagination
-// @Description  Get a paginated list of all users
+// @Description  Get a paginated list of all users, including soft-deleted ones with include_deleted=true
 // @Tags         Users
 // @Accept       json
 // @Produce      json
-// @Param        page   query     int  false  "Page number"  default(1)
-// @Param        limit  query     int  false  "Items per page"  default(10)
-// @Success      200    {object}  models.UsersListResponse
-// @Failure      500    {object}  models.ErrorResponse
+// @Param        page             query     int   false  "Page number"  default(1)
+// @Param        limit            query     int   false  "Items per page"  default(10)
+// @Param        include_deleted  query     bool  false  "Include soft-deleted users"
+// @Success      200              {object}  models.UsersListResponse
+// @Failure      500              {object}  models.ErrorResponse
 // @Router       /users [get]
 func listUsers(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page", "1"))
 	limit, _ := strconv.Atoi(c.Query("limit", "10"))
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.UserClient.ListUsers(ctx, &proto.ListUsersRequest{
-		Page:  int32(page),
-		Limit: int32(limit),
+		Page:           int32(page),
+		Limit:          int32(limit),
+		IncludeDeleted: c.QueryBool("include_deleted"),
 	})
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
@@ -319,7 +583,7 @@ func updateUser(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.UserClient.UpdateUser(ctx, &proto.UpdateUserRequest{
@@ -341,14 +605,20 @@ func updateUser(c *fiber.Ctx) error {
 
 // deleteUser Delete User
 // @Summary      Delete a user
-// @Description  Delete a user by their ID
+// @Description  Soft delete a user. The products and orders policies decide what happens to what the user owns: block refuses the delete while there are any, soft-cascade deletes the products and archives the orders with the user, and reassign hands them to reassign_to, which only admins can choose. The defaults come from USER_DELETE_PRODUCTS and USER_DELETE_ORDERS. If a step fails the deletion is rolled back. The user can be restored until it is purged after the retention period.
 // @Tags         Users
 // @Accept       json
 // @Produce      json
-// @Param        id   path      int  true  "User ID"
-// @Success      200  {object}  models.SuccessResponse
-// @Failure      400  {object}  models.ErrorResponse
-// @Failure      500  {object}  models.ErrorResponse
+// @Param        id           path      int     true   "User ID"
+// @Param        products     query     string  false  "Policy for the user's products"  Enums(block, soft-cascade, reassign)
+// @Param        orders       query     string  false  "Policy for the user's orders"  Enums(block, soft-cascade, reassign)
+// @Param        reassign_to  query     int     false  "User receiving reassigned products and orders"
+// @Success      200          {object}  models.DeleteUserResponse
+// @Failure      400          {object}  models.ErrorResponse
+// @Failure      403          {object}  models.ErrorResponse
+// @Failure      404          {object}  models.ErrorResponse
+// @Failure      409          {object}  models.ErrorResponse
+// @Failure      500          {object}  models.ErrorResponse
 // @Router       /users/{id} [delete]
 func deleteUser(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
@@ -356,27 +626,119 @@ func deleteUser(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	productPolicy, err := parseDeletePolicy("products", c.Query("products", cfg.UserDeleteProducts))
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+	orderPolicy, err := parseDeletePolicy("orders", c.Query("orders", cfg.UserDeleteOrders))
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+	reassign := productPolicy == policyReassign || orderPolicy == policyReassign
+	reassignTo, _ := strconv.Atoi(c.Query("reassign_to", "0"))
+	// handing records to another account is not self-service
+	if who := callerOf(c); reassign && !policy.Allows(who.Roles, rbac.UsersManageAny) {
+		return denied(c, who, "Permission denied: only admins can reassign a user's products and orders")
+	}
+	if reassign && (reassignTo <= 0 || reassignTo == id) {
+		return c.Status(400).JSON(fiber.Map{"error": "reassign_to must be another user"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
+	user, err := clients.UserClient.GetUser(ctx, &proto.GetUserRequest{UserId: int32(id)})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !user.Found {
+		return c.Status(404).JSON(fiber.Map{"error": "User not found"})
+	}
+	if reassign {
+		target, err := clients.UserClient.GetUser(ctx, &proto.GetUserRequest{UserId: int32(reassignTo)})
+		if err != nil {
+			return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		}
+		if !target.Found {
+			return c.Status(400).JSON(fiber.Map{"error": "User to reassign to not found"})
+		}
+	}
+
+	if err := checkDeleteBlocked(ctx, int32(id), productPolicy, orderPolicy); err != nil {
+		if e, ok := err.(*fiber.Error); ok {
+			return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+		}
+		return inventoryError(c, err)
+	}
+
+	// The soft delete and the cascade can be undone, reassigning cannot, so
+	// it comes last; a failure after the delete rolls the deletion back
 	resp, err := clients.UserClient.DeleteUser(ctx, &proto.DeleteUserRequest{
 		UserId: int32(id),
 	})
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
+	if !resp.Success {
+		return c.Status(500).JSON(fiber.Map{"error": resp.Message})
+	}
+
+	products := models.DeletionCascade{Policy: productPolicy}
+	orders := models.DeletionCascade{Policy: orderPolicy}
+	err = cascadeUserDeletion(ctx, int32(id), resp.User.DeletedAt, &products, &orders)
+	if err == nil {
+		err = reassignUserRecords(ctx, int32(id), int32(reassignTo), &products, &orders)
+	}
+	if err != nil {
+		log.Printf("deleting user %d failed, rolling back: %v", id, err)
+		undoUserDeletion(ctx, int32(id), resp.User.DeletedAt)
+		return inventoryError(c, err)
+	}
 
 	return c.JSON(fiber.Map{
-		"success": resp.Success,
-		"message": resp.Message,
+		"success":  resp.Success,
+		"message":  resp.Message,
+		"user":     resp.User,
+		"products": products,
+		"orders":   orders,
 	})
 }
 
 // Product endpoint handlers
 
+// parseStockSettings normalises a product's stock policy and validates the
+// expected restock date (YYYY-MM-DD); empty values are passed through
+func parseStockSettings(policy, restockDate string) (string, string, error) {
+	policy = strings.ToLower(strings.TrimSpace(policy))
+	switch policy {
+	case "", "none", "backorder", "preorder":
+	default:
+		return "", "", fiber.NewError(fiber.StatusBadRequest, "Invalid stock_policy "+policy+": use none, backorder or preorder")
+	}
+
+	restockDate = strings.TrimSpace(restockDate)
+	if restockDate != "" {
+		if _, err := time.Parse("2006-01-02", restockDate); err != nil {
+			return "", "", fiber.NewError(fiber.StatusBadRequest, "Invalid expected_restock_date "+restockDate+": use YYYY-MM-DD")
+		}
+	}
+	return policy, restockDate, nil
+}
+
+// toProtoOptions converts the option axes of a product request
+func toProtoOptions(options []models.ProductOption) []*proto.ProductOption {
+	var result []*proto.ProductOption
+	for _, o := range options {
+		result = append(result, &proto.ProductOption{Name: o.Name, Values: o.Values})
+	}
+	return result
+}
+
 // createProduct Create Product
 // @Summary      Create a new product
-// @Description  Create a new product with name, description, price and user_id
+// @Description  Create a new product with name, description, price and user_id. Set stock_policy to backorder or preorder to accept orders beyond the available stock, with the expected_restock_date shown to customers. Options such as size and color define the variants the product can be sold in.
 // @Tags         Products
 // @Accept       json
 // @Produce      json
@@ -392,37 +754,131 @@ func createProduct(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	req.Currency = strings.ToUpper(req.Currency)
+	if req.Currency == "" {
+		req.Currency = storefrontCurrency(c)
+	}
+	if req.Currency == "" {
+		req.Currency = cfg.DefaultCurrency
+	}
+	if !converter.Supports(req.Currency) {
+		return c.Status(400).JSON(fiber.Map{"error": "Unsupported currency: " + req.Currency})
+	}
+	if req.TaxCategory == "" {
+		req.TaxCategory = tax.DefaultCategory
+	}
+	policy, restockDate, err := parseStockSettings(req.StockPolicy, req.ExpectedRestockDate)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.ProductClient.CreateProduct(ctx, &proto.CreateProductRequest{
-		Name:        req.Name,
-		Description: req.Description,
-		Price:       req.Price,
-		UserId:      req.UserID,
+		Name:                req.Name,
+		Description:         req.Description,
+		Price:               req.Price,
+		UserId:              req.UserID,
+		Currency:            req.Currency,
+		TaxCategory:         strings.ToLower(req.TaxCategory),
+		StockPolicy:         policy,
+		ExpectedRestockDate: restockDate,
+		Options:             toProtoOptions(req.Options),
+		CategoryIds:         req.CategoryIDs,
 	})
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return inventoryError(c, err)
 	}
 
 	return c.JSON(fiber.Map{
 		"success": resp.Success,
 		"message": resp.Message,
-		"product": resp.Product,
+		"product": presentProduct(resp.Product, ""),
+	})
+}
+
+// updateProduct Update Product
+// @Summary      Update a product
+// @Description  Update the given fields of a product; omitted fields are left unchanged. Options replace the product's options when given and must still fit its existing variants.
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                          true  "Product ID"
+// @Param        product  body      models.UpdateProductRequest  true  "Product update request"
+// @Success      200      {object}  models.ProductResponse
+// @Failure      400      {object}  models.ErrorResponse
+// @Failure      404      {object}  models.ErrorResponse
+// @Failure      409      {object}  models.ErrorResponse
+// @Failure      500      {object}  models.ErrorResponse
+// @Router       /products/{id} [put]
+func updateProduct(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+
+	var req models.UpdateProductRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	req.Currency = strings.ToUpper(req.Currency)
+	if req.Currency != "" && !converter.Supports(req.Currency) {
+		return c.Status(400).JSON(fiber.Map{"error": "Unsupported currency: " + req.Currency})
+	}
+	policy, restockDate, err := parseStockSettings(req.StockPolicy, req.ExpectedRestockDate)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.UpdateProduct(ctx, &proto.UpdateProductRequest{
+		ProductId:           int32(id),
+		Name:                req.Name,
+		Description:         req.Description,
+		Price:               req.Price,
+		Currency:            req.Currency,
+		TaxCategory:         strings.ToLower(req.TaxCategory),
+		StockPolicy:         policy,
+		ExpectedRestockDate: restockDate,
+		SetOptions:          req.Options != nil,
+		Options:             toProtoOptions(req.Options),
+		SetCategories:       req.CategoryIDs != nil,
+		CategoryIds:         req.CategoryIDs,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+	if resp.Success && resp.Product != nil {
+		publishProductUpdated(c, resp.Product)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"product": presentProduct(resp.Product, ""),
 	})
 }
 
 // getProduct Get Product
 // @Summary      Get product by ID
-// @Description  Get a product by its ID
+// @Description  Get a product by its ID, with its average rating and rating histogram
 // @Tags         Products
 // @Accept       json
 // @Produce      json
-// @Param        id   path      int  true  "Product ID"
-// @Success      200  {object}  models.Product
-// @Failure      400  {object}  models.ErrorResponse
-// @Failure      404  {object}  models.ErrorResponse
-// @Failure      500  {object}  models.ErrorResponse
+// @Param        id               path      int     true   "Product ID"
+// @Param        include_deleted  query     bool    false  "Also find a soft-deleted product; only for its seller and product managers"
+// @Param        currency         query     string  false  "Currency to render prices in (overrides Accept-Currency)"
+// @Param        Accept-Currency  header    string  false  "Currency to render prices in"
+// @Success      200              {object}  models.Product
+// @Failure      400              {object}  models.ErrorResponse
+// @Failure      404              {object}  models.ErrorResponse
+// @Failure      500              {object}  models.ErrorResponse
 // @Router       /products/{id} [get]
 func getProduct(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
@@ -430,54 +886,92 @@ func getProduct(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	display, err := displayCurrency(c)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.ProductClient.GetProduct(ctx, &proto.GetProductRequest{
-		ProductId: int32(id),
+		ProductId:      int32(id),
+		IncludeDeleted: c.QueryBool("include_deleted"),
 	})
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
 
-	if !resp.Found {
+	// A soft-deleted product is only found by its seller and product managers
+	if !resp.Found || (resp.Product.DeletedAt != "" && !seesDeletedProducts(callerOf(c), resp.Product.UserId)) {
 		return c.Status(404).JSON(fiber.Map{"error": "Product not found"})
 	}
 
+	product := presentProduct(resp.Product, display)
+	summary, err := clients.ProductClient.GetReviewSummary(ctx, &proto.GetReviewSummaryRequest{ProductId: int32(id)})
+	if err != nil {
+		log.Printf("cannot load rating of product %d: %v", id, err)
+	} else {
+		product.Rating = presentReviewSummary(summary)
+	}
+
 	return c.JSON(fiber.Map{
 		"found":   true,
-		"product": resp.Product,
+		"product": product,
 	})
 }
 
 // listProducts List Products
 // @Summary      List all products with pagination
-// @Description  Get a paginated list of all products
+// @Description  Get a paginated list of all products. Filter by category_id to list a category, including its subcategories when include_descendants is set.
 // @Tags         Products
 // @Accept       json
 // @Produce      json
-// @Param        page   query     int  false  "Page number"  default(1)
-// @Param        limit  query     int  false  "Items per page"  default(10)
-// @Success      200    {object}  models.ProductsListResponse
-// @Failure      500    {object}  models.ErrorResponse
+// @Param        page                 query     int     false  "Page number"  default(1)
+// @Param        limit                query     int     false  "Items per page"  default(10)
+// @Param        category_id          query     int     false  "Only products in this category"
+// @Param        include_descendants  query     bool    false  "Also include products in subcategories of category_id"  default(false)
+// @Param        include_deleted      query     bool    false  "Include soft-deleted products; only for product managers"
+// @Param        currency             query     string  false  "Currency to render prices in (overrides Accept-Currency)"
+// @Param        Accept-Currency      header    string  false  "Currency to render prices in"
+// @Success      200                  {object}  models.ProductsListResponse
+// @Failure      400                  {object}  models.ErrorResponse
+// @Failure      403                  {object}  models.ErrorResponse
+// @Failure      500                  {object}  models.ErrorResponse
 // @Router       /products [get]
 func listProducts(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page", "1"))
 	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	categoryID, err := strconv.Atoi(c.Query("category_id", "0"))
+	if err != nil || categoryID < 0 {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid category ID"})
+	}
+	includeDeleted := c.QueryBool("include_deleted")
+	if who := callerOf(c); includeDeleted && !seesDeletedProducts(who, 0) {
+		return denied(c, who, "Permission denied: only product managers see deleted products")
+	}
+
+	display, err := displayCurrency(c)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.ProductClient.ListProducts(ctx, &proto.ListProductsRequest{
-		Page:  int32(page),
-		Limit: int32(limit),
+		Page:               int32(page),
+		Limit:              int32(limit),
+		CategoryId:         int32(categoryID),
+		IncludeDescendants: c.QueryBool("include_descendants"),
+		IncludeDeleted:     includeDeleted,
 	})
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
 
 	return c.JSON(fiber.Map{
-		"products": resp.Products,
+		"products": presentProducts(resp.Products, display),
 		"total":    resp.Total,
 		"page":     resp.Page,
 		"limit":    resp.Limit,
@@ -490,29 +984,43 @@ func listProducts(c *fiber.Ctx) error {
 // @Tags         Users
 // @Accept       json
 // @Produce      json
-// @Param        id   path      int  true  "User ID"
-// @Success      200  {object}  models.UserProductsResponse
-// @Failure      400  {object}  models.ErrorResponse
-// @Failure      500  {object}  models.ErrorResponse
+// @Param        id               path      int     true   "User ID"
+// @Param        include_deleted  query     bool    false  "Include soft-deleted products; only for the user and product managers"
+// @Param        currency         query     string  false  "Currency to render prices in (overrides Accept-Currency)"
+// @Param        Accept-Currency  header    string  false  "Currency to render prices in"
+// @Success      200              {object}  models.UserProductsResponse
+// @Failure      400              {object}  models.ErrorResponse
+// @Failure      403              {object}  models.ErrorResponse
+// @Failure      500              {object}  models.ErrorResponse
 // @Router       /users/{id}/products [get]
 func getUserProducts(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
 	}
+	includeDeleted := c.QueryBool("include_deleted")
+	if who := callerOf(c); includeDeleted && !seesDeletedProducts(who, int32(id)) {
+		return denied(c, who, "Permission denied: not your own")
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	display, err := displayCurrency(c)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.ProductClient.GetProductsByUser(ctx, &proto.GetProductsByUserRequest{
-		UserId: int32(id),
+		UserId:         int32(id),
+		IncludeDeleted: includeDeleted,
 	})
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
 
 	return c.JSON(fiber.Map{
-		"products": resp.Products,
+		"products": presentProducts(resp.Products, display),
 		"total":    resp.Total,
 	})
 }
@@ -521,41 +1029,61 @@ func getUserProducts(c *fiber.Ctx) error {
 
 // createInventoryItem Create Inventory Item
 // @Summary      Create a new inventory item
-// @Description  Create a new inventory item for a product
+// @Description  Add stock of a product to a warehouse, given by warehouse_id or by location code. Unknown location codes create a new warehouse. Stock of a product variant is given by variant_id or sku.
 // @Tags         Inventory
 // @Accept       json
 // @Produce      json
 // @Param        item  body      models.CreateInventoryItemRequest  true  "Inventory item data"
 // @Success      201   {object}  models.InventoryItemResponse
 // @Failure      400   {object}  models.ErrorResponse
+// @Failure      404   {object}  models.ErrorResponse
 // @Failure      500   {object}  models.ErrorResponse
 // @Router       /inventory [post]
 func createInventoryItem(c *fiber.Ctx) error {
 	var req struct {
-		ProductID int32  `json:"product_id"`
-		Quantity  int32  `json:"quantity"`
-		Location  string `json:"location"`
+		ProductID   int32  `json:"product_id"`
+		VariantID   int32  `json:"variant_id"`
+		SKU         string `json:"sku"`
+		Quantity    int32  `json:"quantity"`
+		WarehouseID int32  `json:"warehouse_id"`
+		Location    string `json:"location"`
+		Note        string `json:"note"`
+		// Reorder settings of a new item
+		ReorderPoint    int32 `json:"reorder_point"`
+		ReorderQuantity int32 `json:"reorder_quantity"`
 	}
 
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
+	productID, variantID, err := stockTarget(ctx, req.ProductID, req.VariantID, req.SKU)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
 	resp, err := clients.InventoryClient.CreateInventoryItem(ctx, &proto.CreateInventoryItemRequest{
-		ProductId: req.ProductID,
-		Quantity:  req.Quantity,
-		Location:  req.Location,
+		ProductId:       productID,
+		VariantId:       variantID,
+		Quantity:        req.Quantity,
+		Location:        req.Location,
+		WarehouseId:     req.WarehouseID,
+		Note:            req.Note,
+		Actor:           actorOf(c),
+		ReorderPoint:    req.ReorderPoint,
+		ReorderQuantity: req.ReorderQuantity,
 	})
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return inventoryError(c, err)
 	}
 
 	return c.Status(201).JSON(fiber.Map{
 		"message": resp.Message,
-		"item":    resp.Item,
+		"item":    presentInventoryItem(resp.Item),
 	})
 }
 
@@ -577,48 +1105,53 @@ func getInventoryItem(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid inventory item ID"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.InventoryClient.GetInventoryItem(ctx, &proto.GetInventoryItemRequest{
 		Id: int32(id),
 	})
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return inventoryError(c, err)
 	}
 
 	return c.JSON(fiber.Map{
 		"message": resp.Message,
-		"item":    resp.Item,
+		"item":    presentInventoryItem(resp.Item),
 	})
 }
 
 // checkStock Check Stock
 // @Summary      Check stock availability
-// @Description  Check if sufficient stock is available for a product
+// @Description  Check if sufficient stock is available for a product, or a variant given by variant_id or sku, across all active warehouses, with the stock level of each warehouse
 // @Tags         Inventory
 // @Accept       json
 // @Produce      json
 // @Param        request  body      models.CheckStockRequest  true  "Stock check data"
 // @Success      200      {object}  models.CheckStockResponse
 // @Failure      400      {object}  models.ErrorResponse
+// @Failure      404      {object}  models.ErrorResponse
 // @Failure      500      {object}  models.ErrorResponse
 // @Router       /inventory/check-stock [post]
 func checkStock(c *fiber.Ctx) error {
-	var req struct {
-		ProductID        int32 `json:"product_id"`
-		RequiredQuantity int32 `json:"required_quantity"`
-	}
+	var req models.CheckStockRequest
 
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
+	productID, variantID, err := stockTarget(ctx, req.ProductID, req.VariantID, req.SKU)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
 	resp, err := clients.InventoryClient.CheckStock(ctx, &proto.CheckStockRequest{
-		ProductId:        req.ProductID,
+		ProductId:        productID,
+		VariantId:        variantID,
 		RequiredQuantity: req.RequiredQuantity,
 	})
 	if err != nil {
@@ -627,41 +1160,62 @@ func checkStock(c *fiber.Ctx) error {
 
 	return c.JSON(fiber.Map{
 		"available":           resp.Available,
-		"available_quantity": resp.AvailableQuantity,
-		"message":            resp.Message,
+		"available_quantity":  resp.AvailableQuantity,
+		"message":             resp.Message,
+		"in_transit_quantity": resp.InTransitQuantity,
+		"locations":           presentLocations(resp.Locations),
 	})
 }
 
 // reserveStock Reserve Stock
 // @Summary      Reserve stock for an order
-// @Description  Reserve stock for a specific order
+// @Description  Reserve stock for a specific order. The reservation holds the stock for ttl_seconds (30 minutes by default) and is released automatically when it expires unless the order ships, it is released or it is extended first. The strategy picks the warehouses to draw from: nearest ships from the single warehouse closest to shipping_location, largest_stock from the single warehouse with the most stock, and split (the default) draws from several warehouses, nearest first when shipping_location is given. Stock of a product variant is reserved by variant_id or sku.
 // @Tags         Inventory
 // @Accept       json
 // @Produce      json
 // @Param        request  body      models.ReserveStockRequest  true  "Stock reservation data"
 // @Success      200      {object}  models.ReserveStockResponse
 // @Failure      400      {object}  models.ErrorResponse
+// @Failure      404      {object}  models.ErrorResponse
 // @Failure      500      {object}  models.ErrorResponse
 // @Router       /inventory/reserve-stock [post]
 func reserveStock(c *fiber.Ctx) error {
-	var req struct {
-		ProductID int32  `json:"product_id"`
-		Quantity  int32  `json:"quantity"`
-		OrderID   string `json:"order_id"`
-	}
+	var req models.ReserveStockRequest
 
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	strategy, err := parseAllocationStrategy(req.Strategy)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
-	resp, err := clients.InventoryClient.ReserveStock(ctx, &proto.ReserveStockRequest{
-		ProductId: req.ProductID,
-		Quantity:  req.Quantity,
-		OrderId:   req.OrderID,
-	})
+	productID, variantID, err := stockTarget(ctx, req.ProductID, req.VariantID, req.SKU)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	grpcReq := &proto.ReserveStockRequest{
+		ProductId:  productID,
+		VariantId:  variantID,
+		Quantity:   req.Quantity,
+		OrderId:    req.OrderID,
+		Strategy:   strategy,
+		TtlSeconds: req.TTLSeconds,
+	}
+	if req.ShippingLocation != nil {
+		grpcReq.HasShippingLocation = true
+		grpcReq.ShippingLatitude = req.ShippingLocation.Latitude
+		grpcReq.ShippingLongitude = req.ShippingLocation.Longitude
+	}
+
+	resp, err := clients.InventoryClient.ReserveStock(ctx, grpcReq)
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
@@ -670,6 +1224,8 @@ func reserveStock(c *fiber.Ctx) error {
 		"success":        resp.Success,
 		"message":        resp.Message,
 		"reservation_id": resp.ReservationId,
+		"allocations":    presentAllocations(resp.Allocations),
+		"expires_at":     resp.ExpiresAt,
 	})
 }
 
@@ -693,7 +1249,7 @@ func releaseStock(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.InventoryClient.ReleaseStock(ctx, &proto.ReleaseStockRequest{
@@ -713,53 +1269,78 @@ func releaseStock(c *fiber.Ctx) error {
 
 // createOrder Create Order
 // @Summary      Create a new order
-// @Description  Create a new order with items
+// @Description  Create a new order with items. Unit prices and totals are computed from the product catalog; a submitted price that differs from the catalog is rejected with 409. Automatic promotions and the given coupon codes are applied; a coupon that does not apply is rejected with 422. Tax is calculated for tax_region, or the default region when omitted. Stock is reserved for every line; lines beyond the available stock are rejected with 409 unless the product is backorderable or pre-orderable, in which case they are accepted as BACKORDERED and allocated first come first served when stock arrives.
 // @Tags         Orders
 // @Accept       json
 // @Produce      json
-// @Param        order  body      models.CreateOrderRequest  true  "Order data"
-// @Success      201    {object}  models.OrderResponse
-// @Failure      400    {object}  models.ErrorResponse
-// @Failure      500    {object}  models.ErrorResponse
+// @Param        order            body      models.CreateOrderRequest  true   "Order data"
+// @Param        currency         query     string                     false  "Currency to render prices in (overrides Accept-Currency)"
+// @Param        Accept-Currency  header    string                     false  "Currency to render prices in"
+// @Success      201              {object}  models.OrderResponse
+// @Failure      400              {object}  models.ErrorResponse
+// @Failure      409              {object}  models.ErrorResponse
+// @Failure      422              {object}  models.ErrorResponse
+// @Failure      500              {object}  models.ErrorResponse
 // @Router       /orders [post]
 func createOrder(c *fiber.Ctx) error {
-	var req struct {
-		UserID int32 `json:"user_id"`
-		Items  []struct {
-			ProductID int32   `json:"product_id"`
-			Quantity  int32   `json:"quantity"`
-			Price     float64 `json:"price"`
-		} `json:"items"`
-	}
+	var req models.CreateOrderRequest
 
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	// The order is settled in the caller's currency
+	settlement, err := displayCurrency(c)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
-	// Convert items
-	var orderItems []*proto.OrderItem
-	for _, item := range req.Items {
-		orderItems = append(orderItems, &proto.OrderItem{
-			ProductId: item.ProductID,
-			Quantity:  item.Quantity,
-			Price:     item.Price,
-		})
+	// Prices come from the catalog, never from the client
+	quote, err := priceOrderItems(ctx, req.Items, settlement)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
 	}
 
-	resp, err := clients.OrderClient.CreateOrder(ctx, &proto.CreateOrderRequest{
-		UserId: req.UserID,
-		Items:  orderItems,
-	})
+	// Storefronts without promotions take no discounts
+	var applied []string
+	if tenantOf(c).Enabled(tenant.FeaturePromotions) {
+		applied, err = applyCoupons(tenantOf(c).ID, quote, req.UserID, req.CouponCodes)
+		if err != nil {
+			e := err.(*fiber.Error)
+			return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+		}
+	} else if len(req.CouponCodes) > 0 {
+		return c.Status(422).JSON(fiber.Map{"error": "Coupons are not available on this storefront"})
+	}
+
+	// Tax is calculated last, on the discounted amounts
+	if err := applyTax(quote, req.TaxRegion); err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	// Usage is counted before the order is placed so limits hold under
+	// concurrent checkouts, and given back if the order fails
+	if err := promos.Redeem(tenantOf(c).ID, applied, req.UserID); err != nil {
+		return c.Status(409).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	resp, err := clients.OrderClient.CreateOrder(ctx, orderRequestFromQuote(req.UserID, quote))
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		if releaseErr := promos.Release(tenantOf(c).ID, applied, req.UserID); releaseErr != nil {
+			log.Printf("failed to release promotions %v: %v", applied, releaseErr)
+		}
+		return inventoryError(c, err)
 	}
+	publishOrderCreated(c, resp.Order)
 
 	return c.Status(201).JSON(fiber.Map{
 		"message": resp.Message,
-		"order":   resp.Order,
+		"order":   presentQuotedOrder(resp.Order, quote, settlement),
 	})
 }
 
@@ -769,11 +1350,13 @@ func createOrder(c *fiber.Ctx) error {
 // @Tags         Orders
 // @Accept       json
 // @Produce      json
-// @Param        id   path      string  true  "Order ID"
-// @Success      200  {object}  models.OrderResponse
-// @Failure      400  {object}  models.ErrorResponse
-// @Failure      404  {object}  models.ErrorResponse
-// @Failure      500  {object}  models.ErrorResponse
+// @Param        id               path      string  true   "Order ID"
+// @Param        currency         query     string  false  "Currency to render prices in (overrides Accept-Currency)"
+// @Param        Accept-Currency  header    string  false  "Currency to render prices in"
+// @Success      200              {object}  models.OrderResponse
+// @Failure      400              {object}  models.ErrorResponse
+// @Failure      404              {object}  models.ErrorResponse
+// @Failure      500              {object}  models.ErrorResponse
 // @Router       /orders/{id} [get]
 func getOrder(c *fiber.Ctx) error {
 	id := c.Params("id")
@@ -781,7 +1364,12 @@ func getOrder(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid order ID"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	display, err := displayCurrency(c)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.OrderClient.GetOrder(ctx, &proto.GetOrderRequest{
@@ -793,41 +1381,50 @@ func getOrder(c *fiber.Ctx) error {
 
 	return c.JSON(fiber.Map{
 		"message": resp.Message,
-		"order":   resp.Order,
+		"order":   presentOrder(resp.Order, display),
 	})
 }
 
 // listOrders List Orders
 // @Summary      List orders with pagination
-// @Description  Get a paginated list of orders, optionally filtered by user
+// @Description  Get a paginated list of orders, optionally filtered by user. Orders archived with a deleted user are only listed with include_archived=true.
 // @Tags         Orders
 // @Accept       json
 // @Produce      json
-// @Param        user_id  query     int  false  "User ID filter"
-// @Param        page     query     int  false  "Page number"  default(1)
-// @Param        limit    query     int  false  "Items per page"  default(10)
-// @Success      200      {object}  models.OrdersListResponse
-// @Failure      500      {object}  models.ErrorResponse
+// @Param        user_id           query     int     false  "User ID filter"
+// @Param        include_archived  query     bool    false  "Include orders archived with their deleted user"
+// @Param        page              query     int     false  "Page number"  default(1)
+// @Param        limit             query     int     false  "Items per page"  default(10)
+// @Param        currency          query     string  false  "Currency to render prices in (overrides Accept-Currency)"
+// @Param        Accept-Currency   header    string  false  "Currency to render prices in"
+// @Success      200               {object}  models.OrdersListResponse
+// @Failure      500               {object}  models.ErrorResponse
 // @Router       /orders [get]
 func listOrders(c *fiber.Ctx) error {
 	userID, _ := strconv.Atoi(c.Query("user_id", "0"))
 	page, _ := strconv.Atoi(c.Query("page", "1"))
 	limit, _ := strconv.Atoi(c.Query("limit", "10"))
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	display, err := displayCurrency(c)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.OrderClient.ListOrders(ctx, &proto.ListOrdersRequest{
-		UserId: int32(userID),
-		Page:   int32(page),
-		Limit:  int32(limit),
+		UserId:          int32(userID),
+		Page:            int32(page),
+		Limit:           int32(limit),
+		IncludeArchived: c.QueryBool("include_archived"),
 	})
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
 
 	return c.JSON(fiber.Map{
-		"orders": resp.Orders,
+		"orders": presentOrders(resp.Orders, display),
 		"total":  resp.Total,
 		"page":   resp.Page,
 		"limit":  resp.Limit,
@@ -836,7 +1433,7 @@ func listOrders(c *fiber.Ctx) error {
 
 // updateOrderStatus Update Order Status
 // @Summary      Update order status
-// @Description  Update the status of an order
+// @Description  Update the status of an order. Shipping consumes the stock reserved for the order and is refused with 409 while lines are backordered; cancelling releases it.
 // @Tags         Orders
 // @Accept       json
 // @Produce      json
@@ -844,6 +1441,8 @@ func listOrders(c *fiber.Ctx) error {
 // @Param        status  body      models.UpdateOrderStatusRequest  true  "Status update data"
 // @Success      200     {object}  models.OrderResponse
 // @Failure      400     {object}  models.ErrorResponse
+// @Failure      404     {object}  models.ErrorResponse
+// @Failure      409     {object}  models.ErrorResponse
 // @Failure      500     {object}  models.ErrorResponse
 // @Router       /orders/{id}/status [put]
 func updateOrderStatus(c *fiber.Ctx) error {
@@ -879,7 +1478,7 @@ func updateOrderStatus(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid status"})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
 	resp, err := clients.OrderClient.UpdateOrderStatus(ctx, &proto.UpdateOrderStatusRequest{
@@ -887,43 +1486,68 @@ func updateOrderStatus(c *fiber.Ctx) error {
 		Status: status,
 	})
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return inventoryError(c, err)
 	}
+	publishOrderStatusChanged(c, resp.Order)
 
 	return c.JSON(fiber.Map{
 		"message": resp.Message,
-		"order":   resp.Order,
+		"order":   presentOrder(resp.Order, ""),
 	})
 }
 
 // listInventoryItems List Inventory Items
 // @Summary      List inventory items with pagination
-// @Description  Get a paginated list of all inventory items
+// @Description  Get a paginated list of inventory items, optionally limited to one warehouse, product or variant
 // @Tags         Inventory
 // @Accept       json
 // @Produce      json
-// @Param        page   query     int  false  "Page number"  default(1)
-// @Param        limit  query     int  false  "Items per page"  default(10)
-// @Success      200    {object}  models.InventoryItemsListResponse
-// @Failure      500    {object}  models.ErrorResponse
+// @Param        page          query     int     false  "Page number"  default(1)
+// @Param        limit         query     int     false  "Items per page"  default(10)
+// @Param        warehouse_id  query     int     false  "Only items stored in this warehouse"
+// @Param        product_id    query     int     false  "Only items of this product"
+// @Param        variant_id    query     int     false  "Only items of this variant"
+// @Param        sku           query     string  false  "Only items of the variant with this SKU"
+// @Param        low_stock     query     bool    false  "Only items at or below their reorder point"
+// @Success      200           {object}  models.InventoryItemsListResponse
+// @Failure      400           {object}  models.ErrorResponse
+// @Failure      404           {object}  models.ErrorResponse
+// @Failure      500           {object}  models.ErrorResponse
 // @Router       /inventory [get]
 func listInventoryItems(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page", "1"))
 	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	warehouseID, _ := strconv.Atoi(c.Query("warehouse_id", "0"))
+	productID, _ := strconv.Atoi(c.Query("product_id", "0"))
+	variantID, _ := strconv.Atoi(c.Query("variant_id", "0"))
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
-	resp, err := clients.InventoryClient.ListInventoryItems(ctx, &proto.ListInventoryItemsRequest{
-		Page:  int32(page),
-		Limit: int32(limit),
-	})
+	grpcReq := &proto.ListInventoryItemsRequest{
+		Page:         int32(page),
+		Limit:        int32(limit),
+		WarehouseId:  int32(warehouseID),
+		ProductId:    int32(productID),
+		LowStockOnly: c.QueryBool("low_stock"),
+	}
+	variant, err := resolveVariant(ctx, int32(productID), int32(variantID), c.Query("sku"))
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+	if variant != nil {
+		grpcReq.ProductId = variant.ProductId
+		grpcReq.VariantId = variant.Id
+	}
+
+	resp, err := clients.InventoryClient.ListInventoryItems(ctx, grpcReq)
+	if err != nil {
+		return inventoryError(c, err)
 	}
 
 	return c.JSON(fiber.Map{
-		"items": resp.Items,
+		"items": presentInventoryItems(resp.Items),
 		"total": resp.Total,
 		"page":  resp.Page,
 		"limit": resp.Limit,
@@ -931,8 +1555,8 @@ func listInventoryItems(c *fiber.Ctx) error {
 }
 
 // updateInventoryItem Update Inventory Item
-// @Summary      Update inventory item
-// @Description  Update an inventory item's quantity and location
+// @Summary      Adjust inventory item
+// @Description  Adjust an inventory item's quantity by a relative amount ("+5", "-3") with a mandatory reason, and optionally move it to another warehouse. Every change is recorded in the item's history. Stock with active reservations cannot be moved.
 // @Tags         Inventory
 // @Accept       json
 // @Produce      json
@@ -940,6 +1564,8 @@ func listInventoryItems(c *fiber.Ctx) error {
 // @Param        item  body      models.UpdateInventoryItemRequest  true  "Inventory item update data"
 // @Success      200   {object}  models.InventoryItemResponse
 // @Failure      400   {object}  models.ErrorResponse
+// @Failure      404   {object}  models.ErrorResponse
+// @Failure      409   {object}  models.ErrorResponse
 // @Failure      500   {object}  models.ErrorResponse
 // @Router       /inventory/{id} [put]
 func updateInventoryItem(c *fiber.Ctx) error {
@@ -949,28 +1575,78 @@ func updateInventoryItem(c *fiber.Ctx) error {
 	}
 
 	var req struct {
-		Quantity int32  `json:"quantity"`
-		Location string `json:"location"`
+		Quantity    *int32          `json:"quantity"`
+		Adjustment  json.RawMessage `json:"adjustment"`
+		Reason      string          `json:"reason"`
+		Note        string          `json:"note"`
+		Reference   string          `json:"reference"`
+		WarehouseID int32           `json:"warehouse_id"`
+		Location    string          `json:"location"`
+		// Reorder settings; omitted fields keep their value
+		ReorderPoint    *int32 `json:"reorder_point"`
+		ReorderQuantity *int32 `json:"reorder_quantity"`
 	}
 
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
 	}
+	if req.Quantity != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Absolute quantities are not accepted; send a relative adjustment with a reason"})
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	delta, err := parseAdjustment(req.Adjustment)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+	reason := strings.ToUpper(strings.TrimSpace(req.Reason))
+	if delta != 0 && reason == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "A reason is required for adjustments"})
+	}
+	setReorder := req.ReorderPoint != nil || req.ReorderQuantity != nil
+	if delta == 0 && req.WarehouseID == 0 && req.Location == "" && !setReorder {
+		return c.Status(400).JSON(fiber.Map{"error": "Nothing to update: send an adjustment, a warehouse or reorder settings"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
 
-	resp, err := clients.InventoryClient.UpdateInventoryItem(ctx, &proto.UpdateInventoryItemRequest{
-		Id:       int32(id),
-		Quantity: req.Quantity,
-		Location: req.Location,
-	})
+	grpcReq := &proto.UpdateInventoryItemRequest{
+		Id:               int32(id),
+		Location:         req.Location,
+		WarehouseId:      req.WarehouseID,
+		Delta:            delta,
+		Reason:           reason,
+		Actor:            actorOf(c),
+		Note:             req.Note,
+		Reference:        req.Reference,
+		SetReorderLevels: setReorder,
+	}
+	if setReorder {
+		// the inventory service replaces both settings, so keep the omitted one
+		if req.ReorderPoint == nil || req.ReorderQuantity == nil {
+			current, err := clients.InventoryClient.GetInventoryItem(ctx, &proto.GetInventoryItemRequest{Id: int32(id)})
+			if err != nil {
+				return inventoryError(c, err)
+			}
+			grpcReq.ReorderPoint = current.Item.ReorderPoint
+			grpcReq.ReorderQuantity = current.Item.ReorderQuantity
+		}
+		if req.ReorderPoint != nil {
+			grpcReq.ReorderPoint = *req.ReorderPoint
+		}
+		if req.ReorderQuantity != nil {
+			grpcReq.ReorderQuantity = *req.ReorderQuantity
+		}
+	}
+
+	resp, err := clients.InventoryClient.UpdateInventoryItem(ctx, grpcReq)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return inventoryError(c, err)
 	}
 
 	return c.JSON(fiber.Map{
 		"message": resp.Message,
-		"item":    resp.Item,
+		"item":    presentInventoryItem(resp.Item),
 	})
 }
\ No newline at end of file
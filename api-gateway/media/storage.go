@@ -0,0 +1,26 @@
+// Package media stores uploaded product images and derives their thumbnails.
+package media
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when no object is stored under a key
+var ErrNotFound = errors.New("media object not found")
+
+// Object is a stored file opened for reading
+type Object struct {
+	Body        io.ReadCloser
+	ContentType string
+	Size        int64
+}
+
+// Storage is a blob store for media files. Keys are slash-separated paths
+// such as products/1/3f2a.jpg.
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) (*Object, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"time"
+
+	"api-gateway/config"
+	"api-gateway/media"
+	"api-gateway/models"
+	"api-gateway/proto"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// openMediaStorage opens the blob storage selected by MEDIA_STORAGE
+func openMediaStorage(cfg *config.Config) (media.Storage, error) {
+	switch cfg.MediaStorage {
+	case "local":
+		return media.NewLocalStorage(cfg.MediaDir)
+	case "s3":
+		return media.NewS3Storage(media.S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			Bucket:    cfg.S3Bucket,
+			Region:    cfg.S3Region,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+		})
+	}
+	return nil, fmt.Errorf("unknown media storage %q: use local or s3", cfg.MediaStorage)
+}
+
+// removeMediaFiles deletes the stored files of an image. Failures are only
+// logged: the image is already gone from the catalog.
+func removeMediaFiles(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if err := mediaStore.Delete(ctx, key); err != nil {
+			log.Printf("cannot remove media file %s: %v", key, err)
+		}
+	}
+}
+
+// productMedia fetches an image and checks it belongs to the product in
+// the path
+func productMedia(ctx context.Context, c *fiber.Ctx) (*proto.ProductMedia, error) {
+	productID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid product ID")
+	}
+	mediaID, err := strconv.Atoi(c.Params("mediaId"))
+	if err != nil || mediaID <= 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid media ID")
+	}
+
+	resp, err := clients.ProductClient.ListProductMedia(ctx, &proto.ListProductMediaRequest{ProductId: int32(productID)})
+	if status.Code(err) == codes.NotFound {
+		return nil, fiber.NewError(fiber.StatusNotFound, status.Convert(err).Message())
+	}
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	for _, m := range resp.Media {
+		if m.Id == int32(mediaID) {
+			return m, nil
+		}
+	}
+	return nil, fiber.NewError(fiber.StatusNotFound, "Media not found")
+}
+
+// uploadProductMedia Upload Product Media
+// @Summary      Upload a product image
+// @Description  Upload a JPEG, PNG or GIF image as multipart form field file. The type is checked against the file contents and the size against MEDIA_MAX_BYTES. A thumbnail is generated, and the image is added after the product's existing media; the first image, or one uploaded with primary=true, becomes the primary image.
+// @Tags         Products
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id        path      int     true   "Product ID"
+// @Param        file      formData  file    true   "Image file"
+// @Param        alt_text  formData  string  false  "Alternative text"
+// @Param        primary   formData  bool    false  "Make this the primary image"
+// @Success      201       {object}  models.ProductMediaResponse
+// @Failure      400       {object}  models.ErrorResponse
+// @Failure      404       {object}  models.ErrorResponse
+// @Failure      413       {object}  models.ErrorResponse
+// @Failure      415       {object}  models.ErrorResponse
+// @Failure      500       {object}  models.ErrorResponse
+// @Router       /products/{id}/media [post]
+func uploadProductMedia(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Image file is required in form field file"})
+	}
+	if header.Size > int64(cfg.MediaMaxBytes) {
+		return c.Status(413).JSON(fiber.Map{"error": fmt.Sprintf("Image is larger than %d bytes", cfg.MediaMaxBytes)})
+	}
+	file, err := header.Open()
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Cannot read uploaded file"})
+	}
+	data, err := io.ReadAll(io.LimitReader(file, int64(cfg.MediaMaxBytes)+1))
+	file.Close()
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Cannot read uploaded file"})
+	}
+	if len(data) > cfg.MediaMaxBytes {
+		return c.Status(413).JSON(fiber.Map{"error": fmt.Sprintf("Image is larger than %d bytes", cfg.MediaMaxBytes)})
+	}
+
+	info, err := media.Inspect(data)
+	if err != nil {
+		return c.Status(415).JSON(fiber.Map{"error": err.Error()})
+	}
+	thumbnail, err := media.Thumbnail(data, cfg.MediaThumbnailSize)
+	if err != nil {
+		return c.Status(415).JSON(fiber.Map{"error": "Cannot process image: " + err.Error()})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 30*time.Second)
+	defer cancel()
+
+	if _, err := variantProduct(ctx, int32(id)); err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	name := uuid.NewString()
+	key := fmt.Sprintf("products/%d/%s%s", id, name, info.Extension)
+	thumbnailKey := fmt.Sprintf("products/%d/%s_thumb.jpg", id, name)
+	if err := mediaStore.Put(ctx, key, data, info.ContentType); err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": "Cannot store image: " + err.Error()})
+	}
+	if err := mediaStore.Put(ctx, thumbnailKey, thumbnail, "image/jpeg"); err != nil {
+		removeMediaFiles(ctx, key)
+		return c.Status(500).JSON(fiber.Map{"error": "Cannot store thumbnail: " + err.Error()})
+	}
+
+	resp, err := clients.ProductClient.AddProductMedia(ctx, &proto.AddProductMediaRequest{
+		ProductId:    int32(id),
+		StorageKey:   key,
+		ThumbnailKey: thumbnailKey,
+		ContentType:  info.ContentType,
+		Size:         int64(len(data)),
+		Width:        int32(info.Width),
+		Height:       int32(info.Height),
+		AltText:      c.FormValue("alt_text"),
+		IsPrimary:    c.FormValue("primary") == "true",
+	})
+	if err != nil {
+		removeMediaFiles(ctx, key, thumbnailKey)
+		return inventoryError(c, err)
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"media":   presentMedia(resp.Media),
+	})
+}
+
+// listProductMedia List Product Media
+// @Summary      List the images of a product
+// @Description  Get the images of a product in display order with their URLs and thumbnails
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Product ID"
+// @Success      200  {object}  models.ProductMediaListResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /products/{id}/media [get]
+func listProductMedia(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.ListProductMedia(ctx, &proto.ListProductMediaRequest{ProductId: int32(id)})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"media": presentMediaList(resp.Media),
+	})
+}
+
+// updateProductMedia Update Product Media
+// @Summary      Reorder or describe a product image
+// @Description  Move an image to another position, make it the primary image or change its alt text; omitted fields are left unchanged
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                               true  "Product ID"
+// @Param        mediaId  path      int                               true  "Media ID"
+// @Param        media    body      models.UpdateProductMediaRequest  true  "Media update"
+// @Success      200      {object}  models.ProductMediaResponse
+// @Failure      400      {object}  models.ErrorResponse
+// @Failure      404      {object}  models.ErrorResponse
+// @Failure      500      {object}  models.ErrorResponse
+// @Router       /products/{id}/media/{mediaId} [put]
+func updateProductMedia(c *fiber.Ctx) error {
+	var req models.UpdateProductMediaRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.Position != nil && *req.Position < 1 {
+		return c.Status(400).JSON(fiber.Map{"error": "Position must be at least 1"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	m, err := productMedia(ctx, c)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	grpcReq := &proto.UpdateProductMediaRequest{
+		MediaId:   m.Id,
+		IsPrimary: req.Primary,
+	}
+	if req.Position != nil {
+		grpcReq.SetPosition = true
+		grpcReq.Position = *req.Position
+	}
+	if req.AltText != nil {
+		grpcReq.SetAltText = true
+		grpcReq.AltText = *req.AltText
+	}
+
+	resp, err := clients.ProductClient.UpdateProductMedia(ctx, grpcReq)
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"media":   presentMedia(resp.Media),
+	})
+}
+
+// deleteProductMedia Delete Product Media
+// @Summary      Delete a product image
+// @Description  Remove an image and its thumbnail. When it was the primary image, the next image becomes primary.
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int  true  "Product ID"
+// @Param        mediaId  path      int  true  "Media ID"
+// @Success      200      {object}  models.SuccessResponse
+// @Failure      400      {object}  models.ErrorResponse
+// @Failure      404      {object}  models.ErrorResponse
+// @Failure      500      {object}  models.ErrorResponse
+// @Router       /products/{id}/media/{mediaId} [delete]
+func deleteProductMedia(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	m, err := productMedia(ctx, c)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	resp, err := clients.ProductClient.DeleteProductMedia(ctx, &proto.DeleteProductMediaRequest{MediaId: m.Id})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+	removeMediaFiles(ctx, resp.Media.StorageKey, resp.Media.ThumbnailKey)
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+	})
+}
+
+// serveMedia streams a stored media file. It is mounted outside /api so
+// media URLs stay short and cacheable.
+func serveMedia(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 30*time.Second)
+	defer cancel()
+
+	obj, err := mediaStore.Get(ctx, c.Params("*"))
+	if errors.Is(err, media.ErrNotFound) {
+		return c.Status(404).JSON(fiber.Map{"error": "Media not found"})
+	}
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	defer obj.Body.Close()
+
+	data, err := io.ReadAll(obj.Body)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if obj.ContentType != "" {
+		c.Set(fiber.HeaderContentType, obj.ContentType)
+	}
+	// keys are never reused, so the files can be cached indefinitely
+	c.Set(fiber.HeaderCacheControl, "public, max-age=31536000, immutable")
+	return c.Send(data)
+}
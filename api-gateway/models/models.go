@@ -1,6 +1,17 @@
 package models
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+
+	"api-gateway/money"
+	"api-gateway/pricing"
+	"api-gateway/privacy"
+	"api-gateway/promotions"
+	"api-gateway/rbac"
+	"api-gateway/restock"
+	"api-gateway/webhook"
+)
 
 // User represents a user in the system
 // @Description User information
@@ -10,6 +21,14 @@ type User struct {
 	Email     string    `json:"email" example:"john@example.com"`
 	Age       int32     `json:"age" example:"30"`
 	CreatedAt string    `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	// DeletedAt is set while the user is soft deleted
+	DeletedAt string `json:"deleted_at,omitempty" example:"2023-06-01T12:00:00Z"`
+	// ErasedAt is set once the user's personal data has been erased
+	ErasedAt string `json:"erased_at,omitempty" example:"2023-07-01T12:00:00Z"`
+	// Roles decide what the user may do; new users are customers
+	Roles []string `json:"roles" example:"customer"`
+	// EmailVerifiedAt is set once the user has confirmed their email address
+	EmailVerifiedAt string `json:"email_verified_at,omitempty" example:"2023-01-01T12:05:00Z"`
 } //@name User
 
 // CreateUserRequest request to create a new user
@@ -31,23 +50,385 @@ type UpdateUserRequest struct {
 // Product represents a product in the system
 // @Description Product information
 type Product struct {
-	ID          int32     `json:"id" example:"1"`
-	Name        string    `json:"name" example:"iPhone 15"`
-	Description string    `json:"description" example:"Latest iPhone model"`
-	Price       float64   `json:"price" example:"999.99"`
-	UserID      int32     `json:"user_id" example:"1"`
-	CreatedAt   string    `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	ID          int32       `json:"id" example:"1"`
+	Name        string      `json:"name" example:"iPhone 15"`
+	Description string      `json:"description" example:"Latest iPhone model"`
+	Price       money.Money `json:"price"`
+	// OriginalPrice is the catalog price when Price was converted into the
+	// caller's currency
+	OriginalPrice *money.Money `json:"original_price,omitempty"`
+	TaxCategory   string       `json:"tax_category" example:"standard"`
+	// StockPolicy says whether orders may exceed the available stock
+	StockPolicy         string `json:"stock_policy" enums:"none,backorder,preorder" example:"none"`
+	ExpectedRestockDate string `json:"expected_restock_date,omitempty" example:"2024-03-01"`
+	UserID              int32  `json:"user_id" example:"1"`
+	CreatedAt           string `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	// PriceVersion counts the changes to the price of the product and its
+	// variants; orders record the version they were priced at
+	PriceVersion int32 `json:"price_version" example:"3"`
+	// DeletedAt is set while the product is soft deleted
+	DeletedAt string `json:"deleted_at,omitempty" example:"2023-06-01T12:00:00Z"`
+	// Options are the axes the product varies along, such as size and
+	// color; each variant picks one value of every option
+	Options  []ProductOption  `json:"options"`
+	Variants []ProductVariant `json:"variants"`
+	// CategoryIDs are the categories the product is listed in
+	CategoryIDs []int32 `json:"category_ids"`
+	// ImageURL is the primary image, omitted for products without media
+	ImageURL string         `json:"image_url,omitempty" example:"/media/products/1/9b2f6c1e.jpg"`
+	Media    []ProductMedia `json:"media"`
+	// Rating summarizes the approved reviews; only single-product responses include it
+	Rating *ReviewSummary `json:"rating,omitempty"`
 } //@name Product
 
+// PriceChange represents an entry of a product's price history
+// @Description Product price change
+type PriceChange struct {
+	Version int32 `json:"version" example:"3"`
+	// VariantID is set when a variant's price override changed
+	VariantID int32 `json:"variant_id,omitempty" example:"7"`
+	// Price is omitted when a variant's price override was removed
+	Price         *money.Money `json:"price,omitempty"`
+	PreviousPrice *money.Money `json:"previous_price,omitempty"`
+	Reason        string       `json:"reason" enums:"CREATE,MANUAL,SCHEDULE_START,SCHEDULE_END" example:"MANUAL"`
+	ScheduleID    int32        `json:"schedule_id,omitempty" example:"2"`
+	ChangedAt     string       `json:"changed_at" example:"2023-01-01T12:00:00Z"`
+} //@name PriceChange
+
+// PriceHistoryResponse represents a page of a product's price history, newest first
+// @Description Product price history response
+type PriceHistoryResponse struct {
+	ProductID int32         `json:"product_id" example:"1"`
+	Changes   []PriceChange `json:"changes"`
+	Total     int32         `json:"total" example:"5"`
+	Page      int32         `json:"page" example:"1"`
+	Limit     int32         `json:"limit" example:"20"`
+} //@name PriceHistoryResponse
+
+// PriceSchedule represents a planned product price for a period
+// @Description Scheduled price change
+type PriceSchedule struct {
+	ID        int32       `json:"id" example:"2"`
+	ProductID int32       `json:"product_id" example:"1"`
+	Price     money.Money `json:"price"`
+	StartsAt  string      `json:"starts_at" example:"2024-11-29T00:00:00Z"`
+	// EndsAt is omitted for a permanent price change
+	EndsAt string `json:"ends_at,omitempty" example:"2024-12-02T00:00:00Z"`
+	Status string `json:"status" enums:"SCHEDULED,ACTIVE,COMPLETED,CANCELLED" example:"SCHEDULED"`
+	// PreviousPrice is the price the schedule replaced, restored when it ends
+	PreviousPrice *money.Money `json:"previous_price,omitempty"`
+	Note          string       `json:"note,omitempty" example:"Black Friday"`
+	CreatedAt     string       `json:"created_at" example:"2024-11-01T09:00:00Z"`
+} //@name PriceSchedule
+
+// CreatePriceScheduleRequest request to schedule a product price
+// @Description Request body for scheduling a price change
+type CreatePriceScheduleRequest struct {
+	// Price in the product currency
+	Price    float64 `json:"price" binding:"required" example:"799.99"`
+	StartsAt string  `json:"starts_at" binding:"required" example:"2024-11-29T00:00:00Z"`
+	// EndsAt restores the previous price at that time; omit it for a permanent change
+	EndsAt string `json:"ends_at,omitempty" example:"2024-12-02T00:00:00Z"`
+	Note   string `json:"note,omitempty" example:"Black Friday"`
+} //@name CreatePriceScheduleRequest
+
+// PriceScheduleResponse represents a scheduled price change response
+// @Description Price schedule response
+type PriceScheduleResponse struct {
+	Success  bool          `json:"success" example:"true"`
+	Message  string        `json:"message" example:"Price change scheduled"`
+	Schedule PriceSchedule `json:"schedule"`
+} //@name PriceScheduleResponse
+
+// PriceSchedulesListResponse represents the price schedules of a product
+// @Description Price schedules list response
+type PriceSchedulesListResponse struct {
+	Schedules []PriceSchedule `json:"schedules"`
+} //@name PriceSchedulesListResponse
+
+// ReviewSummary aggregates the approved reviews of a product
+// @Description Product rating summary
+type ReviewSummary struct {
+	AverageRating float64 `json:"average_rating" example:"4.25"`
+	ReviewCount   int32   `json:"review_count" example:"12"`
+	// Histogram maps each star rating from 1 to 5 to its number of reviews
+	Histogram map[string]int32 `json:"histogram"`
+} //@name ReviewSummary
+
+// Review represents a customer review of a product
+// @Description Product review
+type Review struct {
+	ID        int32  `json:"id" example:"1"`
+	ProductID int32  `json:"product_id" example:"1"`
+	UserID    int32  `json:"user_id" example:"1"`
+	Rating    int32  `json:"rating" minimum:"1" maximum:"5" example:"5"`
+	Title     string `json:"title" example:"Great phone"`
+	Body      string `json:"body" example:"Fast and the battery lasts all day."`
+	// Status is PENDING, APPROVED or REJECTED; only approved reviews are public
+	Status         string `json:"status" enums:"PENDING,APPROVED,REJECTED" example:"APPROVED"`
+	ModerationNote string `json:"moderation_note,omitempty" example:"Contains personal data"`
+	// OrderID is the delivered order the purchase was verified with
+	OrderID   string `json:"order_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CreatedAt string `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt string `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+} //@name Review
+
+// CreateReviewRequest request to review a product
+// @Description Request body for creating a review
+type CreateReviewRequest struct {
+	UserID int32  `json:"user_id" binding:"required" example:"1"`
+	Rating int32  `json:"rating" binding:"required" minimum:"1" maximum:"5" example:"5"`
+	Title  string `json:"title,omitempty" example:"Great phone"`
+	Body   string `json:"body,omitempty" example:"Fast and the battery lasts all day."`
+} //@name CreateReviewRequest
+
+// UpdateReviewRequest request to change a review; omitted fields are unchanged
+// @Description Request body for updating a review
+type UpdateReviewRequest struct {
+	Rating int32  `json:"rating,omitempty" minimum:"1" maximum:"5" example:"4"`
+	Title  string `json:"title,omitempty" example:"Good phone"`
+	Body   string `json:"body,omitempty" example:"Fast, but the battery could be better."`
+} //@name UpdateReviewRequest
+
+// ModerateReviewRequest request to change the moderation status of a review
+// @Description Request body for moderating a review
+type ModerateReviewRequest struct {
+	Status string `json:"status" binding:"required" enums:"PENDING,APPROVED,REJECTED" example:"REJECTED"`
+	Note   string `json:"note,omitempty" example:"Contains personal data"`
+} //@name ModerateReviewRequest
+
+// ReviewResponse represents a review response
+// @Description Review response
+type ReviewResponse struct {
+	Success bool   `json:"success" example:"true"`
+	Message string `json:"message" example:"Review created successfully"`
+	Review  Review `json:"review"`
+} //@name ReviewResponse
+
+// ReviewsListResponse represents a page of reviews with the product rating
+// @Description Reviews list response
+type ReviewsListResponse struct {
+	Reviews []Review      `json:"reviews"`
+	Summary ReviewSummary `json:"summary"`
+	Total   int32         `json:"total" example:"12"`
+	Page    int32         `json:"page" example:"1"`
+	Limit   int32         `json:"limit" example:"10"`
+} //@name ReviewsListResponse
+
+// ProductMedia represents an uploaded product image
+// @Description Product image with its thumbnail
+type ProductMedia struct {
+	ID           int32  `json:"id" example:"1"`
+	URL          string `json:"url" example:"/media/products/1/9b2f6c1e.jpg"`
+	ThumbnailURL string `json:"thumbnail_url" example:"/media/products/1/9b2f6c1e_thumb.jpg"`
+	ContentType  string `json:"content_type" example:"image/jpeg"`
+	Size         int64  `json:"size" example:"204800"`
+	Width        int32  `json:"width" example:"1200"`
+	Height       int32  `json:"height" example:"900"`
+	// Position is the 1-based display order
+	Position  int32  `json:"position" example:"1"`
+	IsPrimary bool   `json:"is_primary" example:"true"`
+	AltText   string `json:"alt_text" example:"Front view"`
+	CreatedAt string `json:"created_at" example:"2023-01-01T12:00:00Z"`
+} //@name ProductMedia
+
+// UpdateProductMediaRequest request to reorder or describe a product image;
+// omitted fields are unchanged
+// @Description Request body for updating a product image
+type UpdateProductMediaRequest struct {
+	// Position moves the image, shifting the others; positions start at 1
+	Position *int32 `json:"position,omitempty" example:"1"`
+	// Primary makes this the product's primary image
+	Primary bool    `json:"primary,omitempty" example:"true"`
+	AltText *string `json:"alt_text,omitempty" example:"Front view"`
+} //@name UpdateProductMediaRequest
+
+// ProductMediaResponse represents a product image response
+// @Description Product image response
+type ProductMediaResponse struct {
+	Success bool         `json:"success" example:"true"`
+	Message string       `json:"message" example:"Media added successfully"`
+	Media   ProductMedia `json:"media"`
+} //@name ProductMediaResponse
+
+// ProductMediaListResponse represents the images of a product
+// @Description Product images list response
+type ProductMediaListResponse struct {
+	Media []ProductMedia `json:"media"`
+} //@name ProductMediaListResponse
+
+// ProductOption is an axis a product varies along
+// @Description Product option and its allowed values
+type ProductOption struct {
+	Name   string   `json:"name" example:"size"`
+	Values []string `json:"values" example:"S,M,L"`
+} //@name ProductOption
+
+// ProductVariant is a purchasable combination of option values
+// @Description Product variant with its own SKU and price
+type ProductVariant struct {
+	ID        int32             `json:"id" example:"7"`
+	ProductID int32             `json:"product_id" example:"1"`
+	SKU       string            `json:"sku" example:"TSHIRT-M-RED"`
+	Options   map[string]string `json:"options"`
+	// Price is the variant's own price when PriceOverride is set, otherwise
+	// the product price
+	Price         money.Money `json:"price"`
+	PriceOverride bool        `json:"price_override" example:"false"`
+	CreatedAt     string      `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt     string      `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+} //@name ProductVariant
+
 // CreateProductRequest request to create a new product
 // @Description Request body for creating a product
 type CreateProductRequest struct {
 	Name        string  `json:"name" binding:"required" example:"iPhone 15"`
 	Description string  `json:"description" binding:"required" example:"Latest iPhone model"`
 	Price       float64 `json:"price" binding:"required" example:"999.99"`
+	Currency    string  `json:"currency" example:"USD"`
+	TaxCategory string  `json:"tax_category" example:"standard"`
 	UserID      int32   `json:"user_id" binding:"required" example:"1"`
+	// StockPolicy lets orders exceed the available stock: backorder for
+	// restocks, preorder for products not released yet
+	StockPolicy         string `json:"stock_policy,omitempty" enums:"none,backorder,preorder" example:"backorder"`
+	ExpectedRestockDate string `json:"expected_restock_date,omitempty" example:"2024-03-01"`
+	// Options the product's variants choose from
+	Options []ProductOption `json:"options,omitempty"`
+	// CategoryIDs are the categories to list the product in
+	CategoryIDs []int32 `json:"category_ids,omitempty" example:"3,7"`
 } //@name CreateProductRequest
 
+// UpdateProductRequest request to update a product; omitted fields are unchanged
+// @Description Request body for updating a product
+type UpdateProductRequest struct {
+	Name                string  `json:"name,omitempty" example:"iPhone 15"`
+	Description         string  `json:"description,omitempty" example:"Latest iPhone model"`
+	Price               float64 `json:"price,omitempty" example:"999.99"`
+	Currency            string  `json:"currency,omitempty" example:"USD"`
+	TaxCategory         string  `json:"tax_category,omitempty" example:"standard"`
+	StockPolicy         string  `json:"stock_policy,omitempty" enums:"none,backorder,preorder" example:"backorder"`
+	ExpectedRestockDate string  `json:"expected_restock_date,omitempty" example:"2024-03-01"`
+	// Options replaces the product's options when present; an empty list
+	// removes them. Existing variants must fit the new options.
+	Options []ProductOption `json:"options,omitempty"`
+	// CategoryIDs replaces the product's categories when present; an
+	// empty list removes the product from all categories
+	CategoryIDs []int32 `json:"category_ids,omitempty" example:"3,7"`
+} //@name UpdateProductRequest
+
+// CreateVariantRequest request to add a variant to a product
+// @Description Request body for creating a product variant
+type CreateVariantRequest struct {
+	SKU     string            `json:"sku" binding:"required" example:"TSHIRT-M-RED"`
+	Options map[string]string `json:"options" binding:"required"`
+	// Price overrides the product price for this variant, in the product currency
+	Price *float64 `json:"price,omitempty" example:"24.99"`
+} //@name CreateVariantRequest
+
+// UpdateVariantRequest request to update a variant; omitted fields are unchanged
+// @Description Request body for updating a product variant
+type UpdateVariantRequest struct {
+	SKU     string            `json:"sku,omitempty" example:"TSHIRT-M-RED"`
+	Options map[string]string `json:"options,omitempty"`
+	Price   *float64          `json:"price,omitempty" example:"24.99"`
+	// ClearPrice removes the price override so the variant sells at the product price
+	ClearPrice bool `json:"clear_price,omitempty" example:"false"`
+} //@name UpdateVariantRequest
+
+// VariantResponse represents a product variant response
+// @Description Product variant response
+type VariantResponse struct {
+	Success bool           `json:"success" example:"true"`
+	Message string         `json:"message" example:"Variant created successfully"`
+	Variant ProductVariant `json:"variant"`
+} //@name VariantResponse
+
+// VariantsListResponse represents the variants of a product
+// @Description Product variants list response
+type VariantsListResponse struct {
+	Variants []ProductVariant `json:"variants"`
+} //@name VariantsListResponse
+
+// SKULookupResponse represents the product and variant a SKU belongs to
+// @Description SKU lookup response
+type SKULookupResponse struct {
+	Product Product        `json:"product"`
+	Variant ProductVariant `json:"variant"`
+} //@name SKULookupResponse
+
+// Category represents a node of the category tree
+// @Description Product category
+type Category struct {
+	ID          int32  `json:"id" example:"7"`
+	Name        string `json:"name" example:"Smartphones"`
+	Slug        string `json:"slug" example:"smartphones"`
+	Description string `json:"description" example:"Phones and accessories"`
+	// ParentID is omitted for root categories
+	ParentID  int32  `json:"parent_id,omitempty" example:"3"`
+	CreatedAt string `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt string `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+} //@name Category
+
+// CategoryNode represents a category with its subcategories
+// @Description Category tree node
+type CategoryNode struct {
+	Category
+	Children []CategoryNode `json:"children"`
+} //@name CategoryNode
+
+// CreateCategoryRequest request to create a category
+// @Description Request body for creating a category
+type CreateCategoryRequest struct {
+	Name string `json:"name" binding:"required" example:"Smartphones"`
+	// Slug is derived from the name when omitted
+	Slug        string `json:"slug,omitempty" example:"smartphones"`
+	Description string `json:"description,omitempty" example:"Phones and accessories"`
+	// ParentID places the category below another one; omit it for a root category
+	ParentID int32 `json:"parent_id,omitempty" example:"3"`
+} //@name CreateCategoryRequest
+
+// UpdateCategoryRequest request to update a category; omitted fields are unchanged
+// @Description Request body for updating a category
+type UpdateCategoryRequest struct {
+	Name        string `json:"name,omitempty" example:"Smartphones"`
+	Slug        string `json:"slug,omitempty" example:"smartphones"`
+	Description string `json:"description,omitempty" example:"Phones and accessories"`
+} //@name UpdateCategoryRequest
+
+// MoveCategoryRequest request to move a category and its subtree
+// @Description Request body for moving a category
+type MoveCategoryRequest struct {
+	// ParentID is the new parent; 0 moves the category to the root
+	ParentID int32 `json:"parent_id" example:"3"`
+} //@name MoveCategoryRequest
+
+// CategoryResponse represents a category with its position in the tree
+// @Description Category response
+type CategoryResponse struct {
+	Success  bool     `json:"success" example:"true"`
+	Message  string   `json:"message" example:"Category created successfully"`
+	Category Category `json:"category"`
+	// Breadcrumbs is the path from the root down to the category itself
+	Breadcrumbs []Category `json:"breadcrumbs"`
+	Children    []Category `json:"children"`
+} //@name CategoryResponse
+
+// CategoryTreeResponse represents the whole category tree
+// @Description Category tree response
+type CategoryTreeResponse struct {
+	Categories []CategoryNode `json:"categories"`
+} //@name CategoryTreeResponse
+
+// CategoryProductsResponse represents the products listed in a category
+// @Description Category products response
+type CategoryProductsResponse struct {
+	Category Category  `json:"category"`
+	Products []Product `json:"products"`
+	Total    int32     `json:"total" example:"50"`
+	Page     int32     `json:"page" example:"1"`
+	Limit    int32     `json:"limit" example:"10"`
+} //@name CategoryProductsResponse
+
 // SuccessResponse represents a successful operation response
 // @Description Success response
 type SuccessResponse struct {
@@ -79,6 +460,256 @@ type UsersListResponse struct {
 	Limit int32  `json:"limit" example:"10"`
 } //@name UsersListResponse
 
+// DeletionCascade reports what happened to a deleted user's products or orders
+// @Description Cascade of a user deletion
+type DeletionCascade struct {
+	Policy string `json:"policy" enums:"block,soft-cascade,reassign" example:"soft-cascade"`
+	// Count is the number of products or orders deleted, archived or reassigned
+	Count        int32 `json:"count" example:"3"`
+	ReassignedTo int32 `json:"reassigned_to,omitempty" example:"2"`
+} //@name DeletionCascade
+
+// DeleteUserResponse represents the result of soft deleting a user
+// @Description Delete user response
+type DeleteUserResponse struct {
+	Success  bool            `json:"success" example:"true"`
+	Message  string          `json:"message" example:"User deleted successfully"`
+	User     User            `json:"user"`
+	Products DeletionCascade `json:"products"`
+	Orders   DeletionCascade `json:"orders"`
+} //@name DeleteUserResponse
+
+// RestoreUserResponse represents the result of restoring a deleted user
+// @Description Restore user response
+type RestoreUserResponse struct {
+	Success bool   `json:"success" example:"true"`
+	Message string `json:"message" example:"User restored successfully"`
+	User    User   `json:"user"`
+	// RestoredProducts and RestoredOrders count the records deleted
+	// together with the user and brought back with it
+	RestoredProducts int32 `json:"restored_products" example:"3"`
+	RestoredOrders   int32 `json:"restored_orders" example:"5"`
+} //@name RestoreUserResponse
+
+// UserDataExport is everything the system holds about a user, as handed out
+// for a data subject access request
+// @Description User data export
+type UserDataExport struct {
+	ExportedAt string     `json:"exported_at" example:"2024-01-01T12:00:00Z"`
+	User       User       `json:"user"`
+	Products   []*Product `json:"products"`
+	Orders     []*Order   `json:"orders"`
+	// Reviews the user wrote, whatever their moderation status
+	Reviews []Review `json:"reviews"`
+	// Invoices lists the invoices issued for the user's orders
+	Invoices        []ExportedInvoice `json:"invoices"`
+	PrivacyRequests []privacy.Request `json:"privacy_requests"`
+} //@name UserDataExport
+
+// ExportedInvoice names the invoice issued for an order
+// @Description Invoice of an exported order
+type ExportedInvoice struct {
+	OrderID string `json:"order_id" example:"3f2a9c1e-8d4b-4e6f-9a1b-2c3d4e5f6a7b"`
+	Number  string `json:"number" example:"INV-000042"`
+} //@name ExportedInvoice
+
+// EraseUserResponse represents the result of erasing a user's personal data
+// @Description Erase user response
+type EraseUserResponse struct {
+	Success bool            `json:"success" example:"true"`
+	Message string          `json:"message" example:"User erased successfully"`
+	User    User            `json:"user"`
+	Request privacy.Request `json:"request"`
+} //@name EraseUserResponse
+
+// PrivacyRequestsListResponse represents the audit trail of data subject requests
+// @Description Privacy requests list response
+type PrivacyRequestsListResponse struct {
+	Requests []privacy.Request `json:"requests"`
+	Total    int32             `json:"total" example:"2"`
+} //@name PrivacyRequestsListResponse
+
+// Role represents a role and the permissions it grants
+// @Description Role
+type Role struct {
+	Name        string            `json:"name" example:"seller"`
+	Permissions []rbac.Permission `json:"permissions" swaggertype:"array,string" example:"products:manage:own,inventory:manage:own"`
+} //@name Role
+
+// RolesListResponse represents the roles of the access policy
+// @Description Roles list response
+type RolesListResponse struct {
+	Roles []Role `json:"roles"`
+} //@name RolesListResponse
+
+// UserRolesResponse represents the roles of a user and the permissions they
+// add up to
+// @Description User roles response
+type UserRolesResponse struct {
+	UserID      int32             `json:"user_id" example:"1"`
+	Roles       []string          `json:"roles" example:"customer,seller"`
+	Permissions []rbac.Permission `json:"permissions" swaggertype:"array,string" example:"catalog:read,products:manage:own"`
+} //@name UserRolesResponse
+
+// GrantRoleRequest request to grant a role to a user
+// @Description Request body for granting a role
+type GrantRoleRequest struct {
+	Role string `json:"role" binding:"required" example:"seller"`
+} //@name GrantRoleRequest
+
+// SignupRequest request to sign up with a password
+// @Description Request body for signing up
+type SignupRequest struct {
+	Name     string `json:"name" binding:"required" example:"John Doe"`
+	Email    string `json:"email" binding:"required" example:"john@example.com"`
+	Age      int32  `json:"age" binding:"required" example:"30"`
+	Password string `json:"password" binding:"required" example:"correct horse battery"`
+} //@name SignupRequest
+
+// LoginRequest request to log in
+// @Description Request body for logging in
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required" example:"john@example.com"`
+	Password string `json:"password" binding:"required" example:"correct horse battery"`
+} //@name LoginRequest
+
+// AuthTokensResponse represents the tokens of a session
+// @Description Access and refresh tokens
+type AuthTokensResponse struct {
+	Success               bool   `json:"success" example:"true"`
+	Message               string `json:"message" example:"Logged in successfully"`
+	User                  User   `json:"user"`
+	AccessToken           string `json:"access_token" example:"q3Jx0c9u..."`
+	AccessTokenExpiresAt  string `json:"access_token_expires_at" example:"2024-01-01T12:15:00Z"`
+	RefreshToken          string `json:"refresh_token" example:"Zk4mT1b8..."`
+	RefreshTokenExpiresAt string `json:"refresh_token_expires_at" example:"2024-01-31T12:00:00Z"`
+	TokenType             string `json:"token_type" example:"Bearer"`
+} //@name AuthTokensResponse
+
+// RefreshTokenRequest request to rotate a refresh token
+// @Description Request body for refreshing tokens
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required" example:"Zk4mT1b8..."`
+} //@name RefreshTokenRequest
+
+// LogoutRequest request to end sessions
+// @Description Request body for logging out
+type LogoutRequest struct {
+	// RefreshToken names the session to end; defaults to the session of the access token
+	RefreshToken string `json:"refresh_token,omitempty" example:"Zk4mT1b8..."`
+	// AllSessions ends every session of the user
+	AllSessions bool `json:"all_sessions,omitempty" example:"false"`
+} //@name LogoutRequest
+
+// LogoutResponse represents the result of logging out
+// @Description Logout response
+type LogoutResponse struct {
+	Success bool   `json:"success" example:"true"`
+	Message string `json:"message" example:"Logged out successfully"`
+	Revoked int32  `json:"revoked" example:"2"`
+} //@name LogoutResponse
+
+// EmailRequest request naming an email address
+// @Description Request body with an email address
+type EmailRequest struct {
+	Email string `json:"email" binding:"required" example:"john@example.com"`
+} //@name EmailRequest
+
+// ResetPasswordRequest request to set a new password with a reset token
+// @Description Request body for resetting a password
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required" example:"b5Yw2kq..."`
+	NewPassword string `json:"new_password" binding:"required" example:"another horse battery"`
+} //@name ResetPasswordRequest
+
+// AuthActionResponse represents the result of an account action
+// @Description Account action response
+type AuthActionResponse struct {
+	Success bool   `json:"success" example:"true"`
+	Message string `json:"message" example:"Email verified successfully"`
+	User    *User  `json:"user,omitempty"`
+} //@name AuthActionResponse
+
+// CurrentUserResponse represents the signed-in user and their permissions
+// @Description Current user response
+type CurrentUserResponse struct {
+	User        User              `json:"user"`
+	Permissions []rbac.Permission `json:"permissions" swaggertype:"array,string" example:"catalog:read,orders:create:own"`
+} //@name CurrentUserResponse
+
+// APIKey represents an API key of a machine client; the key itself is only
+// shown when it is created
+// @Description API key information
+type APIKey struct {
+	ID     int32  `json:"id" example:"1"`
+	UserID int32  `json:"user_id" example:"1"`
+	Name   string `json:"name" example:"Warehouse scanner 3"`
+	// Prefix is the start of the key, to tell keys apart
+	Prefix     string   `json:"prefix" example:"mk_Q2x9Lw0a"`
+	Scopes     []string `json:"scopes" example:"inventory:write,orders:read"`
+	CreatedAt  string   `json:"created_at" example:"2024-01-01T12:00:00Z"`
+	ExpiresAt  string   `json:"expires_at,omitempty" example:"2025-01-01T00:00:00Z"`
+	LastUsedAt string   `json:"last_used_at,omitempty" example:"2024-03-01T08:30:00Z"`
+	RevokedAt  string   `json:"revoked_at,omitempty" example:"2024-06-01T12:00:00Z"`
+} //@name APIKey
+
+// CreateAPIKeyRequest request to create an API key
+// @Description Request body for creating an API key
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name" binding:"required" example:"Warehouse scanner 3"`
+	Scopes []string `json:"scopes" binding:"required" example:"inventory:write,orders:read"`
+	// ExpiresAt is an RFC 3339 time; keys without it never expire
+	ExpiresAt string `json:"expires_at,omitempty" example:"2025-01-01T00:00:00Z"`
+} //@name CreateAPIKeyRequest
+
+// APIKeyCreatedResponse represents a new API key, including the key
+// @Description API key created response
+type APIKeyCreatedResponse struct {
+	Success bool   `json:"success" example:"true"`
+	Message string `json:"message" example:"API key created; store it now, it is not shown again"`
+	APIKey  APIKey `json:"api_key"`
+	Key     string `json:"key" example:"mk_Q2x9Lw0aV7c..."`
+} //@name APIKeyCreatedResponse
+
+// APIKeyResponse represents the result of an API key action
+// @Description API key response
+type APIKeyResponse struct {
+	Success bool   `json:"success" example:"true"`
+	Message string `json:"message" example:"API key revoked successfully"`
+	APIKey  APIKey `json:"api_key"`
+} //@name APIKeyResponse
+
+// APIKeysListResponse represents the API keys of a user
+// @Description API keys list response
+type APIKeysListResponse struct {
+	APIKeys []APIKey `json:"api_keys"`
+} //@name APIKeysListResponse
+
+// OIDCProvider represents an external identity provider users can sign in with
+// @Description External identity provider
+type OIDCProvider struct {
+	Name        string `json:"name" example:"corp"`
+	DisplayName string `json:"display_name" example:"Corporate SSO"`
+	// LoginURL starts the sign-in; it redirects to the provider
+	LoginURL string `json:"login_url" example:"/api/auth/oidc/corp/login"`
+} //@name OIDCProvider
+
+// OIDCProvidersResponse represents the configured identity providers
+// @Description Identity providers response
+type OIDCProvidersResponse struct {
+	Providers []OIDCProvider `json:"providers"`
+} //@name OIDCProvidersResponse
+
+// Storefront represents the tenant a request is served for
+// @Description Storefront the request is served for
+type Storefront struct {
+	ID   string `json:"id" example:"acme"`
+	Name string `json:"name" example:"Acme Outdoor"`
+	// Currency prices are shown in by default; empty for each product's own
+	Currency string          `json:"currency" example:"EUR"`
+	Features map[string]bool `json:"features"`
+} //@name Storefront
+
 // ProductResponse represents a product response
 // @Description Product response
 type ProductResponse struct {
@@ -114,29 +745,89 @@ type HealthResponse struct {
 // InventoryItem represents an inventory item
 // @Description Inventory item information
 type InventoryItem struct {
-	ID        int32  `json:"id" example:"1"`
-	ProductID int32  `json:"product_id" example:"1"`
-	Quantity  int32  `json:"quantity" example:"100"`
-	Location  string `json:"location" example:"Warehouse A"`
-	CreatedAt string `json:"created_at" example:"2023-01-01T12:00:00Z"`
-	UpdatedAt string `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+	ID                int32  `json:"id" example:"1"`
+	ProductID         int32  `json:"product_id" example:"1"`
+	VariantID         int32  `json:"variant_id,omitempty" example:"7"`
+	Quantity          int32  `json:"quantity" example:"100"`
+	ReservedQuantity  int32  `json:"reserved_quantity" example:"10"`
+	AvailableQuantity int32  `json:"available_quantity" example:"90"`
+	InTransitQuantity int32  `json:"in_transit_quantity" example:"20"`
+	ReorderPoint      int32  `json:"reorder_point" example:"10"`
+	ReorderQuantity   int32  `json:"reorder_quantity" example:"50"`
+	WarehouseID       int32  `json:"warehouse_id" example:"1"`
+	Location          string `json:"location" example:"WH-EAST"`
+	CreatedAt         string `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt         string `json:"updated_at" example:"2023-01-01T12:00:00Z"`
 } //@name InventoryItem
 
 // CreateInventoryItemRequest request to create a new inventory item
 // @Description Request body for creating an inventory item
 type CreateInventoryItemRequest struct {
-	ProductID int32  `json:"product_id" binding:"required" example:"1"`
-	Quantity  int32  `json:"quantity" binding:"required" example:"100"`
-	Location  string `json:"location" binding:"required" example:"Warehouse A"`
+	ProductID   int32  `json:"product_id" binding:"required" example:"1"`
+	VariantID   int32  `json:"variant_id,omitempty" example:"7"`
+	SKU         string `json:"sku,omitempty" example:"TSHIRT-M-RED"`
+	Quantity    int32  `json:"quantity" binding:"required" example:"100"`
+	WarehouseID int32  `json:"warehouse_id,omitempty" example:"1"`
+	Location    string `json:"location,omitempty" example:"WH-EAST"`
+	Note        string `json:"note,omitempty" example:"PO-2023-117"`
+	// Reorder settings of a new item; 0 disables low-stock alerts
+	ReorderPoint    int32 `json:"reorder_point,omitempty" example:"10"`
+	ReorderQuantity int32 `json:"reorder_quantity,omitempty" example:"50"`
 } //@name CreateInventoryItemRequest
 
-// UpdateInventoryItemRequest request to update an inventory item
-// @Description Request body for updating an inventory item
+// UpdateInventoryItemRequest request to adjust an inventory item
+// @Description Request body for adjusting an inventory item. Quantities change by a relative adjustment with a reason; absolute quantities are not accepted.
 type UpdateInventoryItemRequest struct {
-	Quantity int32  `json:"quantity" binding:"required" example:"100"`
-	Location string `json:"location" binding:"required" example:"Warehouse A"`
+	// Adjustment is a signed number, given as "+5", "-3" or 5
+	Adjustment  string `json:"adjustment" example:"-3"`
+	Reason      string `json:"reason" enums:"RECEIVED,CYCLE_COUNT,DAMAGED,LOST,FOUND,CORRECTION,CUSTOMER_RETURN" example:"DAMAGED"`
+	Note        string `json:"note,omitempty" example:"Forklift accident"`
+	Reference   string `json:"reference,omitempty" example:"ord_123456"`
+	WarehouseID int32  `json:"warehouse_id,omitempty" example:"1"`
+	Location    string `json:"location,omitempty" example:"WH-EAST"`
+	// Reorder settings; omitted fields keep their value
+	ReorderPoint    *int32 `json:"reorder_point,omitempty" example:"10"`
+	ReorderQuantity *int32 `json:"reorder_quantity,omitempty" example:"50"`
 } //@name UpdateInventoryItemRequest
 
+// ReorderSuggestionsResponse represents suggested purchases
+// @Description Suggested purchase quantities, most urgent first
+type ReorderSuggestionsResponse struct {
+	WindowDays   int                  `json:"window_days" example:"30"`
+	LeadTimeDays int                  `json:"lead_time_days" example:"7"`
+	CoverageDays int                  `json:"coverage_days" example:"30"`
+	Suggestions  []restock.Suggestion `json:"suggestions"`
+} //@name ReorderSuggestionsResponse
+
+// InventoryLedgerEntry represents one recorded change to the stock of an item
+// @Description Immutable inventory ledger entry
+type InventoryLedgerEntry struct {
+	ID              int32  `json:"id" example:"42"`
+	InventoryItemID int32  `json:"inventory_item_id" example:"1"`
+	ProductID       int32  `json:"product_id" example:"1"`
+	VariantID       int32  `json:"variant_id,omitempty" example:"7"`
+	WarehouseID     int32  `json:"warehouse_id" example:"1"`
+	Type            string `json:"type" enums:"CREATE,ADJUSTMENT,RESERVATION,RELEASE,FULFILLMENT,RETURN,TRANSFER_OUT,TRANSFER_IN,MOVE" example:"ADJUSTMENT"`
+	Reason          string `json:"reason" example:"DAMAGED"`
+	Actor           string `json:"actor" example:"user:12"`
+	QuantityDelta   int32  `json:"quantity_delta" example:"-3"`
+	ReservedDelta   int32  `json:"reserved_delta" example:"0"`
+	QuantityAfter   int32  `json:"quantity_after" example:"97"`
+	ReservedAfter   int32  `json:"reserved_after" example:"10"`
+	Reference       string `json:"reference" example:"ord_123456"`
+	Note            string `json:"note" example:"Forklift accident"`
+	CreatedAt       string `json:"created_at" example:"2023-01-01T12:00:00Z"`
+} //@name InventoryLedgerEntry
+
+// InventoryHistoryResponse represents the ledger of an inventory item
+// @Description Inventory item history, newest first
+type InventoryHistoryResponse struct {
+	Entries []InventoryLedgerEntry `json:"entries"`
+	Total   int32                  `json:"total" example:"12"`
+	Page    int32                  `json:"page" example:"1"`
+	Limit   int32                  `json:"limit" example:"20"`
+} //@name InventoryHistoryResponse
+
 // InventoryItemResponse represents an inventory item response
 // @Description Inventory item response
 type InventoryItemResponse struct {
@@ -156,34 +847,72 @@ type InventoryItemsListResponse struct {
 // CheckStockRequest request to check stock availability
 // @Description Request body for checking stock availability
 type CheckStockRequest struct {
-	ProductID        int32 `json:"product_id" binding:"required" example:"1"`
-	RequiredQuantity int32 `json:"required_quantity" binding:"required" example:"10"`
+	ProductID        int32  `json:"product_id" binding:"required" example:"1"`
+	VariantID        int32  `json:"variant_id,omitempty" example:"7"`
+	SKU              string `json:"sku,omitempty" example:"TSHIRT-M-RED"`
+	RequiredQuantity int32  `json:"required_quantity" binding:"required" example:"10"`
 } //@name CheckStockRequest
 
 // CheckStockResponse represents stock availability response
 // @Description Stock availability response
 type CheckStockResponse struct {
-	Available         bool   `json:"available" example:"true"`
-	AvailableQuantity int32  `json:"available_quantity" example:"100"`
-	Message           string `json:"message" example:"Stock is available"`
+	Available         bool            `json:"available" example:"true"`
+	AvailableQuantity int32           `json:"available_quantity" example:"100"`
+	Message           string          `json:"message" example:"Stock is available"`
+	InTransitQuantity int32           `json:"in_transit_quantity" example:"20"`
+	Locations         []LocationStock `json:"locations"`
 } //@name CheckStockResponse
 
+// LocationStock represents the stock of a product in one warehouse
+// @Description Stock level of a product in one warehouse
+type LocationStock struct {
+	WarehouseID       int32  `json:"warehouse_id" example:"1"`
+	WarehouseCode     string `json:"warehouse_code" example:"WH-EAST"`
+	WarehouseName     string `json:"warehouse_name" example:"East Coast DC"`
+	Quantity          int32  `json:"quantity" example:"100"`
+	ReservedQuantity  int32  `json:"reserved_quantity" example:"10"`
+	AvailableQuantity int32  `json:"available_quantity" example:"90"`
+	InTransitQuantity int32  `json:"in_transit_quantity" example:"20"`
+} //@name LocationStock
+
 // ReserveStockRequest request to reserve stock
 // @Description Request body for reserving stock
 type ReserveStockRequest struct {
-	ProductID int32  `json:"product_id" binding:"required" example:"1"`
-	Quantity  int32  `json:"quantity" binding:"required" example:"10"`
-	OrderID   string `json:"order_id" binding:"required" example:"ord_123456"`
+	ProductID        int32        `json:"product_id" binding:"required" example:"1"`
+	VariantID        int32        `json:"variant_id,omitempty" example:"7"`
+	SKU              string       `json:"sku,omitempty" example:"TSHIRT-M-RED"`
+	Quantity         int32        `json:"quantity" binding:"required" example:"10"`
+	OrderID          string       `json:"order_id" binding:"required" example:"ord_123456"`
+	Strategy         string       `json:"strategy,omitempty" enums:"nearest,largest_stock,split" example:"nearest"`
+	ShippingLocation *GeoLocation `json:"shipping_location,omitempty"`
+	TTLSeconds       int32        `json:"ttl_seconds,omitempty" example:"900"`
 } //@name ReserveStockRequest
 
+// GeoLocation is a point given as latitude and longitude in degrees
+// @Description Geographic coordinates
+type GeoLocation struct {
+	Latitude  float64 `json:"latitude" example:"40.7128"`
+	Longitude float64 `json:"longitude" example:"-74.0060"`
+} //@name GeoLocation
+
 // ReserveStockResponse represents stock reservation response
 // @Description Stock reservation response
 type ReserveStockResponse struct {
-	Success       bool   `json:"success" example:"true"`
-	Message       string `json:"message" example:"Stock reserved successfully"`
-	ReservationID string `json:"reservation_id" example:"res_123456"`
+	Success       bool                    `json:"success" example:"true"`
+	Message       string                  `json:"message" example:"Stock reserved successfully"`
+	ReservationID string                  `json:"reservation_id" example:"res_123456"`
+	Allocations   []ReservationAllocation `json:"allocations"`
+	ExpiresAt     string                  `json:"expires_at" example:"2023-01-01T12:30:00Z"`
 } //@name ReserveStockResponse
 
+// ReservationAllocation represents the part of a reservation drawn from one warehouse
+// @Description Quantity reserved in one warehouse
+type ReservationAllocation struct {
+	WarehouseID   int32  `json:"warehouse_id" example:"1"`
+	WarehouseCode string `json:"warehouse_code" example:"WH-EAST"`
+	Quantity      int32  `json:"quantity" example:"10"`
+} //@name ReservationAllocation
+
 // ReleaseStockRequest request to release stock
 // @Description Request body for releasing stock
 type ReleaseStockRequest struct {
@@ -197,31 +926,292 @@ type ReleaseStockResponse struct {
 	Message string `json:"message" example:"Stock released successfully"`
 } //@name ReleaseStockResponse
 
+// ReserveBatchRequest request to reserve every line of an order at once
+// @Description Request body for reserving several products atomically
+type ReserveBatchRequest struct {
+	OrderID          string            `json:"order_id" binding:"required" example:"ord_123456"`
+	Lines            []ReservationLine `json:"lines" binding:"required"`
+	Strategy         string            `json:"strategy,omitempty" enums:"nearest,largest_stock,split" example:"split"`
+	ShippingLocation *GeoLocation      `json:"shipping_location,omitempty"`
+	TTLSeconds       int32             `json:"ttl_seconds,omitempty" example:"900"`
+} //@name ReserveBatchRequest
+
+// ReservationLine is one product of a batch reservation
+// @Description Product and quantity to reserve
+type ReservationLine struct {
+	ProductID int32  `json:"product_id" binding:"required" example:"1"`
+	VariantID int32  `json:"variant_id,omitempty" example:"7"`
+	SKU       string `json:"sku,omitempty" example:"TSHIRT-M-RED"`
+	Quantity  int32  `json:"quantity" binding:"required" example:"2"`
+} //@name ReservationLine
+
+// LineShortfall reports a line the available stock cannot cover
+// @Description Stock missing for one line of a batch reservation
+type LineShortfall struct {
+	ProductID int32 `json:"product_id" example:"1"`
+	VariantID int32 `json:"variant_id,omitempty" example:"7"`
+	Requested int32 `json:"requested" example:"5"`
+	Available int32 `json:"available" example:"3"`
+	Shortfall int32 `json:"shortfall" example:"2"`
+} //@name LineShortfall
+
+// ReserveBatchResponse represents batch reservation response
+// @Description Batch reservation response: every line reserved, or shortfalls and nothing reserved
+type ReserveBatchResponse struct {
+	Success      bool            `json:"success" example:"true"`
+	Message      string          `json:"message" example:"Reserved 2 lines successfully"`
+	Reservations []Reservation   `json:"reservations"`
+	Shortfalls   []LineShortfall `json:"shortfalls"`
+	ExpiresAt    string          `json:"expires_at,omitempty" example:"2023-01-01T12:30:00Z"`
+} //@name ReserveBatchResponse
+
+// ReleaseBatchRequest request to release all reservations of an order
+// @Description Request body for releasing the reservations of an order
+type ReleaseBatchRequest struct {
+	OrderID string `json:"order_id" binding:"required" example:"ord_123456"`
+} //@name ReleaseBatchRequest
+
+// ReleaseBatchResponse represents batch release response
+// @Description Batch release response
+type ReleaseBatchResponse struct {
+	Success        bool     `json:"success" example:"true"`
+	Message        string   `json:"message" example:"Released 2 reservations successfully"`
+	ReservationIDs []string `json:"reservation_ids"`
+} //@name ReleaseBatchResponse
+
+// Reservation represents stock held for an order
+// @Description Stock reservation information
+type Reservation struct {
+	ID          string                  `json:"id" example:"res_123456"`
+	ProductID   int32                   `json:"product_id" example:"1"`
+	VariantID   int32                   `json:"variant_id,omitempty" example:"7"`
+	Quantity    int32                   `json:"quantity" example:"10"`
+	OrderID     string                  `json:"order_id" example:"ord_123456"`
+	Status      string                  `json:"status" enums:"ACTIVE,RELEASED,EXPIRED,FULFILLED" example:"ACTIVE"`
+	CreatedAt   string                  `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	ExpiresAt   string                  `json:"expires_at" example:"2023-01-01T12:30:00Z"`
+	ReleasedAt  string                  `json:"released_at,omitempty" example:"2023-01-01T12:30:00Z"`
+	Allocations []ReservationAllocation `json:"allocations"`
+} //@name Reservation
+
+// ExtendReservationRequest request to extend a reservation
+// @Description Request body for extending a reservation
+type ExtendReservationRequest struct {
+	ExtendSeconds int32 `json:"extend_seconds" binding:"required" example:"600"`
+} //@name ExtendReservationRequest
+
+// ReservationResponse represents reservation response
+// @Description Reservation response
+type ReservationResponse struct {
+	Reservation Reservation `json:"reservation"`
+	Message     string      `json:"message" example:"Reservation extended successfully"`
+} //@name ReservationResponse
+
+// ReservationsListResponse represents paginated reservations response
+// @Description Paginated list of reservations
+type ReservationsListResponse struct {
+	Reservations []Reservation `json:"reservations"`
+	Total        int32         `json:"total" example:"100"`
+	Page         int32         `json:"page" example:"1"`
+	Limit        int32         `json:"limit" example:"10"`
+} //@name ReservationsListResponse
+
+// Warehouse represents a stock location
+// @Description Warehouse information
+type Warehouse struct {
+	ID        int32        `json:"id" example:"1"`
+	Code      string       `json:"code" example:"WH-EAST"`
+	Name      string       `json:"name" example:"East Coast DC"`
+	Address   string       `json:"address" example:"1 Dock Rd, Newark, NJ"`
+	Location  *GeoLocation `json:"location,omitempty"`
+	Active    bool         `json:"active" example:"true"`
+	CreatedAt string       `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt string       `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+} //@name Warehouse
+
+// CreateWarehouseRequest request to create a warehouse
+// @Description Request body for creating a warehouse
+type CreateWarehouseRequest struct {
+	Code     string       `json:"code" binding:"required" example:"WH-EAST"`
+	Name     string       `json:"name" binding:"required" example:"East Coast DC"`
+	Address  string       `json:"address" example:"1 Dock Rd, Newark, NJ"`
+	Location *GeoLocation `json:"location,omitempty"`
+} //@name CreateWarehouseRequest
+
+// UpdateWarehouseRequest request to update a warehouse
+// @Description Request body for updating a warehouse. Omitted fields keep their value.
+type UpdateWarehouseRequest struct {
+	Name     *string      `json:"name,omitempty" example:"East Coast DC"`
+	Address  *string      `json:"address,omitempty" example:"1 Dock Rd, Newark, NJ"`
+	Location *GeoLocation `json:"location,omitempty"`
+	Active   *bool        `json:"active,omitempty" example:"true"`
+} //@name UpdateWarehouseRequest
+
+// WarehouseResponse represents a warehouse response
+// @Description Warehouse response
+type WarehouseResponse struct {
+	Message   string    `json:"message" example:"Warehouse created successfully"`
+	Warehouse Warehouse `json:"warehouse"`
+} //@name WarehouseResponse
+
+// WarehousesListResponse represents a list of warehouses response
+// @Description Warehouses list response
+type WarehousesListResponse struct {
+	Warehouses []Warehouse `json:"warehouses"`
+} //@name WarehousesListResponse
+
+// StockTransfer represents a movement of stock between two warehouses
+// @Description Stock transfer with its audit trail
+type StockTransfer struct {
+	ID                       string          `json:"id" example:"4b0f7c1e-2d7a-4d8e-9a51-0f6c1d2e3b4a"`
+	ProductID                int32           `json:"product_id" example:"1"`
+	VariantID                int32           `json:"variant_id,omitempty" example:"7"`
+	Quantity                 int32           `json:"quantity" example:"20"`
+	SourceWarehouseID        int32           `json:"source_warehouse_id" example:"1"`
+	SourceWarehouseCode      string          `json:"source_warehouse_code" example:"WH-EAST"`
+	DestinationWarehouseID   int32           `json:"destination_warehouse_id" example:"2"`
+	DestinationWarehouseCode string          `json:"destination_warehouse_code" example:"WH-WEST"`
+	Status                   string          `json:"status" enums:"REQUESTED,IN_TRANSIT,RECEIVED,CANCELLED" example:"IN_TRANSIT"`
+	Note                     string          `json:"note" example:"Rebalance before holiday sale"`
+	Events                   []TransferEvent `json:"events"`
+	CreatedAt                string          `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt                string          `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+} //@name StockTransfer
+
+// TransferEvent is one entry of a transfer's audit trail
+// @Description Status change of a stock transfer
+type TransferEvent struct {
+	Status    string `json:"status" example:"IN_TRANSIT"`
+	Actor     string `json:"actor" example:"user:12"`
+	Note      string `json:"note" example:"Picked up by carrier"`
+	CreatedAt string `json:"created_at" example:"2023-01-01T12:00:00Z"`
+} //@name TransferEvent
+
+// CreateTransferRequest request to move stock between warehouses
+// @Description Request body for creating a stock transfer
+type CreateTransferRequest struct {
+	ProductID              int32  `json:"product_id" binding:"required" example:"1"`
+	VariantID              int32  `json:"variant_id,omitempty" example:"7"`
+	SKU                    string `json:"sku,omitempty" example:"TSHIRT-M-RED"`
+	Quantity               int32  `json:"quantity" binding:"required" example:"20"`
+	SourceWarehouseID      int32  `json:"source_warehouse_id" binding:"required" example:"1"`
+	DestinationWarehouseID int32  `json:"destination_warehouse_id" binding:"required" example:"2"`
+	Note                   string `json:"note,omitempty" example:"Rebalance before holiday sale"`
+} //@name CreateTransferRequest
+
+// UpdateTransferStatusRequest request to advance a stock transfer
+// @Description Request body for dispatching, receiving or cancelling a transfer
+type UpdateTransferStatusRequest struct {
+	Status string `json:"status" binding:"required" enums:"IN_TRANSIT,RECEIVED,CANCELLED" example:"IN_TRANSIT"`
+	Note   string `json:"note,omitempty" example:"Picked up by carrier"`
+} //@name UpdateTransferStatusRequest
+
+// TransferResponse represents a stock transfer response
+// @Description Stock transfer response
+type TransferResponse struct {
+	Message  string        `json:"message" example:"Transfer requested successfully"`
+	Transfer StockTransfer `json:"transfer"`
+} //@name TransferResponse
+
+// TransfersListResponse represents a list of stock transfers response
+// @Description Stock transfers list response
+type TransfersListResponse struct {
+	Transfers []StockTransfer `json:"transfers"`
+	Total     int32           `json:"total" example:"5"`
+	Page      int32           `json:"page" example:"1"`
+	Limit     int32           `json:"limit" example:"10"`
+} //@name TransfersListResponse
+
 // OrderItem represents an item in an order
 // @Description Order item information
 type OrderItem struct {
-	ProductID int32   `json:"product_id" example:"1"`
-	Quantity  int32   `json:"quantity" example:"2"`
-	Price     float64 `json:"price" example:"999.99"`
+	ProductID int32  `json:"product_id" example:"1"`
+	VariantID int32  `json:"variant_id,omitempty" example:"7"`
+	SKU       string `json:"sku,omitempty" example:"TSHIRT-M-RED"`
+	// PriceVersion is the product price version the line was priced at
+	PriceVersion int32       `json:"price_version,omitempty" example:"3"`
+	Quantity     int32       `json:"quantity" example:"2"`
+	UnitPrice    money.Money `json:"unit_price"`
+	Subtotal     money.Money `json:"subtotal"`
+	// Status is BACKORDERED while the line waits for stock
+	Status              string `json:"status" enums:"ALLOCATED,BACKORDERED" example:"ALLOCATED"`
+	ExpectedRestockDate string `json:"expected_restock_date,omitempty" example:"2024-03-01"`
 } //@name OrderItem
 
+// OrderDiscount is a promotion applied to an order
+// @Description Discount applied to an order
+type OrderDiscount struct {
+	PromotionID string      `json:"promotion_id" example:"5f0c6b1e-8c1d-4a4e-9a51-2f6f3a7d9b10"`
+	Code        string      `json:"code,omitempty" example:"SUMMER10"`
+	Description string      `json:"description" example:"10% off"`
+	Amount      money.Money `json:"amount"`
+} //@name OrderDiscount
+
+// OrderTaxLine is the tax charged on an order at one rate
+// @Description Tax charged on an order
+type OrderTaxLine struct {
+	Region   string      `json:"region" example:"DE"`
+	Category string      `json:"category" example:"standard"`
+	Name     string      `json:"name" example:"VAT"`
+	Rate     string      `json:"rate" example:"0.19"`
+	Taxable  money.Money `json:"taxable"`
+	Amount   money.Money `json:"amount"`
+} //@name OrderTaxLine
+
 // Order represents an order in the system
 // @Description Order information
 type Order struct {
-	ID         string      `json:"id" example:"ord_123456"`
-	UserID     int32       `json:"user_id" example:"1"`
-	Items      []OrderItem `json:"items"`
-	TotalPrice float64     `json:"total_price" example:"1999.98"`
-	Status     string      `json:"status" example:"PENDING"`
-	CreatedAt  string      `json:"created_at" example:"2023-01-01T12:00:00Z"`
-	UpdatedAt  string      `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+	ID     string      `json:"id" example:"ord_123456"`
+	UserID int32       `json:"user_id" example:"1"`
+	Items  []OrderItem `json:"items"`
+	// Currency is the currency the amounts below are rendered in
+	Currency string      `json:"currency" example:"USD"`
+	Subtotal money.Money `json:"subtotal"`
+	Discount money.Money `json:"discount"`
+	// Discounts break the discount down by promotion
+	Discounts []OrderDiscount `json:"discounts"`
+	Tax       money.Money     `json:"tax"`
+	// TaxLines break the tax down by rate. When PricesIncludeTax is set the
+	// tax is part of the subtotal rather than added to the total.
+	TaxRegion        string         `json:"tax_region,omitempty" example:"DE"`
+	PricesIncludeTax bool           `json:"prices_include_tax" example:"false"`
+	TaxLines         []OrderTaxLine `json:"tax_lines"`
+	Total            money.Money    `json:"total"`
+	// OriginalCurrency is the currency the order was priced in
+	OriginalCurrency string      `json:"original_currency" example:"USD"`
+	OriginalTotal    money.Money `json:"original_total"`
+	// SettlementCurrency is the currency the customer pays in, converted
+	// from the original total at ExchangeRate when the order was placed
+	SettlementCurrency string      `json:"settlement_currency" example:"EUR"`
+	ExchangeRate       string      `json:"exchange_rate" example:"0.92"`
+	SettlementTotal    money.Money `json:"settlement_total"`
+	Status             string      `json:"status" example:"PENDING"`
+	CreatedAt          string      `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt          string      `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+	// ArchivedAt is set when the order was archived with its deleted user
+	ArchivedAt string `json:"archived_at,omitempty" example:"2023-06-01T12:00:00Z"`
 } //@name Order
 
+// CreateOrderItem is an order line submitted by a client. The unit price is
+// always taken from the product catalog; when a price is supplied it must match.
+// Products with variants are ordered by variant_id or sku.
+// @Description Order line in a create order request
+type CreateOrderItem struct {
+	ProductID int32       `json:"product_id,omitempty" example:"1"`
+	VariantID int32       `json:"variant_id,omitempty" example:"7"`
+	SKU       string      `json:"sku,omitempty" example:"TSHIRT-M-RED"`
+	Quantity  int32       `json:"quantity" binding:"required" example:"2"`
+	Price     json.Number `json:"price,omitempty" swaggertype:"number" example:"999.99"`
+} //@name CreateOrderItem
+
 // CreateOrderRequest request to create a new order
 // @Description Request body for creating an order
 type CreateOrderRequest struct {
-	UserID int32       `json:"user_id" binding:"required" example:"1"`
-	Items  []OrderItem `json:"items" binding:"required"`
+	UserID      int32             `json:"user_id" binding:"required" example:"1"`
+	Items       []CreateOrderItem `json:"items" binding:"required"`
+	CouponCodes []string          `json:"coupon_codes,omitempty" example:"SUMMER10"`
+	// TaxRegion is the region the order ships to, such as "US-CA" or "DE"
+	TaxRegion string `json:"tax_region,omitempty" example:"US-CA"`
 } //@name CreateOrderRequest
 
 // OrderResponse represents an order response
@@ -244,4 +1234,89 @@ type OrdersListResponse struct {
 // @Description Request body for updating order status
 type UpdateOrderStatusRequest struct {
 	Status string `json:"status" binding:"required" example:"CONFIRMED"`
-} //@name UpdateOrderStatusRequest
\ No newline at end of file
+} //@name UpdateOrderStatusRequest
+
+// EvaluatePromotionsRequest request to preview the discounts on a cart
+// @Description Request body for evaluating promotions on a cart
+type EvaluatePromotionsRequest struct {
+	// UserID is optional; without it per-user limits are not checked
+	UserID      int32             `json:"user_id,omitempty" example:"1"`
+	Items       []CreateOrderItem `json:"items" binding:"required"`
+	CouponCodes []string          `json:"coupon_codes,omitempty" example:"SUMMER10"`
+	TaxRegion   string            `json:"tax_region,omitempty" example:"US-CA"`
+} //@name EvaluatePromotionsRequest
+
+// PromotionEvaluationResponse represents a cart preview with discounts
+// @Description Cart preview with applied promotions
+type PromotionEvaluationResponse struct {
+	Quote    pricing.Quote          `json:"quote"`
+	Rejected []promotions.Rejection `json:"rejected"`
+} //@name PromotionEvaluationResponse
+
+// PromotionResponse represents a promotion response
+// @Description Promotion response
+type PromotionResponse struct {
+	Message   string               `json:"message" example:"Promotion created successfully"`
+	Promotion promotions.Promotion `json:"promotion"`
+} //@name PromotionResponse
+
+// PromotionsListResponse represents a list of promotions response
+// @Description Promotions list response
+type PromotionsListResponse struct {
+	Promotions []promotions.Promotion `json:"promotions"`
+	Total      int32                  `json:"total" example:"3"`
+} //@name PromotionsListResponse
+
+// CreateWebhookRequest request to register a webhook endpoint
+// @Description Request body for registering a webhook
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" binding:"required" example:"https://partner.example.com/hooks/orders"`
+	Events      []string `json:"events" binding:"required" example:"order.created,order.status_changed"`
+	Description string   `json:"description,omitempty" example:"Fulfilment partner"`
+} //@name CreateWebhookRequest
+
+// UpdateWebhookRequest request to update a webhook endpoint; omitted fields
+// are left unchanged
+// @Description Request body for updating a webhook
+type UpdateWebhookRequest struct {
+	URL         string   `json:"url,omitempty" example:"https://partner.example.com/hooks/orders"`
+	Events      []string `json:"events,omitempty" example:"order.created,order.status_changed,inventory.low_stock"`
+	Description *string  `json:"description,omitempty" example:"Fulfilment partner"`
+	Active      *bool    `json:"active,omitempty" example:"false"`
+} //@name UpdateWebhookRequest
+
+// WebhookResponse represents a webhook response
+// @Description Webhook response
+type WebhookResponse struct {
+	Message string               `json:"message" example:"Webhook created successfully"`
+	Webhook webhook.Subscription `json:"webhook"`
+} //@name WebhookResponse
+
+// WebhooksListResponse represents a list of webhooks response
+// @Description Webhooks list response
+type WebhooksListResponse struct {
+	Webhooks []webhook.Subscription `json:"webhooks"`
+	Total    int32                  `json:"total" example:"2"`
+} //@name WebhooksListResponse
+
+// WebhookEventTypesResponse lists the events webhooks can subscribe to
+// @Description Webhook event types
+type WebhookEventTypesResponse struct {
+	Events []string `json:"events" example:"order.created,order.status_changed,inventory.low_stock,product.updated"`
+} //@name WebhookEventTypesResponse
+
+// WebhookDeliveryResponse represents a webhook delivery response
+// @Description Webhook delivery response
+type WebhookDeliveryResponse struct {
+	Message  string           `json:"message" example:"Test event sent"`
+	Delivery webhook.Delivery `json:"delivery"`
+} //@name WebhookDeliveryResponse
+
+// WebhookDeliveriesListResponse represents a page of a webhook's delivery log
+// @Description Webhook deliveries list response
+type WebhookDeliveriesListResponse struct {
+	Deliveries []webhook.Delivery `json:"deliveries"`
+	Total      int32              `json:"total" example:"42"`
+	Page       int32              `json:"page" example:"1"`
+	Limit      int32              `json:"limit" example:"10"`
+} //@name WebhookDeliveriesListResponse
@@ -0,0 +1,140 @@
+// Package money provides exact currency amounts stored in minor units.
+//
+// Amounts never pass through float64 arithmetic: decimal strings are parsed
+// into rationals and rounded once into the currency's minor unit.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidAmount is returned when a decimal amount cannot be parsed
+var ErrInvalidAmount = errors.New("invalid money amount")
+
+// Money represents an amount in the minor unit of a currency (cents for USD)
+// @Description Monetary amount in minor units
+type Money struct {
+	Amount   int64  `json:"amount" example:"199998"`
+	Currency string `json:"currency" example:"USD"`
+} //@name Money
+
+// exponents lists currencies whose minor unit is not 1/100
+var exponents = map[string]int{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLP": 0, "ISK": 0, "JPY": 0, "KRW": 0, "PYG": 0, "UGX": 0, "VND": 0,
+}
+
+// Exponent returns the number of decimal places of the currency's minor unit
+func Exponent(currency string) int {
+	if exp, ok := exponents[strings.ToUpper(currency)]; ok {
+		return exp
+	}
+	return 2
+}
+
+// New creates an amount from a value already expressed in minor units
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: strings.ToUpper(currency)}
+}
+
+// Zero returns a zero amount in the given currency
+func Zero(currency string) Money {
+	return New(0, currency)
+}
+
+// Parse converts a decimal string such as "999.99" into minor units,
+// rounding half away from zero when it has more decimals than the currency
+func Parse(s string, currency string) (Money, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	return FromRat(r, currency)
+}
+
+// FromFloat converts a float64 received at a service boundary into minor units.
+// The float is formatted with the shortest exact representation before parsing,
+// so 999.99 becomes 99999 cents rather than 99998.
+func FromFloat(f float64, currency string) (Money, error) {
+	return Parse(strconv.FormatFloat(f, 'f', -1, 64), currency)
+}
+
+// FromRat rounds a rational amount in major units into minor units
+func FromRat(r *big.Rat, currency string) (Money, error) {
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(Exponent(currency))))
+	n := roundHalfAwayFromZero(scaled)
+	if !n.IsInt64() {
+		return Money{}, fmt.Errorf("%w: %s overflows", ErrInvalidAmount, r.FloatString(Exponent(currency)))
+	}
+	return New(n.Int64(), currency), nil
+}
+
+// Rat returns the amount in major units as an exact rational
+func (m Money) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(m.Amount), pow10(Exponent(m.Currency)))
+}
+
+// Float64 returns the amount in major units. It must only be used to fill
+// legacy protobuf fields that still carry prices as doubles.
+func (m Money) Float64() float64 {
+	f, _ := strconv.ParseFloat(m.Decimal(), 64)
+	return f
+}
+
+// Decimal formats the amount in major units, e.g. "999.99"
+func (m Money) Decimal() string {
+	return m.Rat().FloatString(Exponent(m.Currency))
+}
+
+// String formats the amount with its currency, e.g. "999.99 USD"
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+// IsZero reports whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Add returns m + o. Both amounts must be in the same currency.
+func (m Money) Add(o Money) Money {
+	m.mustMatch(o)
+	return New(m.Amount+o.Amount, m.Currency)
+}
+
+// Sub returns m - o. Both amounts must be in the same currency.
+func (m Money) Sub(o Money) Money {
+	m.mustMatch(o)
+	return New(m.Amount-o.Amount, m.Currency)
+}
+
+// Mul returns the amount multiplied by an integer quantity
+func (m Money) Mul(qty int64) Money {
+	return New(m.Amount*qty, m.Currency)
+}
+
+func (m Money) mustMatch(o Money) {
+	if m.Currency != o.Currency {
+		panic(fmt.Sprintf("money: currency mismatch %s != %s", m.Currency, o.Currency))
+	}
+}
+
+func pow10(exp int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
+
+func roundHalfAwayFromZero(r *big.Rat) *big.Int {
+	num := new(big.Int).Abs(r.Num())
+	q, rem := new(big.Int).QuoRem(num, r.Denom(), new(big.Int))
+	if new(big.Int).Mul(rem, big.NewInt(2)).Cmp(r.Denom()) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if r.Sign() < 0 {
+		q.Neg(q)
+	}
+	return q
+}
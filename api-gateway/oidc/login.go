@@ -0,0 +1,91 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"sync"
+	"time"
+)
+
+// loginTimeout is how long a user has to sign in at the provider
+const loginTimeout = 10 * time.Minute
+
+// Login is a sign-in started at a provider and not yet completed. State ties
+// the callback to it, Nonce ties the ID token to it and Verifier is the PKCE
+// secret the authorization code can only be redeemed with. Tenant is the
+// storefront the user signs in to.
+type Login struct {
+	Provider string
+	Tenant   string
+	State    string
+	Nonce    string
+	Verifier string
+	started  time.Time
+}
+
+// codeChallenge is the S256 PKCE challenge of the verifier
+func (l *Login) codeChallenge() string {
+	sum := sha256.Sum256([]byte(l.Verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Logins keeps pending sign-ins in memory until their callback arrives.
+// Logins do not survive a restart and are not shared between gateway
+// instances; a user caught by either simply signs in again.
+type Logins struct {
+	mu      sync.Mutex
+	pending map[string]*Login
+}
+
+// NewLogins returns an empty set of pending sign-ins
+func NewLogins() *Logins {
+	return &Logins{pending: make(map[string]*Login)}
+}
+
+// Start begins a sign-in at the provider to a storefront
+func (s *Logins) Start(provider, tenant string) *Login {
+	l := &Login{
+		Provider: provider,
+		Tenant:   tenant,
+		State:    randomString(),
+		Nonce:    randomString(),
+		Verifier: randomString(),
+		started:  time.Now(),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for state, pending := range s.pending {
+		if time.Since(pending.started) > loginTimeout {
+			delete(s.pending, state)
+		}
+	}
+	s.pending[l.State] = l
+	return l
+}
+
+// Finish returns the pending sign-in of a callback's state and forgets it,
+// so every state is redeemed once
+func (s *Logins) Finish(state string) (*Login, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.pending[state]
+	if !ok {
+		return nil, false
+	}
+	delete(s.pending, state)
+	if time.Since(l.started) > loginTimeout {
+		return nil, false
+	}
+	return l, true
+}
+
+// randomString returns 32 random bytes, base64url encoded as PKCE expects
+func randomString() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
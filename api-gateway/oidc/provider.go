@@ -0,0 +1,269 @@
+// Package oidc lets the API Gateway sign users in through external OpenID
+// Connect identity providers, acting as a relying party with the
+// authorization code flow and PKCE.
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"api-gateway/store"
+)
+
+// ProviderConfig configures one identity provider
+type ProviderConfig struct {
+	// DisplayName is shown to users choosing a provider
+	DisplayName string `json:"display_name"`
+	// Issuer is the provider's issuer URL; its discovery document is read
+	// from Issuer/.well-known/openid-configuration
+	Issuer       string `json:"issuer"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	// RedirectURL is the gateway's callback registered with the provider,
+	// e.g. http://localhost:8000/api/auth/oidc/corp/callback
+	RedirectURL string `json:"redirect_url"`
+	// Scopes requested besides openid; defaults to email and profile
+	Scopes []string `json:"scopes"`
+	// GroupsClaim names the ID token claim listing the user's groups
+	GroupsClaim string `json:"groups_claim"`
+	// GroupRoles maps IdP groups to the gateway roles their members hold
+	GroupRoles map[string][]string `json:"group_roles"`
+	// LinkByEmail links a first login to an existing user with the same,
+	// provider-verified email instead of refusing it. Only enable it for
+	// providers trusted to verify email addresses.
+	LinkByEmail bool `json:"link_by_email"`
+}
+
+// metadata is the part of the discovery document the gateway uses
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is a configured identity provider. Its discovery document and
+// signing keys are fetched on first use and cached.
+type Provider struct {
+	Name string
+	ProviderConfig
+
+	client *http.Client
+
+	mu       sync.Mutex
+	meta     *metadata
+	keys     *keySet
+	keysRead time.Time
+}
+
+// keyRefreshInterval limits how often unknown key IDs trigger a refetch of
+// the provider's keys, which happens when it rotates them
+const keyRefreshInterval = time.Minute
+
+// LoadProviders reads providers from a JSON file mapping provider names to
+// their configuration. A missing file configures no providers.
+func LoadProviders(path string) (map[string]*Provider, error) {
+	var configs map[string]ProviderConfig
+	if err := store.Load(path, &configs); err != nil {
+		return nil, err
+	}
+
+	providers := make(map[string]*Provider)
+	for name, pc := range configs {
+		if pc.Issuer == "" || pc.ClientID == "" || pc.RedirectURL == "" {
+			return nil, fmt.Errorf("provider %s needs an issuer, a client_id and a redirect_url", name)
+		}
+		if pc.DisplayName == "" {
+			pc.DisplayName = name
+		}
+		if len(pc.Scopes) == 0 {
+			pc.Scopes = []string{"email", "profile"}
+		}
+		pc.Issuer = strings.TrimRight(pc.Issuer, "/")
+		providers[name] = &Provider{
+			Name:           name,
+			ProviderConfig: pc,
+			client:         &http.Client{Timeout: 10 * time.Second},
+		}
+	}
+	return providers, nil
+}
+
+// Names returns the names of the providers, sorted
+func Names(providers map[string]*Provider) []string {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// discover returns the provider's metadata, fetching it on first use
+func (p *Provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	var meta metadata
+	if err := p.getJSON(ctx, p.Issuer+"/.well-known/openid-configuration", &meta); err != nil {
+		return nil, fmt.Errorf("discover %s: %w", p.Name, err)
+	}
+	if strings.TrimRight(meta.Issuer, "/") != p.Issuer {
+		return nil, fmt.Errorf("discover %s: issuer %q does not match %q", p.Name, meta.Issuer, p.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, fmt.Errorf("discover %s: incomplete discovery document", p.Name)
+	}
+	p.meta = &meta
+	return p.meta, nil
+}
+
+// key returns the signing key with the ID, refetching the key set when the
+// ID is unknown
+func (p *Provider) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.keys != nil {
+		if key, ok := p.keys.find(kid); ok {
+			return key, nil
+		}
+		if time.Since(p.keysRead) < keyRefreshInterval {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+	}
+
+	var doc jwksDocument
+	if err := p.getJSON(ctx, meta.JWKSURI, &doc); err != nil {
+		return nil, fmt.Errorf("fetch keys of %s: %w", p.Name, err)
+	}
+	p.keys = doc.keySet()
+	p.keysRead = time.Now()
+	if key, ok := p.keys.find(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// AuthCodeURL returns the provider URL users are sent to for signing in
+func (p *Provider) AuthCodeURL(ctx context.Context, l *Login) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.ClientID},
+		"redirect_uri":          {p.RedirectURL},
+		"scope":                 {strings.Join(append([]string{"openid"}, p.Scopes...), " ")},
+		"state":                 {l.State},
+		"nonce":                 {l.Nonce},
+		"code_challenge":        {l.codeChallenge()},
+		"code_challenge_method": {"S256"},
+	}
+	sep := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return meta.AuthorizationEndpoint + sep + params.Encode(), nil
+}
+
+// tokenResponse is the provider's answer to a code exchange
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Exchange redeems an authorization code for the user's verified identity
+func (p *Provider) Exchange(ctx context.Context, l *Login, code string) (*Identity, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.RedirectURL},
+		"client_id":     {p.ClientID},
+		"code_verifier": {l.Verifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.ClientID), url.QueryEscape(p.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var tokens tokenResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&tokens); err != nil {
+		return nil, fmt.Errorf("token endpoint returned %s", resp.Status)
+	}
+	if tokens.Error != "" {
+		return nil, fmt.Errorf("token endpoint: %s", strings.TrimSpace(tokens.Error+" "+tokens.ErrorDescription))
+	}
+	if resp.StatusCode != http.StatusOK || tokens.IDToken == "" {
+		return nil, fmt.Errorf("token endpoint returned %s without an ID token", resp.Status)
+	}
+
+	return p.verifyIDToken(ctx, tokens.IDToken, l.Nonce)
+}
+
+// Roles maps the identity's groups to gateway roles
+func (p *Provider) Roles(id *Identity) []string {
+	seen := make(map[string]bool)
+	roles := make([]string, 0)
+	for _, group := range id.Groups {
+		for _, role := range p.GroupRoles[group] {
+			if !seen[role] {
+				seen[role] = true
+				roles = append(roles, role)
+			}
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+func (p *Provider) getJSON(ctx context.Context, rawURL string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned %s", rawURL, resp.Status)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(v)
+}
@@ -0,0 +1,219 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// clockSkew is how far the provider's clock may be off from the gateway's
+const clockSkew = time.Minute
+
+// Identity is the user an ID token vouches for
+type Identity struct {
+	// Subject identifies the user at the provider and never changes
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Groups        []string
+}
+
+// jwksDocument is a JSON Web Key Set
+type jwksDocument struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+// keySet holds the RSA signing keys of a provider by key ID
+type keySet struct {
+	keys map[string]*rsa.PublicKey
+}
+
+// keySet keeps the usable RSA signing keys of the document
+func (d jwksDocument) keySet() *keySet {
+	set := &keySet{keys: make(map[string]*rsa.PublicKey)}
+	for _, k := range d.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil || len(e) == 0 || len(e) > 4 {
+			continue
+		}
+		set.keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return set
+}
+
+// find returns the key with the ID; a token without a key ID matches the
+// only key of a single-key set
+func (s *keySet) find(kid string) (*rsa.PublicKey, bool) {
+	if key, ok := s.keys[kid]; ok {
+		return key, true
+	}
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+// hashes maps the supported signing algorithms to their hash
+var hashes = map[string]crypto.Hash{
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+}
+
+// verifyIDToken checks the signature, issuer, audience, lifetime and nonce
+// of an ID token and returns the identity it carries
+func (p *Provider) verifyIDToken(ctx context.Context, raw, nonce string) (*Identity, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed ID token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("malformed ID token header: %w", err)
+	}
+	hash, ok := hashes[header.Alg]
+	if !ok {
+		return nil, fmt.Errorf("unsupported ID token algorithm %q", header.Alg)
+	}
+
+	key, err := p.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New("malformed ID token signature")
+	}
+	h := hash.New()
+	h.Write([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, hash, h.Sum(nil), signature); err != nil {
+		return nil, errors.New("invalid ID token signature")
+	}
+
+	var claims map[string]any
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("malformed ID token claims: %w", err)
+	}
+	if err := p.checkClaims(claims, nonce); err != nil {
+		return nil, err
+	}
+
+	id := &Identity{
+		Subject:       stringClaim(claims, "sub"),
+		Email:         stringClaim(claims, "email"),
+		EmailVerified: boolClaim(claims, "email_verified"),
+		Name:          stringClaim(claims, "name"),
+	}
+	if id.Subject == "" {
+		return nil, errors.New("ID token has no subject")
+	}
+	if id.Name == "" {
+		id.Name = stringClaim(claims, "preferred_username")
+	}
+	if p.GroupsClaim != "" {
+		id.Groups = listClaim(claims, p.GroupsClaim)
+	}
+	return id, nil
+}
+
+// checkClaims validates the registered claims of an ID token
+func (p *Provider) checkClaims(claims map[string]any, nonce string) error {
+	if strings.TrimRight(stringClaim(claims, "iss"), "/") != p.Issuer {
+		return errors.New("ID token was issued by another provider")
+	}
+
+	audiences := listClaim(claims, "aud")
+	found := false
+	for _, aud := range audiences {
+		if aud == p.ClientID {
+			found = true
+		}
+	}
+	if !found {
+		return errors.New("ID token is meant for another client")
+	}
+	if azp := stringClaim(claims, "azp"); len(audiences) > 1 && azp != p.ClientID {
+		return errors.New("ID token is meant for another client")
+	}
+
+	now := time.Now()
+	exp, ok := claims["exp"].(float64)
+	if !ok || now.After(time.Unix(int64(exp), 0).Add(clockSkew)) {
+		return errors.New("ID token expired")
+	}
+	if iat, ok := claims["iat"].(float64); ok && time.Unix(int64(iat), 0).After(now.Add(clockSkew)) {
+		return errors.New("ID token issued in the future")
+	}
+	if stringClaim(claims, "nonce") != nonce {
+		return errors.New("ID token nonce does not match the login")
+	}
+	return nil
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func stringClaim(claims map[string]any, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
+
+// boolClaim reads a boolean claim, which some providers send as a string
+func boolClaim(claims map[string]any, name string) bool {
+	switch v := claims[name].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+// listClaim reads a claim holding a string or a list of strings
+func listClaim(claims map[string]any, name string) []string {
+	switch v := claims[name].(type) {
+	case string:
+		return []string{v}
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/oidc"
+	"api-gateway/proto"
+	"api-gateway/rbac"
+	"api-gateway/tenant"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// checkProviderRoles warns about group mappings to roles the policy does not
+// define; such roles are never granted
+func checkProviderRoles() {
+	for _, name := range oidc.Names(providers) {
+		for group, roles := range providers[name].GroupRoles {
+			for _, role := range roles {
+				if !policy.Has(role) || role == rbac.Guest {
+					log.Printf("⚠️  OIDC provider %s maps group %q to unknown role %q; ignoring it", name, group, role)
+				}
+			}
+		}
+	}
+}
+
+// providerRoles returns the roles the identity's groups map to that users can
+// hold
+func providerRoles(p *oidc.Provider, id *oidc.Identity) []string {
+	roles := make([]string, 0)
+	for _, role := range p.Roles(id) {
+		if policy.Has(role) && role != rbac.Guest {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// listOIDCProviders List Identity Providers
+// @Summary      List identity providers
+// @Description  Get the external identity providers users can sign in with
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.OIDCProvidersResponse
+// @Router       /auth/oidc [get]
+func listOIDCProviders(c *fiber.Ctx) error {
+	result := make([]models.OIDCProvider, 0)
+	for _, name := range oidc.Names(providers) {
+		result = append(result, models.OIDCProvider{
+			Name:        name,
+			DisplayName: providers[name].DisplayName,
+			LoginURL:    storefrontPath(c, "/api/auth/oidc/"+name+"/login"),
+		})
+	}
+	return c.JSON(fiber.Map{
+		"providers": result,
+	})
+}
+
+// oidcLogin OIDC Login
+// @Summary      Sign in with an identity provider
+// @Description  Redirect to the identity provider to sign in with the authorization code flow and PKCE. The provider sends the user back to the callback.
+// @Tags         Auth
+// @Param        provider  path  string  true  "Provider name"
+// @Success      302
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      502  {object}  models.ErrorResponse
+// @Router       /auth/oidc/{provider}/login [get]
+func oidcLogin(c *fiber.Ctx) error {
+	provider, ok := providers[c.Params("provider")]
+	if !ok {
+		return c.Status(404).JSON(fiber.Map{"error": "Unknown identity provider"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	login := oidcLogins.Start(provider.Name, tenantOf(c).ID)
+	authURL, err := provider.AuthCodeURL(ctx, login)
+	if err != nil {
+		log.Printf("⚠️  OIDC login with %s failed: %v", provider.Name, err)
+		return c.Status(502).JSON(fiber.Map{"error": "Identity provider unavailable"})
+	}
+	return c.Redirect(authURL, fiber.StatusFound)
+}
+
+// oidcCallback OIDC Callback
+// @Summary      Complete a sign-in with an identity provider
+// @Description  The identity provider redirects here after sign-in. The code is exchanged and the ID token verified; the user is linked by the provider's subject, created on first login at the storefront the sign-in started at, and holds the roles the provider's groups map to.
+// @Tags         Auth
+// @Produce      json
+// @Param        provider  path      string  true   "Provider name"
+// @Param        code      query     string  false  "Authorization code"
+// @Param        state     query     string  true   "State of the sign-in"
+// @Param        error     query     string  false  "Error reported by the provider"
+// @Success      200       {object}  models.AuthTokensResponse
+// @Failure      400       {object}  models.ErrorResponse
+// @Failure      401       {object}  models.ErrorResponse
+// @Failure      404       {object}  models.ErrorResponse
+// @Failure      409       {object}  models.ErrorResponse
+// @Failure      500       {object}  models.ErrorResponse
+// @Router       /auth/oidc/{provider}/callback [get]
+func oidcCallback(c *fiber.Ctx) error {
+	provider, ok := providers[c.Params("provider")]
+	if !ok {
+		return c.Status(404).JSON(fiber.Map{"error": "Unknown identity provider"})
+	}
+	login, ok := oidcLogins.Finish(c.Query("state"))
+	if !ok || login.Provider != provider.Name {
+		return c.Status(400).JSON(fiber.Map{"error": "Unknown or expired sign-in; start again"})
+	}
+	if reason := c.Query("error"); reason != "" {
+		message := strings.TrimSpace(reason + " " + c.Query("error_description"))
+		return c.Status(401).JSON(fiber.Map{"error": "Sign-in at the identity provider failed: " + message})
+	}
+	code := c.Query("code")
+	if code == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "code is required"})
+	}
+
+	// The user signs in to the storefront the sign-in started at, whichever
+	// host the provider redirects to
+	storefront, ok := tenants.Get(login.Tenant)
+	if !ok {
+		return c.Status(400).JSON(fiber.Map{"error": "Unknown storefront; start again"})
+	}
+	ctx, cancel := context.WithTimeout(tenant.NewContext(c.UserContext(), storefront), 15*time.Second)
+	defer cancel()
+
+	identity, err := provider.Exchange(ctx, login, code)
+	if err != nil {
+		log.Printf("⚠️  OIDC callback from %s rejected: %v", provider.Name, err)
+		return c.Status(401).JSON(fiber.Map{"error": "Sign-in could not be verified: " + err.Error()})
+	}
+
+	resp, err := clients.UserClient.LoginExternal(ctx, &proto.LoginExternalRequest{
+		Provider:      provider.Name,
+		Subject:       identity.Subject,
+		Email:         identity.Email,
+		EmailVerified: identity.EmailVerified,
+		Name:          identity.Name,
+		Roles:         providerRoles(provider, identity),
+		LinkByEmail:   provider.LinkByEmail,
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !resp.Success && strings.HasPrefix(resp.Message, "Email already belongs") {
+		return c.Status(409).JSON(fiber.Map{"error": resp.Message})
+	}
+	return sendTokens(c, resp)
+}
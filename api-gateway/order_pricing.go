@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"api-gateway/models"
+	"api-gateway/money"
+	"api-gateway/pricing"
+	"api-gateway/proto"
+	"api-gateway/tax"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// priceOrderItems looks up every item in ProductService and builds a quote
+// from catalog prices. Client-supplied prices are only used to detect stale
+// carts: a price that differs from the catalog fails with 409 Conflict.
+//
+// The order is priced in the products' own currency when they all share one,
+// otherwise in the default currency, and settled in settlementCurrency.
+// Submitted prices are compared in the settlement currency, since that is
+// the currency the client was shown.
+//
+// Lines of products with variants must name the variant by variant_id or
+// sku; a variant's price override replaces the product price.
+func priceOrderItems(ctx context.Context, items []models.CreateOrderItem, settlementCurrency string) (*pricing.Quote, error) {
+	if len(items) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, pricing.ErrEmptyOrder.Error())
+	}
+
+	products := make(map[int32]*proto.Product)
+	productIDs := make([]int32, len(items))
+	variants := make([]*proto.Variant, len(items))
+	catalog := make([]money.Money, len(items))
+	currencies := make(map[string]bool)
+	for i, item := range items {
+		variant, err := resolveVariant(ctx, item.ProductID, item.VariantID, item.SKU)
+		if err != nil {
+			// an unknown variant is a bad order line, like an unknown product
+			if e := err.(*fiber.Error); e.Code == fiber.StatusNotFound {
+				return nil, fiber.NewError(fiber.StatusBadRequest, e.Message)
+			}
+			return nil, err
+		}
+		productID := item.ProductID
+		if variant != nil {
+			productID = variant.ProductId
+		}
+		if productID == 0 {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "Each item needs a product_id, variant_id or sku")
+		}
+
+		product, ok := products[productID]
+		if !ok {
+			resp, err := clients.ProductClient.GetProduct(ctx, &proto.GetProductRequest{
+				ProductId: productID,
+			})
+			if err != nil {
+				return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+			}
+			if !resp.Found {
+				return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Product %d not found", productID))
+			}
+			product = resp.Product
+			products[productID] = product
+		}
+		if variant == nil && len(product.Variants) > 0 {
+			return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Product %d has variants: give a variant_id or sku", productID))
+		}
+
+		amount := product.Price
+		if variant != nil && variant.HasPrice {
+			amount = variant.Price
+		}
+		price, err := money.FromFloat(amount, productCurrency(product))
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		variants[i] = variant
+		catalog[i] = price
+		productIDs[i] = productID
+		currencies[price.Currency] = true
+	}
+
+	orderCurrency := cfg.DefaultCurrency
+	if len(currencies) == 1 {
+		for code := range currencies {
+			orderCurrency = code
+		}
+	}
+	if settlementCurrency == "" {
+		settlementCurrency = orderCurrency
+	}
+
+	lines := make([]pricing.Line, 0, len(items))
+	for i, item := range items {
+		label := fmt.Sprintf("product %d", productIDs[i])
+		if variants[i] != nil {
+			label = "SKU " + variants[i].Sku
+		}
+
+		price, err := converter.Convert(catalog[i], orderCurrency)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+		}
+
+		if item.Price != "" {
+			shown, err := converter.Convert(catalog[i], settlementCurrency)
+			if err != nil {
+				return nil, fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+			}
+			submitted, err := money.Parse(item.Price.String(), settlementCurrency)
+			if err != nil {
+				return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid price for "+label)
+			}
+			if submitted != shown {
+				return nil, fiber.NewError(fiber.StatusConflict, fmt.Sprintf("Price for %s has changed to %s", label, shown))
+			}
+		}
+
+		product := products[productIDs[i]]
+		line := pricing.Line{
+			ProductID:           productIDs[i],
+			PriceVersion:        product.PriceVersion,
+			Quantity:            item.Quantity,
+			UnitPrice:           price,
+			TaxCategory:         product.TaxCategory,
+			StockPolicy:         product.StockPolicy,
+			ExpectedRestockDate: product.ExpectedRestockDate,
+		}
+		if variants[i] != nil {
+			line.VariantID = variants[i].Id
+			line.SKU = variants[i].Sku
+		}
+		lines = append(lines, line)
+	}
+
+	quote, err := pricing.NewQuote(orderCurrency, lines)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	rate, err := converter.Rate(orderCurrency, settlementCurrency)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if err := quote.Settle(settlementCurrency, rate); err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return quote, nil
+}
+
+// applyTax calculates the tax on a quote for the region the order ships to
+func applyTax(quote *pricing.Quote, region string) error {
+	err := quote.CalculateTax(taxes, strings.ToUpper(strings.TrimSpace(region)))
+	if errors.Is(err, tax.ErrUnknownRegion) {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
+
+// orderRequestFromQuote builds the OrderService request for a priced order.
+// The legacy double price is still filled for older OrderService versions.
+func orderRequestFromQuote(userID int32, quote *pricing.Quote) *proto.CreateOrderRequest {
+	var orderItems []*proto.OrderItem
+	for _, line := range quote.Lines {
+		orderItems = append(orderItems, &proto.OrderItem{
+			ProductId:           line.ProductID,
+			VariantId:           line.VariantID,
+			Sku:                 line.SKU,
+			PriceVersion:        line.PriceVersion,
+			Quantity:            line.Quantity,
+			Price:               line.UnitPrice.Float64(),
+			UnitPrice:           toProtoMoney(line.UnitPrice),
+			Subtotal:            toProtoMoney(line.Subtotal),
+			StockPolicy:         line.StockPolicy,
+			ExpectedRestockDate: line.ExpectedRestockDate,
+		})
+	}
+
+	var discounts []*proto.DiscountLine
+	for _, d := range quote.Discounts {
+		discounts = append(discounts, &proto.DiscountLine{
+			PromotionId: d.PromotionID,
+			Code:        d.Code,
+			Description: d.Description,
+			Amount:      toProtoMoney(d.Amount),
+		})
+	}
+
+	var taxLines []*proto.TaxLine
+	for _, t := range quote.TaxLines {
+		taxLines = append(taxLines, &proto.TaxLine{
+			Region:   t.Region,
+			Category: t.Category,
+			Name:     t.Name,
+			Rate:     t.Rate,
+			Taxable:  toProtoMoney(t.Taxable),
+			Amount:   toProtoMoney(t.Amount),
+		})
+	}
+
+	return &proto.CreateOrderRequest{
+		UserId:             userID,
+		Items:              orderItems,
+		Currency:           quote.Currency,
+		Subtotal:           toProtoMoney(quote.Subtotal),
+		Discount:           toProtoMoney(quote.Discount),
+		Tax:                toProtoMoney(quote.Tax),
+		Total:              toProtoMoney(quote.Total),
+		SettlementCurrency: quote.SettlementCurrency,
+		ExchangeRate:       quote.ExchangeRate,
+		SettlementTotal:    toProtoMoney(quote.SettlementTotal),
+		Discounts:          discounts,
+		TaxRegion:          quote.TaxRegion,
+		PricesIncludeTax:   quote.PricesIncludeTax,
+		TaxLines:           taxLines,
+	}
+}
+
+func toProtoMoney(m money.Money) *proto.Money {
+	return &proto.Money{Amount: m.Amount, Currency: m.Currency}
+}
@@ -0,0 +1,729 @@
+package main
+
+import (
+	"log"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"api-gateway/currency"
+	"api-gateway/models"
+	"api-gateway/money"
+	"api-gateway/pricing"
+	"api-gateway/proto"
+)
+
+// Presenters convert protobuf messages into API models so that every
+// response carries money as minor units plus currency instead of doubles.
+// The display argument is the caller's currency from displayCurrency; when
+// it is empty amounts are rendered in their original currency.
+
+// toMoney converts a legacy double price from a backend service
+func toMoney(amount float64, code string) money.Money {
+	m, err := money.FromFloat(amount, code)
+	if err != nil {
+		log.Printf("invalid amount %v from backend: %v", amount, err)
+		return money.Zero(code)
+	}
+	return m
+}
+
+// fromProtoMoney returns the amount of a proto money field, or fallback when
+// the field was not set by an older backend
+func fromProtoMoney(m *proto.Money, fallback money.Money) money.Money {
+	if m == nil || m.Currency == "" {
+		return fallback
+	}
+	return money.New(m.Amount, m.Currency)
+}
+
+// productCurrency returns the currency of a product, treating products
+// created before currencies were stored as priced in the default currency
+func productCurrency(p *proto.Product) string {
+	if p.Currency == "" {
+		return cfg.DefaultCurrency
+	}
+	return p.Currency
+}
+
+func presentUser(u *proto.User) models.User {
+	return models.User{
+		ID:              u.Id,
+		Name:            u.Name,
+		Email:           u.Email,
+		Age:             u.Age,
+		CreatedAt:       u.CreatedAt,
+		DeletedAt:       u.DeletedAt,
+		ErasedAt:        u.ErasedAt,
+		Roles:           u.Roles,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+	}
+}
+
+func presentAPIKey(k *proto.ApiKey) models.APIKey {
+	return models.APIKey{
+		ID:         k.Id,
+		UserID:     k.UserId,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		CreatedAt:  k.CreatedAt,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+	}
+}
+
+func presentProduct(p *proto.Product, display string) *models.Product {
+	if p == nil {
+		return nil
+	}
+
+	product := &models.Product{
+		ID:                  p.Id,
+		Name:                p.Name,
+		Description:         p.Description,
+		Price:               toMoney(p.Price, productCurrency(p)),
+		TaxCategory:         p.TaxCategory,
+		StockPolicy:         p.StockPolicy,
+		ExpectedRestockDate: p.ExpectedRestockDate,
+		UserID:              p.UserId,
+		CreatedAt:           p.CreatedAt,
+		PriceVersion:        p.PriceVersion,
+		DeletedAt:           p.DeletedAt,
+	}
+	// products created before stock policies existed have none
+	if product.StockPolicy == "" {
+		product.StockPolicy = "none"
+	}
+	product.Options = make([]models.ProductOption, 0, len(p.Options))
+	for _, o := range p.Options {
+		product.Options = append(product.Options, models.ProductOption{Name: o.Name, Values: o.Values})
+	}
+	product.Variants = make([]models.ProductVariant, 0, len(p.Variants))
+	for _, v := range p.Variants {
+		product.Variants = append(product.Variants, *presentVariant(v, p, display))
+	}
+	product.CategoryIDs = p.CategoryIds
+	if product.CategoryIDs == nil {
+		product.CategoryIDs = []int32{}
+	}
+	product.Media = presentMediaList(p.Media)
+	for _, m := range product.Media {
+		if m.IsPrimary {
+			product.ImageURL = m.URL
+		}
+	}
+
+	if display != "" && display != product.Price.Currency {
+		converted, err := converter.Convert(product.Price, display)
+		if err != nil {
+			log.Printf("cannot render product %d in %s: %v", p.Id, display, err)
+			return product
+		}
+		original := product.Price
+		product.Price = converted
+		product.OriginalPrice = &original
+	}
+
+	return product
+}
+
+// presentVariant converts a variant of product p. Variants without a price
+// override sell at the product price.
+func presentVariant(v *proto.Variant, p *proto.Product, display string) *models.ProductVariant {
+	if v == nil {
+		return nil
+	}
+
+	price := toMoney(p.Price, productCurrency(p))
+	if v.HasPrice {
+		price = toMoney(v.Price, productCurrency(p))
+	}
+	if display != "" && display != price.Currency {
+		converted, err := converter.Convert(price, display)
+		if err != nil {
+			log.Printf("cannot render variant %s in %s: %v", v.Sku, display, err)
+		} else {
+			price = converted
+		}
+	}
+
+	options := v.Options
+	if options == nil {
+		options = map[string]string{}
+	}
+	return &models.ProductVariant{
+		ID:            v.Id,
+		ProductID:     v.ProductId,
+		SKU:           v.Sku,
+		Options:       options,
+		Price:         price,
+		PriceOverride: v.HasPrice,
+		CreatedAt:     v.CreatedAt,
+		UpdatedAt:     v.UpdatedAt,
+	}
+}
+
+func presentProducts(products []*proto.Product, display string) []*models.Product {
+	result := make([]*models.Product, 0, len(products))
+	for _, p := range products {
+		result = append(result, presentProduct(p, display))
+	}
+	return result
+}
+
+// presentOrder converts an order stored by OrderService. Orders created
+// before money fields existed only carry doubles, so their breakdown is
+// derived from line prices and the total.
+func presentOrder(o *proto.Order, display string) *models.Order {
+	if o == nil {
+		return nil
+	}
+
+	orderCurrency := o.Currency
+	if orderCurrency == "" {
+		orderCurrency = cfg.DefaultCurrency
+	}
+	total := fromProtoMoney(o.Total, toMoney(o.TotalAmount, orderCurrency))
+
+	order := &models.Order{
+		ID:                 o.Id,
+		UserID:             o.UserId,
+		Items:              make([]models.OrderItem, 0, len(o.Items)),
+		Currency:           orderCurrency,
+		Subtotal:           money.Zero(orderCurrency),
+		Discount:           fromProtoMoney(o.Discount, money.Zero(orderCurrency)),
+		Discounts:          make([]models.OrderDiscount, 0, len(o.Discounts)),
+		Tax:                fromProtoMoney(o.Tax, money.Zero(orderCurrency)),
+		TaxRegion:          o.TaxRegion,
+		PricesIncludeTax:   o.PricesIncludeTax,
+		TaxLines:           make([]models.OrderTaxLine, 0, len(o.TaxLines)),
+		Total:              total,
+		OriginalCurrency:   orderCurrency,
+		OriginalTotal:      total,
+		SettlementCurrency: orderCurrency,
+		ExchangeRate:       "1",
+		SettlementTotal:    total,
+		Status:             o.Status.String(),
+		CreatedAt:          o.CreatedAt,
+		UpdatedAt:          o.UpdatedAt,
+		ArchivedAt:         o.ArchivedAt,
+	}
+	if o.SettlementCurrency != "" {
+		order.SettlementCurrency = o.SettlementCurrency
+		order.ExchangeRate = o.ExchangeRate
+		order.SettlementTotal = fromProtoMoney(o.SettlementTotal, total)
+	}
+
+	for _, item := range o.Items {
+		unitPrice := fromProtoMoney(item.UnitPrice, toMoney(item.Price, orderCurrency))
+		subtotal := fromProtoMoney(item.Subtotal, unitPrice.Mul(int64(item.Quantity)))
+		order.Items = append(order.Items, models.OrderItem{
+			ProductID:           item.ProductId,
+			VariantID:           item.VariantId,
+			SKU:                 item.Sku,
+			PriceVersion:        item.PriceVersion,
+			Quantity:            item.Quantity,
+			UnitPrice:           unitPrice,
+			Subtotal:            subtotal,
+			Status:              item.Status,
+			ExpectedRestockDate: item.ExpectedRestockDate,
+		})
+		order.Subtotal = order.Subtotal.Add(subtotal)
+	}
+	order.Subtotal = fromProtoMoney(o.Subtotal, order.Subtotal)
+
+	for _, d := range o.Discounts {
+		order.Discounts = append(order.Discounts, models.OrderDiscount{
+			PromotionID: d.PromotionId,
+			Code:        d.Code,
+			Description: d.Description,
+			Amount:      fromProtoMoney(d.Amount, money.Zero(orderCurrency)),
+		})
+	}
+
+	for _, t := range o.TaxLines {
+		order.TaxLines = append(order.TaxLines, models.OrderTaxLine{
+			Region:   t.Region,
+			Category: t.Category,
+			Name:     t.Name,
+			Rate:     t.Rate,
+			Taxable:  fromProtoMoney(t.Taxable, money.Zero(orderCurrency)),
+			Amount:   fromProtoMoney(t.Amount, money.Zero(orderCurrency)),
+		})
+	}
+
+	return renderOrderIn(order, display)
+}
+
+func presentOrders(orders []*proto.Order, display string) []*models.Order {
+	result := make([]*models.Order, 0, len(orders))
+	for _, o := range orders {
+		result = append(result, presentOrder(o, display))
+	}
+	return result
+}
+
+// presentQuotedOrder presents a newly created order using the gateway's own
+// quote, which is authoritative over the total recomputed by OrderService.
+func presentQuotedOrder(o *proto.Order, quote *pricing.Quote, display string) *models.Order {
+	order := presentOrder(o, "")
+	if order == nil {
+		return nil
+	}
+
+	// line status comes from the order service, prices from the quote
+	statuses := order.Items
+	order.Items = make([]models.OrderItem, 0, len(quote.Lines))
+	for i, line := range quote.Lines {
+		item := models.OrderItem{
+			ProductID: line.ProductID,
+			VariantID: line.VariantID,
+			SKU:       line.SKU,
+			Quantity:  line.Quantity,
+			UnitPrice: line.UnitPrice,
+			Subtotal:  line.Subtotal,
+		}
+		if i < len(statuses) {
+			item.Status = statuses[i].Status
+			item.ExpectedRestockDate = statuses[i].ExpectedRestockDate
+		}
+		order.Items = append(order.Items, item)
+	}
+	order.Currency = quote.Currency
+	order.Subtotal = quote.Subtotal
+	order.Discount = quote.Discount
+	order.Discounts = order.Discounts[:0]
+	for _, d := range quote.Discounts {
+		order.Discounts = append(order.Discounts, models.OrderDiscount{
+			PromotionID: d.PromotionID,
+			Code:        d.Code,
+			Description: d.Description,
+			Amount:      d.Amount,
+		})
+	}
+	order.Tax = quote.Tax
+	order.TaxRegion = quote.TaxRegion
+	order.PricesIncludeTax = quote.PricesIncludeTax
+	order.TaxLines = order.TaxLines[:0]
+	for _, t := range quote.TaxLines {
+		order.TaxLines = append(order.TaxLines, models.OrderTaxLine{
+			Region:   t.Region,
+			Category: t.Category,
+			Name:     t.Name,
+			Rate:     t.Rate,
+			Taxable:  t.Taxable,
+			Amount:   t.Amount,
+		})
+	}
+	order.Total = quote.Total
+	order.OriginalCurrency = quote.Currency
+	order.OriginalTotal = quote.Total
+	order.SettlementCurrency = quote.SettlementCurrency
+	order.ExchangeRate = quote.ExchangeRate
+	order.SettlementTotal = quote.SettlementTotal
+
+	return renderOrderIn(order, display)
+}
+
+// renderOrderIn converts the order amounts into the display currency. The
+// recorded exchange rate is used for the settlement currency so the caller
+// sees what was charged; other currencies use the current rate.
+func renderOrderIn(order *models.Order, display string) *models.Order {
+	if display == "" || display == order.Currency {
+		return order
+	}
+
+	var rate *big.Rat
+	var err error
+	if display == order.SettlementCurrency {
+		rate, err = currency.ParseRate(order.ExchangeRate)
+	} else {
+		rate, err = converter.Rate(order.Currency, display)
+	}
+	if err != nil {
+		log.Printf("cannot render order %s in %s: %v", order.ID, display, err)
+		return order
+	}
+
+	convert := func(m money.Money) money.Money {
+		converted, err := currency.ConvertAt(m, display, rate)
+		if err != nil {
+			log.Printf("cannot render order %s in %s: %v", order.ID, display, err)
+			return m
+		}
+		return converted
+	}
+
+	for i, item := range order.Items {
+		order.Items[i].UnitPrice = convert(item.UnitPrice)
+		order.Items[i].Subtotal = convert(item.Subtotal)
+	}
+	order.Currency = display
+	order.Subtotal = convert(order.Subtotal)
+	order.Discount = convert(order.Discount)
+	for i, d := range order.Discounts {
+		order.Discounts[i].Amount = convert(d.Amount)
+	}
+	order.Tax = convert(order.Tax)
+	for i, t := range order.TaxLines {
+		order.TaxLines[i].Taxable = convert(t.Taxable)
+		order.TaxLines[i].Amount = convert(t.Amount)
+	}
+	if display == order.SettlementCurrency {
+		order.Total = order.SettlementTotal
+	} else {
+		order.Total = convert(order.Total)
+	}
+
+	return order
+}
+
+func presentInventoryItem(item *proto.InventoryItem) *models.InventoryItem {
+	if item == nil {
+		return nil
+	}
+	return &models.InventoryItem{
+		ID:                item.Id,
+		ProductID:         item.ProductId,
+		VariantID:         item.VariantId,
+		Quantity:          item.Quantity,
+		ReservedQuantity:  item.ReservedQuantity,
+		AvailableQuantity: item.AvailableQuantity,
+		InTransitQuantity: item.InTransitQuantity,
+		ReorderPoint:      item.ReorderPoint,
+		ReorderQuantity:   item.ReorderQuantity,
+		WarehouseID:       item.WarehouseId,
+		Location:          item.Location,
+		CreatedAt:         item.CreatedAt,
+		UpdatedAt:         item.UpdatedAt,
+	}
+}
+
+func presentInventoryItems(items []*proto.InventoryItem) []*models.InventoryItem {
+	result := make([]*models.InventoryItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, presentInventoryItem(item))
+	}
+	return result
+}
+
+func presentWarehouse(w *proto.Warehouse) *models.Warehouse {
+	if w == nil {
+		return nil
+	}
+	warehouse := &models.Warehouse{
+		ID:        w.Id,
+		Code:      w.Code,
+		Name:      w.Name,
+		Address:   w.Address,
+		Active:    w.Active,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+	// the inventory service reports missing coordinates as 0, 0
+	if w.Latitude != 0 || w.Longitude != 0 {
+		warehouse.Location = &models.GeoLocation{Latitude: w.Latitude, Longitude: w.Longitude}
+	}
+	return warehouse
+}
+
+func presentWarehouses(warehouses []*proto.Warehouse) []*models.Warehouse {
+	result := make([]*models.Warehouse, 0, len(warehouses))
+	for _, w := range warehouses {
+		result = append(result, presentWarehouse(w))
+	}
+	return result
+}
+
+func presentLocations(locations []*proto.LocationStock) []models.LocationStock {
+	result := make([]models.LocationStock, 0, len(locations))
+	for _, l := range locations {
+		result = append(result, models.LocationStock{
+			WarehouseID:       l.WarehouseId,
+			WarehouseCode:     l.WarehouseCode,
+			WarehouseName:     l.WarehouseName,
+			Quantity:          l.Quantity,
+			ReservedQuantity:  l.ReservedQuantity,
+			AvailableQuantity: l.AvailableQuantity,
+			InTransitQuantity: l.InTransitQuantity,
+		})
+	}
+	return result
+}
+
+func presentAllocations(allocations []*proto.Allocation) []models.ReservationAllocation {
+	result := make([]models.ReservationAllocation, 0, len(allocations))
+	for _, a := range allocations {
+		result = append(result, models.ReservationAllocation{
+			WarehouseID:   a.WarehouseId,
+			WarehouseCode: a.WarehouseCode,
+			Quantity:      a.Quantity,
+		})
+	}
+	return result
+}
+
+func presentReservation(r *proto.Reservation) *models.Reservation {
+	if r == nil {
+		return nil
+	}
+	return &models.Reservation{
+		ID:          r.Id,
+		ProductID:   r.ProductId,
+		VariantID:   r.VariantId,
+		Quantity:    r.Quantity,
+		OrderID:     r.OrderId,
+		Status:      r.Status,
+		CreatedAt:   r.CreatedAt,
+		ExpiresAt:   r.ExpiresAt,
+		ReleasedAt:  r.ReleasedAt,
+		Allocations: presentAllocations(r.Allocations),
+	}
+}
+
+func presentReservations(reservations []*proto.Reservation) []*models.Reservation {
+	result := make([]*models.Reservation, 0, len(reservations))
+	for _, r := range reservations {
+		result = append(result, presentReservation(r))
+	}
+	return result
+}
+
+func presentShortfalls(shortfalls []*proto.LineShortfall) []models.LineShortfall {
+	result := make([]models.LineShortfall, 0, len(shortfalls))
+	for _, s := range shortfalls {
+		result = append(result, models.LineShortfall{
+			ProductID: s.ProductId,
+			VariantID: s.VariantId,
+			Requested: s.Requested,
+			Available: s.Available,
+			Shortfall: s.Shortfall,
+		})
+	}
+	return result
+}
+
+// transferStatusName strips the enum prefix, so the API speaks REQUESTED
+// rather than TRANSFER_REQUESTED
+func transferStatusName(s proto.TransferStatus) string {
+	return strings.TrimPrefix(s.String(), "TRANSFER_")
+}
+
+func presentTransfer(t *proto.StockTransfer) *models.StockTransfer {
+	if t == nil {
+		return nil
+	}
+	transfer := &models.StockTransfer{
+		ID:                       t.Id,
+		ProductID:                t.ProductId,
+		VariantID:                t.VariantId,
+		Quantity:                 t.Quantity,
+		SourceWarehouseID:        t.SourceWarehouseId,
+		SourceWarehouseCode:      t.SourceWarehouseCode,
+		DestinationWarehouseID:   t.DestinationWarehouseId,
+		DestinationWarehouseCode: t.DestinationWarehouseCode,
+		Status:                   transferStatusName(t.Status),
+		Note:                     t.Note,
+		Events:                   make([]models.TransferEvent, 0, len(t.Events)),
+		CreatedAt:                t.CreatedAt,
+		UpdatedAt:                t.UpdatedAt,
+	}
+	for _, e := range t.Events {
+		transfer.Events = append(transfer.Events, models.TransferEvent{
+			Status:    transferStatusName(e.Status),
+			Actor:     e.Actor,
+			Note:      e.Note,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+	return transfer
+}
+
+func presentTransfers(transfers []*proto.StockTransfer) []*models.StockTransfer {
+	result := make([]*models.StockTransfer, 0, len(transfers))
+	for _, t := range transfers {
+		result = append(result, presentTransfer(t))
+	}
+	return result
+}
+
+func presentLedgerEntries(entries []*proto.InventoryLedgerEntry) []models.InventoryLedgerEntry {
+	result := make([]models.InventoryLedgerEntry, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, models.InventoryLedgerEntry{
+			ID:              e.Id,
+			InventoryItemID: e.InventoryItemId,
+			ProductID:       e.ProductId,
+			VariantID:       e.VariantId,
+			WarehouseID:     e.WarehouseId,
+			Type:            e.Type,
+			Reason:          e.Reason,
+			Actor:           e.Actor,
+			QuantityDelta:   e.QuantityDelta,
+			ReservedDelta:   e.ReservedDelta,
+			QuantityAfter:   e.QuantityAfter,
+			ReservedAfter:   e.ReservedAfter,
+			Reference:       e.Reference,
+			Note:            e.Note,
+			CreatedAt:       e.CreatedAt,
+		})
+	}
+	return result
+}
+
+func presentCategory(c *proto.Category) models.Category {
+	return models.Category{
+		ID:          c.Id,
+		Name:        c.Name,
+		Slug:        c.Slug,
+		Description: c.Description,
+		ParentID:    c.ParentId,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+func presentCategories(categories []*proto.Category) []models.Category {
+	result := make([]models.Category, 0, len(categories))
+	for _, c := range categories {
+		result = append(result, presentCategory(c))
+	}
+	return result
+}
+
+// presentCategoryTree nests the flat category list under each parent;
+// categories whose parent is missing are treated as roots
+func presentCategoryTree(categories []*proto.Category) []models.CategoryNode {
+	known := make(map[int32]bool, len(categories))
+	for _, c := range categories {
+		known[c.Id] = true
+	}
+	children := make(map[int32][]*proto.Category)
+	for _, c := range categories {
+		parent := c.ParentId
+		if !known[parent] {
+			parent = 0
+		}
+		children[parent] = append(children[parent], c)
+	}
+
+	var build func(parent int32) []models.CategoryNode
+	build = func(parent int32) []models.CategoryNode {
+		nodes := make([]models.CategoryNode, 0, len(children[parent]))
+		for _, c := range children[parent] {
+			nodes = append(nodes, models.CategoryNode{
+				Category: presentCategory(c),
+				Children: build(c.Id),
+			})
+		}
+		return nodes
+	}
+	return build(0)
+}
+
+// mediaURL is where a stored media file is served from
+func mediaURL(key string) string {
+	if key == "" {
+		return ""
+	}
+	return cfg.MediaBaseURL + "/" + key
+}
+
+func presentMedia(m *proto.ProductMedia) models.ProductMedia {
+	return models.ProductMedia{
+		ID:           m.Id,
+		URL:          mediaURL(m.StorageKey),
+		ThumbnailURL: mediaURL(m.ThumbnailKey),
+		ContentType:  m.ContentType,
+		Size:         m.Size,
+		Width:        m.Width,
+		Height:       m.Height,
+		Position:     m.Position,
+		IsPrimary:    m.IsPrimary,
+		AltText:      m.AltText,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+func presentMediaList(media []*proto.ProductMedia) []models.ProductMedia {
+	result := make([]models.ProductMedia, 0, len(media))
+	for _, m := range media {
+		result = append(result, presentMedia(m))
+	}
+	return result
+}
+
+func presentReview(r *proto.Review) models.Review {
+	return models.Review{
+		ID:             r.Id,
+		ProductID:      r.ProductId,
+		UserID:         r.UserId,
+		Rating:         r.Rating,
+		Title:          r.Title,
+		Body:           r.Body,
+		Status:         r.Status,
+		ModerationNote: r.ModerationNote,
+		OrderID:        r.OrderId,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+}
+
+func presentReviews(reviews []*proto.Review) []models.Review {
+	result := make([]models.Review, 0, len(reviews))
+	for _, r := range reviews {
+		result = append(result, presentReview(r))
+	}
+	return result
+}
+
+// presentReviewSummary keys the histogram by star rating, listing every
+// rating from 1 to 5 even without reviews
+func presentReviewSummary(s *proto.ReviewSummary) *models.ReviewSummary {
+	summary := &models.ReviewSummary{
+		AverageRating: s.AverageRating,
+		ReviewCount:   s.ReviewCount,
+		Histogram:     make(map[string]int32, 5),
+	}
+	for stars := int32(1); stars <= 5; stars++ {
+		summary.Histogram[strconv.Itoa(int(stars))] = s.Histogram[stars]
+	}
+	return summary
+}
+
+// optionalMoney converts a price that may be absent
+func optionalMoney(present bool, amount float64, currency string) *money.Money {
+	if !present {
+		return nil
+	}
+	m := toMoney(amount, currency)
+	return &m
+}
+
+func presentPriceChange(c *proto.PriceChange) models.PriceChange {
+	return models.PriceChange{
+		Version:       c.Version,
+		VariantID:     c.VariantId,
+		Price:         optionalMoney(c.HasPrice, c.Price, c.Currency),
+		PreviousPrice: optionalMoney(c.PreviousPrice > 0, c.PreviousPrice, c.Currency),
+		Reason:        c.Reason,
+		ScheduleID:    c.ScheduleId,
+		ChangedAt:     c.ChangedAt,
+	}
+}
+
+func presentPriceSchedule(s *proto.PriceSchedule) models.PriceSchedule {
+	return models.PriceSchedule{
+		ID:            s.Id,
+		ProductID:     s.ProductId,
+		Price:         toMoney(s.Price, s.Currency),
+		StartsAt:      s.StartsAt,
+		EndsAt:        s.EndsAt,
+		Status:        s.Status,
+		PreviousPrice: optionalMoney(s.PreviousPrice > 0, s.PreviousPrice, s.Currency),
+		Note:          s.Note,
+		CreatedAt:     s.CreatedAt,
+	}
+}
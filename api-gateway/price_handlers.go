@@ -0,0 +1,297 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/proto"
+	"api-gateway/tenant"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// startPriceScheduler applies due price schedules of every storefront in the
+// background. The product service decides what is due, so running the
+// scheduler in several gateways at once is harmless.
+func startPriceScheduler() {
+	if cfg.PriceScheduleInterval <= 0 {
+		log.Println("Price scheduler disabled")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(cfg.PriceScheduleInterval)
+		defer ticker.Stop()
+		for {
+			for _, t := range tenants.All() {
+				if err := applyDuePriceSchedules(t); err != nil {
+					log.Printf("applying price schedules of %s failed: %v", t.ID, err)
+				}
+			}
+			<-ticker.C
+		}
+	}()
+	log.Printf("Price scheduler checking every %s", cfg.PriceScheduleInterval)
+}
+
+// applyDuePriceSchedules starts and ends the schedules of a storefront due
+// as of now, and queues product.updated webhooks for the prices changed
+func applyDuePriceSchedules(t *tenant.Tenant) error {
+	ctx, cancel := context.WithTimeout(tenant.NewContext(context.Background(), t), cfg.PriceScheduleInterval)
+	defer cancel()
+
+	resp, err := clients.ProductClient.ApplyDuePriceSchedules(ctx, &proto.ApplyDuePriceSchedulesRequest{
+		Now: time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	// prices the products should now be at, when a schedule changed them
+	changed := make(map[int32]float64)
+	for _, s := range resp.Ended {
+		log.Printf("price schedule %d of product %d ended", s.Id, s.ProductId)
+		if s.PreviousPrice != 0 && s.Price != s.PreviousPrice {
+			changed[s.ProductId] = s.PreviousPrice
+		}
+	}
+	for _, s := range resp.Started {
+		log.Printf("price schedule %d started: product %d now at %.2f %s", s.Id, s.ProductId, s.Price, s.Currency)
+		if s.Price != s.PreviousPrice {
+			changed[s.ProductId] = s.Price
+		}
+	}
+
+	for id, price := range changed {
+		resp, err := clients.ProductClient.GetProduct(ctx, &proto.GetProductRequest{ProductId: id})
+		if err != nil {
+			return err
+		}
+		// an ended schedule leaves a price changed by hand as it is
+		if resp.Found && resp.Product.Price == price {
+			queueProductUpdated(t.ID, resp.Product)
+		}
+	}
+	return nil
+}
+
+// parseScheduleTime validates an RFC 3339 time and returns it in UTC
+func parseScheduleTime(field, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", fiber.NewError(fiber.StatusBadRequest, "Invalid "+field+" "+value+": use RFC 3339, such as 2024-11-29T00:00:00Z")
+	}
+	return t.UTC().Format(time.RFC3339), nil
+}
+
+// getPriceHistory Get Price History
+// @Summary      Get the price history of a product
+// @Description  List every change to the price of a product and its variants, newest first, with the price version it produced. Orders record the version their lines were priced at.
+// @Tags         Prices
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int  true   "Product ID"
+// @Param        page   query     int  false  "Page number"  default(1)
+// @Param        limit  query     int  false  "Items per page"  default(20)
+// @Success      200    {object}  models.PriceHistoryResponse
+// @Failure      400    {object}  models.ErrorResponse
+// @Failure      404    {object}  models.ErrorResponse
+// @Failure      500    {object}  models.ErrorResponse
+// @Router       /products/{id}/price-history [get]
+func getPriceHistory(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "20"))
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.ListPriceHistory(ctx, &proto.ListPriceHistoryRequest{
+		ProductId: int32(id),
+		Page:      int32(page),
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	changes := make([]models.PriceChange, 0, len(resp.Changes))
+	for _, change := range resp.Changes {
+		changes = append(changes, presentPriceChange(change))
+	}
+	return c.JSON(fiber.Map{
+		"product_id": id,
+		"changes":    changes,
+		"total":      resp.Total,
+		"page":       resp.Page,
+		"limit":      resp.Limit,
+	})
+}
+
+// createPriceSchedule Create Price Schedule
+// @Summary      Schedule a price change
+// @Description  Set the product price from starts_at, for example for a sale. With ends_at the earlier price is restored at that time, unless the price was changed by hand in the meantime. Schedules of a product may not overlap.
+// @Tags         Prices
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int                                true  "Product ID"
+// @Param        schedule  body      models.CreatePriceScheduleRequest  true  "Scheduled price"
+// @Success      201       {object}  models.PriceScheduleResponse
+// @Failure      400       {object}  models.ErrorResponse
+// @Failure      404       {object}  models.ErrorResponse
+// @Failure      409       {object}  models.ErrorResponse
+// @Failure      500       {object}  models.ErrorResponse
+// @Router       /products/{id}/price-schedules [post]
+func createPriceSchedule(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+
+	var req models.CreatePriceScheduleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.Price <= 0 {
+		return c.Status(400).JSON(fiber.Map{"error": "Price must be positive"})
+	}
+	if strings.TrimSpace(req.StartsAt) == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "starts_at is required"})
+	}
+	startsAt, err := parseScheduleTime("starts_at", req.StartsAt)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+	endsAt, err := parseScheduleTime("ends_at", req.EndsAt)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.CreatePriceSchedule(ctx, &proto.CreatePriceScheduleRequest{
+		ProductId: int32(id),
+		Price:     req.Price,
+		StartsAt:  startsAt,
+		EndsAt:    endsAt,
+		Note:      req.Note,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"success":  resp.Success,
+		"message":  resp.Message,
+		"schedule": presentPriceSchedule(resp.Schedule),
+	})
+}
+
+// listPriceSchedules List Price Schedules
+// @Summary      List the price schedules of a product
+// @Description  Get the upcoming and running price schedules of a product in start order, or all of them with include_finished=true
+// @Tags         Prices
+// @Accept       json
+// @Produce      json
+// @Param        id                path      int   true   "Product ID"
+// @Param        include_finished  query     bool  false  "Include completed and cancelled schedules"
+// @Success      200               {object}  models.PriceSchedulesListResponse
+// @Failure      400               {object}  models.ErrorResponse
+// @Failure      500               {object}  models.ErrorResponse
+// @Router       /products/{id}/price-schedules [get]
+func listPriceSchedules(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.ListPriceSchedules(ctx, &proto.ListPriceSchedulesRequest{
+		ProductId:       int32(id),
+		IncludeFinished: c.QueryBool("include_finished"),
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	schedules := make([]models.PriceSchedule, 0, len(resp.Schedules))
+	for _, s := range resp.Schedules {
+		schedules = append(schedules, presentPriceSchedule(s))
+	}
+	return c.JSON(fiber.Map{
+		"schedules": schedules,
+	})
+}
+
+// cancelPriceSchedule Cancel Price Schedule
+// @Summary      Cancel a price schedule
+// @Description  Cancel an upcoming price schedule, or end a running one early and restore the price it replaced
+// @Tags         Prices
+// @Accept       json
+// @Produce      json
+// @Param        id          path      int  true  "Product ID"
+// @Param        scheduleId  path      int  true  "Schedule ID"
+// @Success      200         {object}  models.PriceScheduleResponse
+// @Failure      400         {object}  models.ErrorResponse
+// @Failure      404         {object}  models.ErrorResponse
+// @Failure      409         {object}  models.ErrorResponse
+// @Failure      500         {object}  models.ErrorResponse
+// @Router       /products/{id}/price-schedules/{scheduleId} [delete]
+func cancelPriceSchedule(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+	scheduleID, err := strconv.Atoi(c.Params("scheduleId"))
+	if err != nil || scheduleID <= 0 {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid schedule ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	// the schedule must belong to the product in the path
+	schedules, err := clients.ProductClient.ListPriceSchedules(ctx, &proto.ListPriceSchedulesRequest{
+		ProductId:       int32(id),
+		IncludeFinished: true,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+	found := false
+	for _, s := range schedules.Schedules {
+		found = found || s.Id == int32(scheduleID)
+	}
+	if !found {
+		return c.Status(404).JSON(fiber.Map{"error": "Price schedule not found"})
+	}
+
+	resp, err := clients.ProductClient.CancelPriceSchedule(ctx, &proto.CancelPriceScheduleRequest{
+		ScheduleId: int32(scheduleID),
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success":  resp.Success,
+		"message":  resp.Message,
+		"schedule": presentPriceSchedule(resp.Schedule),
+	})
+}
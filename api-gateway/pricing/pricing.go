@@ -0,0 +1,212 @@
+// Package pricing computes order totals on the server from catalog prices.
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"api-gateway/currency"
+	"api-gateway/money"
+	"api-gateway/tax"
+)
+
+var (
+	// ErrEmptyOrder is returned when a quote has no lines
+	ErrEmptyOrder = errors.New("order must contain at least one item")
+	// ErrInvalidQuantity is returned when a line quantity is not positive
+	ErrInvalidQuantity = errors.New("item quantity must be positive")
+)
+
+// Line is a single priced order line
+// @Description Priced order line
+type Line struct {
+	ProductID int32  `json:"product_id" example:"1"`
+	VariantID int32  `json:"variant_id,omitempty" example:"7"`
+	SKU       string `json:"sku,omitempty" example:"TSHIRT-M-RED"`
+	// PriceVersion is the product price version UnitPrice was taken from
+	PriceVersion int32       `json:"price_version,omitempty" example:"3"`
+	Quantity     int32       `json:"quantity" example:"2"`
+	UnitPrice    money.Money `json:"unit_price"`
+	Subtotal     money.Money `json:"subtotal"`
+	// TaxCategory selects the tax rate of the product
+	TaxCategory string `json:"tax_category,omitempty" example:"standard"`
+	// StockPolicy and ExpectedRestockDate are carried through to the order
+	// service, which decides whether the line may be backordered
+	StockPolicy         string `json:"-"`
+	ExpectedRestockDate string `json:"-"`
+} //@name PricedLine
+
+// DiscountLine is a promotion applied to an order
+// @Description Discount applied by a promotion
+type DiscountLine struct {
+	PromotionID string      `json:"promotion_id" example:"5f0c6b1e-8c1d-4a4e-9a51-2f6f3a7d9b10"`
+	Code        string      `json:"code,omitempty" example:"SUMMER10"`
+	Description string      `json:"description" example:"10% off"`
+	Amount      money.Money `json:"amount"`
+} //@name DiscountLine
+
+// Quote is the server-side price breakdown of an order
+// @Description Order price breakdown
+type Quote struct {
+	Currency string      `json:"currency" example:"USD"`
+	Lines    []Line      `json:"lines"`
+	Subtotal money.Money `json:"subtotal"`
+	Discount money.Money `json:"discount"`
+	// Discounts break the discount down by promotion
+	Discounts []DiscountLine `json:"discounts"`
+	Tax       money.Money    `json:"tax"`
+	// TaxLines break the tax down by region, category and rate. When prices
+	// include tax, the tax is already part of the subtotal and not added again.
+	TaxRegion        string        `json:"tax_region,omitempty" example:"DE"`
+	PricesIncludeTax bool          `json:"prices_include_tax" example:"false"`
+	TaxLines         []tax.TaxLine `json:"tax_lines"`
+	Total            money.Money   `json:"total"`
+	// Settlement is the currency the customer pays in. The exchange rate is
+	// recorded so the settlement total can be reproduced later.
+	SettlementCurrency string      `json:"settlement_currency" example:"EUR"`
+	ExchangeRate       string      `json:"exchange_rate" example:"0.92"`
+	SettlementTotal    money.Money `json:"settlement_total"`
+} //@name Quote
+
+// NewQuote prices the given lines. Unit prices must already be in
+// orderCurrency; line subtotals and order totals are computed here.
+func NewQuote(orderCurrency string, lines []Line) (*Quote, error) {
+	if len(lines) == 0 {
+		return nil, ErrEmptyOrder
+	}
+
+	q := &Quote{
+		Currency:  orderCurrency,
+		Subtotal:  money.Zero(orderCurrency),
+		Discount:  money.Zero(orderCurrency),
+		Discounts: []DiscountLine{},
+		Tax:       money.Zero(orderCurrency),
+		TaxLines:  []tax.TaxLine{},
+	}
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			return nil, fmt.Errorf("product %d: %w", line.ProductID, ErrInvalidQuantity)
+		}
+		if line.UnitPrice.Currency != q.Currency {
+			return nil, fmt.Errorf("product %d is priced in %s, expected %s", line.ProductID, line.UnitPrice.Currency, q.Currency)
+		}
+		line.Subtotal = line.UnitPrice.Mul(int64(line.Quantity))
+		q.Lines = append(q.Lines, line)
+		q.Subtotal = q.Subtotal.Add(line.Subtotal)
+	}
+	q.recalculate()
+
+	return q, nil
+}
+
+// ApplyDiscount adds a promotion discount to the quote. The amount must be
+// in the quote currency and is capped so the discount never exceeds the
+// subtotal. It returns the amount actually applied.
+func (q *Quote) ApplyDiscount(line DiscountLine) (money.Money, error) {
+	if line.Amount.Currency != q.Currency {
+		return money.Money{}, fmt.Errorf("discount is in %s, expected %s", line.Amount.Currency, q.Currency)
+	}
+	if line.Amount.Amount < 0 {
+		return money.Money{}, fmt.Errorf("discount must not be negative")
+	}
+
+	remaining := q.Subtotal.Sub(q.Discount)
+	if line.Amount.Amount > remaining.Amount {
+		line.Amount = remaining
+	}
+	if line.Amount.IsZero() {
+		return line.Amount, nil
+	}
+
+	q.Discounts = append(q.Discounts, line)
+	q.Discount = q.Discount.Add(line.Amount)
+	q.recalculate()
+	return line.Amount, nil
+}
+
+// CalculateTax computes the tax for the region with the given calculator.
+// It must be called after all discounts are applied: the discount is spread
+// over the lines in proportion to their subtotals before taxing them.
+func (q *Quote) CalculateTax(calc tax.Calculator, region string) error {
+	req := tax.Request{Region: region, Currency: q.Currency}
+	for i, net := range q.discountedLines() {
+		req.Lines = append(req.Lines, tax.Line{
+			ProductID: q.Lines[i].ProductID,
+			Category:  q.Lines[i].TaxCategory,
+			Amount:    net,
+		})
+	}
+
+	result, err := calc.Calculate(req)
+	if err != nil {
+		return err
+	}
+	if result.Total.Currency != q.Currency {
+		return fmt.Errorf("tax is in %s, expected %s", result.Total.Currency, q.Currency)
+	}
+
+	q.TaxRegion = result.Region
+	q.PricesIncludeTax = result.PricesIncludeTax
+	q.TaxLines = result.Lines
+	q.Tax = result.Total
+	q.recalculate()
+	return nil
+}
+
+// discountedLines returns the line subtotals after the order discount is
+// allocated across them. Rounding leftovers go to the last line so the
+// amounts always add up to subtotal minus discount.
+func (q *Quote) discountedLines() []money.Money {
+	result := make([]money.Money, len(q.Lines))
+	remaining := q.Discount
+	for i, line := range q.Lines {
+		share := money.Zero(q.Currency)
+		if i == len(q.Lines)-1 {
+			share = remaining
+		} else if !q.Subtotal.IsZero() {
+			r := new(big.Rat).Mul(q.Discount.Rat(), big.NewRat(line.Subtotal.Amount, q.Subtotal.Amount))
+			share, _ = money.FromRat(r, q.Currency)
+		}
+		if share.Amount > line.Subtotal.Amount {
+			share = line.Subtotal
+		}
+		remaining = remaining.Sub(share)
+		result[i] = line.Subtotal.Sub(share)
+	}
+	return result
+}
+
+// Settle converts the total into the currency the customer pays in.
+// The rate is rounded to the precision it is recorded with first, so the
+// stored rate reproduces the settlement total exactly.
+func (q *Quote) Settle(to string, rate *big.Rat) error {
+	recorded := currency.FormatRate(rate)
+	rate, err := currency.ParseRate(recorded)
+	if err != nil {
+		return err
+	}
+
+	q.SettlementCurrency = to
+	q.ExchangeRate = recorded
+	q.SettlementTotal, err = currency.ConvertAt(q.Total, to, rate)
+	return err
+}
+
+// recalculate refreshes the total from subtotal, discount and tax and keeps
+// the settlement total in step with it
+func (q *Quote) recalculate() {
+	q.Total = q.Subtotal.Sub(q.Discount)
+	if !q.PricesIncludeTax {
+		q.Total = q.Total.Add(q.Tax)
+	}
+	if q.SettlementCurrency == "" {
+		q.SettlementCurrency = q.Currency
+		q.ExchangeRate = "1"
+		q.SettlementTotal = q.Total
+		return
+	}
+	if rate, err := currency.ParseRate(q.ExchangeRate); err == nil {
+		q.SettlementTotal, _ = currency.ConvertAt(q.Total, q.SettlementCurrency, rate)
+	}
+}
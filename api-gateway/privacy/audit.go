@@ -0,0 +1,106 @@
+// Package privacy keeps the audit trail of data subject requests: exports of
+// everything held about a user and erasures of their personal data.
+package privacy
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"api-gateway/store"
+
+	"github.com/google/uuid"
+)
+
+// Request types
+const (
+	// Export is a copy of the user's data handed out to them
+	Export = "EXPORT"
+	// Erasure anonymizes the user's personal data
+	Erasure = "ERASURE"
+)
+
+// Request outcomes
+const (
+	Completed = "COMPLETED"
+	Failed    = "FAILED"
+)
+
+// Request is the audit record of one data subject request
+type Request struct {
+	ID string `json:"id"`
+	// Tenant is the storefront the request was made on
+	Tenant string `json:"tenant"`
+	UserID int32  `json:"user_id"`
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	// Format is the export format, json or zip
+	Format string `json:"format,omitempty"`
+	// Detail summarizes what was exported or erased, or why the request failed
+	Detail string `json:"detail,omitempty"`
+	// RequestedBy identifies the caller as user:<id>, or by client IP for guests
+	RequestedBy string    `json:"requested_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// AuditLog is an append-only list of the requests of every storefront,
+// optionally persisted to a file
+type AuditLog struct {
+	mu       sync.RWMutex
+	path     string
+	requests []Request
+}
+
+// NewAuditLog creates an audit log. When path is not empty, records are
+// loaded from and saved to that file; those recorded before requests
+// belonged to a storefront are given to defaultTenant.
+func NewAuditLog(path, defaultTenant string) (*AuditLog, error) {
+	l := &AuditLog{path: path}
+	if path == "" {
+		return l, nil
+	}
+	if err := store.Load(path, &l.requests); err != nil {
+		return nil, err
+	}
+	for i := range l.requests {
+		if l.requests[i].Tenant == "" {
+			l.requests[i].Tenant = defaultTenant
+		}
+	}
+	return l, nil
+}
+
+// Record appends a request, filling in its ID and time
+func (l *AuditLog) Record(r Request) (Request, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	r.ID = uuid.NewString()
+	r.CreatedAt = time.Now().UTC()
+	l.requests = append(l.requests, r)
+	if l.path != "" {
+		if err := store.Save(l.path, l.requests); err != nil {
+			l.requests = l.requests[:len(l.requests)-1]
+			return Request{}, err
+		}
+	}
+	return r, nil
+}
+
+// List returns the requests made on a tenant for a user, or for all users
+// when userID is 0, newest first
+func (l *AuditLog) List(tenant string, userID int32) []Request {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	result := make([]Request, 0)
+	for _, r := range l.requests {
+		if r.Tenant == tenant && (userID == 0 || r.UserID == userID) {
+			result = append(result, r)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	return result
+}
@@ -0,0 +1,338 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/invoice"
+	"api-gateway/models"
+	"api-gateway/privacy"
+	"api-gateway/proto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// collectUserData gathers everything held about a user across the services,
+// including soft deleted products, archived orders and reviews awaiting or
+// refused moderation, and the privacy requests made for them on the tenant
+func collectUserData(ctx context.Context, tenantID string, userID int32) (*models.UserDataExport, error) {
+	user, err := clients.UserClient.GetUser(ctx, &proto.GetUserRequest{
+		UserId:         userID,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !user.Found {
+		return nil, fiber.NewError(fiber.StatusNotFound, "User not found")
+	}
+
+	products, err := clients.ProductClient.GetProductsByUser(ctx, &proto.GetProductsByUserRequest{
+		UserId:         userID,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []*proto.Order
+	for page := int32(1); ; page++ {
+		resp, err := clients.OrderClient.ListOrders(ctx, &proto.ListOrdersRequest{
+			UserId:          userID,
+			Page:            page,
+			Limit:           100,
+			IncludeArchived: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, resp.Orders...)
+		if len(resp.Orders) == 0 || int32(len(orders)) >= resp.Total {
+			break
+		}
+	}
+
+	var reviews []*proto.Review
+	for page := int32(1); ; page++ {
+		resp, err := clients.ProductClient.ListReviews(ctx, &proto.ListReviewsRequest{
+			UserId: userID,
+			Status: "ALL",
+			Sort:   "oldest",
+			Page:   page,
+			Limit:  100,
+		})
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, resp.Reviews...)
+		if len(resp.Reviews) == 0 || int32(len(reviews)) >= resp.Total {
+			break
+		}
+	}
+
+	issued := make([]models.ExportedInvoice, 0)
+	for _, o := range orders {
+		if number, ok := invoices.Number(o.Id); ok {
+			issued = append(issued, models.ExportedInvoice{OrderID: o.Id, Number: number})
+		}
+	}
+
+	return &models.UserDataExport{
+		ExportedAt:      time.Now().UTC().Format(time.RFC3339),
+		User:            presentUser(user.User),
+		Products:        presentProducts(products.Products, ""),
+		Orders:          presentOrders(orders, ""),
+		Reviews:         presentReviews(reviews),
+		Invoices:        issued,
+		PrivacyRequests: privacyLog.List(tenantID, userID),
+	}, nil
+}
+
+// zipUserData packs an export as one JSON file per section, with the PDF of
+// every issued invoice
+func zipUserData(data *models.UserDataExport) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	files := []struct {
+		name string
+		v    interface{}
+	}{
+		{"user.json", data.User},
+		{"products.json", data.Products},
+		{"orders.json", data.Orders},
+		{"reviews.json", data.Reviews},
+		{"invoices.json", data.Invoices},
+		{"privacy_requests.json", data.PrivacyRequests},
+	}
+	for _, f := range files {
+		body, err := json.MarshalIndent(f.v, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		w, err := zw.Create(f.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(body); err != nil {
+			return nil, err
+		}
+	}
+	for _, inv := range data.Invoices {
+		doc, err := invoices.Document(inv.Number, invoice.PDF)
+		if err != nil {
+			return nil, err
+		}
+		w, err := zw.Create("invoices/" + inv.Number + ".pdf")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(doc); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// recordPrivacyRequest adds a request to the audit log. A failure to record
+// is logged rather than failing a request that has already been carried out.
+func recordPrivacyRequest(r privacy.Request) privacy.Request {
+	recorded, err := privacyLog.Record(r)
+	if err != nil {
+		log.Printf("cannot record %s request of user %d: %v", r.Type, r.UserID, err)
+		return r
+	}
+	return recorded
+}
+
+// requester identifies the caller of a privacy request for the audit log:
+// the signed-in user, or the client IP of a guest
+func requester(c *fiber.Ctx) string {
+	if who := callerOf(c); who.ID != 0 {
+		return fmt.Sprintf("user:%d", who.ID)
+	}
+	return c.IP()
+}
+
+// exportUserData Export User Data
+// @Summary      Export a user's data
+// @Description  Download everything held about a user: the profile, their products and orders including deleted and archived ones, the reviews they wrote, the invoices issued to them and their earlier privacy requests. format=zip returns one JSON file per section plus the invoice PDFs. Every export is recorded in the privacy audit log.
+// @Tags         Users
+// @Produce      json
+// @Produce      application/zip
+// @Param        id      path      int     true   "User ID"
+// @Param        format  query     string  false  "Export format"  Enums(json, zip)  default(json)
+// @Success      200     {object}  models.UserDataExport
+// @Failure      400     {object}  models.ErrorResponse
+// @Failure      404     {object}  models.ErrorResponse
+// @Failure      500     {object}  models.ErrorResponse
+// @Router       /users/{id}/data-export [get]
+func exportUserData(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+	format := strings.ToLower(c.Query("format", "json"))
+	if format != "json" && format != "zip" {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid format " + format + ": use json or zip"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 30*time.Second)
+	defer cancel()
+
+	audit := privacy.Request{
+		Tenant:      tenantOf(c).ID,
+		UserID:      int32(id),
+		Type:        privacy.Export,
+		Format:      format,
+		RequestedBy: requester(c),
+	}
+
+	data, err := collectUserData(ctx, audit.Tenant, int32(id))
+	if err != nil {
+		if e, ok := err.(*fiber.Error); ok {
+			return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+		}
+		audit.Status, audit.Detail = privacy.Failed, err.Error()
+		recordPrivacyRequest(audit)
+		return inventoryError(c, err)
+	}
+
+	var body []byte
+	if format == "zip" {
+		body, err = zipUserData(data)
+	} else {
+		body, err = json.MarshalIndent(data, "", "  ")
+	}
+	if err != nil {
+		audit.Status, audit.Detail = privacy.Failed, err.Error()
+		recordPrivacyRequest(audit)
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	audit.Status = privacy.Completed
+	audit.Detail = fmt.Sprintf("%d products, %d orders, %d reviews, %d invoices", len(data.Products), len(data.Orders), len(data.Reviews), len(data.Invoices))
+	recordPrivacyRequest(audit)
+
+	if format == "zip" {
+		c.Set(fiber.HeaderContentType, "application/zip")
+	} else {
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+	}
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="user-%d-export.%s"`, id, format))
+	return c.Send(body)
+}
+
+// eraseUser Erase User
+// @Summary      Erase a user's personal data
+// @Description  Anonymize the name, email and age of a user, whether active or soft deleted, and delete the reviews they wrote. Orders and issued invoices are kept as accounting records and stay linked to the anonymized user. Every erasure is recorded in the privacy audit log.
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Success      200  {object}  models.EraseUserResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /users/{id}/erase [post]
+func eraseUser(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	current, err := clients.UserClient.GetUser(ctx, &proto.GetUserRequest{
+		UserId:         int32(id),
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !current.Found {
+		return c.Status(404).JSON(fiber.Map{"error": "User not found"})
+	}
+
+	audit := privacy.Request{
+		Tenant:      tenantOf(c).ID,
+		UserID:      int32(id),
+		Type:        privacy.Erasure,
+		RequestedBy: requester(c),
+	}
+
+	// reviews go first, so a failure leaves the user to be erased again
+	reviews, err := clients.ProductClient.DeleteUserReviews(ctx, &proto.UserReviewsRequest{UserId: int32(id)})
+	if err != nil {
+		audit.Status, audit.Detail = privacy.Failed, err.Error()
+		recordPrivacyRequest(audit)
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	resp, err := clients.UserClient.EraseUser(ctx, &proto.EraseUserRequest{UserId: int32(id)})
+	if err == nil && !resp.Success {
+		err = errors.New(resp.Message)
+	}
+	if err != nil {
+		audit.Status, audit.Detail = privacy.Failed, fmt.Sprintf("%d reviews deleted; %s", reviews.Count, err)
+		recordPrivacyRequest(audit)
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	audit.Status = privacy.Completed
+	audit.Detail = fmt.Sprintf("Name, email and age anonymized; %d reviews deleted", reviews.Count)
+	if orders, err := clients.OrderClient.ListOrders(ctx, &proto.ListOrdersRequest{
+		UserId:          int32(id),
+		Limit:           1,
+		IncludeArchived: true,
+	}); err == nil {
+		audit.Detail += fmt.Sprintf("; %d orders kept", orders.Total)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"user":    presentUser(resp.User),
+		"request": recordPrivacyRequest(audit),
+	})
+}
+
+// listPrivacyRequests List Privacy Requests
+// @Summary      List privacy requests
+// @Description  Get the audit trail of data exports and erasures made on the storefront, newest first, optionally for one user
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        user_id  query     int  false  "User ID"
+// @Success      200      {object}  models.PrivacyRequestsListResponse
+// @Failure      400      {object}  models.ErrorResponse
+// @Router       /admin/privacy-requests [get]
+func listPrivacyRequests(c *fiber.Ctx) error {
+	userID := 0
+	if raw := c.Query("user_id"); raw != "" {
+		id, err := strconv.Atoi(raw)
+		if err != nil || id <= 0 {
+			return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
+		}
+		userID = id
+	}
+
+	requests := privacyLog.List(tenantOf(c).ID, int32(userID))
+	return c.JSON(fiber.Map{
+		"requests": requests,
+		"total":    len(requests),
+	})
+}
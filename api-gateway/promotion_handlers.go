@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/pricing"
+	"api-gateway/promotions"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// applyCoupons adds the discounts of automatic promotions and the given
+// coupon codes to an order quote. Unlike a cart preview, a coupon that
+// cannot be applied fails the order with 422 so the customer is never
+// charged more than they expect.
+func applyCoupons(tenantID string, quote *pricing.Quote, userID int32, codes []string) ([]string, error) {
+	applied, rejected := promos.Apply(tenantID, quote, userID, codes, time.Now(), converter.Convert)
+	if len(rejected) > 0 {
+		reasons := make([]string, 0, len(rejected))
+		for _, r := range rejected {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", r.Code, r.Reason))
+		}
+		return nil, fiber.NewError(fiber.StatusUnprocessableEntity, "Coupon not applicable: "+strings.Join(reasons, "; "))
+	}
+	return applied, nil
+}
+
+// promotionError maps store errors to HTTP status codes
+func promotionError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, promotions.ErrNotFound):
+		return c.Status(404).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, promotions.ErrInvalidPromotion):
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, promotions.ErrDuplicateCode):
+		return c.Status(409).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+}
+
+// evaluatePromotions Evaluate Promotions
+// @Summary      Preview discounts on a cart
+// @Description  Price a cart from the catalog, apply automatic promotions and coupon codes and calculate tax without placing an order. Coupons that do not apply are listed with the reason.
+// @Tags         Promotions
+// @Accept       json
+// @Produce      json
+// @Param        cart             body      models.EvaluatePromotionsRequest  true   "Cart to evaluate"
+// @Param        currency         query     string                            false  "Currency to settle the cart in (overrides Accept-Currency)"
+// @Param        Accept-Currency  header    string                            false  "Currency to settle the cart in"
+// @Success      200              {object}  models.PromotionEvaluationResponse
+// @Failure      400              {object}  models.ErrorResponse
+// @Failure      409              {object}  models.ErrorResponse
+// @Failure      500              {object}  models.ErrorResponse
+// @Router       /promotions/evaluate [post]
+func evaluatePromotions(c *fiber.Ctx) error {
+	var req models.EvaluatePromotionsRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	settlement, err := displayCurrency(c)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	quote, err := priceOrderItems(ctx, req.Items, settlement)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	_, rejected := promos.Apply(tenantOf(c).ID, quote, req.UserID, req.CouponCodes, time.Now(), converter.Convert)
+	if rejected == nil {
+		rejected = []promotions.Rejection{}
+	}
+
+	if err := applyTax(quote, req.TaxRegion); err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	return c.JSON(models.PromotionEvaluationResponse{
+		Quote:    *quote,
+		Rejected: rejected,
+	})
+}
+
+// listPromotions List Promotions
+// @Summary      List promotions
+// @Description  List the promotions of the storefront with their usage
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.PromotionsListResponse
+// @Router       /admin/promotions [get]
+func listPromotions(c *fiber.Ctx) error {
+	list := promos.List(tenantOf(c).ID)
+	return c.JSON(models.PromotionsListResponse{
+		Promotions: list,
+		Total:      int32(len(list)),
+	})
+}
+
+// getPromotion Get Promotion
+// @Summary      Get promotion by ID
+// @Description  Get a promotion of the storefront and its usage by ID
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Promotion ID"
+// @Success      200  {object}  models.PromotionResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Router       /admin/promotions/{id} [get]
+func getPromotion(c *fiber.Ctx) error {
+	p, err := promos.Get(tenantOf(c).ID, c.Params("id"))
+	if err != nil {
+		return promotionError(c, err)
+	}
+
+	return c.JSON(models.PromotionResponse{
+		Message:   "Promotion retrieved successfully",
+		Promotion: p,
+	})
+}
+
+// createPromotion Create Promotion
+// @Summary      Create a promotion
+// @Description  Create a percentage, fixed or buy-X-get-Y promotion on the storefront. Promotions without a code apply automatically. Coupon codes are unique within a storefront.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        promotion  body      promotions.Rules  true  "Promotion settings"
+// @Success      201        {object}  models.PromotionResponse
+// @Failure      400        {object}  models.ErrorResponse
+// @Failure      409        {object}  models.ErrorResponse
+// @Failure      500        {object}  models.ErrorResponse
+// @Router       /admin/promotions [post]
+func createPromotion(c *fiber.Ctx) error {
+	var req promotions.Rules
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	p, err := promos.Create(tenantOf(c).ID, req)
+	if err != nil {
+		return promotionError(c, err)
+	}
+
+	return c.Status(201).JSON(models.PromotionResponse{
+		Message:   "Promotion created successfully",
+		Promotion: p,
+	})
+}
+
+// updatePromotion Update Promotion
+// @Summary      Update a promotion
+// @Description  Replace the settings of a promotion. Its usage is kept.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id         path      string            true  "Promotion ID"
+// @Param        promotion  body      promotions.Rules  true  "Promotion settings"
+// @Success      200        {object}  models.PromotionResponse
+// @Failure      400        {object}  models.ErrorResponse
+// @Failure      404        {object}  models.ErrorResponse
+// @Failure      409        {object}  models.ErrorResponse
+// @Failure      500        {object}  models.ErrorResponse
+// @Router       /admin/promotions/{id} [put]
+func updatePromotion(c *fiber.Ctx) error {
+	var req promotions.Rules
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	p, err := promos.Update(tenantOf(c).ID, c.Params("id"), req)
+	if err != nil {
+		return promotionError(c, err)
+	}
+
+	return c.JSON(models.PromotionResponse{
+		Message:   "Promotion updated successfully",
+		Promotion: p,
+	})
+}
+
+// deletePromotion Delete Promotion
+// @Summary      Delete a promotion
+// @Description  Delete a promotion and its usage history. Orders keep their recorded discounts.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Promotion ID"
+// @Success      200  {object}  models.SuccessResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /admin/promotions/{id} [delete]
+func deletePromotion(c *fiber.Ctx) error {
+	if err := promos.Delete(tenantOf(c).ID, c.Params("id")); err != nil {
+		return promotionError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Promotion deleted successfully",
+	})
+}
@@ -0,0 +1,156 @@
+package promotions
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"api-gateway/money"
+	"api-gateway/pricing"
+)
+
+// ConvertFunc converts an amount into another currency
+type ConvertFunc func(m money.Money, to string) (money.Money, error)
+
+// Rejection explains why a coupon code was not applied
+// @Description Coupon code that could not be applied
+type Rejection struct {
+	Code   string `json:"code" example:"SUMMER10"`
+	Reason string `json:"reason" example:"minimum order amount is 50.00 USD"`
+} //@name RejectedCoupon
+
+var (
+	errUnknownCode = errors.New("unknown coupon code")
+	errNotActive   = errors.New("promotion is not active")
+	errNoItems     = errors.New("no items in the order qualify")
+)
+
+// Apply adds the discounts of the tenant's automatic promotions and of the
+// given coupon codes to the quote. Automatic promotions that do not qualify are
+// skipped silently; coupons that do not qualify are reported as rejections.
+// It returns the IDs of the applied promotions for Redeem.
+func (s *Store) Apply(tenant string, q *pricing.Quote, userID int32, codes []string, now time.Time, convert ConvertFunc) ([]string, []Rejection) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var applied []string
+	var rejected []Rejection
+
+	for _, p := range s.sorted(tenant) {
+		if p.Code != "" {
+			continue
+		}
+		if ok, _ := s.apply(q, &p, userID, now, convert); ok {
+			applied = append(applied, p.ID)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+
+		p := s.byCode(tenant, code)
+		if p == nil {
+			rejected = append(rejected, Rejection{Code: code, Reason: errUnknownCode.Error()})
+			continue
+		}
+		ok, err := s.apply(q, p, userID, now, convert)
+		if err != nil {
+			rejected = append(rejected, Rejection{Code: code, Reason: err.Error()})
+			continue
+		}
+		if ok {
+			applied = append(applied, p.ID)
+		}
+	}
+
+	return applied, rejected
+}
+
+// apply checks that the promotion qualifies and adds its discount. It
+// returns false without an error when the quote was already fully discounted.
+func (s *Store) apply(q *pricing.Quote, p *Promotion, userID int32, now time.Time, convert ConvertFunc) (bool, error) {
+	if !p.activeAt(now) {
+		return false, errNotActive
+	}
+	if err := s.checkLimits(p, userID); err != nil {
+		return false, err
+	}
+	if p.MinOrderAmount != nil {
+		minimum, err := convert(*p.MinOrderAmount, q.Currency)
+		if err != nil {
+			return false, err
+		}
+		if q.Subtotal.Amount < minimum.Amount {
+			return false, fmt.Errorf("minimum order amount is %s", minimum)
+		}
+	}
+
+	amount, err := discountFor(q, p, convert)
+	if err != nil {
+		return false, err
+	}
+	if amount.IsZero() {
+		return false, errNoItems
+	}
+
+	applied, err := q.ApplyDiscount(pricing.DiscountLine{
+		PromotionID: p.ID,
+		Code:        p.Code,
+		Description: p.Describe(),
+		Amount:      amount,
+	})
+	if err != nil {
+		return false, err
+	}
+	return !applied.IsZero(), nil
+}
+
+// discountFor computes the discount a promotion grants on the quote lines
+func discountFor(q *pricing.Quote, p *Promotion, convert ConvertFunc) (money.Money, error) {
+	eligible := money.Zero(q.Currency)
+	for _, line := range q.Lines {
+		if p.appliesTo(line.ProductID) {
+			eligible = eligible.Add(line.Subtotal)
+		}
+	}
+
+	switch p.Type {
+	case Percentage:
+		percent, err := p.percent()
+		if err != nil {
+			return money.Money{}, err
+		}
+		return money.FromRat(new(big.Rat).Mul(eligible.Rat(), percent), q.Currency)
+
+	case Fixed:
+		amount, err := convert(*p.Amount, q.Currency)
+		if err != nil {
+			return money.Money{}, err
+		}
+		if amount.Amount > eligible.Amount {
+			return eligible, nil
+		}
+		return amount, nil
+
+	case BuyXGetY:
+		discount := money.Zero(q.Currency)
+		group := int64(p.BuyQuantity + p.GetQuantity)
+		for _, line := range q.Lines {
+			if !p.appliesTo(line.ProductID) {
+				continue
+			}
+			free := int64(line.Quantity) / group * int64(p.GetQuantity)
+			discount = discount.Add(line.UnitPrice.Mul(free))
+		}
+		return discount, nil
+	}
+
+	return money.Zero(q.Currency), nil
+}
@@ -0,0 +1,192 @@
+// Package promotions stores discount promotions and applies them to order
+// quotes. Promotions without a code apply automatically; the others are
+// coupons the customer has to enter.
+package promotions
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"api-gateway/currency"
+	"api-gateway/money"
+)
+
+// Type is the kind of discount a promotion grants
+type Type string
+
+const (
+	// Percentage takes a percentage off the eligible items
+	Percentage Type = "percentage"
+	// Fixed takes a fixed amount off the eligible items
+	Fixed Type = "fixed"
+	// BuyXGetY gives GetQuantity units free for every BuyQuantity units bought
+	BuyXGetY Type = "buy_x_get_y"
+)
+
+var (
+	// ErrNotFound is returned when no promotion has the given ID
+	ErrNotFound = errors.New("promotion not found")
+	// ErrInvalidPromotion is returned when promotion rules are inconsistent
+	ErrInvalidPromotion = errors.New("invalid promotion")
+	// ErrDuplicateCode is returned when another promotion of the storefront
+	// uses the same code
+	ErrDuplicateCode = errors.New("coupon code already in use")
+	// ErrUsageLimitReached is returned when a promotion cannot be redeemed again
+	ErrUsageLimitReached = errors.New("usage limit reached")
+)
+
+// Rules are the settings of a promotion an administrator can edit
+// @Description Promotion settings
+type Rules struct {
+	// Code is the coupon code; promotions without a code apply automatically
+	Code string `json:"code,omitempty" example:"SUMMER10"`
+	Name string `json:"name" example:"Summer sale"`
+	Type Type   `json:"type" enums:"percentage,fixed,buy_x_get_y" example:"percentage"`
+	// Percent is the percentage off for percentage promotions
+	Percent string `json:"percent,omitempty" example:"10"`
+	// Amount is the amount off for fixed promotions
+	Amount *money.Money `json:"amount,omitempty"`
+	// BuyQuantity and GetQuantity configure buy-X-get-Y promotions
+	BuyQuantity int32 `json:"buy_quantity,omitempty" example:"2"`
+	GetQuantity int32 `json:"get_quantity,omitempty" example:"1"`
+	// ProductIDs limits the promotion to these products; empty means all
+	ProductIDs     []int32      `json:"product_ids,omitempty"`
+	MinOrderAmount *money.Money `json:"min_order_amount,omitempty"`
+	StartsAt       *time.Time   `json:"starts_at,omitempty"`
+	EndsAt         *time.Time   `json:"ends_at,omitempty"`
+	// UsageLimit caps redemptions across all users; 0 means unlimited
+	UsageLimit int32 `json:"usage_limit,omitempty" example:"1000"`
+	// PerUserLimit caps redemptions by a single user; 0 means unlimited
+	PerUserLimit int32 `json:"per_user_limit,omitempty" example:"1"`
+	Active       bool  `json:"active" example:"true"`
+} //@name PromotionRules
+
+// Promotion is a stored promotion with its usage
+// @Description Promotion information
+type Promotion struct {
+	ID string `json:"id" example:"5f0c6b1e-8c1d-4a4e-9a51-2f6f3a7d9b10"`
+	// Tenant is the storefront the promotion is offered on
+	Tenant string `json:"tenant" example:"default"`
+	Rules
+	UsageCount int32     `json:"usage_count" example:"42"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+} //@name Promotion
+
+// Normalize cleans up user input before validation
+func (r *Rules) Normalize() {
+	r.Code = strings.ToUpper(strings.TrimSpace(r.Code))
+	r.Name = strings.TrimSpace(r.Name)
+	r.Percent = strings.TrimSpace(r.Percent)
+	if r.Amount != nil {
+		r.Amount.Currency = strings.ToUpper(r.Amount.Currency)
+	}
+	if r.MinOrderAmount != nil {
+		r.MinOrderAmount.Currency = strings.ToUpper(r.MinOrderAmount.Currency)
+	}
+}
+
+// Validate checks that the rules describe a usable promotion
+func (r *Rules) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidPromotion)
+	}
+
+	switch r.Type {
+	case Percentage:
+		if _, err := r.percent(); err != nil {
+			return err
+		}
+	case Fixed:
+		if err := validAmount("amount", r.Amount); err != nil {
+			return err
+		}
+		if r.Amount.IsZero() {
+			return fmt.Errorf("%w: amount must be positive", ErrInvalidPromotion)
+		}
+	case BuyXGetY:
+		if r.BuyQuantity <= 0 || r.GetQuantity <= 0 {
+			return fmt.Errorf("%w: buy_quantity and get_quantity must be positive", ErrInvalidPromotion)
+		}
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidPromotion, r.Type)
+	}
+
+	if r.MinOrderAmount != nil {
+		if err := validAmount("min_order_amount", r.MinOrderAmount); err != nil {
+			return err
+		}
+	}
+	if r.StartsAt != nil && r.EndsAt != nil && !r.EndsAt.After(*r.StartsAt) {
+		return fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidPromotion)
+	}
+	if r.UsageLimit < 0 || r.PerUserLimit < 0 {
+		return fmt.Errorf("%w: usage limits must not be negative", ErrInvalidPromotion)
+	}
+	return nil
+}
+
+// percent returns the percentage as a fraction between 0 and 1
+func (r *Rules) percent() (*big.Rat, error) {
+	p, ok := new(big.Rat).SetString(r.Percent)
+	if !ok || p.Sign() <= 0 || p.Cmp(big.NewRat(100, 1)) > 0 {
+		return nil, fmt.Errorf("%w: percent must be between 0 and 100", ErrInvalidPromotion)
+	}
+	return p.Quo(p, big.NewRat(100, 1)), nil
+}
+
+// Describe returns a short human readable summary of the discount
+func (r *Rules) Describe() string {
+	switch r.Type {
+	case Percentage:
+		return r.Percent + "% off"
+	case Fixed:
+		return r.Amount.String() + " off"
+	case BuyXGetY:
+		return fmt.Sprintf("Buy %d get %d free", r.BuyQuantity, r.GetQuantity)
+	}
+	return r.Name
+}
+
+// appliesTo reports whether the promotion covers the product
+func (r *Rules) appliesTo(productID int32) bool {
+	if len(r.ProductIDs) == 0 {
+		return true
+	}
+	for _, id := range r.ProductIDs {
+		if id == productID {
+			return true
+		}
+	}
+	return false
+}
+
+// activeAt reports whether the promotion is enabled and inside its window
+func (r *Rules) activeAt(now time.Time) bool {
+	if !r.Active {
+		return false
+	}
+	if r.StartsAt != nil && now.Before(*r.StartsAt) {
+		return false
+	}
+	if r.EndsAt != nil && !now.Before(*r.EndsAt) {
+		return false
+	}
+	return true
+}
+
+func validAmount(field string, m *money.Money) error {
+	if m == nil {
+		return fmt.Errorf("%w: %s is required", ErrInvalidPromotion, field)
+	}
+	if !currency.ValidCode(m.Currency) {
+		return fmt.Errorf("%w: %s has invalid currency %q", ErrInvalidPromotion, field, m.Currency)
+	}
+	if m.Amount < 0 {
+		return fmt.Errorf("%w: %s must not be negative", ErrInvalidPromotion, field)
+	}
+	return nil
+}
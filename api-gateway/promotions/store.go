@@ -0,0 +1,282 @@
+package promotions
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"api-gateway/store"
+
+	"github.com/google/uuid"
+)
+
+// Store keeps the promotions of every storefront and their redemptions,
+// optionally persisted to a file
+type Store struct {
+	mu         sync.RWMutex
+	path       string
+	promotions map[string]*Promotion
+	// usage counts redemptions per promotion ID and user ID
+	usage map[string]map[int32]int32
+}
+
+// state is the on-disk layout of the store
+type state struct {
+	Promotions []Promotion                `json:"promotions"`
+	Usage      map[string]map[int32]int32 `json:"usage"`
+}
+
+// NewStore creates a store. When path is not empty, promotions are loaded
+// from and saved to that file; those saved before promotions belonged to a
+// storefront are given to defaultTenant.
+func NewStore(path, defaultTenant string) (*Store, error) {
+	s := &Store{
+		path:       path,
+		promotions: make(map[string]*Promotion),
+		usage:      make(map[string]map[int32]int32),
+	}
+	if path == "" {
+		return s, nil
+	}
+
+	var st state
+	if err := store.Load(path, &st); err != nil {
+		return nil, err
+	}
+	for i := range st.Promotions {
+		p := st.Promotions[i]
+		if p.Tenant == "" {
+			p.Tenant = defaultTenant
+		}
+		s.promotions[p.ID] = &p
+	}
+	for id, users := range st.Usage {
+		s.usage[id] = users
+	}
+	return s, nil
+}
+
+// List returns the promotions of a tenant, oldest first
+func (s *Store) List(tenant string) []Promotion {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.sorted(tenant)
+}
+
+// Get returns a promotion of a tenant by ID
+func (s *Store) Get(tenant, id string) (Promotion, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, err := s.find(tenant, id)
+	if err != nil {
+		return Promotion{}, err
+	}
+	return *p, nil
+}
+
+// Create validates and stores a new promotion for a tenant
+func (s *Store) Create(tenant string, rules Rules) (Promotion, error) {
+	rules.Normalize()
+	if err := rules.Validate(); err != nil {
+		return Promotion{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkCode(tenant, rules.Code, ""); err != nil {
+		return Promotion{}, err
+	}
+
+	now := time.Now().UTC()
+	p := &Promotion{
+		ID:        uuid.NewString(),
+		Tenant:    tenant,
+		Rules:     rules,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	s.promotions[p.ID] = p
+	if err := s.save(); err != nil {
+		delete(s.promotions, p.ID)
+		return Promotion{}, err
+	}
+	return *p, nil
+}
+
+// Update replaces the rules of a promotion. Usage is kept.
+func (s *Store) Update(tenant, id string, rules Rules) (Promotion, error) {
+	rules.Normalize()
+	if err := rules.Validate(); err != nil {
+		return Promotion{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.find(tenant, id)
+	if err != nil {
+		return Promotion{}, err
+	}
+	if err := s.checkCode(tenant, rules.Code, id); err != nil {
+		return Promotion{}, err
+	}
+
+	previous := *p
+	p.Rules = rules
+	p.UpdatedAt = time.Now().UTC()
+	if err := s.save(); err != nil {
+		*p = previous
+		return Promotion{}, err
+	}
+	return *p, nil
+}
+
+// Delete removes a promotion and its usage history
+func (s *Store) Delete(tenant, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.find(tenant, id)
+	if err != nil {
+		return err
+	}
+	usage := s.usage[id]
+	delete(s.promotions, id)
+	delete(s.usage, id)
+	if err := s.save(); err != nil {
+		s.promotions[id] = p
+		if usage != nil {
+			s.usage[id] = usage
+		}
+		return err
+	}
+	return nil
+}
+
+// Redeem records one use of each promotion of the tenant by the user.
+// Limits are checked again under the lock so concurrent orders cannot exceed
+// them; either all promotions are redeemed or none.
+func (s *Store) Redeem(tenant string, ids []string, userID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range ids {
+		p, err := s.find(tenant, id)
+		if err != nil {
+			return err
+		}
+		if err := s.checkLimits(p, userID); err != nil {
+			return fmt.Errorf("%s: %w", p.Name, err)
+		}
+	}
+
+	for _, id := range ids {
+		s.adjustUsage(id, userID, 1)
+	}
+	if err := s.save(); err != nil {
+		for _, id := range ids {
+			s.adjustUsage(id, userID, -1)
+		}
+		return err
+	}
+	return nil
+}
+
+// Release undoes a Redeem, for example when the order could not be created
+func (s *Store) Release(tenant string, ids []string, userID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range ids {
+		if _, err := s.find(tenant, id); err == nil {
+			s.adjustUsage(id, userID, -1)
+		}
+	}
+	return s.save()
+}
+
+func (s *Store) adjustUsage(id string, userID int32, delta int32) {
+	p := s.promotions[id]
+	p.UsageCount = max(p.UsageCount+delta, 0)
+
+	users := s.usage[id]
+	if users == nil {
+		users = make(map[int32]int32)
+		s.usage[id] = users
+	}
+	users[userID] = max(users[userID]+delta, 0)
+	if users[userID] == 0 {
+		delete(users, userID)
+	}
+}
+
+// checkLimits reports whether the user may redeem the promotion once more.
+// A zero userID skips the per-user check, for anonymous cart previews.
+func (s *Store) checkLimits(p *Promotion, userID int32) error {
+	if p.UsageLimit > 0 && p.UsageCount >= p.UsageLimit {
+		return ErrUsageLimitReached
+	}
+	if userID != 0 && p.PerUserLimit > 0 && s.usage[p.ID][userID] >= p.PerUserLimit {
+		return ErrUsageLimitReached
+	}
+	return nil
+}
+
+// checkCode reports whether another promotion of the tenant uses the code;
+// storefronts pick their coupon codes independently
+func (s *Store) checkCode(tenant, code, exceptID string) error {
+	if code == "" {
+		return nil
+	}
+	if p := s.byCode(tenant, code); p != nil && p.ID != exceptID {
+		return fmt.Errorf("%w: %s", ErrDuplicateCode, code)
+	}
+	return nil
+}
+
+func (s *Store) byCode(tenant, code string) *Promotion {
+	for _, p := range s.promotions {
+		if p.Tenant == tenant && p.Code == code {
+			return p
+		}
+	}
+	return nil
+}
+
+// find returns a promotion of the tenant; those of other storefronts are
+// not found
+func (s *Store) find(tenant, id string) (*Promotion, error) {
+	p, ok := s.promotions[id]
+	if !ok || p.Tenant != tenant {
+		return nil, ErrNotFound
+	}
+	return p, nil
+}
+
+// sorted returns the promotions of a tenant, or of every tenant when tenant
+// is empty, oldest first
+func (s *Store) sorted(tenant string) []Promotion {
+	list := make([]Promotion, 0, len(s.promotions))
+	for _, p := range s.promotions {
+		if tenant == "" || p.Tenant == tenant {
+			list = append(list, *p)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].ID < list[j].ID
+		}
+		return list[i].CreatedAt.Before(list[j].CreatedAt)
+	})
+	return list
+}
+
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	return store.Save(s.path, state{Promotions: s.sorted(""), Usage: s.usage})
+}
@@ -21,6 +21,118 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TransferStatus is the lifecycle of a stock transfer. Requesting reserves
+// the stock at the source, dispatch takes it out of the source and receipt
+// adds it to the destination.
+type TransferStatus int32
+
+const (
+	TransferStatus_TRANSFER_REQUESTED  TransferStatus = 0
+	TransferStatus_TRANSFER_IN_TRANSIT TransferStatus = 1
+	TransferStatus_TRANSFER_RECEIVED   TransferStatus = 2
+	TransferStatus_TRANSFER_CANCELLED  TransferStatus = 3
+)
+
+// Enum value maps for TransferStatus.
+var (
+	TransferStatus_name = map[int32]string{
+		0: "TRANSFER_REQUESTED",
+		1: "TRANSFER_IN_TRANSIT",
+		2: "TRANSFER_RECEIVED",
+		3: "TRANSFER_CANCELLED",
+	}
+	TransferStatus_value = map[string]int32{
+		"TRANSFER_REQUESTED":  0,
+		"TRANSFER_IN_TRANSIT": 1,
+		"TRANSFER_RECEIVED":   2,
+		"TRANSFER_CANCELLED":  3,
+	}
+)
+
+func (x TransferStatus) Enum() *TransferStatus {
+	p := new(TransferStatus)
+	*p = x
+	return p
+}
+
+func (x TransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (TransferStatus) Type() protoreflect.EnumType {
+	return &file_inventory_proto_enumTypes[0]
+}
+
+func (x TransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferStatus.Descriptor instead.
+func (TransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// AllocationStrategy decides which warehouses a reservation draws from
+type AllocationStrategy int32
+
+const (
+	// SPLIT across warehouses, nearest first when coordinates are given
+	AllocationStrategy_ALLOCATION_DEFAULT AllocationStrategy = 0
+	// NEAREST single warehouse to the shipping address that has enough stock
+	AllocationStrategy_NEAREST AllocationStrategy = 1
+	// LARGEST_STOCK single warehouse with the most available stock
+	AllocationStrategy_LARGEST_STOCK AllocationStrategy = 2
+	// SPLIT across as many warehouses as needed
+	AllocationStrategy_SPLIT AllocationStrategy = 3
+)
+
+// Enum value maps for AllocationStrategy.
+var (
+	AllocationStrategy_name = map[int32]string{
+		0: "ALLOCATION_DEFAULT",
+		1: "NEAREST",
+		2: "LARGEST_STOCK",
+		3: "SPLIT",
+	}
+	AllocationStrategy_value = map[string]int32{
+		"ALLOCATION_DEFAULT": 0,
+		"NEAREST":            1,
+		"LARGEST_STOCK":      2,
+		"SPLIT":              3,
+	}
+)
+
+func (x AllocationStrategy) Enum() *AllocationStrategy {
+	p := new(AllocationStrategy)
+	*p = x
+	return p
+}
+
+func (x AllocationStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AllocationStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (AllocationStrategy) Type() protoreflect.EnumType {
+	return &file_inventory_proto_enumTypes[1]
+}
+
+func (x AllocationStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AllocationStrategy.Descriptor instead.
+func (AllocationStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{1}
+}
+
 type OrderStatus int32
 
 const (
@@ -63,11 +175,11 @@ func (x OrderStatus) String() string {
 }
 
 func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_inventory_proto_enumTypes[0].Descriptor()
+	return file_inventory_proto_enumTypes[2].Descriptor()
 }
 
 func (OrderStatus) Type() protoreflect.EnumType {
-	return &file_inventory_proto_enumTypes[0]
+	return &file_inventory_proto_enumTypes[2]
 }
 
 func (x OrderStatus) Number() protoreflect.EnumNumber {
@@ -76,37 +188,39 @@ func (x OrderStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OrderStatus.Descriptor instead.
 func (OrderStatus) EnumDescriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{0}
+	return file_inventory_proto_rawDescGZIP(), []int{2}
 }
 
-// Inventory Item Messages
-type InventoryItem struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProductId        int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity         int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	ReservedQuantity int32                  `protobuf:"varint,4,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
-	Location         string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
-	CreatedAt        string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Warehouse Messages
+type Warehouse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Active        bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InventoryItem) Reset() {
-	*x = InventoryItem{}
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
 	mi := &file_inventory_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InventoryItem) String() string {
+func (x *Warehouse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InventoryItem) ProtoMessage() {}
+func (*Warehouse) ProtoMessage() {}
 
-func (x *InventoryItem) ProtoReflect() protoreflect.Message {
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -118,83 +232,99 @@ func (x *InventoryItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InventoryItem.ProtoReflect.Descriptor instead.
-func (*InventoryItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
+func (*Warehouse) Descriptor() ([]byte, []int) {
 	return file_inventory_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *InventoryItem) GetId() int32 {
+func (x *Warehouse) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *InventoryItem) GetProductId() int32 {
+func (x *Warehouse) GetCode() string {
 	if x != nil {
-		return x.ProductId
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *InventoryItem) GetQuantity() int32 {
+func (x *Warehouse) GetName() string {
 	if x != nil {
-		return x.Quantity
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Warehouse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Warehouse) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
 	}
 	return 0
 }
 
-func (x *InventoryItem) GetReservedQuantity() int32 {
+func (x *Warehouse) GetLongitude() float64 {
 	if x != nil {
-		return x.ReservedQuantity
+		return x.Longitude
 	}
 	return 0
 }
 
-func (x *InventoryItem) GetLocation() string {
+func (x *Warehouse) GetActive() bool {
 	if x != nil {
-		return x.Location
+		return x.Active
 	}
-	return ""
+	return false
 }
 
-func (x *InventoryItem) GetCreatedAt() string {
+func (x *Warehouse) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *InventoryItem) GetUpdatedAt() string {
+func (x *Warehouse) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return ""
 }
 
-type CreateInventoryItemRequest struct {
+type CreateWarehouseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateInventoryItemRequest) Reset() {
-	*x = CreateInventoryItemRequest{}
+func (x *CreateWarehouseRequest) Reset() {
+	*x = CreateWarehouseRequest{}
 	mi := &file_inventory_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateInventoryItemRequest) String() string {
+func (x *CreateWarehouseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInventoryItemRequest) ProtoMessage() {}
+func (*CreateWarehouseRequest) ProtoMessage() {}
 
-func (x *CreateInventoryItemRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -206,53 +336,67 @@ func (x *CreateInventoryItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInventoryItemRequest.ProtoReflect.Descriptor instead.
-func (*CreateInventoryItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateInventoryItemRequest) GetProductId() int32 {
+func (x *CreateWarehouseRequest) GetCode() string {
 	if x != nil {
-		return x.ProductId
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateInventoryItemRequest) GetQuantity() int32 {
+func (x *CreateWarehouseRequest) GetName() string {
 	if x != nil {
-		return x.Quantity
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateInventoryItemRequest) GetLocation() string {
+func (x *CreateWarehouseRequest) GetAddress() string {
 	if x != nil {
-		return x.Location
+		return x.Address
 	}
 	return ""
 }
 
-type GetInventoryItemRequest struct {
+func (x *CreateWarehouseRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateWarehouseRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type GetWarehouseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInventoryItemRequest) Reset() {
-	*x = GetInventoryItemRequest{}
+func (x *GetWarehouseRequest) Reset() {
+	*x = GetWarehouseRequest{}
 	mi := &file_inventory_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInventoryItemRequest) String() string {
+func (x *GetWarehouseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInventoryItemRequest) ProtoMessage() {}
+func (*GetWarehouseRequest) ProtoMessage() {}
 
-func (x *GetInventoryItemRequest) ProtoReflect() protoreflect.Message {
+func (x *GetWarehouseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -264,41 +408,44 @@ func (x *GetInventoryItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInventoryItemRequest.ProtoReflect.Descriptor instead.
-func (*GetInventoryItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*GetWarehouseRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetInventoryItemRequest) GetId() int32 {
+func (x *GetWarehouseRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type UpdateInventoryItemRequest struct {
+type UpdateWarehouseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateInventoryItemRequest) Reset() {
-	*x = UpdateInventoryItemRequest{}
+func (x *UpdateWarehouseRequest) Reset() {
+	*x = UpdateWarehouseRequest{}
 	mi := &file_inventory_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateInventoryItemRequest) String() string {
+func (x *UpdateWarehouseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInventoryItemRequest) ProtoMessage() {}
+func (*UpdateWarehouseRequest) ProtoMessage() {}
 
-func (x *UpdateInventoryItemRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateWarehouseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -310,54 +457,74 @@ func (x *UpdateInventoryItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInventoryItemRequest.ProtoReflect.Descriptor instead.
-func (*UpdateInventoryItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWarehouseRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdateInventoryItemRequest) GetId() int32 {
+func (x *UpdateWarehouseRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateInventoryItemRequest) GetQuantity() int32 {
+func (x *UpdateWarehouseRequest) GetName() string {
 	if x != nil {
-		return x.Quantity
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateInventoryItemRequest) GetLocation() string {
+func (x *UpdateWarehouseRequest) GetAddress() string {
 	if x != nil {
-		return x.Location
+		return x.Address
 	}
 	return ""
 }
 
-type ListInventoryItemsRequest struct {
+func (x *UpdateWarehouseRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateWarehouseRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *UpdateWarehouseRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type DeleteWarehouseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInventoryItemsRequest) Reset() {
-	*x = ListInventoryItemsRequest{}
+func (x *DeleteWarehouseRequest) Reset() {
+	*x = DeleteWarehouseRequest{}
 	mi := &file_inventory_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInventoryItemsRequest) String() string {
+func (x *DeleteWarehouseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInventoryItemsRequest) ProtoMessage() {}
+func (*DeleteWarehouseRequest) ProtoMessage() {}
 
-func (x *ListInventoryItemsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteWarehouseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -369,47 +536,40 @@ func (x *ListInventoryItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInventoryItemsRequest.ProtoReflect.Descriptor instead.
-func (*ListInventoryItemsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWarehouseRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListInventoryItemsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListInventoryItemsRequest) GetLimit() int32 {
+func (x *DeleteWarehouseRequest) GetId() int32 {
 	if x != nil {
-		return x.Limit
+		return x.Id
 	}
 	return 0
 }
 
-type InventoryItemResponse struct {
+type DeleteWarehouseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *InventoryItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InventoryItemResponse) Reset() {
-	*x = InventoryItemResponse{}
+func (x *DeleteWarehouseResponse) Reset() {
+	*x = DeleteWarehouseResponse{}
 	mi := &file_inventory_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InventoryItemResponse) String() string {
+func (x *DeleteWarehouseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InventoryItemResponse) ProtoMessage() {}
+func (*DeleteWarehouseResponse) ProtoMessage() {}
 
-func (x *InventoryItemResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteWarehouseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -421,49 +581,46 @@ func (x *InventoryItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InventoryItemResponse.ProtoReflect.Descriptor instead.
-func (*InventoryItemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWarehouseResponse) Descriptor() ([]byte, []int) {
 	return file_inventory_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *InventoryItemResponse) GetItem() *InventoryItem {
+func (x *DeleteWarehouseResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Item
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *InventoryItemResponse) GetMessage() string {
+func (x *DeleteWarehouseResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ListInventoryItemsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*InventoryItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListWarehousesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListInventoryItemsResponse) Reset() {
-	*x = ListInventoryItemsResponse{}
+func (x *ListWarehousesRequest) Reset() {
+	*x = ListWarehousesRequest{}
 	mi := &file_inventory_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInventoryItemsResponse) String() string {
+func (x *ListWarehousesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInventoryItemsResponse) ProtoMessage() {}
+func (*ListWarehousesRequest) ProtoMessage() {}
 
-func (x *ListInventoryItemsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -475,62 +632,3016 @@ func (x *ListInventoryItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInventoryItemsResponse.ProtoReflect.Descriptor instead.
-func (*ListInventoryItemsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListInventoryItemsResponse) GetItems() []*InventoryItem {
+func (x *ListWarehousesRequest) GetIncludeInactive() bool {
 	if x != nil {
-		return x.Items
+		return x.IncludeInactive
 	}
-	return nil
+	return false
 }
 
-func (x *ListInventoryItemsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+type WarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInventoryItemsResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+func (x *WarehouseResponse) Reset() {
+	*x = WarehouseResponse{}
+	mi := &file_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInventoryItemsResponse) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+func (x *WarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type CheckStockRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ProductId        int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	RequiredQuantity int32                  `protobuf:"varint,2,opt,name=required_quantity,json=requiredQuantity,proto3" json:"required_quantity,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (*WarehouseResponse) ProtoMessage() {}
+
+func (x *WarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseResponse.ProtoReflect.Descriptor instead.
+func (*WarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+func (x *WarehouseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListWarehousesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouses    []*Warehouse           `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesResponse) Reset() {
+	*x = ListWarehousesResponse{}
+	mi := &file_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesResponse) ProtoMessage() {}
+
+func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+// TransferEvent is one entry of the audit trail of a transfer
+type TransferEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        TransferStatus         `protobuf:"varint,1,opt,name=status,proto3,enum=inventory.TransferStatus" json:"status,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferEvent) Reset() {
+	*x = TransferEvent{}
+	mi := &file_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferEvent) ProtoMessage() {}
+
+func (x *TransferEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferEvent.ProtoReflect.Descriptor instead.
+func (*TransferEvent) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TransferEvent) GetStatus() TransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferStatus_TRANSFER_REQUESTED
+}
+
+func (x *TransferEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *TransferEvent) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TransferEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type StockTransfer struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Id                       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId                int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity                 int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	SourceWarehouseId        int32                  `protobuf:"varint,4,opt,name=source_warehouse_id,json=sourceWarehouseId,proto3" json:"source_warehouse_id,omitempty"`
+	SourceWarehouseCode      string                 `protobuf:"bytes,5,opt,name=source_warehouse_code,json=sourceWarehouseCode,proto3" json:"source_warehouse_code,omitempty"`
+	DestinationWarehouseId   int32                  `protobuf:"varint,6,opt,name=destination_warehouse_id,json=destinationWarehouseId,proto3" json:"destination_warehouse_id,omitempty"`
+	DestinationWarehouseCode string                 `protobuf:"bytes,7,opt,name=destination_warehouse_code,json=destinationWarehouseCode,proto3" json:"destination_warehouse_code,omitempty"`
+	Status                   TransferStatus         `protobuf:"varint,8,opt,name=status,proto3,enum=inventory.TransferStatus" json:"status,omitempty"`
+	Note                     string                 `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt                string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Events                   []*TransferEvent       `protobuf:"bytes,12,rep,name=events,proto3" json:"events,omitempty"`
+	VariantId                int32                  `protobuf:"varint,13,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *StockTransfer) Reset() {
+	*x = StockTransfer{}
+	mi := &file_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockTransfer) ProtoMessage() {}
+
+func (x *StockTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockTransfer.ProtoReflect.Descriptor instead.
+func (*StockTransfer) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StockTransfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockTransfer) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockTransfer) GetSourceWarehouseId() int32 {
+	if x != nil {
+		return x.SourceWarehouseId
+	}
+	return 0
+}
+
+func (x *StockTransfer) GetSourceWarehouseCode() string {
+	if x != nil {
+		return x.SourceWarehouseCode
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetDestinationWarehouseId() int32 {
+	if x != nil {
+		return x.DestinationWarehouseId
+	}
+	return 0
+}
+
+func (x *StockTransfer) GetDestinationWarehouseCode() string {
+	if x != nil {
+		return x.DestinationWarehouseCode
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetStatus() TransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferStatus_TRANSFER_REQUESTED
+}
+
+func (x *StockTransfer) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetEvents() []*TransferEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *StockTransfer) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type CreateTransferRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ProductId              int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity               int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	SourceWarehouseId      int32                  `protobuf:"varint,3,opt,name=source_warehouse_id,json=sourceWarehouseId,proto3" json:"source_warehouse_id,omitempty"`
+	DestinationWarehouseId int32                  `protobuf:"varint,4,opt,name=destination_warehouse_id,json=destinationWarehouseId,proto3" json:"destination_warehouse_id,omitempty"`
+	Note                   string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	Actor                  string                 `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	VariantId              int32                  `protobuf:"varint,7,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateTransferRequest) Reset() {
+	*x = CreateTransferRequest{}
+	mi := &file_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferRequest) ProtoMessage() {}
+
+func (x *CreateTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransferRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateTransferRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetSourceWarehouseId() int32 {
+	if x != nil {
+		return x.SourceWarehouseId
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetDestinationWarehouseId() int32 {
+	if x != nil {
+		return x.DestinationWarehouseId
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type GetTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferRequest) Reset() {
+	*x = GetTransferRequest{}
+	mi := &file_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferRequest) ProtoMessage() {}
+
+func (x *GetTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetTransferRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTransferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTransfersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Page  int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Filters; has_status distinguishes TRANSFER_REQUESTED from no filter
+	HasStatus     bool           `protobuf:"varint,3,opt,name=has_status,json=hasStatus,proto3" json:"has_status,omitempty"`
+	Status        TransferStatus `protobuf:"varint,4,opt,name=status,proto3,enum=inventory.TransferStatus" json:"status,omitempty"`
+	WarehouseId   int32          `protobuf:"varint,5,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId     int32          `protobuf:"varint,6,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
+	mi := &file_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersRequest) ProtoMessage() {}
+
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTransfersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTransfersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTransfersRequest) GetHasStatus() bool {
+	if x != nil {
+		return x.HasStatus
+	}
+	return false
+}
+
+func (x *ListTransfersRequest) GetStatus() TransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferStatus_TRANSFER_REQUESTED
+}
+
+func (x *ListTransfersRequest) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ListTransfersRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type UpdateTransferStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        TransferStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.TransferStatus" json:"status,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransferStatusRequest) Reset() {
+	*x = UpdateTransferStatusRequest{}
+	mi := &file_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransferStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransferStatusRequest) ProtoMessage() {}
+
+func (x *UpdateTransferStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransferStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransferStatusRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateTransferStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTransferStatusRequest) GetStatus() TransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferStatus_TRANSFER_REQUESTED
+}
+
+func (x *UpdateTransferStatusRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *UpdateTransferStatusRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type TransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *StockTransfer         `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferResponse) Reset() {
+	*x = TransferResponse{}
+	mi := &file_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferResponse) ProtoMessage() {}
+
+func (x *TransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferResponse.ProtoReflect.Descriptor instead.
+func (*TransferResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TransferResponse) GetTransfer() *StockTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *TransferResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*StockTransfer       `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
+	mi := &file_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersResponse) ProtoMessage() {}
+
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListTransfersResponse) GetTransfers() []*StockTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *ListTransfersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTransfersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTransfersResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Inventory Item Messages
+type InventoryItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId        int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity         int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ReservedQuantity int32                  `protobuf:"varint,4,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	// location is the code of the warehouse holding the stock
+	Location          string `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	CreatedAt         string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	WarehouseId       int32  `protobuf:"varint,8,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	AvailableQuantity int32  `protobuf:"varint,9,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	// Quantity dispatched to this warehouse by transfers not yet received
+	InTransitQuantity int32 `protobuf:"varint,10,opt,name=in_transit_quantity,json=inTransitQuantity,proto3" json:"in_transit_quantity,omitempty"`
+	// Alert when available_quantity falls to reorder_point; 0 disables alerts
+	ReorderPoint int32 `protobuf:"varint,11,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	// Quantity to order when restocking
+	ReorderQuantity int32 `protobuf:"varint,12,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	// Variant of the product the stock belongs to; 0 for products without variants
+	VariantId     int32 `protobuf:"varint,13,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryItem) Reset() {
+	*x = InventoryItem{}
+	mi := &file_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryItem) ProtoMessage() {}
+
+func (x *InventoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryItem.ProtoReflect.Descriptor instead.
+func (*InventoryItem) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InventoryItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetReservedQuantity() int32 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetInTransitQuantity() int32 {
+	if x != nil {
+		return x.InTransitQuantity
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type CreateInventoryItemRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// location is a warehouse code, used when warehouse_id is not set
+	Location        string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	WarehouseId     int32  `protobuf:"varint,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Actor           string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	Note            string `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	ReorderPoint    int32  `protobuf:"varint,7,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	ReorderQuantity int32  `protobuf:"varint,8,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	VariantId       int32  `protobuf:"varint,9,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateInventoryItemRequest) Reset() {
+	*x = CreateInventoryItemRequest{}
+	mi := &file_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInventoryItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInventoryItemRequest) ProtoMessage() {}
+
+func (x *CreateInventoryItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInventoryItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateInventoryItemRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateInventoryItemRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CreateInventoryItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateInventoryItemRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateInventoryItemRequest) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *CreateInventoryItemRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *CreateInventoryItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreateInventoryItemRequest) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *CreateInventoryItemRequest) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *CreateInventoryItemRequest) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type GetInventoryItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInventoryItemRequest) Reset() {
+	*x = GetInventoryItemRequest{}
+	mi := &file_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInventoryItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryItemRequest) ProtoMessage() {}
+
+func (x *GetInventoryItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryItemRequest.ProtoReflect.Descriptor instead.
+func (*GetInventoryItemRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetInventoryItemRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateInventoryItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Replaced by delta; absolute quantities are no longer accepted
+	//
+	// Deprecated: Marked as deprecated in inventory.proto.
+	Quantity    int32  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Location    string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	WarehouseId int32  `protobuf:"varint,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// Relative change of the on-hand quantity, recorded in the ledger
+	Delta int32 `protobuf:"varint,5,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Reason code, required when delta is set
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor  string `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`
+	Note   string `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	// Order or document the adjustment relates to, e.g. a returned order
+	Reference string `protobuf:"bytes,9,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Replace the reorder settings when set_reorder_levels is true
+	SetReorderLevels bool  `protobuf:"varint,10,opt,name=set_reorder_levels,json=setReorderLevels,proto3" json:"set_reorder_levels,omitempty"`
+	ReorderPoint     int32 `protobuf:"varint,11,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	ReorderQuantity  int32 `protobuf:"varint,12,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateInventoryItemRequest) Reset() {
+	*x = UpdateInventoryItemRequest{}
+	mi := &file_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInventoryItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInventoryItemRequest) ProtoMessage() {}
+
+func (x *UpdateInventoryItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInventoryItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInventoryItemRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateInventoryItemRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in inventory.proto.
+func (x *UpdateInventoryItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *UpdateInventoryItemRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateInventoryItemRequest) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *UpdateInventoryItemRequest) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *UpdateInventoryItemRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateInventoryItemRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *UpdateInventoryItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *UpdateInventoryItemRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *UpdateInventoryItemRequest) GetSetReorderLevels() bool {
+	if x != nil {
+		return x.SetReorderLevels
+	}
+	return false
+}
+
+func (x *UpdateInventoryItemRequest) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *UpdateInventoryItemRequest) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+type ListInventoryItemsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Page        int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit       int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	WarehouseId int32                  `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId   int32                  `protobuf:"varint,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Only items whose available quantity is at or below their reorder point
+	LowStockOnly  bool  `protobuf:"varint,5,opt,name=low_stock_only,json=lowStockOnly,proto3" json:"low_stock_only,omitempty"`
+	VariantId     int32 `protobuf:"varint,6,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInventoryItemsRequest) Reset() {
+	*x = ListInventoryItemsRequest{}
+	mi := &file_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryItemsRequest) ProtoMessage() {}
+
+func (x *ListInventoryItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInventoryItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListInventoryItemsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListInventoryItemsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListInventoryItemsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListInventoryItemsRequest) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ListInventoryItemsRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListInventoryItemsRequest) GetLowStockOnly() bool {
+	if x != nil {
+		return x.LowStockOnly
+	}
+	return false
+}
+
+func (x *ListInventoryItemsRequest) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+// InventoryLedgerEntry is one immutable change to the stock of an item
+type InventoryLedgerEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InventoryItemId int32                  `protobuf:"varint,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	ProductId       int32                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId     int32                  `protobuf:"varint,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// CREATE, ADJUSTMENT, RESERVATION, RELEASE, FULFILLMENT, RETURN,
+	// TRANSFER_OUT, TRANSFER_IN or MOVE
+	Type          string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor         string `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`
+	QuantityDelta int32  `protobuf:"varint,8,opt,name=quantity_delta,json=quantityDelta,proto3" json:"quantity_delta,omitempty"`
+	ReservedDelta int32  `protobuf:"varint,9,opt,name=reserved_delta,json=reservedDelta,proto3" json:"reserved_delta,omitempty"`
+	QuantityAfter int32  `protobuf:"varint,10,opt,name=quantity_after,json=quantityAfter,proto3" json:"quantity_after,omitempty"`
+	ReservedAfter int32  `protobuf:"varint,11,opt,name=reserved_after,json=reservedAfter,proto3" json:"reserved_after,omitempty"`
+	Reference     string `protobuf:"bytes,12,opt,name=reference,proto3" json:"reference,omitempty"`
+	Note          string `protobuf:"bytes,13,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     string `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	VariantId     int32  `protobuf:"varint,15,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryLedgerEntry) Reset() {
+	*x = InventoryLedgerEntry{}
+	mi := &file_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryLedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryLedgerEntry) ProtoMessage() {}
+
+func (x *InventoryLedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryLedgerEntry.ProtoReflect.Descriptor instead.
+func (*InventoryLedgerEntry) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InventoryLedgerEntry) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InventoryLedgerEntry) GetInventoryItemId() int32 {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return 0
+}
+
+func (x *InventoryLedgerEntry) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *InventoryLedgerEntry) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *InventoryLedgerEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InventoryLedgerEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InventoryLedgerEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *InventoryLedgerEntry) GetQuantityDelta() int32 {
+	if x != nil {
+		return x.QuantityDelta
+	}
+	return 0
+}
+
+func (x *InventoryLedgerEntry) GetReservedDelta() int32 {
+	if x != nil {
+		return x.ReservedDelta
+	}
+	return 0
+}
+
+func (x *InventoryLedgerEntry) GetQuantityAfter() int32 {
+	if x != nil {
+		return x.QuantityAfter
+	}
+	return 0
+}
+
+func (x *InventoryLedgerEntry) GetReservedAfter() int32 {
+	if x != nil {
+		return x.ReservedAfter
+	}
+	return 0
+}
+
+func (x *InventoryLedgerEntry) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *InventoryLedgerEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *InventoryLedgerEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *InventoryLedgerEntry) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type GetInventoryHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInventoryHistoryRequest) Reset() {
+	*x = GetInventoryHistoryRequest{}
+	mi := &file_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInventoryHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryHistoryRequest) ProtoMessage() {}
+
+func (x *GetInventoryHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetInventoryHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetInventoryHistoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetInventoryHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetInventoryHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type InventoryHistoryResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Entries       []*InventoryLedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         int32                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryHistoryResponse) Reset() {
+	*x = InventoryHistoryResponse{}
+	mi := &file_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryHistoryResponse) ProtoMessage() {}
+
+func (x *InventoryHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryHistoryResponse.ProtoReflect.Descriptor instead.
+func (*InventoryHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *InventoryHistoryResponse) GetEntries() []*InventoryLedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *InventoryHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *InventoryHistoryResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *InventoryHistoryResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// LowStockAlert reports an item that has fallen to its reorder point
+type LowStockAlert struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId   int32                  `protobuf:"varint,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	ProductId         int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId       int32                  `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Location          string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	AvailableQuantity int32                  `protobuf:"varint,5,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	InTransitQuantity int32                  `protobuf:"varint,6,opt,name=in_transit_quantity,json=inTransitQuantity,proto3" json:"in_transit_quantity,omitempty"`
+	ReorderPoint      int32                  `protobuf:"varint,7,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	ReorderQuantity   int32                  `protobuf:"varint,8,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	DetectedAt        string                 `protobuf:"bytes,9,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	VariantId         int32                  `protobuf:"varint,10,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LowStockAlert) Reset() {
+	*x = LowStockAlert{}
+	mi := &file_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LowStockAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowStockAlert) ProtoMessage() {}
+
+func (x *LowStockAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowStockAlert.ProtoReflect.Descriptor instead.
+func (*LowStockAlert) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LowStockAlert) GetInventoryItemId() int32 {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return 0
+}
+
+func (x *LowStockAlert) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *LowStockAlert) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *LowStockAlert) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *LowStockAlert) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *LowStockAlert) GetInTransitQuantity() int32 {
+	if x != nil {
+		return x.InTransitQuantity
+	}
+	return 0
+}
+
+func (x *LowStockAlert) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *LowStockAlert) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *LowStockAlert) GetDetectedAt() string {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return ""
+}
+
+func (x *LowStockAlert) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type ReportLowStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*LowStockAlert       `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportLowStockRequest) Reset() {
+	*x = ReportLowStockRequest{}
+	mi := &file_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportLowStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportLowStockRequest) ProtoMessage() {}
+
+func (x *ReportLowStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportLowStockRequest.ProtoReflect.Descriptor instead.
+func (*ReportLowStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReportLowStockRequest) GetAlerts() []*LowStockAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type ReportLowStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportLowStockResponse) Reset() {
+	*x = ReportLowStockResponse{}
+	mi := &file_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportLowStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportLowStockResponse) ProtoMessage() {}
+
+func (x *ReportLowStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportLowStockResponse.ProtoReflect.Descriptor instead.
+func (*ReportLowStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReportLowStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReportLowStockResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// KafkaEvent is a message consumed from the order-events or inventory-events
+// topic, kept so the API Gateway, which has no Kafka client, can relay it
+type KafkaEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic     string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	EventType string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Key       string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// JSON data of the message
+	Data          string `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	TenantId      string `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ReceivedAt    string `protobuf:"bytes,7,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KafkaEvent) Reset() {
+	*x = KafkaEvent{}
+	mi := &file_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KafkaEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaEvent) ProtoMessage() {}
+
+func (x *KafkaEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaEvent.ProtoReflect.Descriptor instead.
+func (*KafkaEvent) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *KafkaEvent) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KafkaEvent) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *KafkaEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *KafkaEvent) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KafkaEvent) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *KafkaEvent) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *KafkaEvent) GetReceivedAt() string {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return ""
+}
+
+type ListKafkaEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only events recorded after this ID, oldest first
+	AfterId       int32 `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKafkaEventsRequest) Reset() {
+	*x = ListKafkaEventsRequest{}
+	mi := &file_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKafkaEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKafkaEventsRequest) ProtoMessage() {}
+
+func (x *ListKafkaEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKafkaEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListKafkaEventsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListKafkaEventsRequest) GetAfterId() int32 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListKafkaEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListKafkaEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*KafkaEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKafkaEventsResponse) Reset() {
+	*x = ListKafkaEventsResponse{}
+	mi := &file_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKafkaEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKafkaEventsResponse) ProtoMessage() {}
+
+func (x *ListKafkaEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKafkaEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListKafkaEventsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListKafkaEventsResponse) GetEvents() []*KafkaEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type InventoryItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *InventoryItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryItemResponse) Reset() {
+	*x = InventoryItemResponse{}
+	mi := &file_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryItemResponse) ProtoMessage() {}
+
+func (x *InventoryItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryItemResponse.ProtoReflect.Descriptor instead.
+func (*InventoryItemResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InventoryItemResponse) GetItem() *InventoryItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *InventoryItemResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListInventoryItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*InventoryItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInventoryItemsResponse) Reset() {
+	*x = ListInventoryItemsResponse{}
+	mi := &file_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryItemsResponse) ProtoMessage() {}
+
+func (x *ListInventoryItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInventoryItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListInventoryItemsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListInventoryItemsResponse) GetItems() []*InventoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListInventoryItemsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListInventoryItemsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListInventoryItemsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CheckStockRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RequiredQuantity int32                  `protobuf:"varint,2,opt,name=required_quantity,json=requiredQuantity,proto3" json:"required_quantity,omitempty"`
+	VariantId        int32                  `protobuf:"varint,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CheckStockRequest) Reset() {
+	*x = CheckStockRequest{}
+	mi := &file_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStockRequest) ProtoMessage() {}
+
+func (x *CheckStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStockRequest.ProtoReflect.Descriptor instead.
+func (*CheckStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CheckStockRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CheckStockRequest) GetRequiredQuantity() int32 {
+	if x != nil {
+		return x.RequiredQuantity
+	}
+	return 0
+}
+
+func (x *CheckStockRequest) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type CheckStockResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Available         bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	AvailableQuantity int32                  `protobuf:"varint,2,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	Message           string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Locations         []*LocationStock       `protobuf:"bytes,4,rep,name=locations,proto3" json:"locations,omitempty"`
+	// Quantity on its way between warehouses, not counted as available
+	InTransitQuantity int32 `protobuf:"varint,5,opt,name=in_transit_quantity,json=inTransitQuantity,proto3" json:"in_transit_quantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CheckStockResponse) Reset() {
+	*x = CheckStockResponse{}
+	mi := &file_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStockResponse) ProtoMessage() {}
+
+func (x *CheckStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStockResponse.ProtoReflect.Descriptor instead.
+func (*CheckStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CheckStockResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *CheckStockResponse) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *CheckStockResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckStockResponse) GetLocations() []*LocationStock {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *CheckStockResponse) GetInTransitQuantity() int32 {
+	if x != nil {
+		return x.InTransitQuantity
+	}
+	return 0
+}
+
+// LocationStock is the stock of a product in one warehouse
+type LocationStock struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId       int32                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	WarehouseCode     string                 `protobuf:"bytes,2,opt,name=warehouse_code,json=warehouseCode,proto3" json:"warehouse_code,omitempty"`
+	WarehouseName     string                 `protobuf:"bytes,3,opt,name=warehouse_name,json=warehouseName,proto3" json:"warehouse_name,omitempty"`
+	Quantity          int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ReservedQuantity  int32                  `protobuf:"varint,5,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	AvailableQuantity int32                  `protobuf:"varint,6,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	InTransitQuantity int32                  `protobuf:"varint,7,opt,name=in_transit_quantity,json=inTransitQuantity,proto3" json:"in_transit_quantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LocationStock) Reset() {
+	*x = LocationStock{}
+	mi := &file_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationStock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationStock) ProtoMessage() {}
+
+func (x *LocationStock) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationStock.ProtoReflect.Descriptor instead.
+func (*LocationStock) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LocationStock) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *LocationStock) GetWarehouseCode() string {
+	if x != nil {
+		return x.WarehouseCode
+	}
+	return ""
+}
+
+func (x *LocationStock) GetWarehouseName() string {
+	if x != nil {
+		return x.WarehouseName
+	}
+	return ""
+}
+
+func (x *LocationStock) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *LocationStock) GetReservedQuantity() int32 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *LocationStock) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *LocationStock) GetInTransitQuantity() int32 {
+	if x != nil {
+		return x.InTransitQuantity
+	}
+	return 0
+}
+
+type ReserveStockRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	OrderId   string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Strategy  AllocationStrategy     `protobuf:"varint,4,opt,name=strategy,proto3,enum=inventory.AllocationStrategy" json:"strategy,omitempty"`
+	// Shipping address coordinates, used to rank warehouses by distance
+	HasShippingLocation bool    `protobuf:"varint,5,opt,name=has_shipping_location,json=hasShippingLocation,proto3" json:"has_shipping_location,omitempty"`
+	ShippingLatitude    float64 `protobuf:"fixed64,6,opt,name=shipping_latitude,json=shippingLatitude,proto3" json:"shipping_latitude,omitempty"`
+	ShippingLongitude   float64 `protobuf:"fixed64,7,opt,name=shipping_longitude,json=shippingLongitude,proto3" json:"shipping_longitude,omitempty"`
+	// How long the reservation holds the stock; 0 uses the service default
+	TtlSeconds    int32 `protobuf:"varint,8,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	VariantId     int32 `protobuf:"varint,9,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReserveStockRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetStrategy() AllocationStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return AllocationStrategy_ALLOCATION_DEFAULT
+}
+
+func (x *ReserveStockRequest) GetHasShippingLocation() bool {
+	if x != nil {
+		return x.HasShippingLocation
+	}
+	return false
+}
+
+func (x *ReserveStockRequest) GetShippingLatitude() float64 {
+	if x != nil {
+		return x.ShippingLatitude
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetShippingLongitude() float64 {
+	if x != nil {
+		return x.ShippingLongitude
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+// Allocation is the part of a reservation drawn from one warehouse
+type Allocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int32                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	WarehouseCode string                 `protobuf:"bytes,2,opt,name=warehouse_code,json=warehouseCode,proto3" json:"warehouse_code,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Allocation) Reset() {
+	*x = Allocation{}
+	mi := &file_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Allocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Allocation) ProtoMessage() {}
+
+func (x *Allocation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Allocation.ProtoReflect.Descriptor instead.
+func (*Allocation) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Allocation) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *Allocation) GetWarehouseCode() string {
+	if x != nil {
+		return x.WarehouseCode
+	}
+	return ""
+}
+
+func (x *Allocation) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ReserveStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ReservationId string                 `protobuf:"bytes,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Allocations   []*Allocation          `protobuf:"bytes,4,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockResponse) ProtoMessage() {}
+
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReserveStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReserveStockResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReserveStockResponse) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReserveStockResponse) GetAllocations() []*Allocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *ReserveStockResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ReleaseStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockRequest) Reset() {
+	*x = ReleaseStockRequest{}
+	mi := &file_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStockRequest) ProtoMessage() {}
+
+func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReleaseStockRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type ReleaseStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockResponse) Reset() {
+	*x = ReleaseStockResponse{}
+	mi := &file_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStockResponse) ProtoMessage() {}
+
+func (x *ReleaseStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStockResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReleaseStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleaseStockResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ReservationLine is one product of a batch reservation
+type ReservationLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId     int32                  `protobuf:"varint,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationLine) Reset() {
+	*x = ReservationLine{}
+	mi := &file_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationLine) ProtoMessage() {}
+
+func (x *ReservationLine) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationLine.ProtoReflect.Descriptor instead.
+func (*ReservationLine) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReservationLine) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReservationLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReservationLine) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+// ReserveStockBatchRequest reserves every line of an order or none of them
+type ReserveStockBatchRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	OrderId             string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Lines               []*ReservationLine     `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	Strategy            AllocationStrategy     `protobuf:"varint,3,opt,name=strategy,proto3,enum=inventory.AllocationStrategy" json:"strategy,omitempty"`
+	HasShippingLocation bool                   `protobuf:"varint,4,opt,name=has_shipping_location,json=hasShippingLocation,proto3" json:"has_shipping_location,omitempty"`
+	ShippingLatitude    float64                `protobuf:"fixed64,5,opt,name=shipping_latitude,json=shippingLatitude,proto3" json:"shipping_latitude,omitempty"`
+	ShippingLongitude   float64                `protobuf:"fixed64,6,opt,name=shipping_longitude,json=shippingLongitude,proto3" json:"shipping_longitude,omitempty"`
+	TtlSeconds          int32                  `protobuf:"varint,7,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReserveStockBatchRequest) Reset() {
+	*x = ReserveStockBatchRequest{}
+	mi := &file_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockBatchRequest) ProtoMessage() {}
+
+func (x *ReserveStockBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockBatchRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockBatchRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReserveStockBatchRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReserveStockBatchRequest) GetLines() []*ReservationLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ReserveStockBatchRequest) GetStrategy() AllocationStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return AllocationStrategy_ALLOCATION_DEFAULT
+}
+
+func (x *ReserveStockBatchRequest) GetHasShippingLocation() bool {
+	if x != nil {
+		return x.HasShippingLocation
+	}
+	return false
+}
+
+func (x *ReserveStockBatchRequest) GetShippingLatitude() float64 {
+	if x != nil {
+		return x.ShippingLatitude
+	}
+	return 0
+}
+
+func (x *ReserveStockBatchRequest) GetShippingLongitude() float64 {
+	if x != nil {
+		return x.ShippingLongitude
+	}
+	return 0
+}
+
+func (x *ReserveStockBatchRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// LineShortfall reports a line the available stock cannot cover
+type LineShortfall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Requested     int32                  `protobuf:"varint,2,opt,name=requested,proto3" json:"requested,omitempty"`
+	Available     int32                  `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	Shortfall     int32                  `protobuf:"varint,4,opt,name=shortfall,proto3" json:"shortfall,omitempty"`
+	VariantId     int32                  `protobuf:"varint,5,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineShortfall) Reset() {
+	*x = LineShortfall{}
+	mi := &file_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineShortfall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineShortfall) ProtoMessage() {}
+
+func (x *LineShortfall) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineShortfall.ProtoReflect.Descriptor instead.
+func (*LineShortfall) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *LineShortfall) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *LineShortfall) GetRequested() int32 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *LineShortfall) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *LineShortfall) GetShortfall() int32 {
+	if x != nil {
+		return x.Shortfall
+	}
+	return 0
+}
+
+func (x *LineShortfall) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type ReserveStockBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reservations  []*Reservation         `protobuf:"bytes,3,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	Shortfalls    []*LineShortfall       `protobuf:"bytes,4,rep,name=shortfalls,proto3" json:"shortfalls,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockBatchResponse) Reset() {
+	*x = ReserveStockBatchResponse{}
+	mi := &file_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockBatchResponse) ProtoMessage() {}
+
+func (x *ReserveStockBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockBatchResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockBatchResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReserveStockBatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReserveStockBatchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReserveStockBatchResponse) GetReservations() []*Reservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+func (x *ReserveStockBatchResponse) GetShortfalls() []*LineShortfall {
+	if x != nil {
+		return x.Shortfalls
+	}
+	return nil
+}
+
+func (x *ReserveStockBatchResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ReleaseOrderReservationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseOrderReservationsRequest) Reset() {
+	*x = ReleaseOrderReservationsRequest{}
+	mi := &file_inventory_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseOrderReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseOrderReservationsRequest) ProtoMessage() {}
+
+func (x *ReleaseOrderReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseOrderReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseOrderReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReleaseOrderReservationsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
 }
 
-func (x *CheckStockRequest) Reset() {
-	*x = CheckStockRequest{}
-	mi := &file_inventory_proto_msgTypes[7]
+type ReleaseOrderReservationsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ReservationIds []string               `protobuf:"bytes,3,rep,name=reservation_ids,json=reservationIds,proto3" json:"reservation_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReleaseOrderReservationsResponse) Reset() {
+	*x = ReleaseOrderReservationsResponse{}
+	mi := &file_inventory_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckStockRequest) String() string {
+func (x *ReleaseOrderReservationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckStockRequest) ProtoMessage() {}
+func (*ReleaseOrderReservationsResponse) ProtoMessage() {}
 
-func (x *CheckStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[7]
+func (x *ReleaseOrderReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,49 +3652,365 @@ func (x *CheckStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckStockRequest.ProtoReflect.Descriptor instead.
-func (*CheckStockRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ReleaseOrderReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseOrderReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CheckStockRequest) GetProductId() int32 {
+func (x *ReleaseOrderReservationsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleaseOrderReservationsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReleaseOrderReservationsResponse) GetReservationIds() []string {
+	if x != nil {
+		return x.ReservationIds
+	}
+	return nil
+}
+
+// Reservation holds stock for an order until it ships, is released or
+// expires. status is ACTIVE, RELEASED, EXPIRED or FULFILLED.
+type Reservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	OrderId       string                 `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ReleasedAt    string                 `protobuf:"bytes,8,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+	Allocations   []*Allocation          `protobuf:"bytes,9,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	VariantId     int32                  `protobuf:"varint,10,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	mi := &file_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Reservation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reservation) GetProductId() int32 {
 	if x != nil {
 		return x.ProductId
 	}
 	return 0
 }
 
-func (x *CheckStockRequest) GetRequiredQuantity() int32 {
+func (x *Reservation) GetQuantity() int32 {
 	if x != nil {
-		return x.RequiredQuantity
+		return x.Quantity
 	}
 	return 0
 }
 
-type CheckStockResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Available         bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	AvailableQuantity int32                  `protobuf:"varint,2,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
-	Message           string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *Reservation) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
 }
 
-func (x *CheckStockResponse) Reset() {
-	*x = CheckStockResponse{}
-	mi := &file_inventory_proto_msgTypes[8]
+func (x *Reservation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Reservation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Reservation) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Reservation) GetReleasedAt() string {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return ""
+}
+
+func (x *Reservation) GetAllocations() []*Allocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *Reservation) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type ReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationResponse) Reset() {
+	*x = ReservationResponse{}
+	mi := &file_inventory_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckStockResponse) String() string {
+func (x *ReservationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckStockResponse) ProtoMessage() {}
+func (*ReservationResponse) ProtoMessage() {}
+
+func (x *ReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationResponse.ProtoReflect.Descriptor instead.
+func (*ReservationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ReservationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExtendReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	ExtendSeconds int32                  `protobuf:"varint,2,opt,name=extend_seconds,json=extendSeconds,proto3" json:"extend_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendReservationRequest) Reset() {
+	*x = ExtendReservationRequest{}
+	mi := &file_inventory_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendReservationRequest) ProtoMessage() {}
+
+func (x *ExtendReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendReservationRequest.ProtoReflect.Descriptor instead.
+func (*ExtendReservationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExtendReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ExtendReservationRequest) GetExtendSeconds() int32 {
+	if x != nil {
+		return x.ExtendSeconds
+	}
+	return 0
+}
+
+type ListReservationsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderId   string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductId int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Only reservations expiring within this many seconds
+	ExpiringWithinSeconds int32 `protobuf:"varint,3,opt,name=expiring_within_seconds,json=expiringWithinSeconds,proto3" json:"expiring_within_seconds,omitempty"`
+	// Also list released, expired and fulfilled reservations
+	IncludeInactive bool  `protobuf:"varint,4,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	Page            int32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListReservationsRequest) Reset() {
+	*x = ListReservationsRequest{}
+	mi := &file_inventory_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsRequest) ProtoMessage() {}
+
+func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListReservationsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ListReservationsRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListReservationsRequest) GetExpiringWithinSeconds() int32 {
+	if x != nil {
+		return x.ExpiringWithinSeconds
+	}
+	return 0
+}
+
+func (x *ListReservationsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+func (x *ListReservationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReservationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListReservationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservations  []*Reservation         `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsResponse) Reset() {
+	*x = ListReservationsResponse{}
+	mi := &file_inventory_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsResponse) ProtoMessage() {}
 
-func (x *CheckStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[8]
+func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,56 +4021,63 @@ func (x *CheckStockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckStockResponse.ProtoReflect.Descriptor instead.
-func (*CheckStockResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *CheckStockResponse) GetAvailable() bool {
+func (x *ListReservationsResponse) GetReservations() []*Reservation {
 	if x != nil {
-		return x.Available
+		return x.Reservations
 	}
-	return false
+	return nil
 }
 
-func (x *CheckStockResponse) GetAvailableQuantity() int32 {
+func (x *ListReservationsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.AvailableQuantity
+		return x.Total
 	}
 	return 0
 }
 
-func (x *CheckStockResponse) GetMessage() string {
+func (x *ListReservationsResponse) GetPage() int32 {
 	if x != nil {
-		return x.Message
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-type ReserveStockRequest struct {
+func (x *ListReservationsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Money is an exact amount in the minor unit of its currency
+type Money struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReserveStockRequest) Reset() {
-	*x = ReserveStockRequest{}
-	mi := &file_inventory_proto_msgTypes[9]
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_inventory_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveStockRequest) String() string {
+func (x *Money) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveStockRequest) ProtoMessage() {}
+func (*Money) ProtoMessage() {}
 
-func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[9]
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,56 +4088,50 @@ func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
-func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *ReserveStockRequest) GetProductId() int32 {
-	if x != nil {
-		return x.ProductId
-	}
-	return 0
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ReserveStockRequest) GetQuantity() int32 {
+func (x *Money) GetAmount() int64 {
 	if x != nil {
-		return x.Quantity
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *ReserveStockRequest) GetOrderId() string {
+func (x *Money) GetCurrency() string {
 	if x != nil {
-		return x.OrderId
+		return x.Currency
 	}
 	return ""
 }
 
-type ReserveStockResponse struct {
+type DiscountLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ReservationId string                 `protobuf:"bytes,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	PromotionId   string                 `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReserveStockResponse) Reset() {
-	*x = ReserveStockResponse{}
-	mi := &file_inventory_proto_msgTypes[10]
+func (x *DiscountLine) Reset() {
+	*x = DiscountLine{}
+	mi := &file_inventory_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveStockResponse) String() string {
+func (x *DiscountLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveStockResponse) ProtoMessage() {}
+func (*DiscountLine) ProtoMessage() {}
 
-func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[10]
+func (x *DiscountLine) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,54 +4142,66 @@ func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
-func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DiscountLine.ProtoReflect.Descriptor instead.
+func (*DiscountLine) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ReserveStockResponse) GetSuccess() bool {
+func (x *DiscountLine) GetPromotionId() string {
 	if x != nil {
-		return x.Success
+		return x.PromotionId
 	}
-	return false
+	return ""
 }
 
-func (x *ReserveStockResponse) GetMessage() string {
+func (x *DiscountLine) GetCode() string {
 	if x != nil {
-		return x.Message
+		return x.Code
 	}
 	return ""
 }
 
-func (x *ReserveStockResponse) GetReservationId() string {
+func (x *DiscountLine) GetDescription() string {
 	if x != nil {
-		return x.ReservationId
+		return x.Description
 	}
 	return ""
 }
 
-type ReleaseStockRequest struct {
+func (x *DiscountLine) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type TaxLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Rate          string                 `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	Taxable       *Money                 `protobuf:"bytes,5,opt,name=taxable,proto3" json:"taxable,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseStockRequest) Reset() {
-	*x = ReleaseStockRequest{}
-	mi := &file_inventory_proto_msgTypes[11]
+func (x *TaxLine) Reset() {
+	*x = TaxLine{}
+	mi := &file_inventory_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseStockRequest) String() string {
+func (x *TaxLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseStockRequest) ProtoMessage() {}
+func (*TaxLine) ProtoMessage() {}
 
-func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[11]
+func (x *TaxLine) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -772,87 +4212,87 @@ func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseStockRequest.ProtoReflect.Descriptor instead.
-func (*ReleaseStockRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use TaxLine.ProtoReflect.Descriptor instead.
+func (*TaxLine) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ReleaseStockRequest) GetReservationId() string {
+func (x *TaxLine) GetRegion() string {
 	if x != nil {
-		return x.ReservationId
+		return x.Region
 	}
 	return ""
 }
 
-type ReleaseStockResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ReleaseStockResponse) Reset() {
-	*x = ReleaseStockResponse{}
-	mi := &file_inventory_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ReleaseStockResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TaxLine) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
 }
 
-func (*ReleaseStockResponse) ProtoMessage() {}
-
-func (x *ReleaseStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[12]
+func (x *TaxLine) GetName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Name
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ReleaseStockResponse.ProtoReflect.Descriptor instead.
-func (*ReleaseStockResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{12}
+func (x *TaxLine) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
 }
 
-func (x *ReleaseStockResponse) GetSuccess() bool {
+func (x *TaxLine) GetTaxable() *Money {
 	if x != nil {
-		return x.Success
+		return x.Taxable
 	}
-	return false
+	return nil
 }
 
-func (x *ReleaseStockResponse) GetMessage() string {
+func (x *TaxLine) GetAmount() *Money {
 	if x != nil {
-		return x.Message
+		return x.Amount
 	}
-	return ""
+	return nil
 }
 
-// Order Messages
 type Order struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	TotalAmount   float64                `protobuf:"fixed64,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Status        OrderStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=inventory.OrderStatus" json:"status,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items       []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	TotalAmount float64                `protobuf:"fixed64,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Status      OrderStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=inventory.OrderStatus" json:"status,omitempty"`
+	CreatedAt   string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Currency the order was priced in and its server-computed totals
+	Currency string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	Subtotal *Money `protobuf:"bytes,9,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discount *Money `protobuf:"bytes,10,opt,name=discount,proto3" json:"discount,omitempty"`
+	Tax      *Money `protobuf:"bytes,11,opt,name=tax,proto3" json:"tax,omitempty"`
+	Total    *Money `protobuf:"bytes,12,opt,name=total,proto3" json:"total,omitempty"`
+	// Currency the customer pays in and the rate used to convert the total
+	SettlementCurrency string `protobuf:"bytes,13,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"`
+	ExchangeRate       string `protobuf:"bytes,14,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	SettlementTotal    *Money `protobuf:"bytes,15,opt,name=settlement_total,json=settlementTotal,proto3" json:"settlement_total,omitempty"`
+	// Promotions applied to the order, summing to the discount
+	Discounts []*DiscountLine `protobuf:"bytes,16,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	// Tax breakdown; when prices include tax it is part of the subtotal
+	TaxRegion        string     `protobuf:"bytes,17,opt,name=tax_region,json=taxRegion,proto3" json:"tax_region,omitempty"`
+	PricesIncludeTax bool       `protobuf:"varint,18,opt,name=prices_include_tax,json=pricesIncludeTax,proto3" json:"prices_include_tax,omitempty"`
+	TaxLines         []*TaxLine `protobuf:"bytes,19,rep,name=tax_lines,json=taxLines,proto3" json:"tax_lines,omitempty"`
+	// Set while the order is archived with its deleted user
+	ArchivedAt    string `protobuf:"bytes,20,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Order) Reset() {
 	*x = Order{}
-	mi := &file_inventory_proto_msgTypes[13]
+	mi := &file_inventory_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -864,7 +4304,7 @@ func (x *Order) String() string {
 func (*Order) ProtoMessage() {}
 
 func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[13]
+	mi := &file_inventory_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,7 +4317,7 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Order.ProtoReflect.Descriptor instead.
 func (*Order) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{13}
+	return file_inventory_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *Order) GetId() string {
@@ -929,18 +4369,121 @@ func (x *Order) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Order) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Order) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *Order) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *Order) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *Order) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Order) GetSettlementCurrency() string {
+	if x != nil {
+		return x.SettlementCurrency
+	}
+	return ""
+}
+
+func (x *Order) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *Order) GetSettlementTotal() *Money {
+	if x != nil {
+		return x.SettlementTotal
+	}
+	return nil
+}
+
+func (x *Order) GetDiscounts() []*DiscountLine {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *Order) GetTaxRegion() string {
+	if x != nil {
+		return x.TaxRegion
+	}
+	return ""
+}
+
+func (x *Order) GetPricesIncludeTax() bool {
+	if x != nil {
+		return x.PricesIncludeTax
+	}
+	return false
+}
+
+func (x *Order) GetTaxLines() []*TaxLine {
+	if x != nil {
+		return x.TaxLines
+	}
+	return nil
+}
+
+func (x *Order) GetArchivedAt() string {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return ""
+}
+
 type OrderItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price     float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	UnitPrice *Money                 `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Subtotal  *Money                 `protobuf:"bytes,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	// Product stock policy when ordered: "none", "backorder" or "preorder".
+	// Lines beyond available stock are only accepted for the latter two.
+	StockPolicy         string `protobuf:"bytes,6,opt,name=stock_policy,json=stockPolicy,proto3" json:"stock_policy,omitempty"`
+	ExpectedRestockDate string `protobuf:"bytes,7,opt,name=expected_restock_date,json=expectedRestockDate,proto3" json:"expected_restock_date,omitempty"`
+	// ALLOCATED once stock is reserved for the line, BACKORDERED while it waits
+	Status    string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	VariantId int32  `protobuf:"varint,9,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Sku       string `protobuf:"bytes,10,opt,name=sku,proto3" json:"sku,omitempty"`
+	// Product price version the line was priced at
+	PriceVersion  int32 `protobuf:"varint,11,opt,name=price_version,json=priceVersion,proto3" json:"price_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OrderItem) Reset() {
 	*x = OrderItem{}
-	mi := &file_inventory_proto_msgTypes[14]
+	mi := &file_inventory_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -952,7 +4495,7 @@ func (x *OrderItem) String() string {
 func (*OrderItem) ProtoMessage() {}
 
 func (x *OrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[14]
+	mi := &file_inventory_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +4508,7 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
 func (*OrderItem) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{14}
+	return file_inventory_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *OrderItem) GetProductId() int32 {
@@ -989,17 +4532,85 @@ func (x *OrderItem) GetPrice() float64 {
 	return 0
 }
 
-type CreateOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrderItem) GetUnitPrice() *Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *OrderItem) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *OrderItem) GetStockPolicy() string {
+	if x != nil {
+		return x.StockPolicy
+	}
+	return ""
+}
+
+func (x *OrderItem) GetExpectedRestockDate() string {
+	if x != nil {
+		return x.ExpectedRestockDate
+	}
+	return ""
+}
+
+func (x *OrderItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderItem) GetVariantId() int32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *OrderItem) GetPriceVersion() int32 {
+	if x != nil {
+		return x.PriceVersion
+	}
+	return 0
+}
+
+type CreateOrderRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items              []*OrderItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Currency           string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Subtotal           *Money                 `protobuf:"bytes,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discount           *Money                 `protobuf:"bytes,5,opt,name=discount,proto3" json:"discount,omitempty"`
+	Tax                *Money                 `protobuf:"bytes,6,opt,name=tax,proto3" json:"tax,omitempty"`
+	Total              *Money                 `protobuf:"bytes,7,opt,name=total,proto3" json:"total,omitempty"`
+	SettlementCurrency string                 `protobuf:"bytes,8,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"`
+	ExchangeRate       string                 `protobuf:"bytes,9,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	SettlementTotal    *Money                 `protobuf:"bytes,10,opt,name=settlement_total,json=settlementTotal,proto3" json:"settlement_total,omitempty"`
+	Discounts          []*DiscountLine        `protobuf:"bytes,11,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	TaxRegion          string                 `protobuf:"bytes,12,opt,name=tax_region,json=taxRegion,proto3" json:"tax_region,omitempty"`
+	PricesIncludeTax   bool                   `protobuf:"varint,13,opt,name=prices_include_tax,json=pricesIncludeTax,proto3" json:"prices_include_tax,omitempty"`
+	TaxLines           []*TaxLine             `protobuf:"bytes,14,rep,name=tax_lines,json=taxLines,proto3" json:"tax_lines,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CreateOrderRequest) Reset() {
 	*x = CreateOrderRequest{}
-	mi := &file_inventory_proto_msgTypes[15]
+	mi := &file_inventory_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1011,7 +4622,7 @@ func (x *CreateOrderRequest) String() string {
 func (*CreateOrderRequest) ProtoMessage() {}
 
 func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[15]
+	mi := &file_inventory_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,7 +4635,7 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{15}
+	return file_inventory_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *CreateOrderRequest) GetUserId() int32 {
@@ -1041,6 +4652,90 @@ func (x *CreateOrderRequest) GetItems() []*OrderItem {
 	return nil
 }
 
+func (x *CreateOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetSettlementCurrency() string {
+	if x != nil {
+		return x.SettlementCurrency
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetSettlementTotal() *Money {
+	if x != nil {
+		return x.SettlementTotal
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetDiscounts() []*DiscountLine {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetTaxRegion() string {
+	if x != nil {
+		return x.TaxRegion
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetPricesIncludeTax() bool {
+	if x != nil {
+		return x.PricesIncludeTax
+	}
+	return false
+}
+
+func (x *CreateOrderRequest) GetTaxLines() []*TaxLine {
+	if x != nil {
+		return x.TaxLines
+	}
+	return nil
+}
+
 type GetOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1050,7 +4745,7 @@ type GetOrderRequest struct {
 
 func (x *GetOrderRequest) Reset() {
 	*x = GetOrderRequest{}
-	mi := &file_inventory_proto_msgTypes[16]
+	mi := &file_inventory_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1062,7 +4757,7 @@ func (x *GetOrderRequest) String() string {
 func (*GetOrderRequest) ProtoMessage() {}
 
 func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[16]
+	mi := &file_inventory_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,7 +4770,7 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{16}
+	return file_inventory_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetOrderRequest) GetId() string {
@@ -1095,7 +4790,7 @@ type UpdateOrderStatusRequest struct {
 
 func (x *UpdateOrderStatusRequest) Reset() {
 	*x = UpdateOrderStatusRequest{}
-	mi := &file_inventory_proto_msgTypes[17]
+	mi := &file_inventory_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1107,7 +4802,7 @@ func (x *UpdateOrderStatusRequest) String() string {
 func (*UpdateOrderStatusRequest) ProtoMessage() {}
 
 func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[17]
+	mi := &file_inventory_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,7 +4815,7 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{17}
+	return file_inventory_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *UpdateOrderStatusRequest) GetId() string {
@@ -1147,7 +4842,7 @@ type OrderResponse struct {
 
 func (x *OrderResponse) Reset() {
 	*x = OrderResponse{}
-	mi := &file_inventory_proto_msgTypes[18]
+	mi := &file_inventory_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1159,7 +4854,7 @@ func (x *OrderResponse) String() string {
 func (*OrderResponse) ProtoMessage() {}
 
 func (x *OrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[18]
+	mi := &file_inventory_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,7 +4867,7 @@ func (x *OrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderResponse.ProtoReflect.Descriptor instead.
 func (*OrderResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{18}
+	return file_inventory_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *OrderResponse) GetOrder() *Order {
@@ -1190,17 +4885,18 @@ func (x *OrderResponse) GetMessage() string {
 }
 
 type ListOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page            int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListOrdersRequest) Reset() {
 	*x = ListOrdersRequest{}
-	mi := &file_inventory_proto_msgTypes[19]
+	mi := &file_inventory_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1212,7 +4908,7 @@ func (x *ListOrdersRequest) String() string {
 func (*ListOrdersRequest) ProtoMessage() {}
 
 func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[19]
+	mi := &file_inventory_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,7 +4921,7 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
 func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{19}
+	return file_inventory_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ListOrdersRequest) GetUserId() int32 {
@@ -1249,6 +4945,13 @@ func (x *ListOrdersRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListOrdersRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 type ListOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
@@ -1261,7 +4964,7 @@ type ListOrdersResponse struct {
 
 func (x *ListOrdersResponse) Reset() {
 	*x = ListOrdersResponse{}
-	mi := &file_inventory_proto_msgTypes[20]
+	mi := &file_inventory_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1273,7 +4976,7 @@ func (x *ListOrdersResponse) String() string {
 func (*ListOrdersResponse) ProtoMessage() {}
 
 func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[20]
+	mi := &file_inventory_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1286,7 +4989,7 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
 func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{20}
+	return file_inventory_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ListOrdersResponse) GetOrders() []*Order {
@@ -1317,11 +5020,261 @@ func (x *ListOrdersResponse) GetLimit() int32 {
 	return 0
 }
 
+// UserOrdersRequest archives the orders of a deleted user at archived_at, or
+// restores the ones archived at that time
+type UserOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ArchivedAt    string                 `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserOrdersRequest) Reset() {
+	*x = UserOrdersRequest{}
+	mi := &file_inventory_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOrdersRequest) ProtoMessage() {}
+
+func (x *UserOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOrdersRequest.ProtoReflect.Descriptor instead.
+func (*UserOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UserOrdersRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserOrdersRequest) GetArchivedAt() string {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return ""
+}
+
+type ReassignUserOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewUserId     int32                  `protobuf:"varint,2,opt,name=new_user_id,json=newUserId,proto3" json:"new_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignUserOrdersRequest) Reset() {
+	*x = ReassignUserOrdersRequest{}
+	mi := &file_inventory_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignUserOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignUserOrdersRequest) ProtoMessage() {}
+
+func (x *ReassignUserOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignUserOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ReassignUserOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReassignUserOrdersRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReassignUserOrdersRequest) GetNewUserId() int32 {
+	if x != nil {
+		return x.NewUserId
+	}
+	return 0
+}
+
+type UserOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserOrdersResponse) Reset() {
+	*x = UserOrdersResponse{}
+	mi := &file_inventory_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOrdersResponse) ProtoMessage() {}
+
+func (x *UserOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOrdersResponse.ProtoReflect.Descriptor instead.
+func (*UserOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UserOrdersResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_inventory_proto protoreflect.FileDescriptor
 
 const file_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x0finventory.proto\x12\tinventory\"\xe1\x01\n" +
+	"\x0finventory.proto\x12\tinventory\"\xed\x01\n" +
+	"\tWarehouse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x05 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x06 \x01(\x01R\tlongitude\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"\x94\x01\n" +
+	"\x16CreateWarehouseRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\"%\n" +
+	"\x13GetWarehouseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xa8\x01\n" +
+	"\x16UpdateWarehouseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\"(\n" +
+	"\x16DeleteWarehouseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"M\n" +
+	"\x17DeleteWarehouseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"B\n" +
+	"\x15ListWarehousesRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"a\n" +
+	"\x11WarehouseResponse\x122\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x14.inventory.WarehouseR\twarehouse\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"N\n" +
+	"\x16ListWarehousesResponse\x124\n" +
+	"\n" +
+	"warehouses\x18\x01 \x03(\v2\x14.inventory.WarehouseR\n" +
+	"warehouses\"\x8b\x01\n" +
+	"\rTransferEvent\x121\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x19.inventory.TransferStatusR\x06status\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"\x8c\x04\n" +
+	"\rStockTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12.\n" +
+	"\x13source_warehouse_id\x18\x04 \x01(\x05R\x11sourceWarehouseId\x122\n" +
+	"\x15source_warehouse_code\x18\x05 \x01(\tR\x13sourceWarehouseCode\x128\n" +
+	"\x18destination_warehouse_id\x18\x06 \x01(\x05R\x16destinationWarehouseId\x12<\n" +
+	"\x1adestination_warehouse_code\x18\a \x01(\tR\x18destinationWarehouseCode\x121\n" +
+	"\x06status\x18\b \x01(\x0e2\x19.inventory.TransferStatusR\x06status\x12\x12\n" +
+	"\x04note\x18\t \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\x120\n" +
+	"\x06events\x18\f \x03(\v2\x18.inventory.TransferEventR\x06events\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\r \x01(\x05R\tvariantId\"\x85\x02\n" +
+	"\x15CreateTransferRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12.\n" +
+	"\x13source_warehouse_id\x18\x03 \x01(\x05R\x11sourceWarehouseId\x128\n" +
+	"\x18destination_warehouse_id\x18\x04 \x01(\x05R\x16destinationWarehouseId\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\a \x01(\x05R\tvariantId\"$\n" +
+	"\x12GetTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xd4\x01\n" +
+	"\x14ListTransfersRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"has_status\x18\x03 \x01(\bR\thasStatus\x121\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x19.inventory.TransferStatusR\x06status\x12!\n" +
+	"\fwarehouse_id\x18\x05 \x01(\x05R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x06 \x01(\x05R\tproductId\"\x8a\x01\n" +
+	"\x1bUpdateTransferStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.inventory.TransferStatusR\x06status\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"b\n" +
+	"\x10TransferResponse\x124\n" +
+	"\btransfer\x18\x01 \x01(\v2\x18.inventory.StockTransferR\btransfer\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x8f\x01\n" +
+	"\x15ListTransfersResponse\x126\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x18.inventory.StockTransferR\ttransfers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xd2\x03\n" +
 	"\rInventoryItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
@@ -1332,21 +5285,117 @@ const file_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\tR\tupdatedAt\"s\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12!\n" +
+	"\fwarehouse_id\x18\b \x01(\x05R\vwarehouseId\x12-\n" +
+	"\x12available_quantity\x18\t \x01(\x05R\x11availableQuantity\x12.\n" +
+	"\x13in_transit_quantity\x18\n" +
+	" \x01(\x05R\x11inTransitQuantity\x12#\n" +
+	"\rreorder_point\x18\v \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\f \x01(\x05R\x0freorderQuantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\r \x01(\x05R\tvariantId\"\xaf\x02\n" +
 	"\x1aCreateInventoryItemRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1a\n" +
-	"\blocation\x18\x03 \x01(\tR\blocation\")\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\x05R\vwarehouseId\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12#\n" +
+	"\rreorder_point\x18\a \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\b \x01(\x05R\x0freorderQuantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\t \x01(\x05R\tvariantId\")\n" +
 	"\x17GetInventoryItemRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"d\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xff\x02\n" +
 	"\x1aUpdateInventoryItemRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1a\n" +
-	"\blocation\x18\x03 \x01(\tR\blocation\"E\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1e\n" +
+	"\bquantity\x18\x02 \x01(\x05B\x02\x18\x01R\bquantity\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\x05R\vwarehouseId\x12\x14\n" +
+	"\x05delta\x18\x05 \x01(\x05R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\a \x01(\tR\x05actor\x12\x12\n" +
+	"\x04note\x18\b \x01(\tR\x04note\x12\x1c\n" +
+	"\treference\x18\t \x01(\tR\treference\x12,\n" +
+	"\x12set_reorder_levels\x18\n" +
+	" \x01(\bR\x10setReorderLevels\x12#\n" +
+	"\rreorder_point\x18\v \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\f \x01(\x05R\x0freorderQuantity\"\xcc\x01\n" +
 	"\x19ListInventoryItemsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"_\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12!\n" +
+	"\fwarehouse_id\x18\x03 \x01(\x05R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x04 \x01(\x05R\tproductId\x12$\n" +
+	"\x0elow_stock_only\x18\x05 \x01(\bR\flowStockOnly\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x06 \x01(\x05R\tvariantId\"\xe2\x03\n" +
+	"\x14InventoryLedgerEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\x05R\x0finventoryItemId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x05R\tproductId\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\x05R\vwarehouseId\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\a \x01(\tR\x05actor\x12%\n" +
+	"\x0equantity_delta\x18\b \x01(\x05R\rquantityDelta\x12%\n" +
+	"\x0ereserved_delta\x18\t \x01(\x05R\rreservedDelta\x12%\n" +
+	"\x0equantity_after\x18\n" +
+	" \x01(\x05R\rquantityAfter\x12%\n" +
+	"\x0ereserved_after\x18\v \x01(\x05R\rreservedAfter\x12\x1c\n" +
+	"\treference\x18\f \x01(\tR\treference\x12\x12\n" +
+	"\x04note\x18\r \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x0f \x01(\x05R\tvariantId\"V\n" +
+	"\x1aGetInventoryHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x95\x01\n" +
+	"\x18InventoryHistoryResponse\x129\n" +
+	"\aentries\x18\x01 \x03(\v2\x1f.inventory.InventoryLedgerEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x88\x03\n" +
+	"\rLowStockAlert\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\x05R\x0finventoryItemId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12!\n" +
+	"\fwarehouse_id\x18\x03 \x01(\x05R\vwarehouseId\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12-\n" +
+	"\x12available_quantity\x18\x05 \x01(\x05R\x11availableQuantity\x12.\n" +
+	"\x13in_transit_quantity\x18\x06 \x01(\x05R\x11inTransitQuantity\x12#\n" +
+	"\rreorder_point\x18\a \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\b \x01(\x05R\x0freorderQuantity\x12\x1f\n" +
+	"\vdetected_at\x18\t \x01(\tR\n" +
+	"detectedAt\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\n" +
+	" \x01(\x05R\tvariantId\"I\n" +
+	"\x15ReportLowStockRequest\x120\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x18.inventory.LowStockAlertR\x06alerts\"L\n" +
+	"\x16ReportLowStockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb5\x01\n" +
+	"\n" +
+	"KafkaEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\tR\x04data\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\tR\btenantId\x12\x1f\n" +
+	"\vreceived_at\x18\a \x01(\tR\n" +
+	"receivedAt\"I\n" +
+	"\x16ListKafkaEventsRequest\x12\x19\n" +
+	"\bafter_id\x18\x01 \x01(\x05R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"H\n" +
+	"\x17ListKafkaEventsResponse\x12-\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.inventory.KafkaEventR\x06events\"_\n" +
 	"\x15InventoryItemResponse\x12,\n" +
 	"\x04item\x18\x01 \x01(\v2\x18.inventory.InventoryItemR\x04item\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"\x8c\x01\n" +
@@ -1354,29 +5403,146 @@ const file_inventory_proto_rawDesc = "" +
 	"\x05items\x18\x01 \x03(\v2\x18.inventory.InventoryItemR\x05items\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit\"_\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"~\n" +
 	"\x11CheckStockRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12+\n" +
-	"\x11required_quantity\x18\x02 \x01(\x05R\x10requiredQuantity\"{\n" +
+	"\x11required_quantity\x18\x02 \x01(\x05R\x10requiredQuantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\x05R\tvariantId\"\xe3\x01\n" +
 	"\x12CheckStockResponse\x12\x1c\n" +
 	"\tavailable\x18\x01 \x01(\bR\tavailable\x12-\n" +
 	"\x12available_quantity\x18\x02 \x01(\x05R\x11availableQuantity\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"k\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x126\n" +
+	"\tlocations\x18\x04 \x03(\v2\x18.inventory.LocationStockR\tlocations\x12.\n" +
+	"\x13in_transit_quantity\x18\x05 \x01(\x05R\x11inTransitQuantity\"\xa8\x02\n" +
+	"\rLocationStock\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x05R\vwarehouseId\x12%\n" +
+	"\x0ewarehouse_code\x18\x02 \x01(\tR\rwarehouseCode\x12%\n" +
+	"\x0ewarehouse_name\x18\x03 \x01(\tR\rwarehouseName\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12+\n" +
+	"\x11reserved_quantity\x18\x05 \x01(\x05R\x10reservedQuantity\x12-\n" +
+	"\x12available_quantity\x18\x06 \x01(\x05R\x11availableQuantity\x12.\n" +
+	"\x13in_transit_quantity\x18\a \x01(\x05R\x11inTransitQuantity\"\xf6\x02\n" +
 	"\x13ReserveStockRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x19\n" +
-	"\border_id\x18\x03 \x01(\tR\aorderId\"q\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x129\n" +
+	"\bstrategy\x18\x04 \x01(\x0e2\x1d.inventory.AllocationStrategyR\bstrategy\x122\n" +
+	"\x15has_shipping_location\x18\x05 \x01(\bR\x13hasShippingLocation\x12+\n" +
+	"\x11shipping_latitude\x18\x06 \x01(\x01R\x10shippingLatitude\x12-\n" +
+	"\x12shipping_longitude\x18\a \x01(\x01R\x11shippingLongitude\x12\x1f\n" +
+	"\vttl_seconds\x18\b \x01(\x05R\n" +
+	"ttlSeconds\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\t \x01(\x05R\tvariantId\"r\n" +
+	"\n" +
+	"Allocation\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x05R\vwarehouseId\x12%\n" +
+	"\x0ewarehouse_code\x18\x02 \x01(\tR\rwarehouseCode\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\xc9\x01\n" +
 	"\x14ReserveStockResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
-	"\x0ereservation_id\x18\x03 \x01(\tR\rreservationId\"<\n" +
+	"\x0ereservation_id\x18\x03 \x01(\tR\rreservationId\x127\n" +
+	"\vallocations\x18\x04 \x03(\v2\x15.inventory.AllocationR\vallocations\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"<\n" +
 	"\x13ReleaseStockRequest\x12%\n" +
 	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\"J\n" +
 	"\x14ReleaseStockResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xed\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"k\n" +
+	"\x0fReservationLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\x05R\tvariantId\"\xd3\x02\n" +
+	"\x18ReserveStockBatchRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x120\n" +
+	"\x05lines\x18\x02 \x03(\v2\x1a.inventory.ReservationLineR\x05lines\x129\n" +
+	"\bstrategy\x18\x03 \x01(\x0e2\x1d.inventory.AllocationStrategyR\bstrategy\x122\n" +
+	"\x15has_shipping_location\x18\x04 \x01(\bR\x13hasShippingLocation\x12+\n" +
+	"\x11shipping_latitude\x18\x05 \x01(\x01R\x10shippingLatitude\x12-\n" +
+	"\x12shipping_longitude\x18\x06 \x01(\x01R\x11shippingLongitude\x12\x1f\n" +
+	"\vttl_seconds\x18\a \x01(\x05R\n" +
+	"ttlSeconds\"\xa7\x01\n" +
+	"\rLineShortfall\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1c\n" +
+	"\trequested\x18\x02 \x01(\x05R\trequested\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x05R\tavailable\x12\x1c\n" +
+	"\tshortfall\x18\x04 \x01(\x05R\tshortfall\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x05 \x01(\x05R\tvariantId\"\xe4\x01\n" +
+	"\x19ReserveStockBatchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\freservations\x18\x03 \x03(\v2\x16.inventory.ReservationR\freservations\x128\n" +
+	"\n" +
+	"shortfalls\x18\x04 \x03(\v2\x18.inventory.LineShortfallR\n" +
+	"shortfalls\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"<\n" +
+	"\x1fReleaseOrderReservationsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\x7f\n" +
+	" ReleaseOrderReservationsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x0freservation_ids\x18\x03 \x03(\tR\x0ereservationIds\"\xc2\x02\n" +
+	"\vReservation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\x12\x1f\n" +
+	"\vreleased_at\x18\b \x01(\tR\n" +
+	"releasedAt\x127\n" +
+	"\vallocations\x18\t \x03(\v2\x15.inventory.AllocationR\vallocations\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\n" +
+	" \x01(\x05R\tvariantId\"i\n" +
+	"\x13ReservationResponse\x128\n" +
+	"\vreservation\x18\x01 \x01(\v2\x16.inventory.ReservationR\vreservation\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"h\n" +
+	"\x18ExtendReservationRequest\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12%\n" +
+	"\x0eextend_seconds\x18\x02 \x01(\x05R\rextendSeconds\"\xe0\x01\n" +
+	"\x17ListReservationsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x126\n" +
+	"\x17expiring_within_seconds\x18\x03 \x01(\x05R\x15expiringWithinSeconds\x12)\n" +
+	"\x10include_inactive\x18\x04 \x01(\bR\x0fincludeInactive\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\x96\x01\n" +
+	"\x18ListReservationsResponse\x12:\n" +
+	"\freservations\x18\x01 \x03(\v2\x16.inventory.ReservationR\freservations\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\x91\x01\n" +
+	"\fDiscountLine\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\tR\vpromotionId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12(\n" +
+	"\x06amount\x18\x04 \x01(\v2\x10.inventory.MoneyR\x06amount\"\xbb\x01\n" +
+	"\aTaxLine\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\tR\x04rate\x12*\n" +
+	"\ataxable\x18\x05 \x01(\v2\x10.inventory.MoneyR\ataxable\x12(\n" +
+	"\x06amount\x18\x06 \x01(\v2\x10.inventory.MoneyR\x06amount\"\x9a\x06\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12*\n" +
@@ -1386,15 +5552,56 @@ const file_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\tR\tupdatedAt\"\\\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12,\n" +
+	"\bsubtotal\x18\t \x01(\v2\x10.inventory.MoneyR\bsubtotal\x12,\n" +
+	"\bdiscount\x18\n" +
+	" \x01(\v2\x10.inventory.MoneyR\bdiscount\x12\"\n" +
+	"\x03tax\x18\v \x01(\v2\x10.inventory.MoneyR\x03tax\x12&\n" +
+	"\x05total\x18\f \x01(\v2\x10.inventory.MoneyR\x05total\x12/\n" +
+	"\x13settlement_currency\x18\r \x01(\tR\x12settlementCurrency\x12#\n" +
+	"\rexchange_rate\x18\x0e \x01(\tR\fexchangeRate\x12;\n" +
+	"\x10settlement_total\x18\x0f \x01(\v2\x10.inventory.MoneyR\x0fsettlementTotal\x125\n" +
+	"\tdiscounts\x18\x10 \x03(\v2\x17.inventory.DiscountLineR\tdiscounts\x12\x1d\n" +
+	"\n" +
+	"tax_region\x18\x11 \x01(\tR\ttaxRegion\x12,\n" +
+	"\x12prices_include_tax\x18\x12 \x01(\bR\x10pricesIncludeTax\x12/\n" +
+	"\ttax_lines\x18\x13 \x03(\v2\x12.inventory.TaxLineR\btaxLines\x12\x1f\n" +
+	"\varchived_at\x18\x14 \x01(\tR\n" +
+	"archivedAt\"\x80\x03\n" +
 	"\tOrderItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\"Y\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12/\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\v2\x10.inventory.MoneyR\tunitPrice\x12,\n" +
+	"\bsubtotal\x18\x05 \x01(\v2\x10.inventory.MoneyR\bsubtotal\x12!\n" +
+	"\fstock_policy\x18\x06 \x01(\tR\vstockPolicy\x122\n" +
+	"\x15expected_restock_date\x18\a \x01(\tR\x13expectedRestockDate\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\t \x01(\x05R\tvariantId\x12\x10\n" +
+	"\x03sku\x18\n" +
+	" \x01(\tR\x03sku\x12#\n" +
+	"\rprice_version\x18\v \x01(\x05R\fpriceVersion\"\xe5\x04\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12*\n" +
-	"\x05items\x18\x02 \x03(\v2\x14.inventory.OrderItemR\x05items\"!\n" +
+	"\x05items\x18\x02 \x03(\v2\x14.inventory.OrderItemR\x05items\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12,\n" +
+	"\bsubtotal\x18\x04 \x01(\v2\x10.inventory.MoneyR\bsubtotal\x12,\n" +
+	"\bdiscount\x18\x05 \x01(\v2\x10.inventory.MoneyR\bdiscount\x12\"\n" +
+	"\x03tax\x18\x06 \x01(\v2\x10.inventory.MoneyR\x03tax\x12&\n" +
+	"\x05total\x18\a \x01(\v2\x10.inventory.MoneyR\x05total\x12/\n" +
+	"\x13settlement_currency\x18\b \x01(\tR\x12settlementCurrency\x12#\n" +
+	"\rexchange_rate\x18\t \x01(\tR\fexchangeRate\x12;\n" +
+	"\x10settlement_total\x18\n" +
+	" \x01(\v2\x10.inventory.MoneyR\x0fsettlementTotal\x125\n" +
+	"\tdiscounts\x18\v \x03(\v2\x17.inventory.DiscountLineR\tdiscounts\x12\x1d\n" +
+	"\n" +
+	"tax_region\x18\f \x01(\tR\ttaxRegion\x12,\n" +
+	"\x12prices_include_tax\x18\r \x01(\bR\x10pricesIncludeTax\x12/\n" +
+	"\ttax_lines\x18\x0e \x03(\v2\x12.inventory.TaxLineR\btaxLines\"!\n" +
 	"\x0fGetOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
 	"\x18UpdateOrderStatusRequest\x12\x0e\n" +
@@ -1402,16 +5609,36 @@ const file_inventory_proto_rawDesc = "" +
 	"\x06status\x18\x02 \x01(\x0e2\x16.inventory.OrderStatusR\x06status\"Q\n" +
 	"\rOrderResponse\x12&\n" +
 	"\x05order\x18\x01 \x01(\v2\x10.inventory.OrderR\x05order\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"V\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x81\x01\n" +
 	"\x11ListOrdersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"~\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12)\n" +
+	"\x10include_archived\x18\x04 \x01(\bR\x0fincludeArchived\"~\n" +
 	"\x12ListOrdersResponse\x12(\n" +
 	"\x06orders\x18\x01 \x03(\v2\x10.inventory.OrderR\x06orders\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit*d\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"M\n" +
+	"\x11UserOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\varchived_at\x18\x02 \x01(\tR\n" +
+	"archivedAt\"T\n" +
+	"\x19ReassignUserOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1e\n" +
+	"\vnew_user_id\x18\x02 \x01(\x05R\tnewUserId\"*\n" +
+	"\x12UserOrdersResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count*p\n" +
+	"\x0eTransferStatus\x12\x16\n" +
+	"\x12TRANSFER_REQUESTED\x10\x00\x12\x17\n" +
+	"\x13TRANSFER_IN_TRANSIT\x10\x01\x12\x15\n" +
+	"\x11TRANSFER_RECEIVED\x10\x02\x12\x16\n" +
+	"\x12TRANSFER_CANCELLED\x10\x03*W\n" +
+	"\x12AllocationStrategy\x12\x16\n" +
+	"\x12ALLOCATION_DEFAULT\x10\x00\x12\v\n" +
+	"\aNEAREST\x10\x01\x12\x11\n" +
+	"\rLARGEST_STOCK\x10\x02\x12\t\n" +
+	"\x05SPLIT\x10\x03*d\n" +
 	"\vOrderStatus\x12\v\n" +
 	"\aPENDING\x10\x00\x12\r\n" +
 	"\tCONFIRMED\x10\x01\x12\x0e\n" +
@@ -1419,22 +5646,41 @@ const file_inventory_proto_rawDesc = "" +
 	"PROCESSING\x10\x02\x12\v\n" +
 	"\aSHIPPED\x10\x03\x12\r\n" +
 	"\tDELIVERED\x10\x04\x12\r\n" +
-	"\tCANCELLED\x10\x052\xfc\x04\n" +
+	"\tCANCELLED\x10\x052\x90\x10\n" +
 	"\x10InventoryService\x12^\n" +
 	"\x13CreateInventoryItem\x12%.inventory.CreateInventoryItemRequest\x1a .inventory.InventoryItemResponse\x12X\n" +
 	"\x10GetInventoryItem\x12\".inventory.GetInventoryItemRequest\x1a .inventory.InventoryItemResponse\x12^\n" +
 	"\x13UpdateInventoryItem\x12%.inventory.UpdateInventoryItemRequest\x1a .inventory.InventoryItemResponse\x12a\n" +
-	"\x12ListInventoryItems\x12$.inventory.ListInventoryItemsRequest\x1a%.inventory.ListInventoryItemsResponse\x12I\n" +
+	"\x12ListInventoryItems\x12$.inventory.ListInventoryItemsRequest\x1a%.inventory.ListInventoryItemsResponse\x12a\n" +
+	"\x13GetInventoryHistory\x12%.inventory.GetInventoryHistoryRequest\x1a#.inventory.InventoryHistoryResponse\x12U\n" +
+	"\x0eReportLowStock\x12 .inventory.ReportLowStockRequest\x1a!.inventory.ReportLowStockResponse\x12X\n" +
+	"\x0fListKafkaEvents\x12!.inventory.ListKafkaEventsRequest\x1a\".inventory.ListKafkaEventsResponse\x12I\n" +
 	"\n" +
 	"CheckStock\x12\x1c.inventory.CheckStockRequest\x1a\x1d.inventory.CheckStockResponse\x12O\n" +
 	"\fReserveStock\x12\x1e.inventory.ReserveStockRequest\x1a\x1f.inventory.ReserveStockResponse\x12O\n" +
-	"\fReleaseStock\x12\x1e.inventory.ReleaseStockRequest\x1a\x1f.inventory.ReleaseStockResponse2\xb7\x02\n" +
+	"\fReleaseStock\x12\x1e.inventory.ReleaseStockRequest\x1a\x1f.inventory.ReleaseStockResponse\x12^\n" +
+	"\x11ReserveStockBatch\x12#.inventory.ReserveStockBatchRequest\x1a$.inventory.ReserveStockBatchResponse\x12s\n" +
+	"\x18ReleaseOrderReservations\x12*.inventory.ReleaseOrderReservationsRequest\x1a+.inventory.ReleaseOrderReservationsResponse\x12X\n" +
+	"\x11ExtendReservation\x12#.inventory.ExtendReservationRequest\x1a\x1e.inventory.ReservationResponse\x12[\n" +
+	"\x10ListReservations\x12\".inventory.ListReservationsRequest\x1a#.inventory.ListReservationsResponse\x12R\n" +
+	"\x0fCreateWarehouse\x12!.inventory.CreateWarehouseRequest\x1a\x1c.inventory.WarehouseResponse\x12L\n" +
+	"\fGetWarehouse\x12\x1e.inventory.GetWarehouseRequest\x1a\x1c.inventory.WarehouseResponse\x12R\n" +
+	"\x0fUpdateWarehouse\x12!.inventory.UpdateWarehouseRequest\x1a\x1c.inventory.WarehouseResponse\x12X\n" +
+	"\x0fDeleteWarehouse\x12!.inventory.DeleteWarehouseRequest\x1a\".inventory.DeleteWarehouseResponse\x12U\n" +
+	"\x0eListWarehouses\x12 .inventory.ListWarehousesRequest\x1a!.inventory.ListWarehousesResponse\x12O\n" +
+	"\x0eCreateTransfer\x12 .inventory.CreateTransferRequest\x1a\x1b.inventory.TransferResponse\x12I\n" +
+	"\vGetTransfer\x12\x1d.inventory.GetTransferRequest\x1a\x1b.inventory.TransferResponse\x12R\n" +
+	"\rListTransfers\x12\x1f.inventory.ListTransfersRequest\x1a .inventory.ListTransfersResponse\x12[\n" +
+	"\x14UpdateTransferStatus\x12&.inventory.UpdateTransferStatusRequest\x1a\x1b.inventory.TransferResponse2\xb6\x04\n" +
 	"\fOrderService\x12F\n" +
 	"\vCreateOrder\x12\x1d.inventory.CreateOrderRequest\x1a\x18.inventory.OrderResponse\x12@\n" +
 	"\bGetOrder\x12\x1a.inventory.GetOrderRequest\x1a\x18.inventory.OrderResponse\x12I\n" +
 	"\n" +
 	"ListOrders\x12\x1c.inventory.ListOrdersRequest\x1a\x1d.inventory.ListOrdersResponse\x12R\n" +
-	"\x11UpdateOrderStatus\x12#.inventory.UpdateOrderStatusRequest\x1a\x18.inventory.OrderResponseB\tZ\a./protob\x06proto3"
+	"\x11UpdateOrderStatus\x12#.inventory.UpdateOrderStatusRequest\x1a\x18.inventory.OrderResponse\x12P\n" +
+	"\x11ArchiveUserOrders\x12\x1c.inventory.UserOrdersRequest\x1a\x1d.inventory.UserOrdersResponse\x12P\n" +
+	"\x11RestoreUserOrders\x12\x1c.inventory.UserOrdersRequest\x1a\x1d.inventory.UserOrdersResponse\x12Y\n" +
+	"\x12ReassignUserOrders\x12$.inventory.ReassignUserOrdersRequest\x1a\x1d.inventory.UserOrdersResponseB\tZ\a./protob\x06proto3"
 
 var (
 	file_inventory_proto_rawDescOnce sync.Once
@@ -1448,68 +5694,194 @@ func file_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_proto_rawDescData
 }
 
-var file_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_inventory_proto_goTypes = []any{
-	(OrderStatus)(0),                   // 0: inventory.OrderStatus
-	(*InventoryItem)(nil),              // 1: inventory.InventoryItem
-	(*CreateInventoryItemRequest)(nil), // 2: inventory.CreateInventoryItemRequest
-	(*GetInventoryItemRequest)(nil),    // 3: inventory.GetInventoryItemRequest
-	(*UpdateInventoryItemRequest)(nil), // 4: inventory.UpdateInventoryItemRequest
-	(*ListInventoryItemsRequest)(nil),  // 5: inventory.ListInventoryItemsRequest
-	(*InventoryItemResponse)(nil),      // 6: inventory.InventoryItemResponse
-	(*ListInventoryItemsResponse)(nil), // 7: inventory.ListInventoryItemsResponse
-	(*CheckStockRequest)(nil),          // 8: inventory.CheckStockRequest
-	(*CheckStockResponse)(nil),         // 9: inventory.CheckStockResponse
-	(*ReserveStockRequest)(nil),        // 10: inventory.ReserveStockRequest
-	(*ReserveStockResponse)(nil),       // 11: inventory.ReserveStockResponse
-	(*ReleaseStockRequest)(nil),        // 12: inventory.ReleaseStockRequest
-	(*ReleaseStockResponse)(nil),       // 13: inventory.ReleaseStockResponse
-	(*Order)(nil),                      // 14: inventory.Order
-	(*OrderItem)(nil),                  // 15: inventory.OrderItem
-	(*CreateOrderRequest)(nil),         // 16: inventory.CreateOrderRequest
-	(*GetOrderRequest)(nil),            // 17: inventory.GetOrderRequest
-	(*UpdateOrderStatusRequest)(nil),   // 18: inventory.UpdateOrderStatusRequest
-	(*OrderResponse)(nil),              // 19: inventory.OrderResponse
-	(*ListOrdersRequest)(nil),          // 20: inventory.ListOrdersRequest
-	(*ListOrdersResponse)(nil),         // 21: inventory.ListOrdersResponse
+	(TransferStatus)(0),                      // 0: inventory.TransferStatus
+	(AllocationStrategy)(0),                  // 1: inventory.AllocationStrategy
+	(OrderStatus)(0),                         // 2: inventory.OrderStatus
+	(*Warehouse)(nil),                        // 3: inventory.Warehouse
+	(*CreateWarehouseRequest)(nil),           // 4: inventory.CreateWarehouseRequest
+	(*GetWarehouseRequest)(nil),              // 5: inventory.GetWarehouseRequest
+	(*UpdateWarehouseRequest)(nil),           // 6: inventory.UpdateWarehouseRequest
+	(*DeleteWarehouseRequest)(nil),           // 7: inventory.DeleteWarehouseRequest
+	(*DeleteWarehouseResponse)(nil),          // 8: inventory.DeleteWarehouseResponse
+	(*ListWarehousesRequest)(nil),            // 9: inventory.ListWarehousesRequest
+	(*WarehouseResponse)(nil),                // 10: inventory.WarehouseResponse
+	(*ListWarehousesResponse)(nil),           // 11: inventory.ListWarehousesResponse
+	(*TransferEvent)(nil),                    // 12: inventory.TransferEvent
+	(*StockTransfer)(nil),                    // 13: inventory.StockTransfer
+	(*CreateTransferRequest)(nil),            // 14: inventory.CreateTransferRequest
+	(*GetTransferRequest)(nil),               // 15: inventory.GetTransferRequest
+	(*ListTransfersRequest)(nil),             // 16: inventory.ListTransfersRequest
+	(*UpdateTransferStatusRequest)(nil),      // 17: inventory.UpdateTransferStatusRequest
+	(*TransferResponse)(nil),                 // 18: inventory.TransferResponse
+	(*ListTransfersResponse)(nil),            // 19: inventory.ListTransfersResponse
+	(*InventoryItem)(nil),                    // 20: inventory.InventoryItem
+	(*CreateInventoryItemRequest)(nil),       // 21: inventory.CreateInventoryItemRequest
+	(*GetInventoryItemRequest)(nil),          // 22: inventory.GetInventoryItemRequest
+	(*UpdateInventoryItemRequest)(nil),       // 23: inventory.UpdateInventoryItemRequest
+	(*ListInventoryItemsRequest)(nil),        // 24: inventory.ListInventoryItemsRequest
+	(*InventoryLedgerEntry)(nil),             // 25: inventory.InventoryLedgerEntry
+	(*GetInventoryHistoryRequest)(nil),       // 26: inventory.GetInventoryHistoryRequest
+	(*InventoryHistoryResponse)(nil),         // 27: inventory.InventoryHistoryResponse
+	(*LowStockAlert)(nil),                    // 28: inventory.LowStockAlert
+	(*ReportLowStockRequest)(nil),            // 29: inventory.ReportLowStockRequest
+	(*ReportLowStockResponse)(nil),           // 30: inventory.ReportLowStockResponse
+	(*KafkaEvent)(nil),                       // 31: inventory.KafkaEvent
+	(*ListKafkaEventsRequest)(nil),           // 32: inventory.ListKafkaEventsRequest
+	(*ListKafkaEventsResponse)(nil),          // 33: inventory.ListKafkaEventsResponse
+	(*InventoryItemResponse)(nil),            // 34: inventory.InventoryItemResponse
+	(*ListInventoryItemsResponse)(nil),       // 35: inventory.ListInventoryItemsResponse
+	(*CheckStockRequest)(nil),                // 36: inventory.CheckStockRequest
+	(*CheckStockResponse)(nil),               // 37: inventory.CheckStockResponse
+	(*LocationStock)(nil),                    // 38: inventory.LocationStock
+	(*ReserveStockRequest)(nil),              // 39: inventory.ReserveStockRequest
+	(*Allocation)(nil),                       // 40: inventory.Allocation
+	(*ReserveStockResponse)(nil),             // 41: inventory.ReserveStockResponse
+	(*ReleaseStockRequest)(nil),              // 42: inventory.ReleaseStockRequest
+	(*ReleaseStockResponse)(nil),             // 43: inventory.ReleaseStockResponse
+	(*ReservationLine)(nil),                  // 44: inventory.ReservationLine
+	(*ReserveStockBatchRequest)(nil),         // 45: inventory.ReserveStockBatchRequest
+	(*LineShortfall)(nil),                    // 46: inventory.LineShortfall
+	(*ReserveStockBatchResponse)(nil),        // 47: inventory.ReserveStockBatchResponse
+	(*ReleaseOrderReservationsRequest)(nil),  // 48: inventory.ReleaseOrderReservationsRequest
+	(*ReleaseOrderReservationsResponse)(nil), // 49: inventory.ReleaseOrderReservationsResponse
+	(*Reservation)(nil),                      // 50: inventory.Reservation
+	(*ReservationResponse)(nil),              // 51: inventory.ReservationResponse
+	(*ExtendReservationRequest)(nil),         // 52: inventory.ExtendReservationRequest
+	(*ListReservationsRequest)(nil),          // 53: inventory.ListReservationsRequest
+	(*ListReservationsResponse)(nil),         // 54: inventory.ListReservationsResponse
+	(*Money)(nil),                            // 55: inventory.Money
+	(*DiscountLine)(nil),                     // 56: inventory.DiscountLine
+	(*TaxLine)(nil),                          // 57: inventory.TaxLine
+	(*Order)(nil),                            // 58: inventory.Order
+	(*OrderItem)(nil),                        // 59: inventory.OrderItem
+	(*CreateOrderRequest)(nil),               // 60: inventory.CreateOrderRequest
+	(*GetOrderRequest)(nil),                  // 61: inventory.GetOrderRequest
+	(*UpdateOrderStatusRequest)(nil),         // 62: inventory.UpdateOrderStatusRequest
+	(*OrderResponse)(nil),                    // 63: inventory.OrderResponse
+	(*ListOrdersRequest)(nil),                // 64: inventory.ListOrdersRequest
+	(*ListOrdersResponse)(nil),               // 65: inventory.ListOrdersResponse
+	(*UserOrdersRequest)(nil),                // 66: inventory.UserOrdersRequest
+	(*ReassignUserOrdersRequest)(nil),        // 67: inventory.ReassignUserOrdersRequest
+	(*UserOrdersResponse)(nil),               // 68: inventory.UserOrdersResponse
 }
 var file_inventory_proto_depIdxs = []int32{
-	1,  // 0: inventory.InventoryItemResponse.item:type_name -> inventory.InventoryItem
-	1,  // 1: inventory.ListInventoryItemsResponse.items:type_name -> inventory.InventoryItem
-	15, // 2: inventory.Order.items:type_name -> inventory.OrderItem
-	0,  // 3: inventory.Order.status:type_name -> inventory.OrderStatus
-	15, // 4: inventory.CreateOrderRequest.items:type_name -> inventory.OrderItem
-	0,  // 5: inventory.UpdateOrderStatusRequest.status:type_name -> inventory.OrderStatus
-	14, // 6: inventory.OrderResponse.order:type_name -> inventory.Order
-	14, // 7: inventory.ListOrdersResponse.orders:type_name -> inventory.Order
-	2,  // 8: inventory.InventoryService.CreateInventoryItem:input_type -> inventory.CreateInventoryItemRequest
-	3,  // 9: inventory.InventoryService.GetInventoryItem:input_type -> inventory.GetInventoryItemRequest
-	4,  // 10: inventory.InventoryService.UpdateInventoryItem:input_type -> inventory.UpdateInventoryItemRequest
-	5,  // 11: inventory.InventoryService.ListInventoryItems:input_type -> inventory.ListInventoryItemsRequest
-	8,  // 12: inventory.InventoryService.CheckStock:input_type -> inventory.CheckStockRequest
-	10, // 13: inventory.InventoryService.ReserveStock:input_type -> inventory.ReserveStockRequest
-	12, // 14: inventory.InventoryService.ReleaseStock:input_type -> inventory.ReleaseStockRequest
-	16, // 15: inventory.OrderService.CreateOrder:input_type -> inventory.CreateOrderRequest
-	17, // 16: inventory.OrderService.GetOrder:input_type -> inventory.GetOrderRequest
-	20, // 17: inventory.OrderService.ListOrders:input_type -> inventory.ListOrdersRequest
-	18, // 18: inventory.OrderService.UpdateOrderStatus:input_type -> inventory.UpdateOrderStatusRequest
-	6,  // 19: inventory.InventoryService.CreateInventoryItem:output_type -> inventory.InventoryItemResponse
-	6,  // 20: inventory.InventoryService.GetInventoryItem:output_type -> inventory.InventoryItemResponse
-	6,  // 21: inventory.InventoryService.UpdateInventoryItem:output_type -> inventory.InventoryItemResponse
-	7,  // 22: inventory.InventoryService.ListInventoryItems:output_type -> inventory.ListInventoryItemsResponse
-	9,  // 23: inventory.InventoryService.CheckStock:output_type -> inventory.CheckStockResponse
-	11, // 24: inventory.InventoryService.ReserveStock:output_type -> inventory.ReserveStockResponse
-	13, // 25: inventory.InventoryService.ReleaseStock:output_type -> inventory.ReleaseStockResponse
-	19, // 26: inventory.OrderService.CreateOrder:output_type -> inventory.OrderResponse
-	19, // 27: inventory.OrderService.GetOrder:output_type -> inventory.OrderResponse
-	21, // 28: inventory.OrderService.ListOrders:output_type -> inventory.ListOrdersResponse
-	19, // 29: inventory.OrderService.UpdateOrderStatus:output_type -> inventory.OrderResponse
-	19, // [19:30] is the sub-list for method output_type
-	8,  // [8:19] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	3,  // 0: inventory.WarehouseResponse.warehouse:type_name -> inventory.Warehouse
+	3,  // 1: inventory.ListWarehousesResponse.warehouses:type_name -> inventory.Warehouse
+	0,  // 2: inventory.TransferEvent.status:type_name -> inventory.TransferStatus
+	0,  // 3: inventory.StockTransfer.status:type_name -> inventory.TransferStatus
+	12, // 4: inventory.StockTransfer.events:type_name -> inventory.TransferEvent
+	0,  // 5: inventory.ListTransfersRequest.status:type_name -> inventory.TransferStatus
+	0,  // 6: inventory.UpdateTransferStatusRequest.status:type_name -> inventory.TransferStatus
+	13, // 7: inventory.TransferResponse.transfer:type_name -> inventory.StockTransfer
+	13, // 8: inventory.ListTransfersResponse.transfers:type_name -> inventory.StockTransfer
+	25, // 9: inventory.InventoryHistoryResponse.entries:type_name -> inventory.InventoryLedgerEntry
+	28, // 10: inventory.ReportLowStockRequest.alerts:type_name -> inventory.LowStockAlert
+	31, // 11: inventory.ListKafkaEventsResponse.events:type_name -> inventory.KafkaEvent
+	20, // 12: inventory.InventoryItemResponse.item:type_name -> inventory.InventoryItem
+	20, // 13: inventory.ListInventoryItemsResponse.items:type_name -> inventory.InventoryItem
+	38, // 14: inventory.CheckStockResponse.locations:type_name -> inventory.LocationStock
+	1,  // 15: inventory.ReserveStockRequest.strategy:type_name -> inventory.AllocationStrategy
+	40, // 16: inventory.ReserveStockResponse.allocations:type_name -> inventory.Allocation
+	44, // 17: inventory.ReserveStockBatchRequest.lines:type_name -> inventory.ReservationLine
+	1,  // 18: inventory.ReserveStockBatchRequest.strategy:type_name -> inventory.AllocationStrategy
+	50, // 19: inventory.ReserveStockBatchResponse.reservations:type_name -> inventory.Reservation
+	46, // 20: inventory.ReserveStockBatchResponse.shortfalls:type_name -> inventory.LineShortfall
+	40, // 21: inventory.Reservation.allocations:type_name -> inventory.Allocation
+	50, // 22: inventory.ReservationResponse.reservation:type_name -> inventory.Reservation
+	50, // 23: inventory.ListReservationsResponse.reservations:type_name -> inventory.Reservation
+	55, // 24: inventory.DiscountLine.amount:type_name -> inventory.Money
+	55, // 25: inventory.TaxLine.taxable:type_name -> inventory.Money
+	55, // 26: inventory.TaxLine.amount:type_name -> inventory.Money
+	59, // 27: inventory.Order.items:type_name -> inventory.OrderItem
+	2,  // 28: inventory.Order.status:type_name -> inventory.OrderStatus
+	55, // 29: inventory.Order.subtotal:type_name -> inventory.Money
+	55, // 30: inventory.Order.discount:type_name -> inventory.Money
+	55, // 31: inventory.Order.tax:type_name -> inventory.Money
+	55, // 32: inventory.Order.total:type_name -> inventory.Money
+	55, // 33: inventory.Order.settlement_total:type_name -> inventory.Money
+	56, // 34: inventory.Order.discounts:type_name -> inventory.DiscountLine
+	57, // 35: inventory.Order.tax_lines:type_name -> inventory.TaxLine
+	55, // 36: inventory.OrderItem.unit_price:type_name -> inventory.Money
+	55, // 37: inventory.OrderItem.subtotal:type_name -> inventory.Money
+	59, // 38: inventory.CreateOrderRequest.items:type_name -> inventory.OrderItem
+	55, // 39: inventory.CreateOrderRequest.subtotal:type_name -> inventory.Money
+	55, // 40: inventory.CreateOrderRequest.discount:type_name -> inventory.Money
+	55, // 41: inventory.CreateOrderRequest.tax:type_name -> inventory.Money
+	55, // 42: inventory.CreateOrderRequest.total:type_name -> inventory.Money
+	55, // 43: inventory.CreateOrderRequest.settlement_total:type_name -> inventory.Money
+	56, // 44: inventory.CreateOrderRequest.discounts:type_name -> inventory.DiscountLine
+	57, // 45: inventory.CreateOrderRequest.tax_lines:type_name -> inventory.TaxLine
+	2,  // 46: inventory.UpdateOrderStatusRequest.status:type_name -> inventory.OrderStatus
+	58, // 47: inventory.OrderResponse.order:type_name -> inventory.Order
+	58, // 48: inventory.ListOrdersResponse.orders:type_name -> inventory.Order
+	21, // 49: inventory.InventoryService.CreateInventoryItem:input_type -> inventory.CreateInventoryItemRequest
+	22, // 50: inventory.InventoryService.GetInventoryItem:input_type -> inventory.GetInventoryItemRequest
+	23, // 51: inventory.InventoryService.UpdateInventoryItem:input_type -> inventory.UpdateInventoryItemRequest
+	24, // 52: inventory.InventoryService.ListInventoryItems:input_type -> inventory.ListInventoryItemsRequest
+	26, // 53: inventory.InventoryService.GetInventoryHistory:input_type -> inventory.GetInventoryHistoryRequest
+	29, // 54: inventory.InventoryService.ReportLowStock:input_type -> inventory.ReportLowStockRequest
+	32, // 55: inventory.InventoryService.ListKafkaEvents:input_type -> inventory.ListKafkaEventsRequest
+	36, // 56: inventory.InventoryService.CheckStock:input_type -> inventory.CheckStockRequest
+	39, // 57: inventory.InventoryService.ReserveStock:input_type -> inventory.ReserveStockRequest
+	42, // 58: inventory.InventoryService.ReleaseStock:input_type -> inventory.ReleaseStockRequest
+	45, // 59: inventory.InventoryService.ReserveStockBatch:input_type -> inventory.ReserveStockBatchRequest
+	48, // 60: inventory.InventoryService.ReleaseOrderReservations:input_type -> inventory.ReleaseOrderReservationsRequest
+	52, // 61: inventory.InventoryService.ExtendReservation:input_type -> inventory.ExtendReservationRequest
+	53, // 62: inventory.InventoryService.ListReservations:input_type -> inventory.ListReservationsRequest
+	4,  // 63: inventory.InventoryService.CreateWarehouse:input_type -> inventory.CreateWarehouseRequest
+	5,  // 64: inventory.InventoryService.GetWarehouse:input_type -> inventory.GetWarehouseRequest
+	6,  // 65: inventory.InventoryService.UpdateWarehouse:input_type -> inventory.UpdateWarehouseRequest
+	7,  // 66: inventory.InventoryService.DeleteWarehouse:input_type -> inventory.DeleteWarehouseRequest
+	9,  // 67: inventory.InventoryService.ListWarehouses:input_type -> inventory.ListWarehousesRequest
+	14, // 68: inventory.InventoryService.CreateTransfer:input_type -> inventory.CreateTransferRequest
+	15, // 69: inventory.InventoryService.GetTransfer:input_type -> inventory.GetTransferRequest
+	16, // 70: inventory.InventoryService.ListTransfers:input_type -> inventory.ListTransfersRequest
+	17, // 71: inventory.InventoryService.UpdateTransferStatus:input_type -> inventory.UpdateTransferStatusRequest
+	60, // 72: inventory.OrderService.CreateOrder:input_type -> inventory.CreateOrderRequest
+	61, // 73: inventory.OrderService.GetOrder:input_type -> inventory.GetOrderRequest
+	64, // 74: inventory.OrderService.ListOrders:input_type -> inventory.ListOrdersRequest
+	62, // 75: inventory.OrderService.UpdateOrderStatus:input_type -> inventory.UpdateOrderStatusRequest
+	66, // 76: inventory.OrderService.ArchiveUserOrders:input_type -> inventory.UserOrdersRequest
+	66, // 77: inventory.OrderService.RestoreUserOrders:input_type -> inventory.UserOrdersRequest
+	67, // 78: inventory.OrderService.ReassignUserOrders:input_type -> inventory.ReassignUserOrdersRequest
+	34, // 79: inventory.InventoryService.CreateInventoryItem:output_type -> inventory.InventoryItemResponse
+	34, // 80: inventory.InventoryService.GetInventoryItem:output_type -> inventory.InventoryItemResponse
+	34, // 81: inventory.InventoryService.UpdateInventoryItem:output_type -> inventory.InventoryItemResponse
+	35, // 82: inventory.InventoryService.ListInventoryItems:output_type -> inventory.ListInventoryItemsResponse
+	27, // 83: inventory.InventoryService.GetInventoryHistory:output_type -> inventory.InventoryHistoryResponse
+	30, // 84: inventory.InventoryService.ReportLowStock:output_type -> inventory.ReportLowStockResponse
+	33, // 85: inventory.InventoryService.ListKafkaEvents:output_type -> inventory.ListKafkaEventsResponse
+	37, // 86: inventory.InventoryService.CheckStock:output_type -> inventory.CheckStockResponse
+	41, // 87: inventory.InventoryService.ReserveStock:output_type -> inventory.ReserveStockResponse
+	43, // 88: inventory.InventoryService.ReleaseStock:output_type -> inventory.ReleaseStockResponse
+	47, // 89: inventory.InventoryService.ReserveStockBatch:output_type -> inventory.ReserveStockBatchResponse
+	49, // 90: inventory.InventoryService.ReleaseOrderReservations:output_type -> inventory.ReleaseOrderReservationsResponse
+	51, // 91: inventory.InventoryService.ExtendReservation:output_type -> inventory.ReservationResponse
+	54, // 92: inventory.InventoryService.ListReservations:output_type -> inventory.ListReservationsResponse
+	10, // 93: inventory.InventoryService.CreateWarehouse:output_type -> inventory.WarehouseResponse
+	10, // 94: inventory.InventoryService.GetWarehouse:output_type -> inventory.WarehouseResponse
+	10, // 95: inventory.InventoryService.UpdateWarehouse:output_type -> inventory.WarehouseResponse
+	8,  // 96: inventory.InventoryService.DeleteWarehouse:output_type -> inventory.DeleteWarehouseResponse
+	11, // 97: inventory.InventoryService.ListWarehouses:output_type -> inventory.ListWarehousesResponse
+	18, // 98: inventory.InventoryService.CreateTransfer:output_type -> inventory.TransferResponse
+	18, // 99: inventory.InventoryService.GetTransfer:output_type -> inventory.TransferResponse
+	19, // 100: inventory.InventoryService.ListTransfers:output_type -> inventory.ListTransfersResponse
+	18, // 101: inventory.InventoryService.UpdateTransferStatus:output_type -> inventory.TransferResponse
+	63, // 102: inventory.OrderService.CreateOrder:output_type -> inventory.OrderResponse
+	63, // 103: inventory.OrderService.GetOrder:output_type -> inventory.OrderResponse
+	65, // 104: inventory.OrderService.ListOrders:output_type -> inventory.ListOrdersResponse
+	63, // 105: inventory.OrderService.UpdateOrderStatus:output_type -> inventory.OrderResponse
+	68, // 106: inventory.OrderService.ArchiveUserOrders:output_type -> inventory.UserOrdersResponse
+	68, // 107: inventory.OrderService.RestoreUserOrders:output_type -> inventory.UserOrdersResponse
+	68, // 108: inventory.OrderService.ReassignUserOrders:output_type -> inventory.UserOrdersResponse
+	79, // [79:109] is the sub-list for method output_type
+	49, // [49:79] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_inventory_proto_init() }
@@ -1522,8 +5894,8 @@ func file_inventory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_proto_rawDesc), len(file_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   21,
+			NumEnums:      3,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -0,0 +1,160 @@
+// Package rbac maps the roles stored on users to the permissions the API
+// Gateway checks before handling a request.
+package rbac
+
+import (
+	"fmt"
+	"sort"
+
+	"api-gateway/store"
+)
+
+// Permission names an action. Permissions ending in :own cover only records
+// the caller owns; the matching :any permission covers every record.
+type Permission string
+
+// Permissions checked by the gateway routes
+const (
+	// All grants every permission
+	All Permission = "*"
+
+	CatalogRead Permission = "catalog:read"
+	UsersCreate Permission = "users:create"
+
+	UsersReadOwn   Permission = "users:read:own"
+	UsersReadAny   Permission = "users:read:any"
+	UsersManageOwn Permission = "users:manage:own"
+	UsersManageAny Permission = "users:manage:any"
+
+	ProductsManageOwn Permission = "products:manage:own"
+	ProductsManageAny Permission = "products:manage:any"
+
+	InventoryRead      Permission = "inventory:read"
+	InventoryManageOwn Permission = "inventory:manage:own"
+	InventoryManageAny Permission = "inventory:manage:any"
+
+	OrdersCreateOwn Permission = "orders:create:own"
+	OrdersCreateAny Permission = "orders:create:any"
+	OrdersReadOwn   Permission = "orders:read:own"
+	OrdersReadAny   Permission = "orders:read:any"
+	OrdersManage    Permission = "orders:manage"
+
+	ReviewsWriteOwn Permission = "reviews:write:own"
+	ReviewsModerate Permission = "reviews:moderate"
+
+	CategoriesManage Permission = "categories:manage"
+	WarehousesManage Permission = "warehouses:manage"
+	PromotionsManage Permission = "promotions:manage"
+	SettingsManage   Permission = "settings:manage"
+	PrivacyRead      Permission = "privacy:read"
+	RolesManage      Permission = "roles:manage"
+	WebhooksManage   Permission = "webhooks:manage"
+
+	APIKeysManageOwn Permission = "api-keys:manage:own"
+	APIKeysManageAny Permission = "api-keys:manage:any"
+)
+
+// Built-in roles
+const (
+	// Guest is the role of callers that do not identify as a user
+	Guest = "guest"
+	// Customer is the role every new user starts with
+	Customer = "customer"
+	Seller   = "seller"
+	Admin    = "admin"
+)
+
+// Policy lists the permissions of each role
+type Policy struct {
+	roles map[string][]Permission
+}
+
+// DefaultPolicy returns the built-in policy: guests browse the catalog and
+// sign up, customers manage their own account, orders and reviews, sellers
+// additionally manage their own products, inventory and API keys, and admins
+// may do everything.
+func DefaultPolicy() *Policy {
+	guest := []Permission{CatalogRead, UsersCreate}
+	customer := append(append([]Permission{}, guest...),
+		UsersReadOwn, UsersManageOwn, OrdersCreateOwn, OrdersReadOwn, ReviewsWriteOwn)
+	seller := append(append([]Permission{}, customer...),
+		ProductsManageOwn, InventoryRead, InventoryManageOwn, APIKeysManageOwn)
+
+	return &Policy{roles: map[string][]Permission{
+		Guest:    guest,
+		Customer: customer,
+		Seller:   seller,
+		Admin:    {All},
+	}}
+}
+
+// LoadPolicy reads a policy from a JSON file mapping role names to
+// permissions. Without a path, or when the file does not exist, the default
+// policy is used. The guest role must be present so anonymous callers are
+// covered.
+func LoadPolicy(path string) (*Policy, error) {
+	if path == "" {
+		return DefaultPolicy(), nil
+	}
+	var roles map[string][]Permission
+	if err := store.Load(path, &roles); err != nil {
+		return nil, err
+	}
+	if roles == nil {
+		return DefaultPolicy(), nil
+	}
+	if _, ok := roles[Guest]; !ok {
+		return nil, fmt.Errorf("policy %s has no %s role", path, Guest)
+	}
+	return &Policy{roles: roles}, nil
+}
+
+// Has reports whether the policy defines the role
+func (p *Policy) Has(role string) bool {
+	_, ok := p.roles[role]
+	return ok
+}
+
+// Roles returns the names of all roles, sorted
+func (p *Policy) Roles() []string {
+	names := make([]string, 0, len(p.roles))
+	for name := range p.roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RolePermissions returns the permissions granted by one role
+func (p *Policy) RolePermissions(role string) []Permission {
+	return p.roles[role]
+}
+
+// Permissions returns the permissions granted by any of the roles, sorted
+// and without duplicates. Unknown roles grant nothing.
+func (p *Policy) Permissions(roles []string) []Permission {
+	seen := make(map[Permission]bool)
+	result := make([]Permission, 0)
+	for _, role := range roles {
+		for _, perm := range p.roles[role] {
+			if !seen[perm] {
+				seen[perm] = true
+				result = append(result, perm)
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+// Allows reports whether any of the roles grants the permission
+func (p *Policy) Allows(roles []string, perm Permission) bool {
+	for _, role := range roles {
+		for _, granted := range p.roles[role] {
+			if granted == perm || granted == All {
+				return true
+			}
+		}
+	}
+	return false
+}
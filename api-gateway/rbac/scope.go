@@ -0,0 +1,70 @@
+package rbac
+
+import "strings"
+
+// Scope limits what an API key may do to read or write access of one area of
+// the API, such as inventory:write. An API key acts as its user, so it may
+// only do what both its scopes and the user's roles allow.
+type Scope string
+
+// Areas of the API, each covering a route group
+const (
+	AreaUsers      = "users"
+	AreaProducts   = "products"
+	AreaCategories = "categories"
+	AreaInventory  = "inventory"
+	AreaWarehouses = "warehouses"
+	AreaOrders     = "orders"
+	AreaPromotions = "promotions"
+	AreaWebhooks   = "webhooks"
+	AreaAdmin      = "admin"
+)
+
+var areas = []string{
+	AreaUsers, AreaProducts, AreaCategories, AreaInventory,
+	AreaWarehouses, AreaOrders, AreaPromotions, AreaWebhooks, AreaAdmin,
+}
+
+// ReadScope returns the scope allowing reads of an area
+func ReadScope(area string) Scope {
+	return Scope(area + ":read")
+}
+
+// WriteScope returns the scope allowing reads and writes of an area
+func WriteScope(area string) Scope {
+	return Scope(area + ":write")
+}
+
+// Scopes returns every scope an API key can hold, by area
+func Scopes() []Scope {
+	scopes := make([]Scope, 0, 2*len(areas))
+	for _, area := range areas {
+		scopes = append(scopes, ReadScope(area), WriteScope(area))
+	}
+	return scopes
+}
+
+// ValidScope reports whether the scope exists
+func ValidScope(scope string) bool {
+	for _, known := range Scopes() {
+		if string(known) == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// ScopesAllow reports whether the scopes allow reading, or with write
+// changing, an area. A write scope includes reading.
+func ScopesAllow(scopes []string, area string, write bool) bool {
+	for _, scope := range scopes {
+		name, access, ok := strings.Cut(scope, ":")
+		if !ok || name != area {
+			continue
+		}
+		if access == "write" || (access == "read" && !write) {
+			return true
+		}
+	}
+	return false
+}
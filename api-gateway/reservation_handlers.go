@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/proto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// listReservations List Reservations
+// @Summary      List stock reservations
+// @Description  Get a paginated list of active stock reservations, soonest to expire first, optionally filtered by order, product or how soon they expire. Released, expired and fulfilled reservations are included with include_inactive.
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        page              query     int     false  "Page number"                                           default(1)
+// @Param        limit             query     int     false  "Items per page"                                        default(10)
+// @Param        order_id          query     string  false  "Order ID"
+// @Param        product_id        query     int     false  "Product ID"
+// @Param        expiring_within   query     string  false  "Only reservations expiring within this duration, e.g. 10m"
+// @Param        include_inactive  query     bool    false  "Include released, expired and fulfilled reservations"  default(false)
+// @Success      200               {object}  models.ReservationsListResponse
+// @Failure      400               {object}  models.ErrorResponse
+// @Failure      500               {object}  models.ErrorResponse
+// @Router       /inventory/reservations [get]
+func listReservations(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	productID, _ := strconv.Atoi(c.Query("product_id", "0"))
+
+	grpcReq := &proto.ListReservationsRequest{
+		Page:            int32(page),
+		Limit:           int32(limit),
+		OrderId:         c.Query("order_id"),
+		ProductId:       int32(productID),
+		IncludeInactive: c.QueryBool("include_inactive", false),
+	}
+	if value := c.Query("expiring_within"); value != "" {
+		within, err := time.ParseDuration(value)
+		if err != nil || within <= 0 {
+			return c.Status(400).JSON(fiber.Map{"error": "Invalid expiring_within: use a duration such as 10m"})
+		}
+		grpcReq.ExpiringWithinSeconds = int32(within.Seconds())
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.InventoryClient.ListReservations(ctx, grpcReq)
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"reservations": presentReservations(resp.Reservations),
+		"total":        resp.Total,
+		"page":         resp.Page,
+		"limit":        resp.Limit,
+	})
+}
+
+// extendReservation Extend Reservation
+// @Summary      Extend a stock reservation
+// @Description  Push back the expiry of an active reservation by extend_seconds, counted from its current expiry
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                           true  "Reservation ID"
+// @Param        request  body      models.ExtendReservationRequest  true  "Extension"
+// @Success      200      {object}  models.ReservationResponse
+// @Failure      400      {object}  models.ErrorResponse
+// @Failure      404      {object}  models.ErrorResponse
+// @Failure      409      {object}  models.ErrorResponse
+// @Failure      500      {object}  models.ErrorResponse
+// @Router       /inventory/reservations/{id}/extend [post]
+func extendReservation(c *fiber.Ctx) error {
+	var req models.ExtendReservationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.ExtendSeconds <= 0 {
+		return c.Status(400).JSON(fiber.Map{"error": "extend_seconds must be positive"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.InventoryClient.ExtendReservation(ctx, &proto.ExtendReservationRequest{
+		ReservationId: c.Params("id"),
+		ExtendSeconds: req.ExtendSeconds,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message":     resp.Message,
+		"reservation": presentReservation(resp.Reservation),
+	})
+}
+
+// reserveStockBatch Reserve Stock Batch
+// @Summary      Reserve stock for several products at once
+// @Description  Reserve every line of an order in one transaction: either all lines are reserved or none are. When stock is short, success is false and shortfalls lists each line that could not be covered. Strategy, shipping_location and ttl_seconds apply to every line as in reserve-stock. Lines name a product variant by variant_id or sku.
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        request  body      models.ReserveBatchRequest  true  "Order lines to reserve"
+// @Success      200      {object}  models.ReserveBatchResponse
+// @Failure      400      {object}  models.ErrorResponse
+// @Failure      404      {object}  models.ErrorResponse
+// @Failure      500      {object}  models.ErrorResponse
+// @Router       /inventory/reserve-batch [post]
+func reserveStockBatch(c *fiber.Ctx) error {
+	var req models.ReserveBatchRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.OrderID == "" || len(req.Lines) == 0 {
+		return c.Status(400).JSON(fiber.Map{"error": "order_id and at least one line are required"})
+	}
+
+	strategy, err := parseAllocationStrategy(req.Strategy)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	grpcReq := &proto.ReserveStockBatchRequest{
+		OrderId:    req.OrderID,
+		Strategy:   strategy,
+		TtlSeconds: req.TTLSeconds,
+	}
+	for _, line := range req.Lines {
+		if line.Quantity <= 0 {
+			return c.Status(400).JSON(fiber.Map{"error": "Quantity must be positive"})
+		}
+		productID, variantID, err := stockTarget(ctx, line.ProductID, line.VariantID, line.SKU)
+		if err != nil {
+			e := err.(*fiber.Error)
+			return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+		}
+		grpcReq.Lines = append(grpcReq.Lines, &proto.ReservationLine{
+			ProductId: productID,
+			VariantId: variantID,
+			Quantity:  line.Quantity,
+		})
+	}
+	if req.ShippingLocation != nil {
+		grpcReq.HasShippingLocation = true
+		grpcReq.ShippingLatitude = req.ShippingLocation.Latitude
+		grpcReq.ShippingLongitude = req.ShippingLocation.Longitude
+	}
+
+	resp, err := clients.InventoryClient.ReserveStockBatch(ctx, grpcReq)
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success":      resp.Success,
+		"message":      resp.Message,
+		"reservations": presentReservations(resp.Reservations),
+		"shortfalls":   presentShortfalls(resp.Shortfalls),
+		"expires_at":   resp.ExpiresAt,
+	})
+}
+
+// releaseStockBatch Release Stock Batch
+// @Summary      Release all reservations of an order
+// @Description  Release every active reservation held for an order
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        request  body      models.ReleaseBatchRequest  true  "Order to release"
+// @Success      200      {object}  models.ReleaseBatchResponse
+// @Failure      400      {object}  models.ErrorResponse
+// @Failure      500      {object}  models.ErrorResponse
+// @Router       /inventory/release-batch [post]
+func releaseStockBatch(c *fiber.Ctx) error {
+	var req models.ReleaseBatchRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.OrderID == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "order_id is required"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.InventoryClient.ReleaseOrderReservations(ctx, &proto.ReleaseOrderReservationsRequest{
+		OrderId: req.OrderID,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	reservationIDs := resp.ReservationIds
+	if reservationIDs == nil {
+		reservationIDs = []string{}
+	}
+	return c.JSON(fiber.Map{
+		"success":         resp.Success,
+		"message":         resp.Message,
+		"reservation_ids": reservationIDs,
+	})
+}
@@ -0,0 +1,95 @@
+// Package restock watches inventory levels, raises low-stock alerts and
+// suggests purchase quantities from recent sales.
+package restock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+// Alert reports an inventory item whose available stock has fallen to its
+// reorder point
+// @Description Inventory item at or below its reorder point
+type Alert struct {
+	InventoryItemID   int32     `json:"inventory_item_id" example:"1"`
+	ProductID         int32     `json:"product_id" example:"1"`
+	VariantID         int32     `json:"variant_id,omitempty" example:"7"`
+	WarehouseID       int32     `json:"warehouse_id" example:"1"`
+	Location          string    `json:"location" example:"WH-EAST"`
+	AvailableQuantity int32     `json:"available_quantity" example:"4"`
+	InTransitQuantity int32     `json:"in_transit_quantity" example:"0"`
+	ReorderPoint      int32     `json:"reorder_point" example:"10"`
+	ReorderQuantity   int32     `json:"reorder_quantity" example:"50"`
+	DetectedAt        time.Time `json:"detected_at" example:"2023-01-01T12:00:00Z"`
+} //@name LowStockAlert
+
+// Notifier delivers alerts to operators
+type Notifier interface {
+	Notify(ctx context.Context, alerts []Alert) error
+}
+
+// NotifierFunc adapts a function to the Notifier interface
+type NotifierFunc func(ctx context.Context, alerts []Alert) error
+
+// Notify calls f
+func (f NotifierFunc) Notify(ctx context.Context, alerts []Alert) error {
+	return f(ctx, alerts)
+}
+
+// LogNotifier writes alerts to the standard logger
+type LogNotifier struct{}
+
+// Notify logs one line per alert
+func (LogNotifier) Notify(_ context.Context, alerts []Alert) error {
+	for _, a := range alerts {
+		log.Printf("⚠️  Low stock: product %d in %s has %d available (reorder point %d, reorder quantity %d)",
+			a.ProductID, a.Location, a.AvailableQuantity, a.ReorderPoint, a.ReorderQuantity)
+	}
+	return nil
+}
+
+// Webhook posts alerts as JSON to a URL
+type Webhook struct {
+	URL    string
+	Client *http.Client
+}
+
+// webhookPayload is the body sent to the webhook
+type webhookPayload struct {
+	Type   string  `json:"type"`
+	Alerts []Alert `json:"alerts"`
+}
+
+// Notify posts all alerts in one request and fails on a non-2xx response
+func (w Webhook) Notify(ctx context.Context, alerts []Alert) error {
+	body, err := json.Marshal(webhookPayload{Type: "LOW_STOCK", Alerts: alerts})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("low-stock webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package restock
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// ScanFunc returns every inventory item currently at or below its reorder point
+type ScanFunc func(ctx context.Context) ([]Alert, error)
+
+// Monitor periodically scans inventory and notifies about items that have
+// newly fallen to their reorder point. An item is reported once and again
+// only after it has been restocked above its reorder point in between.
+type Monitor struct {
+	scan      ScanFunc
+	notifiers []Notifier
+	interval  time.Duration
+
+	mu     sync.Mutex
+	active map[int32]Alert
+}
+
+// NewMonitor creates a monitor that scans every interval
+func NewMonitor(interval time.Duration, scan ScanFunc, notifiers ...Notifier) *Monitor {
+	return &Monitor{
+		scan:      scan,
+		notifiers: notifiers,
+		interval:  interval,
+		active:    make(map[int32]Alert),
+	}
+}
+
+// Run scans until ctx is cancelled. Scan and notification errors are logged
+// and retried on the next tick.
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.Check(ctx); err != nil {
+			log.Printf("low-stock check failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check runs one scan, notifies about new alerts and returns them
+func (m *Monitor) Check(ctx context.Context) ([]Alert, error) {
+	scanCtx, cancel := context.WithTimeout(ctx, m.interval)
+	defer cancel()
+
+	current, err := m.scan(scanCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	var fresh []Alert
+	low := make(map[int32]Alert, len(current))
+	for _, a := range current {
+		low[a.InventoryItemID] = a
+		if _, seen := m.active[a.InventoryItemID]; !seen {
+			fresh = append(fresh, a)
+		}
+	}
+	m.active = low
+	m.mu.Unlock()
+
+	if len(fresh) == 0 {
+		return nil, nil
+	}
+
+	for _, n := range m.notifiers {
+		if err := n.Notify(scanCtx, fresh); err != nil {
+			log.Printf("low-stock notification failed: %v", err)
+		}
+	}
+	return fresh, nil
+}
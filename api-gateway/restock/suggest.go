@@ -0,0 +1,126 @@
+package restock
+
+import (
+	"math"
+	"sort"
+)
+
+// Reasons a product is suggested for reordering
+const (
+	// BelowReorderPoint means stock on hand and in transit is at or below
+	// the configured reorder point
+	BelowReorderPoint = "below_reorder_point"
+	// ProjectedStockout means recent sales would use up the stock before a
+	// new delivery could arrive
+	ProjectedStockout = "projected_stockout"
+)
+
+// Item identifies what is stocked and sold: a product, or one of its
+// variants when VariantID is set
+type Item struct {
+	ProductID int32
+	VariantID int32
+}
+
+// Stock is the inventory of one product or variant summed over its warehouses
+type Stock struct {
+	Item
+	Available       int32
+	InTransit       int32
+	ReorderPoint    int32
+	ReorderQuantity int32
+}
+
+// Params tune the suggestion model
+type Params struct {
+	// WindowDays is the sales history the daily velocity is averaged over
+	WindowDays int
+	// LeadTimeDays is how long a purchase order takes to arrive
+	LeadTimeDays int
+	// CoverageDays is how long a delivery should last once it arrives
+	CoverageDays int
+}
+
+// Suggestion is a proposed purchase for one product or variant
+// @Description Suggested purchase quantity for a product or variant
+type Suggestion struct {
+	ProductID         int32    `json:"product_id" example:"1"`
+	VariantID         int32    `json:"variant_id,omitempty" example:"7"`
+	Available         int32    `json:"available_quantity" example:"4"`
+	InTransit         int32    `json:"in_transit_quantity" example:"0"`
+	ReorderPoint      int32    `json:"reorder_point" example:"10"`
+	ReorderQuantity   int32    `json:"reorder_quantity" example:"50"`
+	UnitsSold         int64    `json:"units_sold" example:"60"`
+	DailyVelocity     float64  `json:"daily_velocity" example:"2"`
+	DaysOfCover       *float64 `json:"days_of_cover,omitempty" example:"2"`
+	SuggestedQuantity int32    `json:"suggested_quantity" example:"70"`
+	Reason            string   `json:"reason" enums:"below_reorder_point,projected_stockout" example:"below_reorder_point"`
+} //@name ReorderSuggestion
+
+// Suggest proposes purchase quantities for products and variants that are at
+// their reorder point or would sell out within the lead time. The quantity
+// covers the expected demand over lead time plus coverage, less the stock on
+// hand and in transit, and is never below the configured reorder quantity.
+// sold holds the units sold per product or variant over the window.
+// Suggestions are ordered by days of cover, most urgent first.
+func Suggest(stock []Stock, sold map[Item]int64, p Params) []Suggestion {
+	window := float64(max(p.WindowDays, 1))
+
+	suggestions := make([]Suggestion, 0)
+	for _, s := range stock {
+		units := sold[s.Item]
+		velocity := float64(units) / window
+		projected := float64(s.Available + s.InTransit)
+
+		var reason string
+		switch {
+		case s.ReorderPoint > 0 && projected <= float64(s.ReorderPoint):
+			reason = BelowReorderPoint
+		case velocity > 0 && projected < velocity*float64(p.LeadTimeDays):
+			reason = ProjectedStockout
+		default:
+			continue
+		}
+
+		demand := math.Ceil(velocity * float64(p.LeadTimeDays+p.CoverageDays))
+		quantity := max(int32(demand-projected), s.ReorderQuantity)
+		if quantity <= 0 {
+			// nothing sold and no reorder quantity: restore the reorder point
+			quantity = max(s.ReorderPoint-int32(projected), 1)
+		}
+
+		suggestion := Suggestion{
+			ProductID:         s.ProductID,
+			VariantID:         s.VariantID,
+			Available:         s.Available,
+			InTransit:         s.InTransit,
+			ReorderPoint:      s.ReorderPoint,
+			ReorderQuantity:   s.ReorderQuantity,
+			UnitsSold:         units,
+			DailyVelocity:     round2(velocity),
+			SuggestedQuantity: quantity,
+			Reason:            reason,
+		}
+		if velocity > 0 {
+			cover := round2(math.Max(projected, 0) / velocity)
+			suggestion.DaysOfCover = &cover
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		a, b := suggestions[i].DaysOfCover, suggestions[j].DaysOfCover
+		switch {
+		case a == nil:
+			return false
+		case b == nil:
+			return true
+		}
+		return *a < *b
+	})
+	return suggestions
+}
+
+func round2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
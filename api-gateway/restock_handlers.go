@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"api-gateway/proto"
+	"api-gateway/restock"
+	"api-gateway/tenant"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// scanPageSize is the page size used when walking inventory and orders
+const scanPageSize = 100
+
+// orderTimeLayout is how the inventory service formats timestamps (naive UTC)
+const orderTimeLayout = "2006-01-02T15:04:05.999999"
+
+// startLowStockMonitor scans inventory in the background and reports items
+// that fall to their reorder point to the log, to Kafka through the
+// inventory service, to the configured webhook and to webhook subscribers.
+// Each storefront is scanned on its own, so its alerts reach its webhooks.
+func startLowStockMonitor() {
+	if cfg.LowStockCheckInterval <= 0 {
+		log.Println("Low-stock monitor disabled")
+		return
+	}
+
+	notifiers := []restock.Notifier{restock.LogNotifier{}, restock.NotifierFunc(publishLowStock), restock.NotifierFunc(queueLowStockWebhooks)}
+	if cfg.LowStockWebhookURL != "" {
+		notifiers = append(notifiers, restock.Webhook{
+			URL:    cfg.LowStockWebhookURL,
+			Client: &http.Client{Timeout: 10 * time.Second},
+		})
+	}
+
+	for _, t := range tenants.All() {
+		monitor := restock.NewMonitor(cfg.LowStockCheckInterval, scanLowStock, notifiers...)
+		go monitor.Run(tenant.NewContext(context.Background(), t))
+	}
+	log.Printf("Low-stock monitor checking every %s", cfg.LowStockCheckInterval)
+}
+
+// scanLowStock lists every inventory item at or below its reorder point
+func scanLowStock(ctx context.Context) ([]restock.Alert, error) {
+	items, err := listAllInventoryItems(ctx, &proto.ListInventoryItemsRequest{LowStockOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	alerts := make([]restock.Alert, 0, len(items))
+	for _, item := range items {
+		alerts = append(alerts, restock.Alert{
+			InventoryItemID:   item.Id,
+			ProductID:         item.ProductId,
+			VariantID:         item.VariantId,
+			WarehouseID:       item.WarehouseId,
+			Location:          item.Location,
+			AvailableQuantity: item.AvailableQuantity,
+			InTransitQuantity: item.InTransitQuantity,
+			ReorderPoint:      item.ReorderPoint,
+			ReorderQuantity:   item.ReorderQuantity,
+			DetectedAt:        now,
+		})
+	}
+	return alerts, nil
+}
+
+// publishLowStock has the inventory service publish LOW_STOCK events to
+// Kafka, since the gateway has no producer of its own
+func publishLowStock(ctx context.Context, alerts []restock.Alert) error {
+	req := &proto.ReportLowStockRequest{}
+	for _, a := range alerts {
+		req.Alerts = append(req.Alerts, &proto.LowStockAlert{
+			InventoryItemId:   a.InventoryItemID,
+			ProductId:         a.ProductID,
+			VariantId:         a.VariantID,
+			WarehouseId:       a.WarehouseID,
+			Location:          a.Location,
+			AvailableQuantity: a.AvailableQuantity,
+			InTransitQuantity: a.InTransitQuantity,
+			ReorderPoint:      a.ReorderPoint,
+			ReorderQuantity:   a.ReorderQuantity,
+			DetectedAt:        a.DetectedAt.Format(time.RFC3339),
+		})
+	}
+	_, err := clients.InventoryClient.ReportLowStock(ctx, req)
+	return err
+}
+
+// listAllInventoryItems walks every page of ListInventoryItems
+func listAllInventoryItems(ctx context.Context, req *proto.ListInventoryItemsRequest) ([]*proto.InventoryItem, error) {
+	var items []*proto.InventoryItem
+	req.Limit = scanPageSize
+	for page := int32(1); ; page++ {
+		req.Page = page
+		resp, err := clients.InventoryClient.ListInventoryItems(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, resp.Items...)
+		if len(resp.Items) < scanPageSize || int32(len(items)) >= resp.Total {
+			return items, nil
+		}
+	}
+}
+
+// unitsSoldSince sums the quantities per product or variant of orders placed
+// since the given time, leaving out cancelled orders
+func unitsSoldSince(ctx context.Context, since time.Time) (map[restock.Item]int64, error) {
+	sold := make(map[restock.Item]int64)
+	for page := int32(1); ; page++ {
+		resp, err := clients.OrderClient.ListOrders(ctx, &proto.ListOrdersRequest{Page: page, Limit: scanPageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		// orders are listed newest first, so stop at the first older one
+		for _, order := range resp.Orders {
+			createdAt, err := time.Parse(orderTimeLayout, order.CreatedAt)
+			if err == nil && createdAt.Before(since) {
+				return sold, nil
+			}
+			if order.Status == proto.OrderStatus_CANCELLED {
+				continue
+			}
+			for _, item := range order.Items {
+				sold[restock.Item{ProductID: item.ProductId, VariantID: item.VariantId}] += int64(item.Quantity)
+			}
+		}
+		if len(resp.Orders) < scanPageSize {
+			return sold, nil
+		}
+	}
+}
+
+// getReorderSuggestions Get Reorder Suggestions
+// @Summary      Suggest purchase quantities
+// @Description  Suggest what to reorder from current stock, reorder settings and the sales velocity of recent orders. Products, and each variant on its own, are listed when stock on hand and in transit is at or below their reorder point, or would sell out before a delivery ordered today arrives.
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        days            query     int  false  "Days of order history to average sales over"  default(30)
+// @Param        lead_time_days  query     int  false  "Days a purchase order takes to arrive"         default(7)
+// @Param        coverage_days   query     int  false  "Days a delivery should last"                   default(30)
+// @Success      200             {object}  models.ReorderSuggestionsResponse
+// @Failure      400             {object}  models.ErrorResponse
+// @Failure      500             {object}  models.ErrorResponse
+// @Router       /inventory/reorder-suggestions [get]
+func getReorderSuggestions(c *fiber.Ctx) error {
+	params := restock.Params{WindowDays: 30, LeadTimeDays: 7, CoverageDays: 30}
+	for name, target := range map[string]*int{
+		"days":           &params.WindowDays,
+		"lead_time_days": &params.LeadTimeDays,
+		"coverage_days":  &params.CoverageDays,
+	} {
+		if value := c.Query(name); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 || (name == "days" && n == 0) {
+				return c.Status(400).JSON(fiber.Map{"error": "Invalid " + name})
+			}
+			*target = n
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 15*time.Second)
+	defer cancel()
+
+	items, err := listAllInventoryItems(ctx, &proto.ListInventoryItemsRequest{})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+	sold, err := unitsSoldSince(ctx, time.Now().UTC().AddDate(0, 0, -params.WindowDays))
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	// sum stock and reorder settings over the warehouses of each product or
+	// variant, so a sold-out variant is not hidden by the stock of the others
+	var stock []restock.Stock
+	index := make(map[restock.Item]int)
+	for _, item := range items {
+		key := restock.Item{ProductID: item.ProductId, VariantID: item.VariantId}
+		i, ok := index[key]
+		if !ok {
+			i = len(stock)
+			index[key] = i
+			stock = append(stock, restock.Stock{Item: key})
+		}
+		stock[i].Available += item.AvailableQuantity
+		stock[i].InTransit += item.InTransitQuantity
+		stock[i].ReorderPoint += item.ReorderPoint
+		stock[i].ReorderQuantity += item.ReorderQuantity
+	}
+
+	return c.JSON(fiber.Map{
+		"window_days":    params.WindowDays,
+		"lead_time_days": params.LeadTimeDays,
+		"coverage_days":  params.CoverageDays,
+		"suggestions":    restock.Suggest(stock, sold, params),
+	})
+}
@@ -0,0 +1,336 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/proto"
+	"api-gateway/rbac"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// deliveredOrder finds a delivered order of the user containing the
+// product and returns its ID, or "" when there is none. Only such
+// customers may review a product.
+func deliveredOrder(ctx context.Context, userID, productID int32) (string, error) {
+	const pageSize = 100
+	for page := int32(1); ; page++ {
+		resp, err := clients.OrderClient.ListOrders(ctx, &proto.ListOrdersRequest{
+			UserId: userID,
+			Page:   page,
+			Limit:  pageSize,
+		})
+		if err != nil {
+			return "", err
+		}
+		for _, order := range resp.Orders {
+			if order.Status != proto.OrderStatus_DELIVERED {
+				continue
+			}
+			for _, item := range order.Items {
+				if item.ProductId == productID {
+					return order.Id, nil
+				}
+			}
+		}
+		if page*pageSize >= resp.Total || len(resp.Orders) == 0 {
+			return "", nil
+		}
+	}
+}
+
+// productReview fetches a review and checks it belongs to the product in
+// the path
+func productReview(ctx context.Context, c *fiber.Ctx) (*proto.Review, error) {
+	productID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid product ID")
+	}
+	reviewID, err := strconv.Atoi(c.Params("reviewId"))
+	if err != nil || reviewID <= 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid review ID")
+	}
+
+	resp, err := clients.ProductClient.GetReview(ctx, &proto.GetReviewRequest{ReviewId: int32(reviewID)})
+	if status.Code(err) == codes.NotFound {
+		return nil, fiber.NewError(fiber.StatusNotFound, status.Convert(err).Message())
+	}
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	if resp.Review.ProductId != int32(productID) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Review not found")
+	}
+	return resp.Review, nil
+}
+
+// createReview Create Review
+// @Summary      Review a product
+// @Description  Rate a product from 1 to 5 stars with an optional title and text. Only users with a delivered order containing the product can review it, once per product; the review is published right away.
+// @Tags         Reviews
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int                         true  "Product ID"
+// @Param        review  body      models.CreateReviewRequest  true  "Review"
+// @Success      201     {object}  models.ReviewResponse
+// @Failure      400     {object}  models.ErrorResponse
+// @Failure      403     {object}  models.ErrorResponse
+// @Failure      404     {object}  models.ErrorResponse
+// @Failure      409     {object}  models.ErrorResponse
+// @Failure      500     {object}  models.ErrorResponse
+// @Router       /products/{id}/reviews [post]
+func createReview(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+
+	var req models.CreateReviewRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.UserID <= 0 {
+		return c.Status(400).JSON(fiber.Map{"error": "user_id is required"})
+	}
+	if req.Rating < 1 || req.Rating > 5 {
+		return c.Status(400).JSON(fiber.Map{"error": "Rating must be between 1 and 5"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	if _, err := variantProduct(ctx, int32(id)); err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+	orderID, err := deliveredOrder(ctx, req.UserID, int32(id))
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": "Cannot verify purchase: " + err.Error()})
+	}
+	if orderID == "" {
+		return c.Status(403).JSON(fiber.Map{"error": "Only customers with a delivered order of this product can review it"})
+	}
+
+	resp, err := clients.ProductClient.CreateReview(ctx, &proto.CreateReviewRequest{
+		ProductId: int32(id),
+		UserId:    req.UserID,
+		Rating:    req.Rating,
+		Title:     req.Title,
+		Body:      req.Body,
+		OrderId:   orderID,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"review":  presentReview(resp.Review),
+	})
+}
+
+// listReviews List Reviews
+// @Summary      List the reviews of a product
+// @Description  Get a page of the approved reviews of a product with its rating summary. Moderators pass status PENDING, REJECTED or ALL to see other reviews.
+// @Tags         Reviews
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int     true   "Product ID"
+// @Param        page    query     int     false  "Page number"  default(1)
+// @Param        limit   query     int     false  "Items per page"  default(10)
+// @Param        sort    query     string  false  "Sort order"  Enums(newest, oldest, highest, lowest)  default(newest)
+// @Param        status  query     string  false  "Moderation status"  Enums(APPROVED, PENDING, REJECTED, ALL)  default(APPROVED)
+// @Success      200     {object}  models.ReviewsListResponse
+// @Failure      400     {object}  models.ErrorResponse
+// @Failure      401     {object}  models.ErrorResponse
+// @Failure      403     {object}  models.ErrorResponse
+// @Failure      500     {object}  models.ErrorResponse
+// @Router       /products/{id}/reviews [get]
+func listReviews(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+
+	// Reviews awaiting or refused moderation are for moderators only
+	status := strings.ToUpper(strings.TrimSpace(c.Query("status")))
+	if who := callerOf(c); status != "" && status != "APPROVED" && !policy.Allows(who.Roles, rbac.ReviewsModerate) {
+		return denied(c, who, "Permission denied: only moderators see reviews that are not approved")
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.ListReviews(ctx, &proto.ListReviewsRequest{
+		ProductId: int32(id),
+		Page:      int32(page),
+		Limit:     int32(limit),
+		Sort:      strings.ToLower(c.Query("sort")),
+		Status:    status,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+	summary, err := clients.ProductClient.GetReviewSummary(ctx, &proto.GetReviewSummaryRequest{ProductId: int32(id)})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"reviews": presentReviews(resp.Reviews),
+		"summary": presentReviewSummary(summary),
+		"total":   resp.Total,
+		"page":    resp.Page,
+		"limit":   resp.Limit,
+	})
+}
+
+// updateReview Update Review
+// @Summary      Update a review
+// @Description  Change the rating, title or text of a review; only its author and moderators can. A rejected review goes back to moderation once its author changes it.
+// @Tags         Reviews
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int                         true  "Product ID"
+// @Param        reviewId  path      int                         true  "Review ID"
+// @Param        review    body      models.UpdateReviewRequest  true  "Review update"
+// @Success      200       {object}  models.ReviewResponse
+// @Failure      400       {object}  models.ErrorResponse
+// @Failure      403       {object}  models.ErrorResponse
+// @Failure      404       {object}  models.ErrorResponse
+// @Failure      500       {object}  models.ErrorResponse
+// @Router       /products/{id}/reviews/{reviewId} [put]
+func updateReview(c *fiber.Ctx) error {
+	var req models.UpdateReviewRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.Rating != 0 && (req.Rating < 1 || req.Rating > 5) {
+		return c.Status(400).JSON(fiber.Map{"error": "Rating must be between 1 and 5"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	review, err := productReview(ctx, c)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	who := callerOf(c)
+	resp, err := clients.ProductClient.UpdateReview(ctx, &proto.UpdateReviewRequest{
+		ReviewId:  review.Id,
+		UserId:    who.ID,
+		Rating:    req.Rating,
+		Title:     req.Title,
+		Body:      req.Body,
+		Moderator: policy.Allows(who.Roles, rbac.ReviewsModerate),
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"review":  presentReview(resp.Review),
+	})
+}
+
+// deleteReview Delete Review
+// @Summary      Delete a review
+// @Description  Delete a review; only its author and moderators can. Moderators usually hide reviews by rejecting them instead.
+// @Tags         Reviews
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int  true  "Product ID"
+// @Param        reviewId  path      int  true  "Review ID"
+// @Success      200       {object}  models.SuccessResponse
+// @Failure      400       {object}  models.ErrorResponse
+// @Failure      403       {object}  models.ErrorResponse
+// @Failure      404       {object}  models.ErrorResponse
+// @Failure      500       {object}  models.ErrorResponse
+// @Router       /products/{id}/reviews/{reviewId} [delete]
+func deleteReview(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	review, err := productReview(ctx, c)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	who := callerOf(c)
+	resp, err := clients.ProductClient.DeleteReview(ctx, &proto.DeleteReviewRequest{
+		ReviewId:  review.Id,
+		UserId:    who.ID,
+		Moderator: policy.Allows(who.Roles, rbac.ReviewsModerate),
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+	})
+}
+
+// moderateReview Moderate Review
+// @Summary      Moderate a review
+// @Description  Approve, reject or hold a review for moderation. Only approved reviews are listed publicly and count towards the product rating.
+// @Tags         Reviews
+// @Accept       json
+// @Produce      json
+// @Param        id          path      int                           true  "Product ID"
+// @Param        reviewId    path      int                           true  "Review ID"
+// @Param        moderation  body      models.ModerateReviewRequest  true  "Moderation decision"
+// @Success      200         {object}  models.ReviewResponse
+// @Failure      400         {object}  models.ErrorResponse
+// @Failure      404         {object}  models.ErrorResponse
+// @Failure      500         {object}  models.ErrorResponse
+// @Router       /products/{id}/reviews/{reviewId}/moderation [put]
+func moderateReview(c *fiber.Ctx) error {
+	var req models.ModerateReviewRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if strings.TrimSpace(req.Status) == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "status is required"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	review, err := productReview(ctx, c)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	resp, err := clients.ProductClient.ModerateReview(ctx, &proto.ModerateReviewRequest{
+		ReviewId: review.Id,
+		Status:   strings.ToUpper(req.Status),
+		Note:     req.Note,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"review":  presentReview(resp.Review),
+	})
+}
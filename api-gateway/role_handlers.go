@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/proto"
+	"api-gateway/rbac"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// updateUserRoles grants and revokes roles and answers with the user's
+// resulting roles and permissions
+func updateUserRoles(c *fiber.Ctx, grant, revoke []string) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.UpdateUserRoles(ctx, &proto.UpdateUserRolesRequest{
+		UserId: int32(id),
+		Grant:  grant,
+		Revoke: revoke,
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !resp.Success {
+		if resp.Message == "User not found" {
+			return c.Status(404).JSON(fiber.Map{"error": resp.Message})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": resp.Message})
+	}
+
+	return c.JSON(fiber.Map{
+		"user_id":     resp.User.Id,
+		"roles":       resp.User.Roles,
+		"permissions": policy.Permissions(resp.User.Roles),
+	})
+}
+
+// listRoles List Roles
+// @Summary      List roles
+// @Description  Get the roles users can hold and the permissions each grants. Permissions ending in :own only cover the caller's own records.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.RolesListResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Router       /admin/roles [get]
+func listRoles(c *fiber.Ctx) error {
+	roles := make([]models.Role, 0)
+	for _, name := range policy.Roles() {
+		roles = append(roles, models.Role{
+			Name:        name,
+			Permissions: policy.RolePermissions(name),
+		})
+	}
+	return c.JSON(fiber.Map{
+		"roles": roles,
+	})
+}
+
+// getUserRoles Get User Roles
+// @Summary      Get the roles of a user
+// @Description  Get the roles a user holds and the permissions they add up to
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Success      200  {object}  models.UserRolesResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /admin/users/{id}/roles [get]
+func getUserRoles(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.UserClient.GetUser(ctx, &proto.GetUserRequest{UserId: int32(id)})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !resp.Found {
+		return c.Status(404).JSON(fiber.Map{"error": "User not found"})
+	}
+
+	return c.JSON(fiber.Map{
+		"user_id":     resp.User.Id,
+		"roles":       resp.User.Roles,
+		"permissions": policy.Permissions(resp.User.Roles),
+	})
+}
+
+// grantRole Grant Role
+// @Summary      Grant a role to a user
+// @Description  Add a role such as seller or admin to a user. Granting a role the user already holds changes nothing.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                     true  "User ID"
+// @Param        role  body      models.GrantRoleRequest  true  "Role to grant"
+// @Success      200   {object}  models.UserRolesResponse
+// @Failure      400   {object}  models.ErrorResponse
+// @Failure      401   {object}  models.ErrorResponse
+// @Failure      403   {object}  models.ErrorResponse
+// @Failure      404   {object}  models.ErrorResponse
+// @Failure      500   {object}  models.ErrorResponse
+// @Router       /admin/users/{id}/roles [post]
+func grantRole(c *fiber.Ctx) error {
+	var req models.GrantRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	role := strings.ToLower(strings.TrimSpace(req.Role))
+	if err := checkRole(role); err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	return updateUserRoles(c, []string{role}, nil)
+}
+
+// revokeRole Revoke Role
+// @Summary      Revoke a role from a user
+// @Description  Remove a role from a user. Admins cannot revoke their own admin role, so there is always someone left to grant roles.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int     true  "User ID"
+// @Param        role  path      string  true  "Role"
+// @Success      200   {object}  models.UserRolesResponse
+// @Failure      400   {object}  models.ErrorResponse
+// @Failure      401   {object}  models.ErrorResponse
+// @Failure      403   {object}  models.ErrorResponse
+// @Failure      404   {object}  models.ErrorResponse
+// @Failure      409   {object}  models.ErrorResponse
+// @Failure      500   {object}  models.ErrorResponse
+// @Router       /admin/users/{id}/roles/{role} [delete]
+func revokeRole(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+	role := strings.ToLower(strings.TrimSpace(c.Params("role")))
+	if err := checkRole(role); err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+	if role == rbac.Admin && int32(id) == callerOf(c).ID {
+		return c.Status(409).JSON(fiber.Map{"error": "You cannot revoke your own admin role"})
+	}
+
+	return updateUserRoles(c, nil, []string{role})
+}
@@ -0,0 +1,216 @@
+package tax
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"api-gateway/money"
+	"api-gateway/store"
+)
+
+// Level is where rounding is applied
+type Level string
+
+const (
+	// PerLine rounds the tax of every order line, then adds them up
+	PerLine Level = "line"
+	// PerTotal adds up exact tax per rate and rounds once
+	PerTotal Level = "total"
+)
+
+// Jurisdiction holds the rates and rounding rules of a tax region
+type Jurisdiction struct {
+	Name string `json:"name"`
+	// Inclusive is true when catalog prices in this region include tax
+	Inclusive bool     `json:"inclusive"`
+	Rounding  Rounding `json:"rounding"`
+	Level     Level    `json:"level"`
+	// Rates maps a product tax category to a decimal rate, such as "0.19"
+	Rates map[string]string `json:"rates"`
+}
+
+// TableConfig is the layout of the tax rates file
+type TableConfig struct {
+	// DefaultRegion is used when an order does not name a region;
+	// when empty such orders are not taxed
+	DefaultRegion string                  `json:"default_region"`
+	Jurisdictions map[string]Jurisdiction `json:"jurisdictions"`
+}
+
+// Table calculates tax from a fixed table of rates
+type Table struct {
+	defaultRegion string
+	jurisdictions map[string]jurisdiction
+}
+
+type jurisdiction struct {
+	Jurisdiction
+	rates map[string]*big.Rat
+}
+
+// LoadTable reads a rate table from a JSON file. A missing file yields an
+// empty table that charges no tax.
+func LoadTable(path string) (*Table, error) {
+	var config TableConfig
+	if err := store.Load(path, &config); err != nil {
+		return nil, err
+	}
+	table, err := NewTable(config)
+	if err != nil {
+		return nil, fmt.Errorf("load %s: %w", path, err)
+	}
+	return table, nil
+}
+
+// NewTable validates a rate table
+func NewTable(config TableConfig) (*Table, error) {
+	t := &Table{
+		defaultRegion: strings.ToUpper(config.DefaultRegion),
+		jurisdictions: make(map[string]jurisdiction, len(config.Jurisdictions)),
+	}
+
+	for region, j := range config.Jurisdictions {
+		region = strings.ToUpper(region)
+		switch j.Rounding {
+		case "":
+			j.Rounding = HalfUp
+		case HalfUp, HalfEven, Up, Down:
+		default:
+			return nil, fmt.Errorf("%w: %s has unknown rounding %q", ErrInvalidTable, region, j.Rounding)
+		}
+		switch j.Level {
+		case "":
+			j.Level = PerLine
+		case PerLine, PerTotal:
+		default:
+			return nil, fmt.Errorf("%w: %s has unknown rounding level %q", ErrInvalidTable, region, j.Level)
+		}
+
+		parsed := jurisdiction{Jurisdiction: j, rates: make(map[string]*big.Rat, len(j.Rates))}
+		for category, value := range j.Rates {
+			rate, ok := new(big.Rat).SetString(value)
+			if !ok || rate.Sign() < 0 {
+				return nil, fmt.Errorf("%w: %s rate for %s is %q", ErrInvalidTable, region, category, value)
+			}
+			parsed.rates[strings.ToLower(category)] = rate
+		}
+		t.jurisdictions[region] = parsed
+	}
+
+	if _, ok := t.jurisdictions[t.defaultRegion]; t.defaultRegion != "" && !ok {
+		return nil, fmt.Errorf("%w: default region %s has no rates", ErrInvalidTable, t.defaultRegion)
+	}
+	return t, nil
+}
+
+// Supports reports whether rates are configured for the region
+func (t *Table) Supports(region string) bool {
+	_, ok := t.jurisdictions[strings.ToUpper(region)]
+	return ok
+}
+
+// Calculate computes the tax per category. Products in a category without
+// a rate are taxed at the standard rate of the region.
+func (t *Table) Calculate(req Request) (Result, error) {
+	region := strings.ToUpper(req.Region)
+	if region == "" {
+		region = t.defaultRegion
+	}
+	if region == "" {
+		return None{}.Calculate(req)
+	}
+	j, ok := t.jurisdictions[region]
+	if !ok {
+		return Result{}, fmt.Errorf("%w: %s", ErrUnknownRegion, region)
+	}
+
+	type group struct {
+		category string
+		rate     *big.Rat
+		taxable  money.Money
+		exact    *big.Rat
+		rounded  money.Money
+	}
+	groups := make(map[string]*group)
+
+	for _, line := range req.Lines {
+		category := strings.ToLower(line.Category)
+		if category == "" {
+			category = DefaultCategory
+		}
+		rate, ok := j.rates[category]
+		if !ok {
+			category = DefaultCategory
+			rate = j.rates[DefaultCategory]
+		}
+		if rate == nil {
+			rate = new(big.Rat)
+		}
+
+		key := category + "@" + rate.RatString()
+		g, ok := groups[key]
+		if !ok {
+			g = &group{
+				category: category,
+				rate:     rate,
+				taxable:  money.Zero(req.Currency),
+				exact:    new(big.Rat),
+				rounded:  money.Zero(req.Currency),
+			}
+			groups[key] = g
+		}
+
+		amount := lineTax(line.Amount.Rat(), rate, j.Inclusive)
+		g.taxable = g.taxable.Add(line.Amount)
+		g.exact.Add(g.exact, amount)
+		g.rounded = g.rounded.Add(j.Rounding.round(amount, req.Currency))
+	}
+
+	result := Result{
+		Region:           region,
+		PricesIncludeTax: j.Inclusive,
+		Lines:            []TaxLine{},
+		Total:            money.Zero(req.Currency),
+	}
+	for _, g := range groups {
+		amount := g.rounded
+		if j.Level == PerTotal {
+			amount = j.Rounding.round(g.exact, req.Currency)
+		}
+		result.Lines = append(result.Lines, TaxLine{
+			Region:   region,
+			Category: g.category,
+			Name:     j.Name,
+			Rate:     formatRate(g.rate),
+			Taxable:  g.taxable,
+			Amount:   amount,
+		})
+		result.Total = result.Total.Add(amount)
+	}
+	sort.Slice(result.Lines, func(a, b int) bool {
+		if result.Lines[a].Category == result.Lines[b].Category {
+			return result.Lines[a].Rate < result.Lines[b].Rate
+		}
+		return result.Lines[a].Category < result.Lines[b].Category
+	})
+
+	return result, nil
+}
+
+// lineTax returns the exact tax on an amount. For inclusive prices the tax
+// is the part of the amount above amount / (1 + rate).
+func lineTax(amount, rate *big.Rat, inclusive bool) *big.Rat {
+	if !inclusive {
+		return new(big.Rat).Mul(amount, rate)
+	}
+	divisor := new(big.Rat).Add(big.NewRat(1, 1), rate)
+	net := new(big.Rat).Quo(amount, divisor)
+	return net.Sub(amount, net)
+}
+
+func formatRate(rate *big.Rat) string {
+	s := rate.FloatString(6)
+	return strings.TrimRight(strings.TrimRight(s, "0"), ".")
+}
@@ -0,0 +1,112 @@
+// Package tax calculates sales tax and VAT for orders. Calculators are
+// pluggable; the table-driven implementation reads rates per region and
+// product tax category from a JSON file.
+package tax
+
+import (
+	"errors"
+	"math/big"
+
+	"api-gateway/money"
+)
+
+// DefaultCategory is the tax category of products that do not set one
+const DefaultCategory = "standard"
+
+var (
+	// ErrUnknownRegion is returned when no rates are configured for a region
+	ErrUnknownRegion = errors.New("unsupported tax region")
+	// ErrInvalidTable is returned when the rate table is inconsistent
+	ErrInvalidTable = errors.New("invalid tax table")
+)
+
+// Calculator computes the tax on a set of order lines
+type Calculator interface {
+	Calculate(req Request) (Result, error)
+}
+
+// Request describes the lines to tax. Line amounts are what the customer
+// pays for the line after discounts, in the request currency.
+type Request struct {
+	Region   string
+	Currency string
+	Lines    []Line
+}
+
+// Line is a taxable amount for one product
+type Line struct {
+	ProductID int32
+	Category  string
+	Amount    money.Money
+}
+
+// Result is the tax due on a request
+type Result struct {
+	Region string
+	// PricesIncludeTax is true when line amounts already contain the tax,
+	// as with VAT-inclusive shelf prices
+	PricesIncludeTax bool
+	Lines            []TaxLine
+	Total            money.Money
+}
+
+// TaxLine is the tax due for one category at one rate
+// @Description Tax charged at one rate
+type TaxLine struct {
+	Region   string      `json:"region" example:"DE"`
+	Category string      `json:"category" example:"standard"`
+	Name     string      `json:"name" example:"VAT"`
+	Rate     string      `json:"rate" example:"0.19"`
+	Taxable  money.Money `json:"taxable"`
+	Amount   money.Money `json:"amount"`
+} //@name TaxLine
+
+// None is a calculator that never charges tax
+type None struct{}
+
+// Calculate returns a zero result
+func (None) Calculate(req Request) (Result, error) {
+	return Result{Region: req.Region, Lines: []TaxLine{}, Total: money.Zero(req.Currency)}, nil
+}
+
+// Rounding is how fractional minor units of tax are rounded
+type Rounding string
+
+const (
+	// HalfUp rounds halves away from zero
+	HalfUp Rounding = "half_up"
+	// HalfEven rounds halves to the nearest even unit
+	HalfEven Rounding = "half_even"
+	// Up always rounds up
+	Up Rounding = "up"
+	// Down always rounds down
+	Down Rounding = "down"
+)
+
+// round converts an amount to whole minor units of the currency
+func (r Rounding) round(amount *big.Rat, code string) money.Money {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(money.Exponent(code))), nil)
+	minor := new(big.Rat).Mul(amount, new(big.Rat).SetInt(scale))
+
+	q, m := new(big.Int).QuoRem(minor.Num(), minor.Denom(), new(big.Int))
+	if m.Sign() == 0 {
+		return money.New(q.Int64(), code)
+	}
+
+	// amounts are never negative here, so truncation rounds down
+	twice := new(big.Int).Mul(m, big.NewInt(2))
+	switch r {
+	case Up:
+		q.Add(q, big.NewInt(1))
+	case Down:
+	case HalfEven:
+		if c := twice.Cmp(minor.Denom()); c > 0 || (c == 0 && q.Bit(0) == 1) {
+			q.Add(q, big.NewInt(1))
+		}
+	default:
+		if twice.Cmp(minor.Denom()) >= 0 {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return money.New(q.Int64(), code)
+}
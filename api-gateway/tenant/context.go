@@ -0,0 +1,36 @@
+package tenant
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey carries the tenant ID on calls to the services
+const MetadataKey = "x-tenant-id"
+
+type contextKey struct{}
+
+// NewContext returns a context whose service calls are made for the tenant
+func NewContext(ctx context.Context, t *Tenant) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// FromContext returns the tenant calls made with the context are made for
+func FromContext(ctx context.Context) (*Tenant, bool) {
+	t, ok := ctx.Value(contextKey{}).(*Tenant)
+	return t, ok
+}
+
+// UnaryClientInterceptor sends the tenant of the call's context as metadata.
+// Calls without a tenant, such as those of background jobs, are sent without
+// and see every tenant.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if t, ok := FromContext(ctx); ok {
+			ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, t.ID)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
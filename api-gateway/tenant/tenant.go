@@ -0,0 +1,202 @@
+// Package tenant lets one API Gateway serve several storefronts. Each request
+// is resolved to a tenant whose ID travels to the services as gRPC metadata,
+// so they scope their data to it, and whose settings shape the response.
+package tenant
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"api-gateway/store"
+)
+
+// Features a storefront can switch off; all are on unless configured off
+const (
+	FeatureSignup     = "signup"
+	FeatureOIDC       = "oidc"
+	FeatureAPIKeys    = "api_keys"
+	FeatureReviews    = "reviews"
+	FeaturePromotions = "promotions"
+)
+
+var features = []string{FeatureSignup, FeatureOIDC, FeatureAPIKeys, FeatureReviews, FeaturePromotions}
+
+// PathPrefix selects a tenant by path, as in /t/<tenant>/api/products, for
+// storefronts that share a host
+const PathPrefix = "/t/"
+
+var validID = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// RateLimit caps the requests a client IP makes to the storefront
+type RateLimit struct {
+	// Requests allowed per Window; 0 disables the limit
+	Requests int `json:"requests"`
+	// Window is a Go duration such as "1m"
+	Window string `json:"window"`
+}
+
+// Config configures one storefront
+type Config struct {
+	Name string `json:"name"`
+	// Hosts the storefront is served on, matched against the Host header
+	Hosts []string `json:"hosts"`
+	// CORSOrigins allowed to call the API from a browser; all when empty
+	CORSOrigins []string  `json:"cors_origins"`
+	RateLimit   RateLimit `json:"rate_limit"`
+	// Currency prices are shown in unless the caller asks for another; the
+	// product's own currency when empty
+	Currency string `json:"currency"`
+	// Features switched on or off; unlisted features are on
+	Features map[string]bool `json:"features"`
+}
+
+// Tenant is a configured storefront
+type Tenant struct {
+	ID string
+	Config
+	window time.Duration
+}
+
+// Enabled reports whether the storefront offers a feature
+func (t *Tenant) Enabled(feature string) bool {
+	on, ok := t.Features[feature]
+	return !ok || on
+}
+
+// Window returns the rate limit window
+func (t *Tenant) Window() time.Duration {
+	return t.window
+}
+
+// FeatureFlags returns the state of every feature
+func (t *Tenant) FeatureFlags() map[string]bool {
+	flags := make(map[string]bool, len(features))
+	for _, feature := range features {
+		flags[feature] = t.Enabled(feature)
+	}
+	return flags
+}
+
+// Registry holds the storefronts by ID and by host
+type Registry struct {
+	tenants map[string]*Tenant
+	hosts   map[string]*Tenant
+	def     *Tenant
+}
+
+// Load reads storefronts from a JSON file mapping tenant IDs to their
+// configuration. Requests no host, path or token assigns to a storefront go
+// to the default tenant, which is added with an empty configuration when the
+// file does not define it, as is the case without a file.
+func Load(path, defaultID string) (*Registry, error) {
+	var configs map[string]Config
+	if err := store.Load(path, &configs); err != nil {
+		return nil, err
+	}
+	if _, ok := configs[defaultID]; !ok {
+		if configs == nil {
+			configs = make(map[string]Config)
+		}
+		configs[defaultID] = Config{}
+	}
+
+	r := &Registry{
+		tenants: make(map[string]*Tenant),
+		hosts:   make(map[string]*Tenant),
+	}
+	for id, tc := range configs {
+		t, err := newTenant(id, tc)
+		if err != nil {
+			return nil, err
+		}
+		for _, host := range t.Hosts {
+			if other, ok := r.hosts[host]; ok {
+				return nil, fmt.Errorf("host %s is served by both tenant %s and %s", host, other.ID, id)
+			}
+			r.hosts[host] = t
+		}
+		r.tenants[id] = t
+	}
+	r.def = r.tenants[defaultID]
+	return r, nil
+}
+
+func newTenant(id string, tc Config) (*Tenant, error) {
+	if !validID.MatchString(id) {
+		return nil, fmt.Errorf("invalid tenant ID %q: use lowercase letters, digits, - and _", id)
+	}
+	if tc.Name == "" {
+		tc.Name = id
+	}
+	tc.Currency = strings.ToUpper(strings.TrimSpace(tc.Currency))
+	for i, host := range tc.Hosts {
+		tc.Hosts[i] = normalizeHost(host)
+	}
+	for feature := range tc.Features {
+		if !known(feature) {
+			return nil, fmt.Errorf("tenant %s: unknown feature %q: use one of %s", id, feature, strings.Join(features, ", "))
+		}
+	}
+
+	t := &Tenant{ID: id, Config: tc}
+	if tc.RateLimit.Requests < 0 {
+		return nil, fmt.Errorf("tenant %s: rate_limit requests must not be negative", id)
+	}
+	if tc.RateLimit.Requests > 0 {
+		window, err := time.ParseDuration(tc.RateLimit.Window)
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("tenant %s: invalid rate_limit window %q", id, tc.RateLimit.Window)
+		}
+		t.window = window
+	}
+	return t, nil
+}
+
+func known(feature string) bool {
+	for _, f := range features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeHost lowercases a host and drops its port
+func normalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return host
+}
+
+// Get returns the tenant with the ID
+func (r *Registry) Get(id string) (*Tenant, bool) {
+	t, ok := r.tenants[id]
+	return t, ok
+}
+
+// ByHost returns the tenant served on the host of a Host header
+func (r *Registry) ByHost(host string) (*Tenant, bool) {
+	t, ok := r.hosts[normalizeHost(host)]
+	return t, ok
+}
+
+// Default returns the tenant of requests not assigned to another
+func (r *Registry) Default() *Tenant {
+	return r.def
+}
+
+// All returns every tenant, sorted by ID
+func (r *Registry) All() []*Tenant {
+	all := make([]*Tenant, 0, len(r.tenants))
+	for _, t := range r.tenants {
+		all = append(all, t)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].ID < all[j].ID })
+	return all
+}
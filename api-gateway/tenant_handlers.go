@@ -0,0 +1,160 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	"api-gateway/models"
+	"api-gateway/tenant"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+)
+
+// checkTenantCurrencies warns about storefront currencies without an
+// exchange rate; their prices are shown in each product's currency until a
+// rate is set
+func checkTenantCurrencies() {
+	for _, t := range tenants.All() {
+		if t.Currency != "" && !converter.Supports(t.Currency) {
+			log.Printf("⚠️  Tenant %s shows prices in %s, which has no exchange rate; using product currencies until one is set", t.ID, t.Currency)
+		}
+	}
+}
+
+// bindTenant serves the request for a storefront: service calls made with
+// the request's context carry its ID
+func bindTenant(c *fiber.Ctx, t *tenant.Tenant) {
+	c.Locals("tenant", t)
+	c.SetUserContext(tenant.NewContext(c.UserContext(), t))
+}
+
+// tenantOf returns the storefront the request is served for
+func tenantOf(c *fiber.Ctx) *tenant.Tenant {
+	if t, ok := c.Locals("tenant").(*tenant.Tenant); ok {
+		return t
+	}
+	return tenants.Default()
+}
+
+// resolveTenant serves a request for the storefront named by its path
+// prefix, /t/<tenant>, which is stripped so routes match as usual, or else
+// for the storefront served on its host. Other requests are left to
+// claimTenant.
+func resolveTenant(c *fiber.Ctx) error {
+	if rest, ok := strings.CutPrefix(c.Path(), tenant.PathPrefix); ok {
+		id, path, _ := strings.Cut(rest, "/")
+		t, found := tenants.Get(id)
+		if !found {
+			return c.Status(404).JSON(fiber.Map{"error": "Unknown storefront"})
+		}
+		c.Locals("tenantPrefix", tenant.PathPrefix+id)
+		c.Path("/" + path)
+		bindTenant(c, t)
+		return c.Next()
+	}
+	if t, ok := tenants.ByHost(c.Hostname()); ok {
+		bindTenant(c, t)
+	}
+	return c.Next()
+}
+
+// claimTenant serves requests neither path nor host assigned for the
+// signed-in user's storefront, and for the default one otherwise. API keys
+// are refused on storefronts that switched them off.
+func claimTenant(c *fiber.Ctx) error {
+	who := callerOf(c)
+	t, ok := c.Locals("tenant").(*tenant.Tenant)
+	if !ok {
+		t = tenants.Default()
+		if own, found := tenants.Get(who.Tenant); found {
+			t = own
+		}
+		bindTenant(c, t)
+	}
+	if who.APIKeyID != 0 && !t.Enabled(tenant.FeatureAPIKeys) {
+		return c.Status(403).JSON(fiber.Map{"error": "API keys are not available on this storefront"})
+	}
+	return c.Next()
+}
+
+// storefrontPath prefixes an API path with the storefront prefix the request
+// was made with, if any
+func storefrontPath(c *fiber.Ctx, path string) string {
+	prefix, _ := c.Locals("tenantPrefix").(string)
+	return prefix + path
+}
+
+// tenantCORS answers CORS requests with the origins the storefront allows
+func tenantCORS() fiber.Handler {
+	handlers := make(map[string]fiber.Handler)
+	for _, t := range tenants.All() {
+		origins := "*"
+		if len(t.CORSOrigins) > 0 {
+			origins = strings.Join(t.CORSOrigins, ",")
+		}
+		handlers[t.ID] = cors.New(cors.Config{
+			AllowOrigins:     origins,
+			AllowMethods:     "GET,POST,PUT,DELETE,OPTIONS",
+			AllowHeaders:     "Origin,Content-Type,Accept,Accept-Currency,Authorization,X-API-Key,X-Requested-With",
+			AllowCredentials: false,
+			ExposeHeaders:    "Content-Length",
+			MaxAge:           86400,
+		})
+	}
+	return func(c *fiber.Ctx) error {
+		return handlers[tenantOf(c).ID](c)
+	}
+}
+
+// tenantRateLimit limits the requests each client IP makes to a storefront
+// to its configured rate; every storefront counts separately
+func tenantRateLimit() fiber.Handler {
+	handlers := make(map[string]fiber.Handler)
+	for _, t := range tenants.All() {
+		if t.RateLimit.Requests == 0 {
+			continue
+		}
+		handlers[t.ID] = limiter.New(limiter.Config{
+			Max:        t.RateLimit.Requests,
+			Expiration: t.Window(),
+			LimitReached: func(c *fiber.Ctx) error {
+				return c.Status(429).JSON(fiber.Map{"error": "Too many requests; try again later"})
+			},
+		})
+	}
+	return func(c *fiber.Ctx) error {
+		if handler, ok := handlers[tenantOf(c).ID]; ok {
+			return handler(c)
+		}
+		return c.Next()
+	}
+}
+
+// requireFeature answers 404 on storefronts that switched the feature off
+func requireFeature(feature string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !tenantOf(c).Enabled(feature) {
+			return c.Status(404).JSON(fiber.Map{"error": "Not available on this storefront"})
+		}
+		return c.Next()
+	}
+}
+
+// getStorefront Get Storefront
+// @Summary      Get the storefront
+// @Description  Get the storefront the request is served for, chosen by the /t/{tenant} path prefix, the host or the signed-in user, with its default currency and the features it offers
+// @Tags         Storefront
+// @Produce      json
+// @Success      200  {object}  models.Storefront
+// @Router       /storefront [get]
+func getStorefront(c *fiber.Ctx) error {
+	t := tenantOf(c)
+	return c.JSON(models.Storefront{
+		ID:       t.ID,
+		Name:     t.Name,
+		Currency: t.Currency,
+		Features: t.FeatureFlags(),
+	})
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/proto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// parseTransferStatus accepts the API status names, which are the enum
+// names without the TRANSFER_ prefix
+func parseTransferStatus(name string) (proto.TransferStatus, error) {
+	value, ok := proto.TransferStatus_value["TRANSFER_"+strings.ToUpper(strings.TrimSpace(name))]
+	if !ok {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "Invalid status "+name+": use REQUESTED, IN_TRANSIT, RECEIVED or CANCELLED")
+	}
+	return proto.TransferStatus(value), nil
+}
+
+// createTransfer Create Stock Transfer
+// @Summary      Request a stock transfer
+// @Description  Request moving stock of a product, or a variant given by variant_id or sku, from one warehouse to another. The quantity is held at the source until the transfer is dispatched.
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        transfer  body      models.CreateTransferRequest  true  "Transfer data"
+// @Success      201       {object}  models.TransferResponse
+// @Failure      400       {object}  models.ErrorResponse
+// @Failure      404       {object}  models.ErrorResponse
+// @Failure      409       {object}  models.ErrorResponse
+// @Failure      500       {object}  models.ErrorResponse
+// @Router       /inventory/transfers [post]
+func createTransfer(c *fiber.Ctx) error {
+	var req models.CreateTransferRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.Quantity <= 0 {
+		return c.Status(400).JSON(fiber.Map{"error": "Quantity must be positive"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	productID, variantID, err := stockTarget(ctx, req.ProductID, req.VariantID, req.SKU)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	resp, err := clients.InventoryClient.CreateTransfer(ctx, &proto.CreateTransferRequest{
+		ProductId:              productID,
+		VariantId:              variantID,
+		Quantity:               req.Quantity,
+		SourceWarehouseId:      req.SourceWarehouseID,
+		DestinationWarehouseId: req.DestinationWarehouseID,
+		Note:                   req.Note,
+		Actor:                  actorOf(c),
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"message":  resp.Message,
+		"transfer": presentTransfer(resp.Transfer),
+	})
+}
+
+// listTransfers List Stock Transfers
+// @Summary      List stock transfers
+// @Description  Get a paginated list of stock transfers, newest first, optionally filtered by status, warehouse (source or destination) or product
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        page          query     int     false  "Page number"     default(1)
+// @Param        limit         query     int     false  "Items per page"  default(10)
+// @Param        status        query     string  false  "Transfer status"  Enums(REQUESTED, IN_TRANSIT, RECEIVED, CANCELLED)
+// @Param        warehouse_id  query     int     false  "Source or destination warehouse"
+// @Param        product_id    query     int     false  "Product ID"
+// @Success      200           {object}  models.TransfersListResponse
+// @Failure      400           {object}  models.ErrorResponse
+// @Failure      500           {object}  models.ErrorResponse
+// @Router       /inventory/transfers [get]
+func listTransfers(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	warehouseID, _ := strconv.Atoi(c.Query("warehouse_id", "0"))
+	productID, _ := strconv.Atoi(c.Query("product_id", "0"))
+
+	grpcReq := &proto.ListTransfersRequest{
+		Page:        int32(page),
+		Limit:       int32(limit),
+		WarehouseId: int32(warehouseID),
+		ProductId:   int32(productID),
+	}
+	if name := c.Query("status"); name != "" {
+		status, err := parseTransferStatus(name)
+		if err != nil {
+			e := err.(*fiber.Error)
+			return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+		}
+		grpcReq.HasStatus = true
+		grpcReq.Status = status
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.InventoryClient.ListTransfers(ctx, grpcReq)
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"transfers": presentTransfers(resp.Transfers),
+		"total":     resp.Total,
+		"page":      resp.Page,
+		"limit":     resp.Limit,
+	})
+}
+
+// getTransfer Get Stock Transfer
+// @Summary      Get stock transfer by ID
+// @Description  Get a stock transfer with its full audit trail
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Transfer ID"
+// @Success      200  {object}  models.TransferResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /inventory/transfers/{id} [get]
+func getTransfer(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.InventoryClient.GetTransfer(ctx, &proto.GetTransferRequest{Id: c.Params("id")})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message":  resp.Message,
+		"transfer": presentTransfer(resp.Transfer),
+	})
+}
+
+// updateTransferStatus Update Stock Transfer Status
+// @Summary      Dispatch, receive or cancel a stock transfer
+// @Description  IN_TRANSIT takes the stock out of the source warehouse, RECEIVED adds it to the destination. Cancelling a requested transfer releases the hold; cancelling one in transit returns the stock to the source.
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        id      path      string                              true  "Transfer ID"
+// @Param        status  body      models.UpdateTransferStatusRequest  true  "Status update data"
+// @Success      200     {object}  models.TransferResponse
+// @Failure      400     {object}  models.ErrorResponse
+// @Failure      404     {object}  models.ErrorResponse
+// @Failure      409     {object}  models.ErrorResponse
+// @Failure      500     {object}  models.ErrorResponse
+// @Router       /inventory/transfers/{id}/status [put]
+func updateTransferStatus(c *fiber.Ctx) error {
+	var req models.UpdateTransferStatusRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	status, err := parseTransferStatus(req.Status)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.InventoryClient.UpdateTransferStatus(ctx, &proto.UpdateTransferStatusRequest{
+		Id:     c.Params("id"),
+		Status: status,
+		Actor:  actorOf(c),
+		Note:   req.Note,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message":  resp.Message,
+		"transfer": presentTransfer(resp.Transfer),
+	})
+}
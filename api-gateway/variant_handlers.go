@@ -0,0 +1,344 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/proto"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resolveVariant finds the variant a request refers to by ID or SKU, or
+// returns nil when it names neither. A product ID, when also given, must be
+// the variant's product.
+func resolveVariant(ctx context.Context, productID, variantID int32, sku string) (*proto.Variant, error) {
+	sku = strings.TrimSpace(sku)
+	if variantID == 0 && sku == "" {
+		return nil, nil
+	}
+
+	var resp *proto.VariantResponse
+	var err error
+	if sku != "" {
+		resp, err = clients.ProductClient.GetVariantBySku(ctx, &proto.GetVariantBySkuRequest{Sku: sku})
+	} else {
+		resp, err = clients.ProductClient.GetVariant(ctx, &proto.GetVariantRequest{VariantId: variantID})
+	}
+	if status.Code(err) == codes.NotFound {
+		return nil, fiber.NewError(fiber.StatusNotFound, status.Convert(err).Message())
+	}
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	variant := resp.Variant
+	if variantID != 0 && variant.Id != variantID {
+		return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("SKU %s is variant %d, not %d", variant.Sku, variant.Id, variantID))
+	}
+	if productID != 0 && variant.ProductId != productID {
+		return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Variant %s belongs to product %d, not %d", variant.Sku, variant.ProductId, productID))
+	}
+	return variant, nil
+}
+
+// stockTarget returns the product and variant ID that inventory requests
+// send to the inventory service. Stock is tracked per variant, so a
+// variant_id or sku identifies the product on its own.
+func stockTarget(ctx context.Context, productID, variantID int32, sku string) (int32, int32, error) {
+	variant, err := resolveVariant(ctx, productID, variantID, sku)
+	if err != nil {
+		return 0, 0, err
+	}
+	if variant != nil {
+		return variant.ProductId, variant.Id, nil
+	}
+	if productID == 0 {
+		return 0, 0, fiber.NewError(fiber.StatusBadRequest, "product_id, variant_id or sku is required")
+	}
+	return productID, 0, nil
+}
+
+// productVariant fetches a variant and checks it belongs to the product
+// in the path
+func productVariant(ctx context.Context, c *fiber.Ctx) (*proto.Variant, error) {
+	productID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid product ID")
+	}
+	variantID, err := strconv.Atoi(c.Params("variantId"))
+	if err != nil || variantID <= 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid variant ID")
+	}
+
+	variant, err := resolveVariant(ctx, 0, int32(variantID), "")
+	if err != nil {
+		return nil, err
+	}
+	if variant.ProductId != int32(productID) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Variant not found")
+	}
+	return variant, nil
+}
+
+// variantProduct fetches the product of a variant, which supplies the
+// price of variants without an override
+func variantProduct(ctx context.Context, productID int32) (*proto.Product, error) {
+	resp, err := clients.ProductClient.GetProduct(ctx, &proto.GetProductRequest{ProductId: productID})
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	if !resp.Found {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Product not found")
+	}
+	return resp.Product, nil
+}
+
+// getProductBySKU Get Product By SKU
+// @Summary      Look up a product by SKU
+// @Description  Get the variant with the given SKU and the product it belongs to
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        sku              path      string  true   "Variant SKU"
+// @Param        currency         query     string  false  "Currency to render prices in (overrides Accept-Currency)"
+// @Param        Accept-Currency  header    string  false  "Currency to render prices in"
+// @Success      200              {object}  models.SKULookupResponse
+// @Failure      400              {object}  models.ErrorResponse
+// @Failure      404              {object}  models.ErrorResponse
+// @Failure      500              {object}  models.ErrorResponse
+// @Router       /products/sku/{sku} [get]
+func getProductBySKU(c *fiber.Ctx) error {
+	display, err := displayCurrency(c)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	variant, err := resolveVariant(ctx, 0, 0, c.Params("sku"))
+	if err == nil && variant == nil {
+		err = fiber.NewError(fiber.StatusBadRequest, "SKU is required")
+	}
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+	product, err := variantProduct(ctx, variant.ProductId)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	return c.JSON(fiber.Map{
+		"product": presentProduct(product, display),
+		"variant": presentVariant(variant, product, display),
+	})
+}
+
+// createVariant Create Variant
+// @Summary      Add a variant to a product
+// @Description  Create a variant with its own SKU for one combination of the product's options, such as size M in red. Options must give one allowed value for every option of the product. Price overrides the product price for this variant.
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                          true  "Product ID"
+// @Param        variant  body      models.CreateVariantRequest  true  "Variant data"
+// @Success      201      {object}  models.VariantResponse
+// @Failure      400      {object}  models.ErrorResponse
+// @Failure      404      {object}  models.ErrorResponse
+// @Failure      409      {object}  models.ErrorResponse
+// @Failure      500      {object}  models.ErrorResponse
+// @Router       /products/{id}/variants [post]
+func createVariant(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+
+	var req models.CreateVariantRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if strings.TrimSpace(req.SKU) == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "SKU is required"})
+	}
+
+	grpcReq := &proto.CreateVariantRequest{
+		ProductId: int32(id),
+		Sku:       req.SKU,
+		Options:   req.Options,
+	}
+	if req.Price != nil {
+		grpcReq.HasPrice = true
+		grpcReq.Price = *req.Price
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.ProductClient.CreateVariant(ctx, grpcReq)
+	if err != nil {
+		return inventoryError(c, err)
+	}
+	product, err := variantProduct(ctx, resp.Variant.ProductId)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+	if grpcReq.HasPrice {
+		publishProductUpdated(c, product)
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"variant": presentVariant(resp.Variant, product, ""),
+	})
+}
+
+// listVariants List Variants
+// @Summary      List the variants of a product
+// @Description  Get every variant of a product with its SKU, options and effective price
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id               path      int     true   "Product ID"
+// @Param        currency         query     string  false  "Currency to render prices in (overrides Accept-Currency)"
+// @Param        Accept-Currency  header    string  false  "Currency to render prices in"
+// @Success      200              {object}  models.VariantsListResponse
+// @Failure      400              {object}  models.ErrorResponse
+// @Failure      404              {object}  models.ErrorResponse
+// @Failure      500              {object}  models.ErrorResponse
+// @Router       /products/{id}/variants [get]
+func listVariants(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid product ID"})
+	}
+
+	display, err := displayCurrency(c)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	product, err := variantProduct(ctx, int32(id))
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	return c.JSON(fiber.Map{
+		"variants": presentProduct(product, display).Variants,
+	})
+}
+
+// updateVariant Update Variant
+// @Summary      Update a product variant
+// @Description  Change the SKU, options or price override of a variant; omitted fields are left unchanged. Set clear_price to sell the variant at the product price again.
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int                          true  "Product ID"
+// @Param        variantId  path      int                          true  "Variant ID"
+// @Param        variant    body      models.UpdateVariantRequest  true  "Variant update"
+// @Success      200        {object}  models.VariantResponse
+// @Failure      400        {object}  models.ErrorResponse
+// @Failure      404        {object}  models.ErrorResponse
+// @Failure      409        {object}  models.ErrorResponse
+// @Failure      500        {object}  models.ErrorResponse
+// @Router       /products/{id}/variants/{variantId} [put]
+func updateVariant(c *fiber.Ctx) error {
+	var req models.UpdateVariantRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.ClearPrice && req.Price != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Give either price or clear_price"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	variant, err := productVariant(ctx, c)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	grpcReq := &proto.UpdateVariantRequest{
+		VariantId: variant.Id,
+		Sku:       req.SKU,
+		Options:   req.Options,
+		SetPrice:  req.Price != nil || req.ClearPrice,
+	}
+	if req.Price != nil {
+		grpcReq.HasPrice = true
+		grpcReq.Price = *req.Price
+	}
+
+	resp, err := clients.ProductClient.UpdateVariant(ctx, grpcReq)
+	if err != nil {
+		return inventoryError(c, err)
+	}
+	product, err := variantProduct(ctx, resp.Variant.ProductId)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+	if grpcReq.SetPrice {
+		publishProductUpdated(c, product)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+		"variant": presentVariant(resp.Variant, product, ""),
+	})
+}
+
+// deleteVariant Delete Variant
+// @Summary      Delete a product variant
+// @Description  Remove a variant from a product. Its stock records are kept.
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int  true  "Product ID"
+// @Param        variantId  path      int  true  "Variant ID"
+// @Success      200        {object}  models.SuccessResponse
+// @Failure      400        {object}  models.ErrorResponse
+// @Failure      404        {object}  models.ErrorResponse
+// @Failure      500        {object}  models.ErrorResponse
+// @Router       /products/{id}/variants/{variantId} [delete]
+func deleteVariant(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	variant, err := productVariant(ctx, c)
+	if err != nil {
+		e := err.(*fiber.Error)
+		return c.Status(e.Code).JSON(fiber.Map{"error": e.Message})
+	}
+
+	resp, err := clients.ProductClient.DeleteVariant(ctx, &proto.DeleteVariantRequest{
+		VariantId: variant.Id,
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+	})
+}
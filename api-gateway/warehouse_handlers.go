@@ -0,0 +1,254 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/proto"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// allocationStrategies maps the strategy names accepted by the API to the
+// inventory service enum
+var allocationStrategies = map[string]proto.AllocationStrategy{
+	"":              proto.AllocationStrategy_ALLOCATION_DEFAULT,
+	"nearest":       proto.AllocationStrategy_NEAREST,
+	"largest_stock": proto.AllocationStrategy_LARGEST_STOCK,
+	"split":         proto.AllocationStrategy_SPLIT,
+}
+
+func parseAllocationStrategy(name string) (proto.AllocationStrategy, error) {
+	strategy, ok := allocationStrategies[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "Invalid strategy "+name+": use nearest, largest_stock or split")
+	}
+	return strategy, nil
+}
+
+// inventoryError maps gRPC errors of the inventory service to HTTP status codes
+func inventoryError(c *fiber.Ctx, err error) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.NotFound:
+		return c.Status(404).JSON(fiber.Map{"error": st.Message()})
+	case codes.InvalidArgument:
+		return c.Status(400).JSON(fiber.Map{"error": st.Message()})
+	case codes.PermissionDenied:
+		return c.Status(403).JSON(fiber.Map{"error": st.Message()})
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return c.Status(409).JSON(fiber.Map{"error": st.Message()})
+	}
+	return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+}
+
+// createWarehouse Create Warehouse
+// @Summary      Create a warehouse
+// @Description  Create a stock location. Coordinates are optional and enable nearest-warehouse allocation.
+// @Tags         Warehouses
+// @Accept       json
+// @Produce      json
+// @Param        warehouse  body      models.CreateWarehouseRequest  true  "Warehouse data"
+// @Success      201        {object}  models.WarehouseResponse
+// @Failure      400        {object}  models.ErrorResponse
+// @Failure      409        {object}  models.ErrorResponse
+// @Failure      500        {object}  models.ErrorResponse
+// @Router       /warehouses [post]
+func createWarehouse(c *fiber.Ctx) error {
+	var req models.CreateWarehouseRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if strings.TrimSpace(req.Code) == "" || strings.TrimSpace(req.Name) == "" {
+		return c.Status(400).JSON(fiber.Map{"error": "Warehouse code and name are required"})
+	}
+
+	grpcReq := &proto.CreateWarehouseRequest{
+		Code:    req.Code,
+		Name:    req.Name,
+		Address: req.Address,
+	}
+	if req.Location != nil {
+		grpcReq.Latitude = req.Location.Latitude
+		grpcReq.Longitude = req.Location.Longitude
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.InventoryClient.CreateWarehouse(ctx, grpcReq)
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"message":   resp.Message,
+		"warehouse": presentWarehouse(resp.Warehouse),
+	})
+}
+
+// listWarehouses List Warehouses
+// @Summary      List warehouses
+// @Description  Get all active warehouses, or all warehouses with include_inactive=true
+// @Tags         Warehouses
+// @Accept       json
+// @Produce      json
+// @Param        include_inactive  query     bool  false  "Include deactivated warehouses"
+// @Success      200               {object}  models.WarehousesListResponse
+// @Failure      500               {object}  models.ErrorResponse
+// @Router       /warehouses [get]
+func listWarehouses(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.InventoryClient.ListWarehouses(ctx, &proto.ListWarehousesRequest{
+		IncludeInactive: c.QueryBool("include_inactive"),
+	})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"warehouses": presentWarehouses(resp.Warehouses),
+	})
+}
+
+// getWarehouse Get Warehouse
+// @Summary      Get warehouse by ID
+// @Description  Get a warehouse by its ID
+// @Tags         Warehouses
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Warehouse ID"
+// @Success      200  {object}  models.WarehouseResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /warehouses/{id} [get]
+func getWarehouse(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid warehouse ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.InventoryClient.GetWarehouse(ctx, &proto.GetWarehouseRequest{Id: int32(id)})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message":   resp.Message,
+		"warehouse": presentWarehouse(resp.Warehouse),
+	})
+}
+
+// updateWarehouse Update Warehouse
+// @Summary      Update warehouse
+// @Description  Update a warehouse. Omitted fields keep their value; deactivated warehouses are skipped by stock checks and reservations.
+// @Tags         Warehouses
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int                            true  "Warehouse ID"
+// @Param        warehouse  body      models.UpdateWarehouseRequest  true  "Warehouse update data"
+// @Success      200        {object}  models.WarehouseResponse
+// @Failure      400        {object}  models.ErrorResponse
+// @Failure      404        {object}  models.ErrorResponse
+// @Failure      500        {object}  models.ErrorResponse
+// @Router       /warehouses/{id} [put]
+func updateWarehouse(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid warehouse ID"})
+	}
+
+	var req models.UpdateWarehouseRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	// the inventory service replaces every field, so start from the stored warehouse
+	current, err := clients.InventoryClient.GetWarehouse(ctx, &proto.GetWarehouseRequest{Id: int32(id)})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+	w := current.Warehouse
+
+	grpcReq := &proto.UpdateWarehouseRequest{
+		Id:        w.Id,
+		Name:      w.Name,
+		Address:   w.Address,
+		Latitude:  w.Latitude,
+		Longitude: w.Longitude,
+		Active:    w.Active,
+	}
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return c.Status(400).JSON(fiber.Map{"error": "Warehouse name cannot be empty"})
+		}
+		grpcReq.Name = *req.Name
+	}
+	if req.Address != nil {
+		grpcReq.Address = *req.Address
+	}
+	if req.Location != nil {
+		grpcReq.Latitude = req.Location.Latitude
+		grpcReq.Longitude = req.Location.Longitude
+	}
+	if req.Active != nil {
+		grpcReq.Active = *req.Active
+	}
+
+	resp, err := clients.InventoryClient.UpdateWarehouse(ctx, grpcReq)
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message":   resp.Message,
+		"warehouse": presentWarehouse(resp.Warehouse),
+	})
+}
+
+// deleteWarehouse Delete Warehouse
+// @Summary      Delete warehouse
+// @Description  Delete a warehouse. Warehouses that still hold stock cannot be deleted; deactivate them instead.
+// @Tags         Warehouses
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Warehouse ID"
+// @Success      200  {object}  models.SuccessResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /warehouses/{id} [delete]
+func deleteWarehouse(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid warehouse ID"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	resp, err := clients.InventoryClient.DeleteWarehouse(ctx, &proto.DeleteWarehouseRequest{Id: int32(id)})
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": resp.Success,
+		"message": resp.Message,
+	})
+}
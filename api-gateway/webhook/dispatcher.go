@@ -0,0 +1,184 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// batchSize caps the deliveries claimed per round
+	batchSize = 100
+	// concurrency caps the requests in flight at once
+	concurrency = 8
+	// maxResponseBytes of an endpoint's response are kept in the delivery log
+	maxResponseBytes = 512
+)
+
+// RetryPolicy spaces out the attempts of a failing delivery
+type RetryPolicy struct {
+	// MaxAttempts a delivery gets before it is dead
+	MaxAttempts int
+	// BaseDelay is the wait after the first failed attempt; it doubles with
+	// every further failure up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// Delay returns the wait before the next attempt after the given number of
+// failed attempts
+func (p RetryPolicy) Delay(failures int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// Dispatcher posts due deliveries to their endpoints
+type Dispatcher struct {
+	store  *Store
+	client *http.Client
+	policy RetryPolicy
+}
+
+// NewDispatcher creates a dispatcher whose requests time out after timeout.
+// Redirects are not followed; endpoints must answer themselves.
+func NewDispatcher(store *Store, policy RetryPolicy, timeout time.Duration) *Dispatcher {
+	return &Dispatcher{
+		store: store,
+		client: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		policy: policy,
+	}
+}
+
+// Run delivers due deliveries every interval, and as soon as new ones are
+// queued, until ctx is cancelled. Delivered deliveries older than retention
+// are pruned on the way; 0 keeps them.
+func (d *Dispatcher) Run(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		d.DeliverDue(ctx)
+		if err := d.store.prune(time.Now().UTC(), retention); err != nil {
+			log.Printf("webhook outbox prune failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.store.queued:
+		}
+	}
+}
+
+// DeliverDue attempts every delivery that is due, a few at a time
+func (d *Dispatcher) DeliverDue(ctx context.Context) {
+	for {
+		batch := d.store.due(time.Now().UTC(), batchSize)
+		if len(batch) == 0 {
+			return
+		}
+
+		var wg sync.WaitGroup
+		slots := make(chan struct{}, concurrency)
+		for _, o := range batch {
+			wg.Add(1)
+			slots <- struct{}{}
+			go func(o outgoing) {
+				defer wg.Done()
+				defer func() { <-slots }()
+				if _, err := d.send(ctx, o); err != nil {
+					log.Printf("webhook delivery %s not recorded: %v", o.delivery.ID, err)
+				}
+			}(o)
+		}
+		wg.Wait()
+
+		if len(batch) < batchSize || ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// Deliver attempts a pending delivery now, whether or not it is due, and
+// returns it with the outcome. Deliveries already delivered, dead or being
+// attempted are returned as they are.
+func (d *Dispatcher) Deliver(ctx context.Context, id string) (Delivery, error) {
+	o, err := d.store.claim(id)
+	if err != nil {
+		return Delivery{}, err
+	}
+	if o.url == "" {
+		return o.delivery, nil
+	}
+	return d.send(ctx, o)
+}
+
+// send posts a claimed delivery and records the attempt
+func (d *Dispatcher) send(ctx context.Context, o outgoing) (Delivery, error) {
+	attempt := d.post(ctx, o)
+	ok := attempt.Error == ""
+	if !ok {
+		log.Printf("webhook delivery %s of %s to %s failed: %s", o.delivery.ID, o.delivery.Event.Type, o.url, attempt.Error)
+	}
+	return d.store.record(o.delivery.ID, attempt, ok, d.policy)
+}
+
+// post makes one attempt; the attempt has an Error unless the endpoint
+// answered with a 2xx status
+func (d *Dispatcher) post(ctx context.Context, o outgoing) Attempt {
+	start := time.Now()
+	attempt := Attempt{At: start.UTC()}
+	fail := func(err error) Attempt {
+		attempt.Error = err.Error()
+		attempt.DurationMS = time.Since(start).Milliseconds()
+		return attempt
+	}
+
+	body, err := json.Marshal(o.delivery.Event)
+	if err != nil {
+		return fail(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.url, bytes.NewReader(body))
+	if err != nil {
+		return fail(err)
+	}
+	timestamp := start.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "api-gateway-webhooks/1.0")
+	req.Header.Set(HeaderDelivery, o.delivery.ID)
+	req.Header.Set(HeaderEvent, o.delivery.Event.Type)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, "sha256="+Sign(o.secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fail(err)
+	}
+	defer resp.Body.Close()
+
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	attempt.StatusCode = resp.StatusCode
+	attempt.Response = strings.TrimSpace(string(snippet))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fail(fmt.Errorf("endpoint returned %s", resp.Status))
+	}
+	attempt.DurationMS = time.Since(start).Milliseconds()
+	return attempt
+}
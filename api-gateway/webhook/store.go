@@ -0,0 +1,546 @@
+package webhook
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"api-gateway/store"
+
+	"github.com/google/uuid"
+)
+
+// dedupeWindow is how long the key of a published event is remembered, so
+// an event reported both by a gateway action and through Kafka is sent once
+const dedupeWindow = 24 * time.Hour
+
+// Store keeps webhooks and the outbox of their deliveries, optionally
+// persisted to a file
+type Store struct {
+	mu            sync.Mutex
+	path          string
+	subscriptions map[string]*Subscription
+	deliveries    map[string]*Delivery
+	// seen holds the keys of recently published events by publication time
+	seen        map[string]time.Time
+	kafkaCursor int32
+	// inFlight holds the IDs of deliveries being attempted
+	inFlight map[string]bool
+	// queued wakes the dispatcher when deliveries are queued
+	queued chan struct{}
+}
+
+// state is the on-disk layout of the store
+type state struct {
+	Webhooks    []Subscription       `json:"webhooks"`
+	Deliveries  []Delivery           `json:"deliveries"`
+	Seen        map[string]time.Time `json:"seen"`
+	KafkaCursor int32                `json:"kafka_cursor"`
+}
+
+// outgoing is a delivery with the endpoint it is posted to
+type outgoing struct {
+	delivery Delivery
+	url      string
+	secret   string
+}
+
+// NewStore creates a store. When path is not empty, webhooks and pending
+// deliveries are loaded from and saved to that file, so the outbox survives
+// restarts.
+func NewStore(path string) (*Store, error) {
+	s := &Store{
+		path:          path,
+		subscriptions: make(map[string]*Subscription),
+		deliveries:    make(map[string]*Delivery),
+		seen:          make(map[string]time.Time),
+		inFlight:      make(map[string]bool),
+		queued:        make(chan struct{}, 1),
+	}
+	if path == "" {
+		return s, nil
+	}
+
+	var st state
+	if err := store.Load(path, &st); err != nil {
+		return nil, err
+	}
+	for i := range st.Webhooks {
+		w := st.Webhooks[i]
+		s.subscriptions[w.ID] = &w
+	}
+	for i := range st.Deliveries {
+		d := st.Deliveries[i]
+		s.deliveries[d.ID] = &d
+	}
+	for key, at := range st.Seen {
+		s.seen[key] = at
+	}
+	s.kafkaCursor = st.KafkaCursor
+	return s, nil
+}
+
+// List returns the webhooks of a tenant, oldest first, without secrets
+func (s *Store) List(tenant string) []Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := make([]Subscription, 0)
+	for _, w := range s.sorted() {
+		if w.Tenant == tenant {
+			list = append(list, w.Redacted())
+		}
+	}
+	return list
+}
+
+// Get returns a webhook of a tenant without its secret
+func (s *Store) Get(tenant, id string) (Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w, err := s.find(tenant, id)
+	if err != nil {
+		return Subscription{}, err
+	}
+	return w.Redacted(), nil
+}
+
+// Create validates and stores a new webhook for a tenant. The returned
+// webhook includes its signing secret.
+func (s *Store) Create(tenant string, createdBy int32, endpoint Endpoint) (Subscription, error) {
+	endpoint.Normalize()
+	if err := endpoint.Validate(); err != nil {
+		return Subscription{}, err
+	}
+	secret, err := newSecret()
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	w := &Subscription{
+		ID:        uuid.NewString(),
+		Tenant:    tenant,
+		Endpoint:  endpoint,
+		Secret:    secret,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	s.subscriptions[w.ID] = w
+	if err := s.save(); err != nil {
+		delete(s.subscriptions, w.ID)
+		return Subscription{}, err
+	}
+	return *w, nil
+}
+
+// Update replaces the settings of a webhook. Activating it makes the
+// deliveries queued while it was inactive due.
+func (s *Store) Update(tenant, id string, endpoint Endpoint) (Subscription, error) {
+	endpoint.Normalize()
+	if err := endpoint.Validate(); err != nil {
+		return Subscription{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w, err := s.find(tenant, id)
+	if err != nil {
+		return Subscription{}, err
+	}
+	previous := *w
+	w.Endpoint = endpoint
+	w.UpdatedAt = time.Now().UTC()
+	if err := s.save(); err != nil {
+		*w = previous
+		return Subscription{}, err
+	}
+	if endpoint.Active {
+		s.wake()
+	}
+	return w.Redacted(), nil
+}
+
+// RotateSecret replaces the signing secret of a webhook. The returned
+// webhook includes the new secret, which signs every later attempt.
+func (s *Store) RotateSecret(tenant, id string) (Subscription, error) {
+	secret, err := newSecret()
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w, err := s.find(tenant, id)
+	if err != nil {
+		return Subscription{}, err
+	}
+	previous := *w
+	w.Secret = secret
+	w.UpdatedAt = time.Now().UTC()
+	if err := s.save(); err != nil {
+		*w = previous
+		return Subscription{}, err
+	}
+	return *w, nil
+}
+
+// Delete removes a webhook and its deliveries
+func (s *Store) Delete(tenant, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w, err := s.find(tenant, id)
+	if err != nil {
+		return err
+	}
+	removed := make(map[string]*Delivery)
+	for deliveryID, d := range s.deliveries {
+		if d.WebhookID == id {
+			removed[deliveryID] = d
+			delete(s.deliveries, deliveryID)
+		}
+	}
+	delete(s.subscriptions, id)
+	if err := s.save(); err != nil {
+		s.subscriptions[id] = w
+		for deliveryID, d := range removed {
+			s.deliveries[deliveryID] = d
+		}
+		return err
+	}
+	return nil
+}
+
+// Publish queues an event of a tenant for every webhook of the tenant
+// subscribed to its type and returns how many deliveries were queued. Events
+// are identified by key: an event whose key was published within the last
+// day is dropped, so sources reporting the same event overlap safely.
+func (s *Store) Publish(tenant, eventType, key string, data any) (int, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	if at, ok := s.seen[key]; ok && now.Sub(at) < dedupeWindow {
+		return 0, nil
+	}
+	s.seen[key] = now
+
+	event := Event{
+		ID:         uuid.NewString(),
+		Type:       eventType,
+		Tenant:     tenant,
+		OccurredAt: now,
+		Data:       raw,
+	}
+	var queued []string
+	for _, w := range s.subscriptions {
+		if w.Tenant == tenant && w.Subscribes(eventType) {
+			d := newDelivery(w.ID, event, now)
+			s.deliveries[d.ID] = d
+			queued = append(queued, d.ID)
+		}
+	}
+	if len(queued) == 0 {
+		return 0, nil
+	}
+	if err := s.save(); err != nil {
+		delete(s.seen, key)
+		for _, id := range queued {
+			delete(s.deliveries, id)
+		}
+		return 0, err
+	}
+	s.wake()
+	return len(queued), nil
+}
+
+// QueueTest queues a test event for one webhook, whatever its event filter
+// and even while it is inactive
+func (s *Store) QueueTest(tenant, id string, data any) (Delivery, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.find(tenant, id); err != nil {
+		return Delivery{}, err
+	}
+	now := time.Now().UTC()
+	d := newDelivery(id, Event{
+		ID:         uuid.NewString(),
+		Type:       EventTest,
+		Tenant:     tenant,
+		OccurredAt: now,
+		Data:       raw,
+	}, now)
+	s.deliveries[d.ID] = d
+	if err := s.save(); err != nil {
+		delete(s.deliveries, d.ID)
+		return Delivery{}, err
+	}
+	return *d, nil
+}
+
+// Deliveries returns the deliveries of a webhook, newest first, optionally
+// only those with a status
+func (s *Store) Deliveries(tenant, webhookID, status string) ([]Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.find(tenant, webhookID); err != nil {
+		return nil, err
+	}
+	list := make([]Delivery, 0)
+	for _, d := range s.deliveries {
+		if d.WebhookID == webhookID && (status == "" || d.Status == status) {
+			list = append(list, *d)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].ID > list[j].ID
+		}
+		return list[i].CreatedAt.After(list[j].CreatedAt)
+	})
+	return list, nil
+}
+
+// Retry queues a dead delivery again. It gets one more attempt and is dead
+// again if that fails too.
+func (s *Store) Retry(tenant, webhookID, deliveryID string) (Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.find(tenant, webhookID); err != nil {
+		return Delivery{}, err
+	}
+	d, ok := s.deliveries[deliveryID]
+	if !ok || d.WebhookID != webhookID {
+		return Delivery{}, ErrDeliveryNotFound
+	}
+	if d.Status != Dead {
+		return Delivery{}, ErrNotDead
+	}
+
+	previous := *d
+	now := time.Now().UTC()
+	d.Status = Pending
+	d.NextAttemptAt = &now
+	d.DeadAt = nil
+	if err := s.save(); err != nil {
+		*d = previous
+		return Delivery{}, err
+	}
+	s.wake()
+	return *d, nil
+}
+
+// KafkaCursor returns the ID of the last Kafka event relayed
+func (s *Store) KafkaCursor() int32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.kafkaCursor
+}
+
+// SetKafkaCursor records the ID of the last Kafka event relayed
+func (s *Store) SetKafkaCursor(id int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous := s.kafkaCursor
+	s.kafkaCursor = id
+	if err := s.save(); err != nil {
+		s.kafkaCursor = previous
+		return err
+	}
+	return nil
+}
+
+// due claims up to limit pending deliveries whose next attempt is due, oldest
+// first. Deliveries of inactive webhooks wait, except test events.
+func (s *Store) due(now time.Time, limit int) []outgoing {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var ready []*Delivery
+	for _, d := range s.deliveries {
+		if d.Status != Pending || s.inFlight[d.ID] || d.NextAttemptAt == nil || d.NextAttemptAt.After(now) {
+			continue
+		}
+		if w := s.subscriptions[d.WebhookID]; w != nil && (w.Active || d.Event.Type == EventTest) {
+			ready = append(ready, d)
+		}
+	}
+	sort.Slice(ready, func(i, j int) bool { return ready[i].NextAttemptAt.Before(*ready[j].NextAttemptAt) })
+	if len(ready) > limit {
+		ready = ready[:limit]
+	}
+
+	claimed := make([]outgoing, 0, len(ready))
+	for _, d := range ready {
+		s.inFlight[d.ID] = true
+		w := s.subscriptions[d.WebhookID]
+		claimed = append(claimed, outgoing{delivery: *d, url: w.URL, secret: w.Secret})
+	}
+	return claimed
+}
+
+// claim claims one pending delivery whether or not it is due
+func (s *Store) claim(id string) (outgoing, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.deliveries[id]
+	if !ok {
+		return outgoing{}, ErrDeliveryNotFound
+	}
+	w := s.subscriptions[d.WebhookID]
+	if w == nil {
+		return outgoing{}, ErrNotFound
+	}
+	if d.Status != Pending || s.inFlight[id] {
+		return outgoing{delivery: *d}, nil
+	}
+	s.inFlight[id] = true
+	return outgoing{delivery: *d, url: w.URL, secret: w.Secret}, nil
+}
+
+// record adds an attempt to a claimed delivery and releases it. A successful
+// attempt delivers it; a failed one schedules the next attempt, or marks the
+// delivery dead once the policy's attempts are used up.
+func (s *Store) record(id string, attempt Attempt, ok bool, policy RetryPolicy) (Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.inFlight, id)
+	d, found := s.deliveries[id]
+	if !found {
+		// the webhook was deleted while the attempt was made
+		return Delivery{}, ErrDeliveryNotFound
+	}
+
+	d.Attempts = append(d.Attempts, attempt)
+	switch {
+	case ok:
+		d.Status = Delivered
+		d.DeliveredAt = &attempt.At
+		d.NextAttemptAt = nil
+	case len(d.Attempts) >= policy.MaxAttempts:
+		d.Status = Dead
+		d.DeadAt = &attempt.At
+		d.NextAttemptAt = nil
+	default:
+		next := attempt.At.Add(policy.Delay(len(d.Attempts)))
+		d.NextAttemptAt = &next
+	}
+	return *d, s.save()
+}
+
+// prune forgets delivered deliveries older than retention, unless retention
+// is 0, and event keys older than the dedupe window. Dead deliveries are
+// kept until they are retried or their webhook is deleted.
+func (s *Store) prune(now time.Time, retention time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := false
+	if retention > 0 {
+		for id, d := range s.deliveries {
+			if d.Status == Delivered && d.DeliveredAt != nil && now.Sub(*d.DeliveredAt) > retention {
+				delete(s.deliveries, id)
+				changed = true
+			}
+		}
+	}
+	for key, at := range s.seen {
+		if now.Sub(at) > dedupeWindow {
+			delete(s.seen, key)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return s.save()
+}
+
+func newDelivery(webhookID string, event Event, now time.Time) *Delivery {
+	return &Delivery{
+		ID:            uuid.NewString(),
+		WebhookID:     webhookID,
+		Event:         event,
+		Status:        Pending,
+		Attempts:      []Attempt{},
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+	}
+}
+
+func (s *Store) find(tenant, id string) (*Subscription, error) {
+	w, ok := s.subscriptions[id]
+	if !ok || w.Tenant != tenant {
+		return nil, ErrNotFound
+	}
+	return w, nil
+}
+
+// wake signals the dispatcher without blocking; one pending signal is enough
+func (s *Store) wake() {
+	select {
+	case s.queued <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Store) sorted() []Subscription {
+	list := make([]Subscription, 0, len(s.subscriptions))
+	for _, w := range s.subscriptions {
+		list = append(list, *w)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].ID < list[j].ID
+		}
+		return list[i].CreatedAt.Before(list[j].CreatedAt)
+	})
+	return list
+}
+
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	deliveries := make([]Delivery, 0, len(s.deliveries))
+	for _, d := range s.deliveries {
+		deliveries = append(deliveries, *d)
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		if deliveries[i].CreatedAt.Equal(deliveries[j].CreatedAt) {
+			return deliveries[i].ID < deliveries[j].ID
+		}
+		return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt)
+	})
+	return store.Save(s.path, state{
+		Webhooks:    s.sorted(),
+		Deliveries:  deliveries,
+		Seen:        s.seen,
+		KafkaCursor: s.kafkaCursor,
+	})
+}
@@ -0,0 +1,214 @@
+// Package webhook notifies external integrations of events over HTTP. Each
+// event is written to a persistent outbox as one delivery per subscribed
+// endpoint, posted with an HMAC signature, retried with exponential backoff
+// and dead-lettered after too many failed attempts.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Event types subscriptions can filter on
+const (
+	EventOrderCreated       = "order.created"
+	EventOrderStatusChanged = "order.status_changed"
+	EventLowStock           = "inventory.low_stock"
+	EventProductUpdated     = "product.updated"
+)
+
+// EventTest is sent to one endpoint on request, whatever its filter
+const EventTest = "webhook.test"
+
+var eventTypes = []string{EventOrderCreated, EventOrderStatusChanged, EventLowStock, EventProductUpdated}
+
+// Delivery statuses
+const (
+	// Pending deliveries are attempted when NextAttemptAt is due
+	Pending = "PENDING"
+	// Delivered deliveries were answered with a 2xx status
+	Delivered = "DELIVERED"
+	// Dead deliveries failed every attempt and are only retried on request
+	Dead = "DEAD"
+)
+
+// Request headers of a delivery
+const (
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+var (
+	// ErrNotFound is returned when no webhook has the given ID
+	ErrNotFound = errors.New("webhook not found")
+	// ErrDeliveryNotFound is returned when the webhook has no delivery with the given ID
+	ErrDeliveryNotFound = errors.New("delivery not found")
+	// ErrInvalidWebhook is returned when an endpoint is misconfigured
+	ErrInvalidWebhook = errors.New("invalid webhook")
+	// ErrNotDead is returned when retrying a delivery that has not failed for good
+	ErrNotDead = errors.New("only dead deliveries can be retried")
+)
+
+// EventTypes returns the event types subscriptions can filter on
+func EventTypes() []string {
+	return append([]string(nil), eventTypes...)
+}
+
+// Endpoint is the part of a webhook its owner configures
+// @Description Webhook settings
+type Endpoint struct {
+	// URL receives deliveries as POST requests
+	URL string `json:"url" example:"https://partner.example.com/hooks/orders"`
+	// Events the endpoint is notified of
+	Events      []string `json:"events" example:"order.created,order.status_changed"`
+	Description string   `json:"description,omitempty" example:"Fulfilment partner"`
+	// Active endpoints receive deliveries; deliveries queued for an inactive
+	// endpoint wait until it is activated again
+	Active bool `json:"active" example:"true"`
+} //@name WebhookEndpoint
+
+// Normalize cleans up user input before validation
+func (e *Endpoint) Normalize() {
+	e.URL = strings.TrimSpace(e.URL)
+	e.Description = strings.TrimSpace(e.Description)
+
+	seen := make(map[string]bool)
+	events := make([]string, 0, len(e.Events))
+	for _, event := range e.Events {
+		event = strings.ToLower(strings.TrimSpace(event))
+		if event != "" && !seen[event] {
+			seen[event] = true
+			events = append(events, event)
+		}
+	}
+	sort.Strings(events)
+	e.Events = events
+}
+
+// Validate checks the URL and the event filter
+func (e *Endpoint) Validate() error {
+	u, err := url.Parse(e.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidWebhook)
+	}
+	if len(e.Events) == 0 {
+		return fmt.Errorf("%w: at least one event is required", ErrInvalidWebhook)
+	}
+	for _, event := range e.Events {
+		if !known(event) {
+			return fmt.Errorf("%w: unknown event %q: use one of %s", ErrInvalidWebhook, event, strings.Join(eventTypes, ", "))
+		}
+	}
+	return nil
+}
+
+// Subscribes reports whether the endpoint is notified of the event type
+func (e *Endpoint) Subscribes(eventType string) bool {
+	for _, event := range e.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func known(eventType string) bool {
+	for _, t := range eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscription is a registered webhook endpoint
+// @Description Webhook endpoint
+type Subscription struct {
+	ID string `json:"id" example:"3b1f7c52-9d0e-4d8a-b6f1-0c2e4a9d7e11"`
+	// Tenant is the storefront whose events the endpoint receives
+	Tenant string `json:"tenant" example:"default"`
+	Endpoint
+	// Secret signs deliveries; it is only shown when the webhook is created
+	// or its secret rotated
+	Secret    string    `json:"secret,omitempty" example:"whsec_5d41402abc4b2a76b9719d911017c592a1b2c3d4e5f60718"`
+	CreatedBy int32     `json:"created_by" example:"1"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+} //@name Webhook
+
+// Redacted returns the subscription without its secret
+func (s Subscription) Redacted() Subscription {
+	s.Secret = ""
+	return s
+}
+
+// Event is something that happened subscribers may be notified of; it is
+// the JSON body of a delivery
+// @Description Webhook event
+type Event struct {
+	ID         string          `json:"id" example:"9a7e3c0d-41b2-4f59-8c3e-2d6b1f0a5e47"`
+	Type       string          `json:"type" example:"order.created"`
+	Tenant     string          `json:"tenant" example:"default"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data" swaggertype:"object"`
+} //@name WebhookEvent
+
+// Attempt is the outcome of one delivery attempt
+// @Description Webhook delivery attempt
+type Attempt struct {
+	At time.Time `json:"at"`
+	// StatusCode is the HTTP status the endpoint answered with; 0 when it
+	// could not be reached
+	StatusCode int `json:"status_code,omitempty" example:"500"`
+	// Response is the start of the response body
+	Response   string `json:"response,omitempty" example:"upstream unavailable"`
+	Error      string `json:"error,omitempty" example:"endpoint returned 500 Internal Server Error"`
+	DurationMS int64  `json:"duration_ms" example:"182"`
+} //@name WebhookAttempt
+
+// Delivery is an event queued for one endpoint, with its delivery log
+// @Description Webhook delivery
+type Delivery struct {
+	ID        string `json:"id" example:"c8d2f4a1-6e3b-4b7a-9f10-5a2e8d3c1b96"`
+	WebhookID string `json:"webhook_id" example:"3b1f7c52-9d0e-4d8a-b6f1-0c2e4a9d7e11"`
+	Event     Event  `json:"event"`
+	Status    string `json:"status" enums:"PENDING,DELIVERED,DEAD" example:"PENDING"`
+	// Attempts made so far, oldest first
+	Attempts      []Attempt  `json:"attempts"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
+	DeadAt        *time.Time `json:"dead_at,omitempty"`
+} //@name WebhookDelivery
+
+// Sign returns the signature of a delivery body sent at a Unix timestamp: the
+// hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the endpoint's secret.
+// Receivers recompute it and compare it with the X-Webhook-Signature header,
+// which carries it as sha256=<signature>, and reject stale timestamps to
+// prevent replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// newSecret returns a random signing secret
+func newSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,526 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/models"
+	"api-gateway/proto"
+	"api-gateway/restock"
+	"api-gateway/tenant"
+	"api-gateway/webhook"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// orderEvent is the data of order webhooks; receivers fetch the order for
+// its details
+type orderEvent struct {
+	OrderID string `json:"order_id"`
+	UserID  int32  `json:"user_id"`
+	Status  string `json:"status"`
+}
+
+// productEvent is the data of product webhooks
+type productEvent struct {
+	ProductID int32 `json:"product_id"`
+	UserID    int32 `json:"user_id"`
+}
+
+// startWebhooks delivers queued webhooks in the background and relays the
+// events the inventory service consumes from Kafka to them
+func startWebhooks() {
+	if cfg.WebhookDispatchInterval <= 0 {
+		log.Println("Webhook deliveries disabled")
+	} else {
+		go webhookDispatcher.Run(context.Background(), cfg.WebhookDispatchInterval, cfg.WebhookLogRetention)
+		log.Printf("Webhook dispatcher running every %s", cfg.WebhookDispatchInterval)
+	}
+
+	if cfg.WebhookKafkaPollInterval <= 0 {
+		log.Println("Kafka webhook relay disabled")
+		return
+	}
+	go relayKafkaEvents(context.Background())
+	log.Printf("Kafka webhook relay polling every %s", cfg.WebhookKafkaPollInterval)
+}
+
+// publishEvent queues an event for the storefront's webhooks. Failures are
+// logged and never fail the request that caused the event.
+func publishEvent(tenantID, eventType, key string, data any) {
+	if _, err := webhooks.Publish(tenantID, eventType, key, data); err != nil {
+		log.Printf("failed to queue %s webhooks: %v", eventType, err)
+	}
+}
+
+// The keys below identify events reported both by gateway actions and
+// through Kafka, so each is delivered once
+
+func orderCreatedKey(orderID string) string {
+	return webhook.EventOrderCreated + ":" + orderID
+}
+
+func orderStatusKey(orderID, status string) string {
+	return webhook.EventOrderStatusChanged + ":" + orderID + ":" + status
+}
+
+func lowStockKey(inventoryItemID int32, detectedAt string) string {
+	return fmt.Sprintf("%s:%d:%s", webhook.EventLowStock, inventoryItemID, detectedAt)
+}
+
+// publishOrderCreated queues order.created webhooks
+func publishOrderCreated(c *fiber.Ctx, order *proto.Order) {
+	publishEvent(tenantOf(c).ID, webhook.EventOrderCreated, orderCreatedKey(order.Id), orderEvent{
+		OrderID: order.Id,
+		UserID:  order.UserId,
+		Status:  order.Status.String(),
+	})
+}
+
+// publishOrderStatusChanged queues order.status_changed webhooks
+func publishOrderStatusChanged(c *fiber.Ctx, order *proto.Order) {
+	status := order.Status.String()
+	publishEvent(tenantOf(c).ID, webhook.EventOrderStatusChanged, orderStatusKey(order.Id, status), orderEvent{
+		OrderID: order.Id,
+		UserID:  order.UserId,
+		Status:  status,
+	})
+}
+
+// publishProductUpdated queues product.updated webhooks
+func publishProductUpdated(c *fiber.Ctx, product *proto.Product) {
+	queueProductUpdated(tenantOf(c).ID, product)
+}
+
+// queueProductUpdated queues product.updated webhooks of a storefront, for
+// changes made outside a request such as scheduled prices
+func queueProductUpdated(tenantID string, product *proto.Product) {
+	publishEvent(tenantID, webhook.EventProductUpdated, webhook.EventProductUpdated+":"+uuid.NewString(), productEvent{
+		ProductID: product.Id,
+		UserID:    product.UserId,
+	})
+}
+
+// queueLowStockWebhooks queues inventory.low_stock webhooks for the alerts
+// of a storefront's low-stock monitor
+func queueLowStockWebhooks(ctx context.Context, alerts []restock.Alert) error {
+	t, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	for _, a := range alerts {
+		key := lowStockKey(a.InventoryItemID, a.DetectedAt.Format(time.RFC3339))
+		if _, err := webhooks.Publish(t.ID, webhook.EventLowStock, key, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// relayKafkaEvents polls the events the inventory service consumed from the
+// order-events and inventory-events topics and queues webhooks for them,
+// resuming after the last event relayed
+func relayKafkaEvents(ctx context.Context) {
+	ticker := time.NewTicker(cfg.WebhookKafkaPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := relayKafkaBatch(ctx); err != nil {
+			log.Printf("Kafka webhook relay failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayKafkaBatch relays every event recorded since the last one relayed.
+// Calls carry no tenant, so events of every storefront are seen.
+func relayKafkaBatch(ctx context.Context) error {
+	for {
+		cursor := webhooks.KafkaCursor()
+		callCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		resp, err := clients.InventoryClient.ListKafkaEvents(callCtx, &proto.ListKafkaEventsRequest{
+			AfterId: cursor,
+			Limit:   scanPageSize,
+		})
+		cancel()
+		if err != nil {
+			return err
+		}
+
+		last := cursor
+		for _, e := range resp.Events {
+			if eventType, key, data, ok := kafkaWebhookEvent(e); ok {
+				if _, err := webhooks.Publish(e.TenantId, eventType, key, data); err != nil {
+					return errors.Join(err, webhooks.SetKafkaCursor(last))
+				}
+			}
+			last = e.Id
+		}
+		if last != cursor {
+			if err := webhooks.SetKafkaCursor(last); err != nil {
+				return err
+			}
+		}
+		if len(resp.Events) < scanPageSize {
+			return nil
+		}
+	}
+}
+
+// kafkaWebhookEvent maps an event consumed from Kafka to the webhook event it
+// reports; ok is false for events webhooks do not cover
+func kafkaWebhookEvent(e *proto.KafkaEvent) (eventType, key string, data any, ok bool) {
+	switch {
+	case e.Topic == "order-events" && strings.HasPrefix(e.EventType, "ORDER_"):
+		var order struct {
+			ID     string `json:"id"`
+			UserID int32  `json:"user_id"`
+			Status string `json:"status"`
+		}
+		if err := json.Unmarshal([]byte(e.Data), &order); err != nil || order.ID == "" {
+			return "", "", nil, false
+		}
+		if e.EventType == "ORDER_CREATED" {
+			return webhook.EventOrderCreated, orderCreatedKey(order.ID), orderEvent{
+				OrderID: order.ID,
+				UserID:  order.UserID,
+				Status:  order.Status,
+			}, true
+		}
+		status := strings.TrimPrefix(e.EventType, "ORDER_")
+		return webhook.EventOrderStatusChanged, orderStatusKey(order.ID, status), orderEvent{
+			OrderID: order.ID,
+			UserID:  order.UserID,
+			Status:  status,
+		}, true
+
+	case e.Topic == "inventory-events" && e.EventType == "LOW_STOCK":
+		var alert struct {
+			restock.Alert
+			// the producer names detected_at updated_at
+			UpdatedAt string `json:"updated_at"`
+		}
+		if err := json.Unmarshal([]byte(e.Data), &alert); err != nil || alert.InventoryItemID == 0 {
+			return "", "", nil, false
+		}
+		alert.Alert.DetectedAt, _ = time.Parse(time.RFC3339, alert.UpdatedAt)
+		return webhook.EventLowStock, lowStockKey(alert.InventoryItemID, alert.UpdatedAt), alert.Alert, true
+	}
+	return "", "", nil, false
+}
+
+// webhookError maps store errors to HTTP status codes
+func webhookError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, webhook.ErrNotFound), errors.Is(err, webhook.ErrDeliveryNotFound):
+		return c.Status(404).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, webhook.ErrInvalidWebhook):
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, webhook.ErrNotDead):
+		return c.Status(409).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+}
+
+// listWebhookEventTypes List Webhook Event Types
+// @Summary      List webhook event types
+// @Description  List the events webhooks can subscribe to
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.WebhookEventTypesResponse
+// @Router       /webhooks/events [get]
+func listWebhookEventTypes(c *fiber.Ctx) error {
+	return c.JSON(models.WebhookEventTypesResponse{Events: webhook.EventTypes()})
+}
+
+// listWebhooks List Webhooks
+// @Summary      List webhooks
+// @Description  List the webhook endpoints registered on the storefront, without their secrets
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.WebhooksListResponse
+// @Router       /webhooks [get]
+func listWebhooks(c *fiber.Ctx) error {
+	list := webhooks.List(tenantOf(c).ID)
+	return c.JSON(models.WebhooksListResponse{
+		Webhooks: list,
+		Total:    int32(len(list)),
+	})
+}
+
+// getWebhook Get Webhook
+// @Summary      Get webhook by ID
+// @Description  Get a webhook endpoint of the storefront, without its secret
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Webhook ID"
+// @Success      200  {object}  models.WebhookResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Router       /webhooks/{id} [get]
+func getWebhook(c *fiber.Ctx) error {
+	w, err := webhooks.Get(tenantOf(c).ID, c.Params("id"))
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.JSON(models.WebhookResponse{
+		Message: "Webhook retrieved successfully",
+		Webhook: w,
+	})
+}
+
+// createWebhook Create Webhook
+// @Summary      Register a webhook
+// @Description  Register an endpoint to be notified of the storefront's events. Deliveries are POSTed as JSON and signed: X-Webhook-Signature is sha256=<hex HMAC-SHA256 of "<X-Webhook-Timestamp>.<body>"> keyed with the returned secret, which is not shown again. Failed deliveries are retried with exponential backoff and dead-lettered after WEBHOOK_MAX_ATTEMPTS attempts.
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        webhook  body      models.CreateWebhookRequest  true  "Webhook to register"
+// @Success      201      {object}  models.WebhookResponse
+// @Failure      400      {object}  models.ErrorResponse
+// @Failure      500      {object}  models.ErrorResponse
+// @Router       /webhooks [post]
+func createWebhook(c *fiber.Ctx) error {
+	var req models.CreateWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	w, err := webhooks.Create(tenantOf(c).ID, callerOf(c).ID, webhook.Endpoint{
+		URL:         req.URL,
+		Events:      req.Events,
+		Description: req.Description,
+		Active:      true,
+	})
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.Status(201).JSON(models.WebhookResponse{
+		Message: "Webhook created successfully; store the secret, it is not shown again",
+		Webhook: w,
+	})
+}
+
+// updateWebhook Update Webhook
+// @Summary      Update a webhook
+// @Description  Update the URL, events, description or active state of a webhook; omitted fields are left unchanged. Deliveries queued while a webhook is inactive are sent once it is active again.
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                       true  "Webhook ID"
+// @Param        webhook  body      models.UpdateWebhookRequest  true  "Webhook changes"
+// @Success      200      {object}  models.WebhookResponse
+// @Failure      400      {object}  models.ErrorResponse
+// @Failure      404      {object}  models.ErrorResponse
+// @Failure      500      {object}  models.ErrorResponse
+// @Router       /webhooks/{id} [put]
+func updateWebhook(c *fiber.Ctx) error {
+	var req models.UpdateWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	tenantID := tenantOf(c).ID
+	w, err := webhooks.Get(tenantID, c.Params("id"))
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	endpoint := w.Endpoint
+	if req.URL != "" {
+		endpoint.URL = req.URL
+	}
+	if req.Events != nil {
+		endpoint.Events = req.Events
+	}
+	if req.Description != nil {
+		endpoint.Description = *req.Description
+	}
+	if req.Active != nil {
+		endpoint.Active = *req.Active
+	}
+
+	w, err = webhooks.Update(tenantID, w.ID, endpoint)
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.JSON(models.WebhookResponse{
+		Message: "Webhook updated successfully",
+		Webhook: w,
+	})
+}
+
+// deleteWebhook Delete Webhook
+// @Summary      Delete a webhook
+// @Description  Delete a webhook endpoint with its pending deliveries and delivery log
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Webhook ID"
+// @Success      200  {object}  models.SuccessResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /webhooks/{id} [delete]
+func deleteWebhook(c *fiber.Ctx) error {
+	if err := webhooks.Delete(tenantOf(c).ID, c.Params("id")); err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Webhook deleted successfully",
+	})
+}
+
+// rotateWebhookSecret Rotate Webhook Secret
+// @Summary      Rotate a webhook's secret
+// @Description  Replace the signing secret of a webhook. The new secret is returned once and signs every later attempt, including retries of earlier deliveries.
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Webhook ID"
+// @Success      200  {object}  models.WebhookResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /webhooks/{id}/rotate-secret [post]
+func rotateWebhookSecret(c *fiber.Ctx) error {
+	w, err := webhooks.RotateSecret(tenantOf(c).ID, c.Params("id"))
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.JSON(models.WebhookResponse{
+		Message: "Webhook secret rotated; store the secret, it is not shown again",
+		Webhook: w,
+	})
+}
+
+// sendTestWebhook Send Test Webhook
+// @Summary      Send a test event
+// @Description  Send a webhook.test event to the endpoint right away, whatever its event filter and even while it is inactive, and return the delivery with the outcome. A failed test is retried like any other delivery.
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Webhook ID"
+// @Success      200  {object}  models.WebhookDeliveryResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Router       /webhooks/{id}/test [post]
+func sendTestWebhook(c *fiber.Ctx) error {
+	id := c.Params("id")
+	queued, err := webhooks.QueueTest(tenantOf(c).ID, id, fiber.Map{
+		"webhook_id": id,
+		"message":    "Test event from the API Gateway",
+	})
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), cfg.WebhookTimeout+5*time.Second)
+	defer cancel()
+
+	delivery, err := webhookDispatcher.Deliver(ctx, queued.ID)
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	message := "Test event queued"
+	switch {
+	case delivery.Status == webhook.Delivered:
+		message = "Test event delivered"
+	case delivery.Status == webhook.Dead:
+		message = "Test event failed"
+	case len(delivery.Attempts) > 0:
+		message = "Test event failed; it will be retried"
+	}
+	return c.JSON(models.WebhookDeliveryResponse{
+		Message:  message,
+		Delivery: delivery,
+	})
+}
+
+// listWebhookDeliveries List Webhook Deliveries
+// @Summary      List a webhook's deliveries
+// @Description  Get the delivery log of a webhook, newest first: every event queued for it with the outcome of each attempt. Dead deliveries are the dead letters; successful ones are kept for WEBHOOK_LOG_RETENTION.
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id      path      string  true   "Webhook ID"
+// @Param        status  query     string  false  "Only deliveries with this status"  Enums(PENDING, DELIVERED, DEAD)
+// @Param        page    query     int     false  "Page number"  default(1)
+// @Param        limit   query     int     false  "Items per page"  default(10)
+// @Success      200     {object}  models.WebhookDeliveriesListResponse
+// @Failure      400     {object}  models.ErrorResponse
+// @Failure      404     {object}  models.ErrorResponse
+// @Router       /webhooks/{id}/deliveries [get]
+func listWebhookDeliveries(c *fiber.Ctx) error {
+	status := strings.ToUpper(c.Query("status"))
+	switch status {
+	case "", webhook.Pending, webhook.Delivered, webhook.Dead:
+	default:
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid status: use PENDING, DELIVERED or DEAD"})
+	}
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	page = max(page, 1)
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	deliveries, err := webhooks.Deliveries(tenantOf(c).ID, c.Params("id"), status)
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	total := len(deliveries)
+	start := min((page-1)*limit, total)
+	end := min(start+limit, total)
+	return c.JSON(models.WebhookDeliveriesListResponse{
+		Deliveries: deliveries[start:end],
+		Total:      int32(total),
+		Page:       int32(page),
+		Limit:      int32(limit),
+	})
+}
+
+// retryWebhookDelivery Retry Webhook Delivery
+// @Summary      Retry a dead delivery
+// @Description  Queue a dead-lettered delivery again. It gets one more attempt right away and is dead again if that fails too.
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id          path      string  true  "Webhook ID"
+// @Param        deliveryId  path      string  true  "Delivery ID"
+// @Success      200         {object}  models.WebhookDeliveryResponse
+// @Failure      404         {object}  models.ErrorResponse
+// @Failure      409         {object}  models.ErrorResponse
+// @Failure      500         {object}  models.ErrorResponse
+// @Router       /webhooks/{id}/deliveries/{deliveryId}/retry [post]
+func retryWebhookDelivery(c *fiber.Ctx) error {
+	delivery, err := webhooks.Retry(tenantOf(c).ID, c.Params("id"), c.Params("deliveryId"))
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.JSON(models.WebhookDeliveryResponse{
+		Message:  "Delivery queued again",
+		Delivery: delivery,
+	})
+}
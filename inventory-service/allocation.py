@@ -0,0 +1,77 @@
+import math
+
+import inventory_pb2
+
+EARTH_RADIUS_KM = 6371.0
+
+def distance_km(lat1, lon1, lat2, lon2):
+    """Great-circle distance between two coordinates (haversine formula)"""
+    phi1, phi2 = math.radians(lat1), math.radians(lat2)
+    dphi = math.radians(lat2 - lat1)
+    dlambda = math.radians(lon2 - lon1)
+    a = math.sin(dphi / 2) ** 2 + math.cos(phi1) * math.cos(phi2) * math.sin(dlambda / 2) ** 2
+    return 2 * EARTH_RADIUS_KM * math.asin(math.sqrt(a))
+
+def _distance(item, destination):
+    warehouse = item.warehouse
+    if destination is None or warehouse is None or warehouse.latitude is None or warehouse.longitude is None:
+        # Warehouses without coordinates rank after all located ones
+        return math.inf
+    return distance_km(warehouse.latitude, warehouse.longitude, destination[0], destination[1])
+
+def allocate(items, quantity, strategy, destination=None):
+    """Choose the inventory items a reservation draws from.
+
+    items are the inventory rows of one product, destination an optional
+    (latitude, longitude) of the shipping address. Returns a list of
+    (item, quantity) pairs, or None when the strategy cannot fill the
+    quantity. NEAREST and LARGEST_STOCK ship from a single warehouse;
+    SPLIT draws from as many as needed.
+    """
+    candidates = [
+        item for item in items
+        if item.available_quantity() > 0 and (item.warehouse is None or item.warehouse.active)
+    ]
+
+    by_stock = lambda item: (-item.available_quantity(), item.id)
+    by_distance = lambda item: (_distance(item, destination), -item.available_quantity(), item.id)
+
+    if strategy == inventory_pb2.AllocationStrategy.NEAREST and destination is not None:
+        candidates.sort(key=by_distance)
+        return _single(candidates, quantity)
+
+    if strategy in (inventory_pb2.AllocationStrategy.NEAREST, inventory_pb2.AllocationStrategy.LARGEST_STOCK):
+        candidates.sort(key=by_stock)
+        return _single(candidates, quantity)
+
+    # SPLIT and the default: nearest first when the destination is known,
+    # otherwise largest stock first to keep the number of shipments low
+    candidates.sort(key=by_distance if destination is not None else by_stock)
+    allocations = []
+    remaining = quantity
+    for item in candidates:
+        if remaining <= 0:
+            break
+        take = min(item.available_quantity(), remaining)
+        allocations.append((item, take))
+        remaining -= take
+
+    return allocations if remaining <= 0 else None
+
+def capacity(items, strategy):
+    """The largest quantity the strategy can allocate from items: the stock
+    of the best single warehouse for NEAREST and LARGEST_STOCK, the stock of
+    all active warehouses otherwise"""
+    available = [
+        item.available_quantity() for item in items
+        if item.available_quantity() > 0 and (item.warehouse is None or item.warehouse.active)
+    ]
+    if strategy in (inventory_pb2.AllocationStrategy.NEAREST, inventory_pb2.AllocationStrategy.LARGEST_STOCK):
+        return max(available, default=0)
+    return sum(available)
+
+def _single(candidates, quantity):
+    for item in candidates:
+        if item.available_quantity() >= quantity:
+            return [(item, quantity)]
+    return None
@@ -14,7 +14,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0finventory.proto\x12\tinventory\"\x96\x01\n\rInventoryItem\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x12\n\nproduct_id\x18\x02 \x01(\x05\x12\x10\n\x08quantity\x18\x03 \x01(\x05\x12\x19\n\x11reserved_quantity\x18\x04 \x01(\x05\x12\x10\n\x08location\x18\x05 \x01(\t\x12\x12\n\ncreated_at\x18\x06 \x01(\t\x12\x12\n\nupdated_at\x18\x07 \x01(\t\"T\n\x1a\x43reateInventoryItemRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x10\n\x08quantity\x18\x02 \x01(\x05\x12\x10\n\x08location\x18\x03 \x01(\t\"%\n\x17GetInventoryItemRequest\x12\n\n\x02id\x18\x01 \x01(\x05\"L\n\x1aUpdateInventoryItemRequest\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x10\n\x08quantity\x18\x02 \x01(\x05\x12\x10\n\x08location\x18\x03 \x01(\t\"8\n\x19ListInventoryItemsRequest\x12\x0c\n\x04page\x18\x01 \x01(\x05\x12\r\n\x05limit\x18\x02 \x01(\x05\"P\n\x15InventoryItemResponse\x12&\n\x04item\x18\x01 \x01(\x0b\x32\x18.inventory.InventoryItem\x12\x0f\n\x07message\x18\x02 \x01(\t\"q\n\x1aListInventoryItemsResponse\x12\'\n\x05items\x18\x01 \x03(\x0b\x32\x18.inventory.InventoryItem\x12\r\n\x05total\x18\x02 \x01(\x05\x12\x0c\n\x04page\x18\x03 \x01(\x05\x12\r\n\x05limit\x18\x04 \x01(\x05\"B\n\x11\x43heckStockRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x19\n\x11required_quantity\x18\x02 \x01(\x05\"T\n\x12\x43heckStockResponse\x12\x11\n\tavailable\x18\x01 \x01(\x08\x12\x1a\n\x12\x61vailable_quantity\x18\x02 \x01(\x05\x12\x0f\n\x07message\x18\x03 \x01(\t\"M\n\x13ReserveStockRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x10\n\x08quantity\x18\x02 \x01(\x05\x12\x10\n\x08order_id\x18\x03 \x01(\t\"P\n\x14ReserveStockResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\x12\x16\n\x0ereservation_id\x18\x03 \x01(\t\"-\n\x13ReleaseStockRequest\x12\x16\n\x0ereservation_id\x18\x01 \x01(\t\"8\n\x14ReleaseStockResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"\xaf\x01\n\x05Order\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0f\n\x07user_id\x18\x02 \x01(\x05\x12#\n\x05items\x18\x03 \x03(\x0b\x32\x14.inventory.OrderItem\x12\x14\n\x0ctotal_amount\x18\x04 \x01(\x01\x12&\n\x06status\x18\x05 \x01(\x0e\x32\x16.inventory.OrderStatus\x12\x12\n\ncreated_at\x18\x06 \x01(\t\x12\x12\n\nupdated_at\x18\x07 \x01(\t\"@\n\tOrderItem\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x10\n\x08quantity\x18\x02 \x01(\x05\x12\r\n\x05price\x18\x03 \x01(\x01\"J\n\x12\x43reateOrderRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\x05\x12#\n\x05items\x18\x02 \x03(\x0b\x32\x14.inventory.OrderItem\"\x1d\n\x0fGetOrderRequest\x12\n\n\x02id\x18\x01 \x01(\t\"N\n\x18UpdateOrderStatusRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12&\n\x06status\x18\x02 \x01(\x0e\x32\x16.inventory.OrderStatus\"A\n\rOrderResponse\x12\x1f\n\x05order\x18\x01 \x01(\x0b\x32\x10.inventory.Order\x12\x0f\n\x07message\x18\x02 \x01(\t\"A\n\x11ListOrdersRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\x05\x12\x0c\n\x04page\x18\x02 \x01(\x05\x12\r\n\x05limit\x18\x03 \x01(\x05\"b\n\x12ListOrdersResponse\x12 \n\x06orders\x18\x01 \x03(\x0b\x32\x10.inventory.Order\x12\r\n\x05total\x18\x02 \x01(\x05\x12\x0c\n\x04page\x18\x03 \x01(\x05\x12\r\n\x05limit\x18\x04 \x01(\x05*d\n\x0bOrderStatus\x12\x0b\n\x07PENDING\x10\x00\x12\r\n\tCONFIRMED\x10\x01\x12\x0e\n\nPROCESSING\x10\x02\x12\x0b\n\x07SHIPPED\x10\x03\x12\r\n\tDELIVERED\x10\x04\x12\r\n\tCANCELLED\x10\x05\x32\xfc\x04\n\x10InventoryService\x12^\n\x13\x43reateInventoryItem\x12%.inventory.CreateInventoryItemRequest\x1a .inventory.InventoryItemResponse\x12X\n\x10GetInventoryItem\x12\".inventory.GetInventoryItemRequest\x1a .inventory.InventoryItemResponse\x12^\n\x13UpdateInventoryItem\x12%.inventory.UpdateInventoryItemRequest\x1a .inventory.InventoryItemResponse\x12\x61\n\x12ListInventoryItems\x12$.inventory.ListInventoryItemsRequest\x1a%.inventory.ListInventoryItemsResponse\x12I\n\nCheckStock\x12\x1c.inventory.CheckStockRequest\x1a\x1d.inventory.CheckStockResponse\x12O\n\x0cReserveStock\x12\x1e.inventory.ReserveStockRequest\x1a\x1f.inventory.ReserveStockResponse\x12O\n\x0cReleaseStock\x12\x1e.inventory.ReleaseStockRequest\x1a\x1f.inventory.ReleaseStockResponse2\xb7\x02\n\x0cOrderService\x12\x46\n\x0b\x43reateOrder\x12\x1d.inventory.CreateOrderRequest\x1a\x18.inventory.OrderResponse\x12@\n\x08GetOrder\x12\x1a.inventory.GetOrderRequest\x1a\x18.inventory.OrderResponse\x12I\n\nListOrders\x12\x1c.inventory.ListOrdersRequest\x1a\x1d.inventory.ListOrdersResponse\x12R\n\x11UpdateOrderStatus\x12#.inventory.UpdateOrderStatusRequest\x1a\x18.inventory.OrderResponseB\tZ\x07./protob\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0finventory.proto\x12\tinventory\"\xa1\x01\n\tWarehouse\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x0c\n\x04\x63ode\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0f\n\x07\x61\x64\x64ress\x18\x04 \x01(\t\x12\x10\n\x08latitude\x18\x05 \x01(\x01\x12\x11\n\tlongitude\x18\x06 \x01(\x01\x12\x0e\n\x06\x61\x63tive\x18\x07 \x01(\x08\x12\x12\n\ncreated_at\x18\x08 \x01(\t\x12\x12\n\nupdated_at\x18\t \x01(\t\"j\n\x16\x43reateWarehouseRequest\x12\x0c\n\x04\x63ode\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07\x61\x64\x64ress\x18\x03 \x01(\t\x12\x10\n\x08latitude\x18\x04 \x01(\x01\x12\x11\n\tlongitude\x18\x05 \x01(\x01\"!\n\x13GetWarehouseRequest\x12\n\n\x02id\x18\x01 \x01(\x05\"x\n\x16UpdateWarehouseRequest\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07\x61\x64\x64ress\x18\x03 \x01(\t\x12\x10\n\x08latitude\x18\x04 \x01(\x01\x12\x11\n\tlongitude\x18\x05 \x01(\x01\x12\x0e\n\x06\x61\x63tive\x18\x06 \x01(\x08\"$\n\x16\x44\x65leteWarehouseRequest\x12\n\n\x02id\x18\x01 \x01(\x05\";\n\x17\x44\x65leteWarehouseResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"1\n\x15ListWarehousesRequest\x12\x18\n\x10include_inactive\x18\x01 \x01(\x08\"M\n\x11WarehouseResponse\x12\'\n\twarehouse\x18\x01 \x01(\x0b\x32\x14.inventory.Warehouse\x12\x0f\n\x07message\x18\x02 \x01(\t\"B\n\x16ListWarehousesResponse\x12(\n\nwarehouses\x18\x01 \x03(\x0b\x32\x14.inventory.Warehouse\"k\n\rTransferEvent\x12)\n\x06status\x18\x01 \x01(\x0e\x32\x19.inventory.TransferStatus\x12\r\n\x05\x61\x63tor\x18\x02 \x01(\t\x12\x0c\n\x04note\x18\x03 \x01(\t\x12\x12\n\ncreated_at\x18\x04 \x01(\t\"\xe2\x02\n\rStockTransfer\x12\n\n\x02id\x18\x01 \x01(\t\x12\x12\n\nproduct_id\x18\x02 \x01(\x05\x12\x10\n\x08quantity\x18\x03 \x01(\x05\x12\x1b\n\x13source_warehouse_id\x18\x04 \x01(\x05\x12\x1d\n\x15source_warehouse_code\x18\x05 \x01(\t\x12 \n\x18\x64\x65stination_warehouse_id\x18\x06 \x01(\x05\x12\"\n\x1a\x64\x65stination_warehouse_code\x18\x07 \x01(\t\x12)\n\x06status\x18\x08 \x01(\x0e\x32\x19.inventory.TransferStatus\x12\x0c\n\x04note\x18\t \x01(\t\x12\x12\n\ncreated_at\x18\n \x01(\t\x12\x12\n\nupdated_at\x18\x0b \x01(\t\x12(\n\x06\x65vents\x18\x0c \x03(\x0b\x32\x18.inventory.TransferEvent\x12\x12\n\nvariant_id\x18\r \x01(\x05\"\xad\x01\n\x15\x43reateTransferRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x10\n\x08quantity\x18\x02 \x01(\x05\x12\x1b\n\x13source_warehouse_id\x18\x03 \x01(\x05\x12 \n\x18\x64\x65stination_warehouse_id\x18\x04 \x01(\x05\x12\x0c\n\x04note\x18\x05 \x01(\t\x12\r\n\x05\x61\x63tor\x18\x06 \x01(\t\x12\x12\n\nvariant_id\x18\x07 \x01(\x05\" \n\x12GetTransferRequest\x12\n\n\x02id\x18\x01 \x01(\t\"\x9c\x01\n\x14ListTransfersRequest\x12\x0c\n\x04page\x18\x01 \x01(\x05\x12\r\n\x05limit\x18\x02 \x01(\x05\x12\x12\n\nhas_status\x18\x03 \x01(\x08\x12)\n\x06status\x18\x04 \x01(\x0e\x32\x19.inventory.TransferStatus\x12\x14\n\x0cwarehouse_id\x18\x05 \x01(\x05\x12\x12\n\nproduct_id\x18\x06 \x01(\x05\"q\n\x1bUpdateTransferStatusRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12)\n\x06status\x18\x02 \x01(\x0e\x32\x19.inventory.TransferStatus\x12\r\n\x05\x61\x63tor\x18\x03 \x01(\t\x12\x0c\n\x04note\x18\x04 \x01(\t\"O\n\x10TransferResponse\x12*\n\x08transfer\x18\x01 \x01(\x0b\x32\x18.inventory.StockTransfer\x12\x0f\n\x07message\x18\x02 \x01(\t\"p\n\x15ListTransfersResponse\x12+\n\ttransfers\x18\x01 \x03(\x0b\x32\x18.inventory.StockTransfer\x12\r\n\x05total\x18\x02 \x01(\x05\x12\x0c\n\x04page\x18\x03 \x01(\x05\x12\r\n\x05limit\x18\x04 \x01(\x05\"\xaa\x02\n\rInventoryItem\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x12\n\nproduct_id\x18\x02 \x01(\x05\x12\x10\n\x08quantity\x18\x03 \x01(\x05\x12\x19\n\x11reserved_quantity\x18\x04 \x01(\x05\x12\x10\n\x08location\x18\x05 \x01(\t\x12\x12\n\ncreated_at\x18\x06 \x01(\t\x12\x12\n\nupdated_at\x18\x07 \x01(\t\x12\x14\n\x0cwarehouse_id\x18\x08 \x01(\x05\x12\x1a\n\x12\x61vailable_quantity\x18\t \x01(\x05\x12\x1b\n\x13in_transit_quantity\x18\n \x01(\x05\x12\x15\n\rreorder_point\x18\x0b \x01(\x05\x12\x18\n\x10reorder_quantity\x18\x0c \x01(\x05\x12\x12\n\nvariant_id\x18\r \x01(\x05\"\xcc\x01\n\x1a\x43reateInventoryItemRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x10\n\x08quantity\x18\x02 \x01(\x05\x12\x10\n\x08location\x18\x03 \x01(\t\x12\x14\n\x0cwarehouse_id\x18\x04 \x01(\x05\x12\r\n\x05\x61\x63tor\x18\x05 \x01(\t\x12\x0c\n\x04note\x18\x06 \x01(\t\x12\x15\n\rreorder_point\x18\x07 \x01(\x05\x12\x18\n\x10reorder_quantity\x18\x08 \x01(\x05\x12\x12\n\nvariant_id\x18\t \x01(\x05\"%\n\x17GetInventoryItemRequest\x12\n\n\x02id\x18\x01 \x01(\x05\"\x82\x02\n\x1aUpdateInventoryItemRequest\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x14\n\x08quantity\x18\x02 \x01(\x05\x42\x02\x18\x01\x12\x10\n\x08location\x18\x03 \x01(\t\x12\x14\n\x0cwarehouse_id\x18\x04 \x01(\x05\x12\r\n\x05\x64\x65lta\x18\x05 \x01(\x05\x12\x0e\n\x06reason\x18\x06 \x01(\t\x12\r\n\x05\x61\x63tor\x18\x07 \x01(\t\x12\x0c\n\x04note\x18\x08 \x01(\t\x12\x11\n\treference\x18\t \x01(\t\x12\x1a\n\x12set_reorder_levels\x18\n \x01(\x08\x12\x15\n\rreorder_point\x18\x0b \x01(\x05\x12\x18\n\x10reorder_quantity\x18\x0c \x01(\x05\"\x8e\x01\n\x19ListInventoryItemsRequest\x12\x0c\n\x04page\x18\x01 \x01(\x05\x12\r\n\x05limit\x18\x02 \x01(\x05\x12\x14\n\x0cwarehouse_id\x18\x03 \x01(\x05\x12\x12\n\nproduct_id\x18\x04 \x01(\x05\x12\x16\n\x0elow_stock_only\x18\x05 \x01(\x08\x12\x12\n\nvariant_id\x18\x06 \x01(\x05\"\xbd\x02\n\x14InventoryLedgerEntry\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x19\n\x11inventory_item_id\x18\x02 \x01(\x05\x12\x12\n\nproduct_id\x18\x03 \x01(\x05\x12\x14\n\x0cwarehouse_id\x18\x04 \x01(\x05\x12\x0c\n\x04type\x18\x05 \x01(\t\x12\x0e\n\x06reason\x18\x06 \x01(\t\x12\r\n\x05\x61\x63tor\x18\x07 \x01(\t\x12\x16\n\x0equantity_delta\x18\x08 \x01(\x05\x12\x16\n\x0ereserved_delta\x18\t \x01(\x05\x12\x16\n\x0equantity_after\x18\n \x01(\x05\x12\x16\n\x0ereserved_after\x18\x0b \x01(\x05\x12\x11\n\treference\x18\x0c \x01(\t\x12\x0c\n\x04note\x18\r \x01(\t\x12\x12\n\ncreated_at\x18\x0e \x01(\t\x12\x12\n\nvariant_id\x18\x0f \x01(\x05\"E\n\x1aGetInventoryHistoryRequest\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x0c\n\x04page\x18\x02 \x01(\x05\x12\r\n\x05limit\x18\x03 \x01(\x05\"x\n\x18InventoryHistoryResponse\x12\x30\n\x07\x65ntries\x18\x01 \x03(\x0b\x32\x1f.inventory.InventoryLedgerEntry\x12\r\n\x05total\x18\x02 \x01(\x05\x12\x0c\n\x04page\x18\x03 \x01(\x05\x12\r\n\x05limit\x18\x04 \x01(\x05\"\xf9\x01\n\rLowStockAlert\x12\x19\n\x11inventory_item_id\x18\x01 \x01(\x05\x12\x12\n\nproduct_id\x18\x02 \x01(\x05\x12\x14\n\x0cwarehouse_id\x18\x03 \x01(\x05\x12\x10\n\x08location\x18\x04 \x01(\t\x12\x1a\n\x12\x61vailable_quantity\x18\x05 \x01(\x05\x12\x1b\n\x13in_transit_quantity\x18\x06 \x01(\x05\x12\x15\n\rreorder_point\x18\x07 \x01(\x05\x12\x18\n\x10reorder_quantity\x18\x08 \x01(\x05\x12\x13\n\x0b\x64\x65tected_at\x18\t \x01(\t\x12\x12\n\nvariant_id\x18\n \x01(\x05\"A\n\x15ReportLowStockRequest\x12(\n\x06\x61lerts\x18\x01 \x03(\x0b\x32\x18.inventory.LowStockAlert\":\n\x16ReportLowStockResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"~\n\nKafkaEvent\x12\n\n\x02id\x18\x01 \x01(\x05\x12\r\n\x05topic\x18\x02 \x01(\t\x12\x12\n\nevent_type\x18\x03 \x01(\t\x12\x0b\n\x03key\x18\x04 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x05 \x01(\t\x12\x11\n\ttenant_id\x18\x06 \x01(\t\x12\x13\n\x0breceived_at\x18\x07 \x01(\t\"9\n\x16ListKafkaEventsRequest\x12\x10\n\x08\x61\x66ter_id\x18\x01 \x01(\x05\x12\r\n\x05limit\x18\x02 \x01(\x05\"@\n\x17ListKafkaEventsResponse\x12%\n\x06\x65vents\x18\x01 \x03(\x0b\x32\x15.inventory.KafkaEvent\"P\n\x15InventoryItemResponse\x12&\n\x04item\x18\x01 \x01(\x0b\x32\x18.inventory.InventoryItem\x12\x0f\n\x07message\x18\x02 \x01(\t\"q\n\x1aListInventoryItemsResponse\x12\'\n\x05items\x18\x01 \x03(\x0b\x32\x18.inventory.InventoryItem\x12\r\n\x05total\x18\x02 \x01(\x05\x12\x0c\n\x04page\x18\x03 \x01(\x05\x12\r\n\x05limit\x18\x04 \x01(\x05\"V\n\x11\x43heckStockRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x19\n\x11required_quantity\x18\x02 \x01(\x05\x12\x12\n\nvariant_id\x18\x03 \x01(\x05\"\x9e\x01\n\x12\x43heckStockResponse\x12\x11\n\tavailable\x18\x01 \x01(\x08\x12\x1a\n\x12\x61vailable_quantity\x18\x02 \x01(\x05\x12\x0f\n\x07message\x18\x03 \x01(\t\x12+\n\tlocations\x18\x04 \x03(\x0b\x32\x18.inventory.LocationStock\x12\x1b\n\x13in_transit_quantity\x18\x05 \x01(\x05\"\xbb\x01\n\rLocationStock\x12\x14\n\x0cwarehouse_id\x18\x01 \x01(\x05\x12\x16\n\x0ewarehouse_code\x18\x02 \x01(\t\x12\x16\n\x0ewarehouse_name\x18\x03 \x01(\t\x12\x10\n\x08quantity\x18\x04 \x01(\x05\x12\x19\n\x11reserved_quantity\x18\x05 \x01(\x05\x12\x1a\n\x12\x61vailable_quantity\x18\x06 \x01(\x05\x12\x1b\n\x13in_transit_quantity\x18\x07 \x01(\x05\"\xfd\x01\n\x13ReserveStockRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x10\n\x08quantity\x18\x02 \x01(\x05\x12\x10\n\x08order_id\x18\x03 \x01(\t\x12/\n\x08strategy\x18\x04 \x01(\x0e\x32\x1d.inventory.AllocationStrategy\x12\x1d\n\x15has_shipping_location\x18\x05 \x01(\x08\x12\x19\n\x11shipping_latitude\x18\x06 \x01(\x01\x12\x1a\n\x12shipping_longitude\x18\x07 \x01(\x01\x12\x13\n\x0bttl_seconds\x18\x08 \x01(\x05\x12\x12\n\nvariant_id\x18\t \x01(\x05\"L\n\nAllocation\x12\x14\n\x0cwarehouse_id\x18\x01 \x01(\x05\x12\x16\n\x0ewarehouse_code\x18\x02 \x01(\t\x12\x10\n\x08quantity\x18\x03 \x01(\x05\"\x90\x01\n\x14ReserveStockResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\x12\x16\n\x0ereservation_id\x18\x03 \x01(\t\x12*\n\x0b\x61llocations\x18\x04 \x03(\x0b\x32\x15.inventory.Allocation\x12\x12\n\nexpires_at\x18\x05 \x01(\t\"-\n\x13ReleaseStockRequest\x12\x16\n\x0ereservation_id\x18\x01 \x01(\t\"8\n\x14ReleaseStockResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"K\n\x0fReservationLine\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x10\n\x08quantity\x18\x02 \x01(\x05\x12\x12\n\nvariant_id\x18\x03 \x01(\x05\"\xf3\x01\n\x18ReserveStockBatchRequest\x12\x10\n\x08order_id\x18\x01 \x01(\t\x12)\n\x05lines\x18\x02 \x03(\x0b\x32\x1a.inventory.ReservationLine\x12/\n\x08strategy\x18\x03 \x01(\x0e\x32\x1d.inventory.AllocationStrategy\x12\x1d\n\x15has_shipping_location\x18\x04 \x01(\x08\x12\x19\n\x11shipping_latitude\x18\x05 \x01(\x01\x12\x1a\n\x12shipping_longitude\x18\x06 \x01(\x01\x12\x13\n\x0bttl_seconds\x18\x07 \x01(\x05\"p\n\rLineShortfall\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x11\n\trequested\x18\x02 \x01(\x05\x12\x11\n\tavailable\x18\x03 \x01(\x05\x12\x11\n\tshortfall\x18\x04 \x01(\x05\x12\x12\n\nvariant_id\x18\x05 \x01(\x05\"\xad\x01\n\x19ReserveStockBatchResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\x12,\n\x0creservations\x18\x03 \x03(\x0b\x32\x16.inventory.Reservation\x12,\n\nshortfalls\x18\x04 \x03(\x0b\x32\x18.inventory.LineShortfall\x12\x12\n\nexpires_at\x18\x05 \x01(\t\"3\n\x1fReleaseOrderReservationsRequest\x12\x10\n\x08order_id\x18\x01 \x01(\t\"]\n ReleaseOrderReservationsResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\x12\x17\n\x0freservation_ids\x18\x03 \x03(\t\"\xde\x01\n\x0bReservation\x12\n\n\x02id\x18\x01 \x01(\t\x12\x12\n\nproduct_id\x18\x02 \x01(\x05\x12\x10\n\x08quantity\x18\x03 \x01(\x05\x12\x10\n\x08order_id\x18\x04 \x01(\t\x12\x0e\n\x06status\x18\x05 \x01(\t\x12\x12\n\ncreated_at\x18\x06 \x01(\t\x12\x12\n\nexpires_at\x18\x07 \x01(\t\x12\x13\n\x0breleased_at\x18\x08 \x01(\t\x12*\n\x0b\x61llocations\x18\t \x03(\x0b\x32\x15.inventory.Allocation\x12\x12\n\nvariant_id\x18\n \x01(\x05\"S\n\x13ReservationResponse\x12+\n\x0breservation\x18\x01 \x01(\x0b\x32\x16.inventory.Reservation\x12\x0f\n\x07message\x18\x02 \x01(\t\"J\n\x18\x45xtendReservationRequest\x12\x16\n\x0ereservation_id\x18\x01 \x01(\t\x12\x16\n\x0e\x65xtend_seconds\x18\x02 \x01(\x05\"\x97\x01\n\x17ListReservationsRequest\x12\x10\n\x08order_id\x18\x01 \x01(\t\x12\x12\n\nproduct_id\x18\x02 \x01(\x05\x12\x1f\n\x17\x65xpiring_within_seconds\x18\x03 \x01(\x05\x12\x18\n\x10include_inactive\x18\x04 \x01(\x08\x12\x0c\n\x04page\x18\x05 \x01(\x05\x12\r\n\x05limit\x18\x06 \x01(\x05\"t\n\x18ListReservationsResponse\x12,\n\x0creservations\x18\x01 \x03(\x0b\x32\x16.inventory.Reservation\x12\r\n\x05total\x18\x02 \x01(\x05\x12\x0c\n\x04page\x18\x03 \x01(\x05\x12\r\n\x05limit\x18\x04 \x01(\x05\")\n\x05Money\x12\x0e\n\x06\x61mount\x18\x01 \x01(\x03\x12\x10\n\x08\x63urrency\x18\x02 \x01(\t\"i\n\x0c\x44iscountLine\x12\x14\n\x0cpromotion_id\x18\x01 \x01(\t\x12\x0c\n\x04\x63ode\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12 \n\x06\x61mount\x18\x04 \x01(\x0b\x32\x10.inventory.Money\"\x8c\x01\n\x07TaxLine\x12\x0e\n\x06region\x18\x01 \x01(\t\x12\x10\n\x08\x63\x61tegory\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0c\n\x04rate\x18\x04 \x01(\t\x12!\n\x07taxable\x18\x05 \x01(\x0b\x32\x10.inventory.Money\x12 \n\x06\x61mount\x18\x06 \x01(\x0b\x32\x10.inventory.Money\"\xc1\x04\n\x05Order\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0f\n\x07user_id\x18\x02 \x01(\x05\x12#\n\x05items\x18\x03 \x03(\x0b\x32\x14.inventory.OrderItem\x12\x14\n\x0ctotal_amount\x18\x04 \x01(\x01\x12&\n\x06status\x18\x05 \x01(\x0e\x32\x16.inventory.OrderStatus\x12\x12\n\ncreated_at\x18\x06 \x01(\t\x12\x12\n\nupdated_at\x18\x07 \x01(\t\x12\x10\n\x08\x63urrency\x18\x08 \x01(\t\x12\"\n\x08subtotal\x18\t \x01(\x0b\x32\x10.inventory.Money\x12\"\n\x08\x64iscount\x18\n \x01(\x0b\x32\x10.inventory.Money\x12\x1d\n\x03tax\x18\x0b \x01(\x0b\x32\x10.inventory.Money\x12\x1f\n\x05total\x18\x0c \x01(\x0b\x32\x10.inventory.Money\x12\x1b\n\x13settlement_currency\x18\r \x01(\t\x12\x15\n\rexchange_rate\x18\x0e \x01(\t\x12*\n\x10settlement_total\x18\x0f \x01(\x0b\x32\x10.inventory.Money\x12*\n\tdiscounts\x18\x10 \x03(\x0b\x32\x17.inventory.DiscountLine\x12\x12\n\ntax_region\x18\x11 \x01(\t\x12\x1a\n\x12prices_include_tax\x18\x12 \x01(\x08\x12%\n\ttax_lines\x18\x13 \x03(\x0b\x32\x12.inventory.TaxLine\x12\x13\n\x0b\x61rchived_at\x18\x14 \x01(\t\"\x87\x02\n\tOrderItem\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x10\n\x08quantity\x18\x02 \x01(\x05\x12\r\n\x05price\x18\x03 \x01(\x01\x12$\n\nunit_price\x18\x04 \x01(\x0b\x32\x10.inventory.Money\x12\"\n\x08subtotal\x18\x05 \x01(\x0b\x32\x10.inventory.Money\x12\x14\n\x0cstock_policy\x18\x06 \x01(\t\x12\x1d\n\x15\x65xpected_restock_date\x18\x07 \x01(\t\x12\x0e\n\x06status\x18\x08 \x01(\t\x12\x12\n\nvariant_id\x18\t \x01(\x05\x12\x0b\n\x03sku\x18\n \x01(\t\x12\x15\n\rprice_version\x18\x0b \x01(\x05\"\xc7\x03\n\x12\x43reateOrderRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\x05\x12#\n\x05items\x18\x02 \x03(\x0b\x32\x14.inventory.OrderItem\x12\x10\n\x08\x63urrency\x18\x03 \x01(\t\x12\"\n\x08subtotal\x18\x04 \x01(\x0b\x32\x10.inventory.Money\x12\"\n\x08\x64iscount\x18\x05 \x01(\x0b\x32\x10.inventory.Money\x12\x1d\n\x03tax\x18\x06 \x01(\x0b\x32\x10.inventory.Money\x12\x1f\n\x05total\x18\x07 \x01(\x0b\x32\x10.inventory.Money\x12\x1b\n\x13settlement_currency\x18\x08 \x01(\t\x12\x15\n\rexchange_rate\x18\t \x01(\t\x12*\n\x10settlement_total\x18\n \x01(\x0b\x32\x10.inventory.Money\x12*\n\tdiscounts\x18\x0b \x03(\x0b\x32\x17.inventory.DiscountLine\x12\x12\n\ntax_region\x18\x0c \x01(\t\x12\x1a\n\x12prices_include_tax\x18\r \x01(\x08\x12%\n\ttax_lines\x18\x0e \x03(\x0b\x32\x12.inventory.TaxLine\"\x1d\n\x0fGetOrderRequest\x12\n\n\x02id\x18\x01 \x01(\t\"N\n\x18UpdateOrderStatusRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12&\n\x06status\x18\x02 \x01(\x0e\x32\x16.inventory.OrderStatus\"A\n\rOrderResponse\x12\x1f\n\x05order\x18\x01 \x01(\x0b\x32\x10.inventory.Order\x12\x0f\n\x07message\x18\x02 \x01(\t\"[\n\x11ListOrdersRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\x05\x12\x0c\n\x04page\x18\x02 \x01(\x05\x12\r\n\x05limit\x18\x03 \x01(\x05\x12\x18\n\x10include_archived\x18\x04 \x01(\x08\"b\n\x12ListOrdersResponse\x12 \n\x06orders\x18\x01 \x03(\x0b\x32\x10.inventory.Order\x12\r\n\x05total\x18\x02 \x01(\x05\x12\x0c\n\x04page\x18\x03 \x01(\x05\x12\r\n\x05limit\x18\x04 \x01(\x05\"9\n\x11UserOrdersRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\x05\x12\x13\n\x0b\x61rchived_at\x18\x02 \x01(\t\"A\n\x19ReassignUserOrdersRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\x05\x12\x13\n\x0bnew_user_id\x18\x02 \x01(\x05\"#\n\x12UserOrdersResponse\x12\r\n\x05\x63ount\x18\x01 \x01(\x05*p\n\x0eTransferStatus\x12\x16\n\x12TRANSFER_REQUESTED\x10\x00\x12\x17\n\x13TRANSFER_IN_TRANSIT\x10\x01\x12\x15\n\x11TRANSFER_RECEIVED\x10\x02\x12\x16\n\x12TRANSFER_CANCELLED\x10\x03*W\n\x12\x41llocationStrategy\x12\x16\n\x12\x41LLOCATION_DEFAULT\x10\x00\x12\x0b\n\x07NEAREST\x10\x01\x12\x11\n\rLARGEST_STOCK\x10\x02\x12\t\n\x05SPLIT\x10\x03*d\n\x0bOrderStatus\x12\x0b\n\x07PENDING\x10\x00\x12\r\n\tCONFIRMED\x10\x01\x12\x0e\n\nPROCESSING\x10\x02\x12\x0b\n\x07SHIPPED\x10\x03\x12\r\n\tDELIVERED\x10\x04\x12\r\n\tCANCELLED\x10\x05\x32\x90\x10\n\x10InventoryService\x12^\n\x13\x43reateInventoryItem\x12%.inventory.CreateInventoryItemRequest\x1a .inventory.InventoryItemResponse\x12X\n\x10GetInventoryItem\x12\".inventory.GetInventoryItemRequest\x1a .inventory.InventoryItemResponse\x12^\n\x13UpdateInventoryItem\x12%.inventory.UpdateInventoryItemRequest\x1a .inventory.InventoryItemResponse\x12\x61\n\x12ListInventoryItems\x12$.inventory.ListInventoryItemsRequest\x1a%.inventory.ListInventoryItemsResponse\x12\x61\n\x13GetInventoryHistory\x12%.inventory.GetInventoryHistoryRequest\x1a#.inventory.InventoryHistoryResponse\x12U\n\x0eReportLowStock\x12 .inventory.ReportLowStockRequest\x1a!.inventory.ReportLowStockResponse\x12X\n\x0fListKafkaEvents\x12!.inventory.ListKafkaEventsRequest\x1a\".inventory.ListKafkaEventsResponse\x12I\n\nCheckStock\x12\x1c.inventory.CheckStockRequest\x1a\x1d.inventory.CheckStockResponse\x12O\n\x0cReserveStock\x12\x1e.inventory.ReserveStockRequest\x1a\x1f.inventory.ReserveStockResponse\x12O\n\x0cReleaseStock\x12\x1e.inventory.ReleaseStockRequest\x1a\x1f.inventory.ReleaseStockResponse\x12^\n\x11ReserveStockBatch\x12#.inventory.ReserveStockBatchRequest\x1a$.inventory.ReserveStockBatchResponse\x12s\n\x18ReleaseOrderReservations\x12*.inventory.ReleaseOrderReservationsRequest\x1a+.inventory.ReleaseOrderReservationsResponse\x12X\n\x11\x45xtendReservation\x12#.inventory.ExtendReservationRequest\x1a\x1e.inventory.ReservationResponse\x12[\n\x10ListReservations\x12\".inventory.ListReservationsRequest\x1a#.inventory.ListReservationsResponse\x12R\n\x0f\x43reateWarehouse\x12!.inventory.CreateWarehouseRequest\x1a\x1c.inventory.WarehouseResponse\x12L\n\x0cGetWarehouse\x12\x1e.inventory.GetWarehouseRequest\x1a\x1c.inventory.WarehouseResponse\x12R\n\x0fUpdateWarehouse\x12!.inventory.UpdateWarehouseRequest\x1a\x1c.inventory.WarehouseResponse\x12X\n\x0f\x44\x65leteWarehouse\x12!.inventory.DeleteWarehouseRequest\x1a\".inventory.DeleteWarehouseResponse\x12U\n\x0eListWarehouses\x12 .inventory.ListWarehousesRequest\x1a!.inventory.ListWarehousesResponse\x12O\n\x0e\x43reateTransfer\x12 .inventory.CreateTransferRequest\x1a\x1b.inventory.TransferResponse\x12I\n\x0bGetTransfer\x12\x1d.inventory.GetTransferRequest\x1a\x1b.inventory.TransferResponse\x12R\n\rListTransfers\x12\x1f.inventory.ListTransfersRequest\x1a .inventory.ListTransfersResponse\x12[\n\x14UpdateTransferStatus\x12&.inventory.UpdateTransferStatusRequest\x1a\x1b.inventory.TransferResponse2\xb6\x04\n\x0cOrderService\x12\x46\n\x0b\x43reateOrder\x12\x1d.inventory.CreateOrderRequest\x1a\x18.inventory.OrderResponse\x12@\n\x08GetOrder\x12\x1a.inventory.GetOrderRequest\x1a\x18.inventory.OrderResponse\x12I\n\nListOrders\x12\x1c.inventory.ListOrdersRequest\x1a\x1d.inventory.ListOrdersResponse\x12R\n\x11UpdateOrderStatus\x12#.inventory.UpdateOrderStatusRequest\x1a\x18.inventory.OrderResponse\x12P\n\x11\x41rchiveUserOrders\x12\x1c.inventory.UserOrdersRequest\x1a\x1d.inventory.UserOrdersResponse\x12P\n\x11RestoreUserOrders\x12\x1c.inventory.UserOrdersRequest\x1a\x1d.inventory.UserOrdersResponse\x12Y\n\x12ReassignUserOrders\x12$.inventory.ReassignUserOrdersRequest\x1a\x1d.inventory.UserOrdersResponseB\tZ\x07./protob\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -22,52 +22,146 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'inventory_pb2', _globals)
 if _descriptor._USE_C_DESCRIPTORS == False:
   _globals['DESCRIPTOR']._options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z\007./proto'
-  _globals['_ORDERSTATUS']._serialized_start=1726
-  _globals['_ORDERSTATUS']._serialized_end=1826
-  _globals['_INVENTORYITEM']._serialized_start=31
-  _globals['_INVENTORYITEM']._serialized_end=181
-  _globals['_CREATEINVENTORYITEMREQUEST']._serialized_start=183
-  _globals['_CREATEINVENTORYITEMREQUEST']._serialized_end=267
-  _globals['_GETINVENTORYITEMREQUEST']._serialized_start=269
-  _globals['_GETINVENTORYITEMREQUEST']._serialized_end=306
-  _globals['_UPDATEINVENTORYITEMREQUEST']._serialized_start=308
-  _globals['_UPDATEINVENTORYITEMREQUEST']._serialized_end=384
-  _globals['_LISTINVENTORYITEMSREQUEST']._serialized_start=386
-  _globals['_LISTINVENTORYITEMSREQUEST']._serialized_end=442
-  _globals['_INVENTORYITEMRESPONSE']._serialized_start=444
-  _globals['_INVENTORYITEMRESPONSE']._serialized_end=524
-  _globals['_LISTINVENTORYITEMSRESPONSE']._serialized_start=526
-  _globals['_LISTINVENTORYITEMSRESPONSE']._serialized_end=639
-  _globals['_CHECKSTOCKREQUEST']._serialized_start=641
-  _globals['_CHECKSTOCKREQUEST']._serialized_end=707
-  _globals['_CHECKSTOCKRESPONSE']._serialized_start=709
-  _globals['_CHECKSTOCKRESPONSE']._serialized_end=793
-  _globals['_RESERVESTOCKREQUEST']._serialized_start=795
-  _globals['_RESERVESTOCKREQUEST']._serialized_end=872
-  _globals['_RESERVESTOCKRESPONSE']._serialized_start=874
-  _globals['_RESERVESTOCKRESPONSE']._serialized_end=954
-  _globals['_RELEASESTOCKREQUEST']._serialized_start=956
-  _globals['_RELEASESTOCKREQUEST']._serialized_end=1001
-  _globals['_RELEASESTOCKRESPONSE']._serialized_start=1003
-  _globals['_RELEASESTOCKRESPONSE']._serialized_end=1059
-  _globals['_ORDER']._serialized_start=1062
-  _globals['_ORDER']._serialized_end=1237
-  _globals['_ORDERITEM']._serialized_start=1239
-  _globals['_ORDERITEM']._serialized_end=1303
-  _globals['_CREATEORDERREQUEST']._serialized_start=1305
-  _globals['_CREATEORDERREQUEST']._serialized_end=1379
-  _globals['_GETORDERREQUEST']._serialized_start=1381
-  _globals['_GETORDERREQUEST']._serialized_end=1410
-  _globals['_UPDATEORDERSTATUSREQUEST']._serialized_start=1412
-  _globals['_UPDATEORDERSTATUSREQUEST']._serialized_end=1490
-  _globals['_ORDERRESPONSE']._serialized_start=1492
-  _globals['_ORDERRESPONSE']._serialized_end=1557
-  _globals['_LISTORDERSREQUEST']._serialized_start=1559
-  _globals['_LISTORDERSREQUEST']._serialized_end=1624
-  _globals['_LISTORDERSRESPONSE']._serialized_start=1626
-  _globals['_LISTORDERSRESPONSE']._serialized_end=1724
-  _globals['_INVENTORYSERVICE']._serialized_start=1829
-  _globals['_INVENTORYSERVICE']._serialized_end=2465
-  _globals['_ORDERSERVICE']._serialized_start=2468
-  _globals['_ORDERSERVICE']._serialized_end=2779
+  _globals['_TRANSFERSTATUS']._serialized_start=8771
+  _globals['_TRANSFERSTATUS']._serialized_end=8883
+  _globals['_ALLOCATIONSTRATEGY']._serialized_start=8885
+  _globals['_ALLOCATIONSTRATEGY']._serialized_end=8972
+  _globals['_ORDERSTATUS']._serialized_start=8974
+  _globals['_ORDERSTATUS']._serialized_end=9074
+  _globals['_WAREHOUSE']._serialized_start=31
+  _globals['_WAREHOUSE']._serialized_end=192
+  _globals['_CREATEWAREHOUSEREQUEST']._serialized_start=194
+  _globals['_CREATEWAREHOUSEREQUEST']._serialized_end=300
+  _globals['_GETWAREHOUSEREQUEST']._serialized_start=302
+  _globals['_GETWAREHOUSEREQUEST']._serialized_end=335
+  _globals['_UPDATEWAREHOUSEREQUEST']._serialized_start=337
+  _globals['_UPDATEWAREHOUSEREQUEST']._serialized_end=457
+  _globals['_DELETEWAREHOUSEREQUEST']._serialized_start=459
+  _globals['_DELETEWAREHOUSEREQUEST']._serialized_end=495
+  _globals['_DELETEWAREHOUSERESPONSE']._serialized_start=497
+  _globals['_DELETEWAREHOUSERESPONSE']._serialized_end=556
+  _globals['_LISTWAREHOUSESREQUEST']._serialized_start=558
+  _globals['_LISTWAREHOUSESREQUEST']._serialized_end=607
+  _globals['_WAREHOUSERESPONSE']._serialized_start=609
+  _globals['_WAREHOUSERESPONSE']._serialized_end=686
+  _globals['_LISTWAREHOUSESRESPONSE']._serialized_start=688
+  _globals['_LISTWAREHOUSESRESPONSE']._serialized_end=754
+  _globals['_TRANSFEREVENT']._serialized_start=756
+  _globals['_TRANSFEREVENT']._serialized_end=863
+  _globals['_STOCKTRANSFER']._serialized_start=866
+  _globals['_STOCKTRANSFER']._serialized_end=1220
+  _globals['_CREATETRANSFERREQUEST']._serialized_start=1223
+  _globals['_CREATETRANSFERREQUEST']._serialized_end=1396
+  _globals['_GETTRANSFERREQUEST']._serialized_start=1398
+  _globals['_GETTRANSFERREQUEST']._serialized_end=1430
+  _globals['_LISTTRANSFERSREQUEST']._serialized_start=1433
+  _globals['_LISTTRANSFERSREQUEST']._serialized_end=1589
+  _globals['_UPDATETRANSFERSTATUSREQUEST']._serialized_start=1591
+  _globals['_UPDATETRANSFERSTATUSREQUEST']._serialized_end=1704
+  _globals['_TRANSFERRESPONSE']._serialized_start=1706
+  _globals['_TRANSFERRESPONSE']._serialized_end=1785
+  _globals['_LISTTRANSFERSRESPONSE']._serialized_start=1787
+  _globals['_LISTTRANSFERSRESPONSE']._serialized_end=1899
+  _globals['_INVENTORYITEM']._serialized_start=1902
+  _globals['_INVENTORYITEM']._serialized_end=2200
+  _globals['_CREATEINVENTORYITEMREQUEST']._serialized_start=2203
+  _globals['_CREATEINVENTORYITEMREQUEST']._serialized_end=2407
+  _globals['_GETINVENTORYITEMREQUEST']._serialized_start=2409
+  _globals['_GETINVENTORYITEMREQUEST']._serialized_end=2446
+  _globals['_UPDATEINVENTORYITEMREQUEST']._serialized_start=2449
+  _globals['_UPDATEINVENTORYITEMREQUEST']._serialized_end=2707
+  _globals['_LISTINVENTORYITEMSREQUEST']._serialized_start=2710
+  _globals['_LISTINVENTORYITEMSREQUEST']._serialized_end=2852
+  _globals['_INVENTORYLEDGERENTRY']._serialized_start=2855
+  _globals['_INVENTORYLEDGERENTRY']._serialized_end=3172
+  _globals['_GETINVENTORYHISTORYREQUEST']._serialized_start=3174
+  _globals['_GETINVENTORYHISTORYREQUEST']._serialized_end=3243
+  _globals['_INVENTORYHISTORYRESPONSE']._serialized_start=3245
+  _globals['_INVENTORYHISTORYRESPONSE']._serialized_end=3365
+  _globals['_LOWSTOCKALERT']._serialized_start=3368
+  _globals['_LOWSTOCKALERT']._serialized_end=3617
+  _globals['_REPORTLOWSTOCKREQUEST']._serialized_start=3619
+  _globals['_REPORTLOWSTOCKREQUEST']._serialized_end=3684
+  _globals['_REPORTLOWSTOCKRESPONSE']._serialized_start=3686
+  _globals['_REPORTLOWSTOCKRESPONSE']._serialized_end=3744
+  _globals['_KAFKAEVENT']._serialized_start=3746
+  _globals['_KAFKAEVENT']._serialized_end=3872
+  _globals['_LISTKAFKAEVENTSREQUEST']._serialized_start=3874
+  _globals['_LISTKAFKAEVENTSREQUEST']._serialized_end=3931
+  _globals['_LISTKAFKAEVENTSRESPONSE']._serialized_start=3933
+  _globals['_LISTKAFKAEVENTSRESPONSE']._serialized_end=3997
+  _globals['_INVENTORYITEMRESPONSE']._serialized_start=3999
+  _globals['_INVENTORYITEMRESPONSE']._serialized_end=4079
+  _globals['_LISTINVENTORYITEMSRESPONSE']._serialized_start=4081
+  _globals['_LISTINVENTORYITEMSRESPONSE']._serialized_end=4194
+  _globals['_CHECKSTOCKREQUEST']._serialized_start=4196
+  _globals['_CHECKSTOCKREQUEST']._serialized_end=4282
+  _globals['_CHECKSTOCKRESPONSE']._serialized_start=4285
+  _globals['_CHECKSTOCKRESPONSE']._serialized_end=4443
+  _globals['_LOCATIONSTOCK']._serialized_start=4446
+  _globals['_LOCATIONSTOCK']._serialized_end=4633
+  _globals['_RESERVESTOCKREQUEST']._serialized_start=4636
+  _globals['_RESERVESTOCKREQUEST']._serialized_end=4889
+  _globals['_ALLOCATION']._serialized_start=4891
+  _globals['_ALLOCATION']._serialized_end=4967
+  _globals['_RESERVESTOCKRESPONSE']._serialized_start=4970
+  _globals['_RESERVESTOCKRESPONSE']._serialized_end=5114
+  _globals['_RELEASESTOCKREQUEST']._serialized_start=5116
+  _globals['_RELEASESTOCKREQUEST']._serialized_end=5161
+  _globals['_RELEASESTOCKRESPONSE']._serialized_start=5163
+  _globals['_RELEASESTOCKRESPONSE']._serialized_end=5219
+  _globals['_RESERVATIONLINE']._serialized_start=5221
+  _globals['_RESERVATIONLINE']._serialized_end=5296
+  _globals['_RESERVESTOCKBATCHREQUEST']._serialized_start=5299
+  _globals['_RESERVESTOCKBATCHREQUEST']._serialized_end=5542
+  _globals['_LINESHORTFALL']._serialized_start=5544
+  _globals['_LINESHORTFALL']._serialized_end=5656
+  _globals['_RESERVESTOCKBATCHRESPONSE']._serialized_start=5659
+  _globals['_RESERVESTOCKBATCHRESPONSE']._serialized_end=5832
+  _globals['_RELEASEORDERRESERVATIONSREQUEST']._serialized_start=5834
+  _globals['_RELEASEORDERRESERVATIONSREQUEST']._serialized_end=5885
+  _globals['_RELEASEORDERRESERVATIONSRESPONSE']._serialized_start=5887
+  _globals['_RELEASEORDERRESERVATIONSRESPONSE']._serialized_end=5980
+  _globals['_RESERVATION']._serialized_start=5983
+  _globals['_RESERVATION']._serialized_end=6205
+  _globals['_RESERVATIONRESPONSE']._serialized_start=6207
+  _globals['_RESERVATIONRESPONSE']._serialized_end=6290
+  _globals['_EXTENDRESERVATIONREQUEST']._serialized_start=6292
+  _globals['_EXTENDRESERVATIONREQUEST']._serialized_end=6366
+  _globals['_LISTRESERVATIONSREQUEST']._serialized_start=6369
+  _globals['_LISTRESERVATIONSREQUEST']._serialized_end=6520
+  _globals['_LISTRESERVATIONSRESPONSE']._serialized_start=6522
+  _globals['_LISTRESERVATIONSRESPONSE']._serialized_end=6638
+  _globals['_MONEY']._serialized_start=6640
+  _globals['_MONEY']._serialized_end=6681
+  _globals['_DISCOUNTLINE']._serialized_start=6683
+  _globals['_DISCOUNTLINE']._serialized_end=6788
+  _globals['_TAXLINE']._serialized_start=6791
+  _globals['_TAXLINE']._serialized_end=6931
+  _globals['_ORDER']._serialized_start=6934
+  _globals['_ORDER']._serialized_end=7511
+  _globals['_ORDERITEM']._serialized_start=7514
+  _globals['_ORDERITEM']._serialized_end=7777
+  _globals['_CREATEORDERREQUEST']._serialized_start=7780
+  _globals['_CREATEORDERREQUEST']._serialized_end=8235
+  _globals['_GETORDERREQUEST']._serialized_start=8237
+  _globals['_GETORDERREQUEST']._serialized_end=8266
+  _globals['_UPDATEORDERSTATUSREQUEST']._serialized_start=8268
+  _globals['_UPDATEORDERSTATUSREQUEST']._serialized_end=8346
+  _globals['_ORDERRESPONSE']._serialized_start=8348
+  _globals['_ORDERRESPONSE']._serialized_end=8413
+  _globals['_LISTORDERSREQUEST']._serialized_start=8415
+  _globals['_LISTORDERSREQUEST']._serialized_end=8506
+  _globals['_LISTORDERSRESPONSE']._serialized_start=8508
+  _globals['_LISTORDERSRESPONSE']._serialized_end=8606
+  _globals['_USERORDERSREQUEST']._serialized_start=8608
+  _globals['_USERORDERSREQUEST']._serialized_end=8665
+  _globals['_REASSIGNUSERORDERSREQUEST']._serialized_start=8667
+  _globals['_REASSIGNUSERORDERSREQUEST']._serialized_end=8732
+  _globals['_USERORDERSRESPONSE']._serialized_start=8734
+  _globals['_USERORDERSRESPONSE']._serialized_end=8769
+  _globals['_INVENTORYSERVICE']._serialized_start=9077
+  _globals['_INVENTORYSERVICE']._serialized_end=11141
+  _globals['_ORDERSERVICE']._serialized_start=11144
+  _globals['_ORDERSERVICE']._serialized_end=11710
 # @@protoc_insertion_point(module_scope)
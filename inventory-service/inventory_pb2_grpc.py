@@ -50,6 +50,86 @@ class InventoryServiceStub(object):
                 request_serializer=inventory__pb2.ReleaseStockRequest.SerializeToString,
                 response_deserializer=inventory__pb2.ReleaseStockResponse.FromString,
                 )
+        self.CreateWarehouse = channel.unary_unary(
+                '/inventory.InventoryService/CreateWarehouse',
+                request_serializer=inventory__pb2.CreateWarehouseRequest.SerializeToString,
+                response_deserializer=inventory__pb2.WarehouseResponse.FromString,
+                )
+        self.GetWarehouse = channel.unary_unary(
+                '/inventory.InventoryService/GetWarehouse',
+                request_serializer=inventory__pb2.GetWarehouseRequest.SerializeToString,
+                response_deserializer=inventory__pb2.WarehouseResponse.FromString,
+                )
+        self.UpdateWarehouse = channel.unary_unary(
+                '/inventory.InventoryService/UpdateWarehouse',
+                request_serializer=inventory__pb2.UpdateWarehouseRequest.SerializeToString,
+                response_deserializer=inventory__pb2.WarehouseResponse.FromString,
+                )
+        self.DeleteWarehouse = channel.unary_unary(
+                '/inventory.InventoryService/DeleteWarehouse',
+                request_serializer=inventory__pb2.DeleteWarehouseRequest.SerializeToString,
+                response_deserializer=inventory__pb2.DeleteWarehouseResponse.FromString,
+                )
+        self.ListWarehouses = channel.unary_unary(
+                '/inventory.InventoryService/ListWarehouses',
+                request_serializer=inventory__pb2.ListWarehousesRequest.SerializeToString,
+                response_deserializer=inventory__pb2.ListWarehousesResponse.FromString,
+                )
+        self.CreateTransfer = channel.unary_unary(
+                '/inventory.InventoryService/CreateTransfer',
+                request_serializer=inventory__pb2.CreateTransferRequest.SerializeToString,
+                response_deserializer=inventory__pb2.TransferResponse.FromString,
+                )
+        self.GetTransfer = channel.unary_unary(
+                '/inventory.InventoryService/GetTransfer',
+                request_serializer=inventory__pb2.GetTransferRequest.SerializeToString,
+                response_deserializer=inventory__pb2.TransferResponse.FromString,
+                )
+        self.ListTransfers = channel.unary_unary(
+                '/inventory.InventoryService/ListTransfers',
+                request_serializer=inventory__pb2.ListTransfersRequest.SerializeToString,
+                response_deserializer=inventory__pb2.ListTransfersResponse.FromString,
+                )
+        self.UpdateTransferStatus = channel.unary_unary(
+                '/inventory.InventoryService/UpdateTransferStatus',
+                request_serializer=inventory__pb2.UpdateTransferStatusRequest.SerializeToString,
+                response_deserializer=inventory__pb2.TransferResponse.FromString,
+                )
+        self.GetInventoryHistory = channel.unary_unary(
+                '/inventory.InventoryService/GetInventoryHistory',
+                request_serializer=inventory__pb2.GetInventoryHistoryRequest.SerializeToString,
+                response_deserializer=inventory__pb2.InventoryHistoryResponse.FromString,
+                )
+        self.ReportLowStock = channel.unary_unary(
+                '/inventory.InventoryService/ReportLowStock',
+                request_serializer=inventory__pb2.ReportLowStockRequest.SerializeToString,
+                response_deserializer=inventory__pb2.ReportLowStockResponse.FromString,
+                )
+        self.ListKafkaEvents = channel.unary_unary(
+                '/inventory.InventoryService/ListKafkaEvents',
+                request_serializer=inventory__pb2.ListKafkaEventsRequest.SerializeToString,
+                response_deserializer=inventory__pb2.ListKafkaEventsResponse.FromString,
+                )
+        self.ExtendReservation = channel.unary_unary(
+                '/inventory.InventoryService/ExtendReservation',
+                request_serializer=inventory__pb2.ExtendReservationRequest.SerializeToString,
+                response_deserializer=inventory__pb2.ReservationResponse.FromString,
+                )
+        self.ListReservations = channel.unary_unary(
+                '/inventory.InventoryService/ListReservations',
+                request_serializer=inventory__pb2.ListReservationsRequest.SerializeToString,
+                response_deserializer=inventory__pb2.ListReservationsResponse.FromString,
+                )
+        self.ReserveStockBatch = channel.unary_unary(
+                '/inventory.InventoryService/ReserveStockBatch',
+                request_serializer=inventory__pb2.ReserveStockBatchRequest.SerializeToString,
+                response_deserializer=inventory__pb2.ReserveStockBatchResponse.FromString,
+                )
+        self.ReleaseOrderReservations = channel.unary_unary(
+                '/inventory.InventoryService/ReleaseOrderReservations',
+                request_serializer=inventory__pb2.ReleaseOrderReservationsRequest.SerializeToString,
+                response_deserializer=inventory__pb2.ReleaseOrderReservationsResponse.FromString,
+                )
 
 
 class InventoryServiceServicer(object):
@@ -98,6 +178,102 @@ class InventoryServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def CreateWarehouse(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetWarehouse(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateWarehouse(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteWarehouse(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListWarehouses(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateTransfer(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetTransfer(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListTransfers(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateTransferStatus(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetInventoryHistory(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReportLowStock(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListKafkaEvents(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ExtendReservation(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListReservations(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReserveStockBatch(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReleaseOrderReservations(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_InventoryServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -136,6 +312,86 @@ def add_InventoryServiceServicer_to_server(servicer, server):
                     request_deserializer=inventory__pb2.ReleaseStockRequest.FromString,
                     response_serializer=inventory__pb2.ReleaseStockResponse.SerializeToString,
             ),
+            'CreateWarehouse': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateWarehouse,
+                    request_deserializer=inventory__pb2.CreateWarehouseRequest.FromString,
+                    response_serializer=inventory__pb2.WarehouseResponse.SerializeToString,
+            ),
+            'GetWarehouse': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetWarehouse,
+                    request_deserializer=inventory__pb2.GetWarehouseRequest.FromString,
+                    response_serializer=inventory__pb2.WarehouseResponse.SerializeToString,
+            ),
+            'UpdateWarehouse': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateWarehouse,
+                    request_deserializer=inventory__pb2.UpdateWarehouseRequest.FromString,
+                    response_serializer=inventory__pb2.WarehouseResponse.SerializeToString,
+            ),
+            'DeleteWarehouse': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteWarehouse,
+                    request_deserializer=inventory__pb2.DeleteWarehouseRequest.FromString,
+                    response_serializer=inventory__pb2.DeleteWarehouseResponse.SerializeToString,
+            ),
+            'ListWarehouses': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListWarehouses,
+                    request_deserializer=inventory__pb2.ListWarehousesRequest.FromString,
+                    response_serializer=inventory__pb2.ListWarehousesResponse.SerializeToString,
+            ),
+            'CreateTransfer': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateTransfer,
+                    request_deserializer=inventory__pb2.CreateTransferRequest.FromString,
+                    response_serializer=inventory__pb2.TransferResponse.SerializeToString,
+            ),
+            'GetTransfer': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetTransfer,
+                    request_deserializer=inventory__pb2.GetTransferRequest.FromString,
+                    response_serializer=inventory__pb2.TransferResponse.SerializeToString,
+            ),
+            'ListTransfers': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListTransfers,
+                    request_deserializer=inventory__pb2.ListTransfersRequest.FromString,
+                    response_serializer=inventory__pb2.ListTransfersResponse.SerializeToString,
+            ),
+            'UpdateTransferStatus': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateTransferStatus,
+                    request_deserializer=inventory__pb2.UpdateTransferStatusRequest.FromString,
+                    response_serializer=inventory__pb2.TransferResponse.SerializeToString,
+            ),
+            'GetInventoryHistory': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetInventoryHistory,
+                    request_deserializer=inventory__pb2.GetInventoryHistoryRequest.FromString,
+                    response_serializer=inventory__pb2.InventoryHistoryResponse.SerializeToString,
+            ),
+            'ReportLowStock': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReportLowStock,
+                    request_deserializer=inventory__pb2.ReportLowStockRequest.FromString,
+                    response_serializer=inventory__pb2.ReportLowStockResponse.SerializeToString,
+            ),
+            'ListKafkaEvents': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListKafkaEvents,
+                    request_deserializer=inventory__pb2.ListKafkaEventsRequest.FromString,
+                    response_serializer=inventory__pb2.ListKafkaEventsResponse.SerializeToString,
+            ),
+            'ExtendReservation': grpc.unary_unary_rpc_method_handler(
+                    servicer.ExtendReservation,
+                    request_deserializer=inventory__pb2.ExtendReservationRequest.FromString,
+                    response_serializer=inventory__pb2.ReservationResponse.SerializeToString,
+            ),
+            'ListReservations': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListReservations,
+                    request_deserializer=inventory__pb2.ListReservationsRequest.FromString,
+                    response_serializer=inventory__pb2.ListReservationsResponse.SerializeToString,
+            ),
+            'ReserveStockBatch': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReserveStockBatch,
+                    request_deserializer=inventory__pb2.ReserveStockBatchRequest.FromString,
+                    response_serializer=inventory__pb2.ReserveStockBatchResponse.SerializeToString,
+            ),
+            'ReleaseOrderReservations': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReleaseOrderReservations,
+                    request_deserializer=inventory__pb2.ReleaseOrderReservationsRequest.FromString,
+                    response_serializer=inventory__pb2.ReleaseOrderReservationsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'inventory.InventoryService', rpc_method_handlers)
@@ -266,6 +522,279 @@ class InventoryService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def CreateWarehouse(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/CreateWarehouse',
+            inventory__pb2.CreateWarehouseRequest.SerializeToString,
+            inventory__pb2.WarehouseResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetWarehouse(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/GetWarehouse',
+            inventory__pb2.GetWarehouseRequest.SerializeToString,
+            inventory__pb2.WarehouseResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateWarehouse(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/UpdateWarehouse',
+            inventory__pb2.UpdateWarehouseRequest.SerializeToString,
+            inventory__pb2.WarehouseResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteWarehouse(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/DeleteWarehouse',
+            inventory__pb2.DeleteWarehouseRequest.SerializeToString,
+            inventory__pb2.DeleteWarehouseResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListWarehouses(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/ListWarehouses',
+            inventory__pb2.ListWarehousesRequest.SerializeToString,
+            inventory__pb2.ListWarehousesResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CreateTransfer(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/CreateTransfer',
+            inventory__pb2.CreateTransferRequest.SerializeToString,
+            inventory__pb2.TransferResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetTransfer(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/GetTransfer',
+            inventory__pb2.GetTransferRequest.SerializeToString,
+            inventory__pb2.TransferResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListTransfers(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/ListTransfers',
+            inventory__pb2.ListTransfersRequest.SerializeToString,
+            inventory__pb2.ListTransfersResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateTransferStatus(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/UpdateTransferStatus',
+            inventory__pb2.UpdateTransferStatusRequest.SerializeToString,
+            inventory__pb2.TransferResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetInventoryHistory(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/GetInventoryHistory',
+            inventory__pb2.GetInventoryHistoryRequest.SerializeToString,
+            inventory__pb2.InventoryHistoryResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ReportLowStock(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/ReportLowStock',
+            inventory__pb2.ReportLowStockRequest.SerializeToString,
+            inventory__pb2.ReportLowStockResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListKafkaEvents(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/ListKafkaEvents',
+            inventory__pb2.ListKafkaEventsRequest.SerializeToString,
+            inventory__pb2.ListKafkaEventsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ExtendReservation(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/ExtendReservation',
+            inventory__pb2.ExtendReservationRequest.SerializeToString,
+            inventory__pb2.ReservationResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListReservations(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/ListReservations',
+            inventory__pb2.ListReservationsRequest.SerializeToString,
+            inventory__pb2.ListReservationsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ReserveStockBatch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/ReserveStockBatch',
+            inventory__pb2.ReserveStockBatchRequest.SerializeToString,
+            inventory__pb2.ReserveStockBatchResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ReleaseOrderReservations(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.InventoryService/ReleaseOrderReservations',
+            inventory__pb2.ReleaseOrderReservationsRequest.SerializeToString,
+            inventory__pb2.ReleaseOrderReservationsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+
 
 class OrderServiceStub(object):
     """Order Service
@@ -297,6 +826,21 @@ class OrderServiceStub(object):
                 request_serializer=inventory__pb2.UpdateOrderStatusRequest.SerializeToString,
                 response_deserializer=inventory__pb2.OrderResponse.FromString,
                 )
+        self.ArchiveUserOrders = channel.unary_unary(
+                '/inventory.OrderService/ArchiveUserOrders',
+                request_serializer=inventory__pb2.UserOrdersRequest.SerializeToString,
+                response_deserializer=inventory__pb2.UserOrdersResponse.FromString,
+                )
+        self.RestoreUserOrders = channel.unary_unary(
+                '/inventory.OrderService/RestoreUserOrders',
+                request_serializer=inventory__pb2.UserOrdersRequest.SerializeToString,
+                response_deserializer=inventory__pb2.UserOrdersResponse.FromString,
+                )
+        self.ReassignUserOrders = channel.unary_unary(
+                '/inventory.OrderService/ReassignUserOrders',
+                request_serializer=inventory__pb2.ReassignUserOrdersRequest.SerializeToString,
+                response_deserializer=inventory__pb2.UserOrdersResponse.FromString,
+                )
 
 
 class OrderServiceServicer(object):
@@ -327,6 +871,24 @@ class OrderServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ArchiveUserOrders(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RestoreUserOrders(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReassignUserOrders(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_OrderServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -350,6 +912,21 @@ def add_OrderServiceServicer_to_server(servicer, server):
                     request_deserializer=inventory__pb2.UpdateOrderStatusRequest.FromString,
                     response_serializer=inventory__pb2.OrderResponse.SerializeToString,
             ),
+            'ArchiveUserOrders': grpc.unary_unary_rpc_method_handler(
+                    servicer.ArchiveUserOrders,
+                    request_deserializer=inventory__pb2.UserOrdersRequest.FromString,
+                    response_serializer=inventory__pb2.UserOrdersResponse.SerializeToString,
+            ),
+            'RestoreUserOrders': grpc.unary_unary_rpc_method_handler(
+                    servicer.RestoreUserOrders,
+                    request_deserializer=inventory__pb2.UserOrdersRequest.FromString,
+                    response_serializer=inventory__pb2.UserOrdersResponse.SerializeToString,
+            ),
+            'ReassignUserOrders': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReassignUserOrders,
+                    request_deserializer=inventory__pb2.ReassignUserOrdersRequest.FromString,
+                    response_serializer=inventory__pb2.UserOrdersResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'inventory.OrderService', rpc_method_handlers)
@@ -428,3 +1005,54 @@ class OrderService(object):
             inventory__pb2.OrderResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ArchiveUserOrders(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.OrderService/ArchiveUserOrders',
+            inventory__pb2.UserOrdersRequest.SerializeToString,
+            inventory__pb2.UserOrdersResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def RestoreUserOrders(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.OrderService/RestoreUserOrders',
+            inventory__pb2.UserOrdersRequest.SerializeToString,
+            inventory__pb2.UserOrdersResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ReassignUserOrders(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/inventory.OrderService/ReassignUserOrders',
+            inventory__pb2.ReassignUserOrdersRequest.SerializeToString,
+            inventory__pb2.UserOrdersResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -2,74 +2,294 @@ import grpc
 from concurrent import futures
 import logging
 import uuid
-from datetime import datetime, timedelta
+from datetime import datetime, timedelta, timezone
 from sqlalchemy.orm import Session
-from sqlalchemy import and_
+from sqlalchemy import and_, or_, func
 
 import inventory_pb2
 import inventory_pb2_grpc
-from models import InventoryItem, Order, OrderItem, StockReservation, get_db, SessionLocal
+from models import Warehouse, InventoryItem, Order, OrderItem, OrderDiscount, OrderTaxLine, StockReservation, StockTransfer, StockTransferEvent, InventoryLedgerEntry, KafkaEvent, get_db, SessionLocal
+from ledger import ADJUSTMENT_REASONS, backfill_ledger, record
+from reservations import BACKORDER_POLICIES, DEFAULT_TTL, ReservationSweeper, allocate_backorders, fulfill_reservation, release_reservation, reserve, stock_items, waiting_backorders
 from kafka_producer import InventoryKafkaProducer
 from kafka_consumer import InventoryKafkaConsumer
+from tenancy import TenantInterceptor, new_row_tenant
 
 logging.basicConfig(level=logging.INFO)
 logger = logging.getLogger(__name__)
 
+DEFAULT_LOCATION = "WAREHOUSE_A"
+
+def warehouse_to_pb(warehouse):
+    return inventory_pb2.Warehouse(
+        id=warehouse.id,
+        code=warehouse.code,
+        name=warehouse.name,
+        address=warehouse.address,
+        latitude=warehouse.latitude or 0.0,
+        longitude=warehouse.longitude or 0.0,
+        active=warehouse.active,
+        created_at=warehouse.created_at.isoformat(),
+        updated_at=warehouse.updated_at.isoformat()
+    )
+
+def item_to_pb(item, in_transit=0):
+    return inventory_pb2.InventoryItem(
+        id=item.id,
+        product_id=item.product_id,
+        variant_id=item.variant_id,
+        quantity=item.quantity,
+        reserved_quantity=item.reserved_quantity,
+        location=item.location,
+        created_at=item.created_at.isoformat(),
+        updated_at=item.updated_at.isoformat(),
+        warehouse_id=item.warehouse_id or 0,
+        available_quantity=item.available_quantity(),
+        in_transit_quantity=in_transit,
+        reorder_point=item.reorder_point or 0,
+        reorder_quantity=item.reorder_quantity or 0
+    )
+
+TRANSFER_STATUS_NAMES = {
+    inventory_pb2.TransferStatus.TRANSFER_REQUESTED: "REQUESTED",
+    inventory_pb2.TransferStatus.TRANSFER_IN_TRANSIT: "IN_TRANSIT",
+    inventory_pb2.TransferStatus.TRANSFER_RECEIVED: "RECEIVED",
+    inventory_pb2.TransferStatus.TRANSFER_CANCELLED: "CANCELLED",
+}
+TRANSFER_STATUS_VALUES = {name: value for value, name in TRANSFER_STATUS_NAMES.items()}
+
+# Allowed status changes of a transfer
+TRANSFER_TRANSITIONS = {
+    "REQUESTED": ("IN_TRANSIT", "CANCELLED"),
+    "IN_TRANSIT": ("RECEIVED", "CANCELLED"),
+}
+
+def transfer_to_pb(transfer):
+    return inventory_pb2.StockTransfer(
+        id=transfer.id,
+        product_id=transfer.product_id,
+        variant_id=transfer.variant_id,
+        quantity=transfer.quantity,
+        source_warehouse_id=transfer.source_warehouse_id,
+        source_warehouse_code=transfer.source.code if transfer.source else "",
+        destination_warehouse_id=transfer.destination_warehouse_id,
+        destination_warehouse_code=transfer.destination.code if transfer.destination else "",
+        status=TRANSFER_STATUS_VALUES[transfer.status],
+        note=transfer.note,
+        created_at=transfer.created_at.isoformat(),
+        updated_at=transfer.updated_at.isoformat(),
+        events=[
+            inventory_pb2.TransferEvent(
+                status=TRANSFER_STATUS_VALUES[event.status],
+                actor=event.actor,
+                note=event.note,
+                created_at=event.created_at.isoformat()
+            ) for event in transfer.events
+        ]
+    )
+
+def in_transit_quantities(db, product_ids=None):
+    """Quantities dispatched but not yet received, keyed by
+    (product_id, variant_id, destination warehouse_id)"""
+    query = db.query(
+        StockTransfer.product_id,
+        StockTransfer.variant_id,
+        StockTransfer.destination_warehouse_id,
+        func.sum(StockTransfer.quantity)
+    ).filter(StockTransfer.status == "IN_TRANSIT")
+    if product_ids is not None:
+        query = query.filter(StockTransfer.product_id.in_(product_ids))
+    rows = query.group_by(StockTransfer.product_id, StockTransfer.variant_id,
+                          StockTransfer.destination_warehouse_id).all()
+    return {(product_id, variant_id, warehouse_id): int(quantity)
+            for product_id, variant_id, warehouse_id, quantity in rows}
+
+def incoming_quantity(in_transit, item):
+    return in_transit.get((item.product_id, item.variant_id, item.warehouse_id), 0)
+
+def stock_item(db, product_id, variant_id, warehouse, actor=""):
+    """Inventory item of a product or variant in a warehouse, created empty
+    when missing"""
+    item = db.query(InventoryItem).filter(
+        and_(InventoryItem.product_id == product_id,
+             InventoryItem.variant_id == variant_id,
+             InventoryItem.warehouse_id == warehouse.id)
+    ).first()
+    if not item:
+        item = InventoryItem(
+            product_id=product_id,
+            variant_id=variant_id,
+            quantity=0,
+            reserved_quantity=0,
+            location=warehouse.code,
+            warehouse_id=warehouse.id
+        )
+        db.add(item)
+        record(db, item, "CREATE", actor=actor)
+    return item
+
+def ledger_entry_to_pb(entry):
+    return inventory_pb2.InventoryLedgerEntry(
+        id=entry.id,
+        inventory_item_id=entry.inventory_item_id,
+        product_id=entry.product_id,
+        variant_id=entry.variant_id,
+        warehouse_id=entry.warehouse_id or 0,
+        type=entry.type,
+        reason=entry.reason,
+        actor=entry.actor,
+        quantity_delta=entry.quantity_delta,
+        reserved_delta=entry.reserved_delta,
+        quantity_after=entry.quantity_after,
+        reserved_after=entry.reserved_after,
+        reference=entry.reference,
+        note=entry.note,
+        created_at=entry.created_at.isoformat()
+    )
+
+def reservation_to_pb(reservation):
+    return inventory_pb2.Reservation(
+        id=reservation.id,
+        product_id=reservation.product_id,
+        variant_id=reservation.variant_id,
+        quantity=reservation.quantity,
+        order_id=reservation.order_id,
+        status=reservation.status,
+        created_at=reservation.created_at.isoformat(),
+        expires_at=reservation.expires_at.isoformat() if reservation.expires_at else "",
+        released_at=reservation.released_at.isoformat() if reservation.released_at else "",
+        allocations=[
+            inventory_pb2.Allocation(
+                warehouse_id=allocation.warehouse_id or 0,
+                warehouse_code=allocation.item.location if allocation.item else "",
+                quantity=allocation.quantity
+            ) for allocation in reservation.allocations
+        ]
+    )
+
+def kafka_event_to_pb(event):
+    return inventory_pb2.KafkaEvent(
+        id=event.id,
+        topic=event.topic,
+        event_type=event.event_type,
+        key=event.key,
+        data=event.data,
+        tenant_id=event.tenant_id,
+        received_at=event.received_at.isoformat()
+    )
+
+def shipping_destination(request):
+    """(latitude, longitude) of a reservation request's shipping address, if given"""
+    if request.has_shipping_location:
+        return (request.shipping_latitude, request.shipping_longitude)
+    return None
+
+def reservation_ttl(ttl_seconds):
+    return timedelta(seconds=ttl_seconds) if ttl_seconds > 0 else DEFAULT_TTL
+
+def resolve_warehouse(db, warehouse_id, location):
+    """Find the warehouse a request refers to by ID or by location code.
+    Unknown location codes become new warehouses so older clients that
+    only send free-text locations keep working."""
+    if warehouse_id:
+        return db.query(Warehouse).filter(Warehouse.id == warehouse_id).first()
+    
+    code = (location or DEFAULT_LOCATION).strip()
+    warehouse = db.query(Warehouse).filter(Warehouse.code == code).first()
+    if not warehouse:
+        warehouse = Warehouse(code=code, name=code)
+        db.add(warehouse)
+        db.flush()
+    return warehouse
+
+def backfill_warehouses():
+    """Link inventory items created before warehouses existed to a warehouse
+    named after their location"""
+    db = SessionLocal()
+    try:
+        items = db.query(InventoryItem).filter(InventoryItem.warehouse_id == None).all()
+        for item in items:
+            item.warehouse_id = resolve_warehouse(db, 0, item.location).id
+        db.commit()
+        if items:
+            logger.info(f"Linked {len(items)} inventory items to warehouses")
+    finally:
+        db.close()
+
 class InventoryServiceImpl(inventory_pb2_grpc.InventoryServiceServicer):
     def __init__(self):
         self.kafka_producer = InventoryKafkaProducer()
         self.kafka_consumer = InventoryKafkaConsumer(self)
         self.kafka_consumer.start_consuming()
+        self.reservation_sweeper = ReservationSweeper(self.kafka_producer)
+        self.reservation_sweeper.start()
     
     def CreateInventoryItem(self, request, context):
         db = SessionLocal()
         try:
-            # Check if inventory item already exists for this product
+            warehouse = resolve_warehouse(db, request.warehouse_id, request.location)
+            if not warehouse:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Warehouse not found")
+                return inventory_pb2.InventoryItemResponse(message="Warehouse not found")
+            
+            # Check if inventory item already exists for this product or variant
             existing_item = db.query(InventoryItem).filter(
                 and_(InventoryItem.product_id == request.product_id,
-                     InventoryItem.location == request.location)
+                     InventoryItem.variant_id == request.variant_id,
+                     InventoryItem.warehouse_id == warehouse.id)
             ).first()
             
+            if request.reorder_point < 0 or request.reorder_quantity < 0:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details("Reorder point and quantity cannot be negative")
+                return inventory_pb2.InventoryItemResponse(message="Reorder point and quantity cannot be negative")
+            
             if existing_item:
-                # Update existing item
-                existing_item.quantity += request.quantity
-                existing_item.updated_at = datetime.utcnow()
-                db.commit()
-                db.refresh(existing_item)
+                # Receive more stock into the existing item
                 item = existing_item
+                record(db, item, "ADJUSTMENT", "RECEIVED", request.actor,
+                       quantity_delta=request.quantity, note=request.note)
             else:
                 # Create new item
                 item = InventoryItem(
                     product_id=request.product_id,
-                    quantity=request.quantity,
-                    location=request.location
+                    variant_id=request.variant_id,
+                    quantity=0,
+                    reserved_quantity=0,
+                    location=warehouse.code,
+                    warehouse_id=warehouse.id,
+                    reorder_point=request.reorder_point,
+                    reorder_quantity=request.reorder_quantity
                 )
                 db.add(item)
-                db.commit()
-                db.refresh(item)
+                record(db, item, "CREATE", "RECEIVED", request.actor,
+                       quantity_delta=request.quantity, note=request.note)
+            
+            # Received stock goes to waiting backorders first
+            allocated = allocate_backorders(db, [(item.product_id, item.variant_id)]) if request.quantity > 0 else []
+            
+            db.commit()
+            db.refresh(item)
+            self._send_backorder_events(allocated)
             
             # Send Kafka event
             self.kafka_producer.send_inventory_event("STOCK_UPDATED", {
                 "product_id": item.product_id,
+                "variant_id": item.variant_id,
                 "quantity": item.quantity,
                 "location": item.location,
+                "warehouse_id": item.warehouse_id,
                 "updated_at": item.updated_at.isoformat()
             })
             
             return inventory_pb2.InventoryItemResponse(
-                item=inventory_pb2.InventoryItem(
-                    id=item.id,
-                    product_id=item.product_id,
-                    quantity=item.quantity,
-                    reserved_quantity=item.reserved_quantity,
-                    location=item.location,
-                    created_at=item.created_at.isoformat(),
-                    updated_at=item.updated_at.isoformat()
-                ),
+                item=item_to_pb(item),
                 message="Inventory item created/updated successfully"
             )
         except Exception as e:
             logger.error(f"Error creating inventory item: {e}")
+            db.rollback()
             context.set_code(grpc.StatusCode.INTERNAL)
             context.set_details(str(e))
             return inventory_pb2.InventoryItemResponse(message=f"Error: {e}")
@@ -85,16 +305,10 @@ class InventoryServiceImpl(inventory_pb2_grpc.InventoryServiceServicer):
                 context.set_details("Inventory item not found")
                 return inventory_pb2.InventoryItemResponse(message="Inventory item not found")
             
+            in_transit = in_transit_quantities(db, [item.product_id])
+            
             return inventory_pb2.InventoryItemResponse(
-                item=inventory_pb2.InventoryItem(
-                    id=item.id,
-                    product_id=item.product_id,
-                    quantity=item.quantity,
-                    reserved_quantity=item.reserved_quantity,
-                    location=item.location,
-                    created_at=item.created_at.isoformat(),
-                    updated_at=item.updated_at.isoformat()
-                ),
+                item=item_to_pb(item, incoming_quantity(in_transit, item)),
                 message="Inventory item retrieved successfully"
             )
         except Exception as e:
@@ -105,21 +319,228 @@ class InventoryServiceImpl(inventory_pb2_grpc.InventoryServiceServicer):
         finally:
             db.close()
     
+    def UpdateInventoryItem(self, request, context):
+        db = SessionLocal()
+        try:
+            item = db.query(InventoryItem).filter(InventoryItem.id == request.id).first()
+            if not item:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Inventory item not found")
+                return inventory_pb2.InventoryItemResponse(message="Inventory item not found")
+            
+            if request.delta and request.reason not in ADJUSTMENT_REASONS:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(f"Adjustments need a reason: {', '.join(ADJUSTMENT_REASONS)}")
+                return inventory_pb2.InventoryItemResponse(message="Invalid adjustment reason")
+            
+            if item.quantity + request.delta < item.reserved_quantity:
+                context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                context.set_details("Quantity cannot be lower than the reserved quantity")
+                return inventory_pb2.InventoryItemResponse(message="Quantity cannot be lower than the reserved quantity")
+            
+            if request.warehouse_id or request.location:
+                warehouse = resolve_warehouse(db, request.warehouse_id, request.location)
+                if not warehouse:
+                    context.set_code(grpc.StatusCode.NOT_FOUND)
+                    context.set_details("Warehouse not found")
+                    return inventory_pb2.InventoryItemResponse(message="Warehouse not found")
+                if warehouse.id != item.warehouse_id and item.reserved_quantity > 0:
+                    context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                    context.set_details("Cannot move stock with active reservations")
+                    return inventory_pb2.InventoryItemResponse(message="Cannot move stock with active reservations")
+                if warehouse.id != item.warehouse_id:
+                    item.warehouse_id = warehouse.id
+                    item.location = warehouse.code
+                    record(db, item, "MOVE", "", request.actor, note=request.note)
+            
+            if request.set_reorder_levels:
+                if request.reorder_point < 0 or request.reorder_quantity < 0:
+                    context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                    context.set_details("Reorder point and quantity cannot be negative")
+                    return inventory_pb2.InventoryItemResponse(message="Reorder point and quantity cannot be negative")
+                item.reorder_point = request.reorder_point
+                item.reorder_quantity = request.reorder_quantity
+            
+            if request.delta:
+                record(db, item, ADJUSTMENT_REASONS[request.reason], request.reason, request.actor,
+                       quantity_delta=request.delta, reference=request.reference, note=request.note)
+            
+            # Added stock goes to waiting backorders first
+            allocated = allocate_backorders(db, [(item.product_id, item.variant_id)]) if request.delta > 0 else []
+            
+            db.commit()
+            db.refresh(item)
+            self._send_backorder_events(allocated)
+            
+            self.kafka_producer.send_inventory_event("STOCK_UPDATED", {
+                "product_id": item.product_id,
+                "variant_id": item.variant_id,
+                "quantity": item.quantity,
+                "location": item.location,
+                "warehouse_id": item.warehouse_id,
+                "updated_at": item.updated_at.isoformat()
+            })
+            
+            return inventory_pb2.InventoryItemResponse(
+                item=item_to_pb(item),
+                message="Inventory item updated successfully"
+            )
+        except Exception as e:
+            logger.error(f"Error updating inventory item: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.InventoryItemResponse(message=f"Error: {e}")
+        finally:
+            db.close()
+    
+    def ListInventoryItems(self, request, context):
+        db = SessionLocal()
+        try:
+            page = request.page if request.page > 0 else 1
+            limit = request.limit if request.limit > 0 else 10
+            
+            query = db.query(InventoryItem)
+            if request.warehouse_id:
+                query = query.filter(InventoryItem.warehouse_id == request.warehouse_id)
+            if request.product_id:
+                query = query.filter(InventoryItem.product_id == request.product_id)
+            if request.variant_id:
+                query = query.filter(InventoryItem.variant_id == request.variant_id)
+            if request.low_stock_only:
+                query = query.filter(and_(
+                    InventoryItem.reorder_point > 0,
+                    InventoryItem.quantity - InventoryItem.reserved_quantity <= InventoryItem.reorder_point
+                ))
+            
+            total = query.count()
+            items = query.order_by(InventoryItem.id).offset((page - 1) * limit).limit(limit).all()
+            in_transit = in_transit_quantities(db, list({item.product_id for item in items}))
+            
+            return inventory_pb2.ListInventoryItemsResponse(
+                items=[item_to_pb(item, incoming_quantity(in_transit, item)) for item in items],
+                total=total,
+                page=page,
+                limit=limit
+            )
+        except Exception as e:
+            logger.error(f"Error listing inventory items: {e}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.ListInventoryItemsResponse()
+        finally:
+            db.close()
+    
+    def GetInventoryHistory(self, request, context):
+        db = SessionLocal()
+        try:
+            item = db.query(InventoryItem).filter(InventoryItem.id == request.id).first()
+            if not item:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Inventory item not found")
+                return inventory_pb2.InventoryHistoryResponse()
+            
+            page = request.page if request.page > 0 else 1
+            limit = request.limit if request.limit > 0 else 20
+            
+            query = db.query(InventoryLedgerEntry).filter(InventoryLedgerEntry.inventory_item_id == item.id)
+            total = query.count()
+            entries = query.order_by(InventoryLedgerEntry.id.desc()).offset((page - 1) * limit).limit(limit).all()
+            
+            return inventory_pb2.InventoryHistoryResponse(
+                entries=[ledger_entry_to_pb(entry) for entry in entries],
+                total=total,
+                page=page,
+                limit=limit
+            )
+        except Exception as e:
+            logger.error(f"Error getting inventory history: {e}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.InventoryHistoryResponse()
+        finally:
+            db.close()
+    
+    def ReportLowStock(self, request, context):
+        try:
+            for alert in request.alerts:
+                self.kafka_producer.send_inventory_event("LOW_STOCK", {
+                    "inventory_item_id": alert.inventory_item_id,
+                    "product_id": alert.product_id,
+                    "variant_id": alert.variant_id,
+                    "warehouse_id": alert.warehouse_id,
+                    "location": alert.location,
+                    "available_quantity": alert.available_quantity,
+                    "in_transit_quantity": alert.in_transit_quantity,
+                    "reorder_point": alert.reorder_point,
+                    "reorder_quantity": alert.reorder_quantity,
+                    "tenant_id": new_row_tenant(),
+                    "updated_at": alert.detected_at or datetime.utcnow().isoformat()
+                })
+            
+            return inventory_pb2.ReportLowStockResponse(
+                success=True,
+                message=f"Published {len(request.alerts)} low-stock alerts"
+            )
+        except Exception as e:
+            logger.error(f"Error publishing low-stock alerts: {e}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.ReportLowStockResponse(success=False, message=f"Error: {e}")
+    
+    def ListKafkaEvents(self, request, context):
+        db = SessionLocal()
+        try:
+            limit = request.limit if request.limit > 0 else 100
+            events = db.query(KafkaEvent).filter(
+                KafkaEvent.id > request.after_id
+            ).order_by(KafkaEvent.id).limit(limit).all()
+            
+            return inventory_pb2.ListKafkaEventsResponse(
+                events=[kafka_event_to_pb(event) for event in events]
+            )
+        except Exception as e:
+            logger.error(f"Error listing Kafka events: {e}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.ListKafkaEventsResponse()
+        finally:
+            db.close()
+    
     def CheckStock(self, request, context):
         db = SessionLocal()
         try:
             total_available = 0
-            items = db.query(InventoryItem).filter(InventoryItem.product_id == request.product_id).all()
+            total_in_transit = 0
+            locations = []
+            items = stock_items(db, request.product_id, request.variant_id)
+            in_transit = in_transit_quantities(db, [request.product_id])
             
             for item in items:
+                warehouse = item.warehouse
+                if warehouse is not None and not warehouse.active:
+                    continue
+                incoming = incoming_quantity(in_transit, item)
                 total_available += item.available_quantity()
+                total_in_transit += incoming
+                locations.append(inventory_pb2.LocationStock(
+                    warehouse_id=item.warehouse_id or 0,
+                    warehouse_code=warehouse.code if warehouse else item.location,
+                    warehouse_name=warehouse.name if warehouse else item.location,
+                    quantity=item.quantity,
+                    reserved_quantity=item.reserved_quantity,
+                    available_quantity=item.available_quantity(),
+                    in_transit_quantity=incoming
+                ))
             
             available = total_available >= request.required_quantity
             
             return inventory_pb2.CheckStockResponse(
                 available=available,
                 available_quantity=total_available,
-                message=f"Available: {total_available}, Required: {request.required_quantity}"
+                message=f"Available: {total_available}, Required: {request.required_quantity}",
+                locations=locations,
+                in_transit_quantity=total_in_transit
             )
         except Exception as e:
             logger.error(f"Error checking stock: {e}")
@@ -132,58 +553,30 @@ class InventoryServiceImpl(inventory_pb2_grpc.InventoryServiceServicer):
     def ReserveStock(self, request, context):
         db = SessionLocal()
         try:
-            # Check if enough stock is available
-            total_available = 0
-            items = db.query(InventoryItem).filter(InventoryItem.product_id == request.product_id).all()
-            
-            for item in items:
-                total_available += item.available_quantity()
-            
-            if total_available < request.quantity:
+            # Pick the warehouses to draw from and hold the stock there until
+            # the reservation expires, unless the order ships or it is released first
+            reservation, available = reserve(
+                db, request.product_id, request.quantity, request.order_id, request.strategy,
+                shipping_destination(request), reservation_ttl(request.ttl_seconds),
+                variant_id=request.variant_id
+            )
+            if reservation is None:
                 return inventory_pb2.ReserveStockResponse(
                     success=False,
-                    message=f"Insufficient stock. Available: {total_available}, Required: {request.quantity}"
+                    message=f"Insufficient stock for {inventory_pb2.AllocationStrategy.Name(request.strategy)} allocation. Available: {available}, Required: {request.quantity}"
                 )
             
-            # Reserve stock from available items
-            remaining_to_reserve = request.quantity
-            
-            for item in items:
-                if remaining_to_reserve <= 0:
-                    break
-                
-                available = item.available_quantity()
-                if available > 0:
-                    reserve_amount = min(available, remaining_to_reserve)
-                    item.reserved_quantity += reserve_amount
-                    remaining_to_reserve -= reserve_amount
-            
-            # Create reservation record
-            reservation_id = str(uuid.uuid4())
-            reservation = StockReservation(
-                id=reservation_id,
-                product_id=request.product_id,
-                quantity=request.quantity,
-                order_id=request.order_id,
-                expires_at=datetime.utcnow() + timedelta(minutes=30)  # 30 minute reservation
-            )
-            
-            db.add(reservation)
             db.commit()
             
-            # Send Kafka event
-            self.kafka_producer.send_inventory_event("STOCK_RESERVED", {
-                "product_id": request.product_id,
-                "reserved_quantity": request.quantity,
-                "order_id": request.order_id,
-                "reservation_id": reservation_id,
-                "updated_at": datetime.utcnow().isoformat()
-            })
+            reservation_pb = reservation_to_pb(reservation)
+            self._send_reserved_event(reservation_pb)
             
             return inventory_pb2.ReserveStockResponse(
                 success=True,
                 message="Stock reserved successfully",
-                reservation_id=reservation_id
+                reservation_id=reservation_pb.id,
+                allocations=reservation_pb.allocations,
+                expires_at=reservation_pb.expires_at
             )
         except Exception as e:
             logger.error(f"Error reserving stock: {e}")
@@ -194,6 +587,73 @@ class InventoryServiceImpl(inventory_pb2_grpc.InventoryServiceServicer):
         finally:
             db.close()
     
+    def ReserveStockBatch(self, request, context):
+        db = SessionLocal()
+        try:
+            if not request.lines:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details("At least one line is required")
+                return inventory_pb2.ReserveStockBatchResponse(success=False, message="At least one line is required")
+            
+            for line in request.lines:
+                if line.quantity <= 0:
+                    message = f"Quantity for product {line.product_id} must be positive"
+                    context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                    context.set_details(message)
+                    return inventory_pb2.ReserveStockBatchResponse(success=False, message=message)
+            
+            # Reserve every line in one transaction. Lines share the session, so
+            # a product or variant listed twice sees the stock held by its earlier line.
+            destination = shipping_destination(request)
+            ttl = reservation_ttl(request.ttl_seconds)
+            reservations = []
+            shortfalls = []
+            for line in request.lines:
+                reservation, available = reserve(
+                    db, line.product_id, line.quantity, request.order_id, request.strategy, destination, ttl,
+                    variant_id=line.variant_id
+                )
+                if reservation is None:
+                    shortfalls.append(inventory_pb2.LineShortfall(
+                        product_id=line.product_id,
+                        variant_id=line.variant_id,
+                        requested=line.quantity,
+                        available=available,
+                        shortfall=line.quantity - available
+                    ))
+                else:
+                    reservations.append(reservation)
+            
+            # All or nothing: any shortfall undoes the lines already reserved
+            if shortfalls:
+                db.rollback()
+                return inventory_pb2.ReserveStockBatchResponse(
+                    success=False,
+                    message=f"Insufficient stock for {len(shortfalls)} of {len(request.lines)} lines; nothing was reserved",
+                    shortfalls=shortfalls
+                )
+            
+            db.commit()
+            
+            reservations_pb = [reservation_to_pb(reservation) for reservation in reservations]
+            for reservation_pb in reservations_pb:
+                self._send_reserved_event(reservation_pb)
+            
+            return inventory_pb2.ReserveStockBatchResponse(
+                success=True,
+                message=f"Reserved {len(reservations_pb)} lines successfully",
+                reservations=reservations_pb,
+                expires_at=reservations_pb[0].expires_at
+            )
+        except Exception as e:
+            logger.error(f"Error reserving stock batch: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.ReserveStockBatchResponse(success=False, message=f"Error: {e}")
+        finally:
+            db.close()
+    
     def ReleaseStock(self, request, context):
         db = SessionLocal()
         try:
@@ -208,31 +668,12 @@ class InventoryServiceImpl(inventory_pb2_grpc.InventoryServiceServicer):
                     message="Reservation not found or already released"
                 )
             
-            # Release reserved stock
-            items = db.query(InventoryItem).filter(InventoryItem.product_id == reservation.product_id).all()
-            remaining_to_release = reservation.quantity
-            
-            for item in items:
-                if remaining_to_release <= 0:
-                    break
-                
-                release_amount = min(item.reserved_quantity, remaining_to_release)
-                item.reserved_quantity -= release_amount
-                remaining_to_release -= release_amount
-            
-            # Mark reservation as inactive
-            reservation.is_active = False
+            release_reservation(db, reservation, "RELEASED")
             
             db.commit()
             
             # Send Kafka event
-            self.kafka_producer.send_inventory_event("STOCK_RELEASED", {
-                "product_id": reservation.product_id,
-                "released_quantity": reservation.quantity,
-                "order_id": reservation.order_id,
-                "reservation_id": request.reservation_id,
-                "updated_at": datetime.utcnow().isoformat()
-            })
+            self._send_released_event(reservation, "RELEASED")
             
             return inventory_pb2.ReleaseStockResponse(
                 success=True,
@@ -246,6 +687,610 @@ class InventoryServiceImpl(inventory_pb2_grpc.InventoryServiceServicer):
             return inventory_pb2.ReleaseStockResponse(success=False, message=f"Error: {e}")
         finally:
             db.close()
+    
+    def ReleaseOrderReservations(self, request, context):
+        db = SessionLocal()
+        try:
+            if not request.order_id:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details("order_id is required")
+                return inventory_pb2.ReleaseOrderReservationsResponse(success=False, message="order_id is required")
+            
+            reservations = db.query(StockReservation).filter(
+                and_(StockReservation.order_id == request.order_id,
+                     StockReservation.is_active == True)
+            ).all()
+            
+            if not reservations:
+                return inventory_pb2.ReleaseOrderReservationsResponse(
+                    success=False,
+                    message="No active reservations for this order"
+                )
+            
+            for reservation in reservations:
+                release_reservation(db, reservation, "RELEASED")
+            
+            db.commit()
+            
+            for reservation in reservations:
+                self._send_released_event(reservation, "RELEASED")
+            
+            return inventory_pb2.ReleaseOrderReservationsResponse(
+                success=True,
+                message=f"Released {len(reservations)} reservations successfully",
+                reservation_ids=[reservation.id for reservation in reservations]
+            )
+        except Exception as e:
+            logger.error(f"Error releasing order reservations: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.ReleaseOrderReservationsResponse(success=False, message=f"Error: {e}")
+        finally:
+            db.close()
+    
+    def ExtendReservation(self, request, context):
+        db = SessionLocal()
+        try:
+            if request.extend_seconds <= 0:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details("extend_seconds must be positive")
+                return inventory_pb2.ReservationResponse(message="extend_seconds must be positive")
+            
+            reservation = db.query(StockReservation).filter(StockReservation.id == request.reservation_id).first()
+            if not reservation:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Reservation not found")
+                return inventory_pb2.ReservationResponse(message="Reservation not found")
+            
+            if not reservation.is_active:
+                message = f"Reservation is {reservation.status.lower()}"
+                context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                context.set_details(message)
+                return inventory_pb2.ReservationResponse(message=message)
+            
+            # Extend from the current expiry, or from now if the sweeper has
+            # not caught up with an expired reservation yet
+            base = max(reservation.expires_at or datetime.utcnow(), datetime.utcnow())
+            reservation.expires_at = base + timedelta(seconds=request.extend_seconds)
+            
+            db.commit()
+            db.refresh(reservation)
+            
+            self.kafka_producer.send_inventory_event("RESERVATION_EXTENDED", {
+                "product_id": reservation.product_id,
+                "variant_id": reservation.variant_id,
+                "order_id": reservation.order_id,
+                "reservation_id": reservation.id,
+                "expires_at": reservation.expires_at.isoformat(),
+                "updated_at": datetime.utcnow().isoformat()
+            })
+            
+            return inventory_pb2.ReservationResponse(
+                reservation=reservation_to_pb(reservation),
+                message="Reservation extended successfully"
+            )
+        except Exception as e:
+            logger.error(f"Error extending reservation: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.ReservationResponse(message=f"Error: {e}")
+        finally:
+            db.close()
+    
+    def ListReservations(self, request, context):
+        db = SessionLocal()
+        try:
+            page = request.page if request.page > 0 else 1
+            limit = request.limit if request.limit > 0 else 10
+            
+            query = db.query(StockReservation)
+            if not request.include_inactive:
+                query = query.filter(StockReservation.is_active == True)
+            if request.order_id:
+                query = query.filter(StockReservation.order_id == request.order_id)
+            if request.product_id:
+                query = query.filter(StockReservation.product_id == request.product_id)
+            if request.expiring_within_seconds > 0:
+                cutoff = datetime.utcnow() + timedelta(seconds=request.expiring_within_seconds)
+                query = query.filter(and_(StockReservation.is_active == True,
+                                          StockReservation.expires_at <= cutoff))
+            
+            total = query.count()
+            reservations = query.order_by(StockReservation.expires_at).offset((page - 1) * limit).limit(limit).all()
+            
+            return inventory_pb2.ListReservationsResponse(
+                reservations=[reservation_to_pb(reservation) for reservation in reservations],
+                total=total,
+                page=page,
+                limit=limit
+            )
+        except Exception as e:
+            logger.error(f"Error listing reservations: {e}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.ListReservationsResponse()
+        finally:
+            db.close()
+    
+    def CreateWarehouse(self, request, context):
+        db = SessionLocal()
+        try:
+            code = request.code.strip()
+            if not code or not request.name.strip():
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details("Warehouse code and name are required")
+                return inventory_pb2.WarehouseResponse(message="Warehouse code and name are required")
+            
+            if db.query(Warehouse).filter(Warehouse.code == code).first():
+                context.set_code(grpc.StatusCode.ALREADY_EXISTS)
+                context.set_details(f"Warehouse {code} already exists")
+                return inventory_pb2.WarehouseResponse(message=f"Warehouse {code} already exists")
+            
+            warehouse = Warehouse(
+                code=code,
+                name=request.name.strip(),
+                address=request.address,
+                latitude=request.latitude if request.latitude or request.longitude else None,
+                longitude=request.longitude if request.latitude or request.longitude else None
+            )
+            db.add(warehouse)
+            db.commit()
+            db.refresh(warehouse)
+            
+            return inventory_pb2.WarehouseResponse(
+                warehouse=warehouse_to_pb(warehouse),
+                message="Warehouse created successfully"
+            )
+        except Exception as e:
+            logger.error(f"Error creating warehouse: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.WarehouseResponse(message=f"Error: {e}")
+        finally:
+            db.close()
+    
+    def GetWarehouse(self, request, context):
+        db = SessionLocal()
+        try:
+            warehouse = db.query(Warehouse).filter(Warehouse.id == request.id).first()
+            if not warehouse:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Warehouse not found")
+                return inventory_pb2.WarehouseResponse(message="Warehouse not found")
+            
+            return inventory_pb2.WarehouseResponse(
+                warehouse=warehouse_to_pb(warehouse),
+                message="Warehouse retrieved successfully"
+            )
+        except Exception as e:
+            logger.error(f"Error getting warehouse: {e}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.WarehouseResponse(message=f"Error: {e}")
+        finally:
+            db.close()
+    
+    def UpdateWarehouse(self, request, context):
+        db = SessionLocal()
+        try:
+            warehouse = db.query(Warehouse).filter(Warehouse.id == request.id).first()
+            if not warehouse:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Warehouse not found")
+                return inventory_pb2.WarehouseResponse(message="Warehouse not found")
+            
+            if request.name.strip():
+                warehouse.name = request.name.strip()
+            warehouse.address = request.address
+            if request.latitude or request.longitude:
+                warehouse.latitude = request.latitude
+                warehouse.longitude = request.longitude
+            else:
+                warehouse.latitude = None
+                warehouse.longitude = None
+            warehouse.active = request.active
+            db.commit()
+            db.refresh(warehouse)
+            
+            return inventory_pb2.WarehouseResponse(
+                warehouse=warehouse_to_pb(warehouse),
+                message="Warehouse updated successfully"
+            )
+        except Exception as e:
+            logger.error(f"Error updating warehouse: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.WarehouseResponse(message=f"Error: {e}")
+        finally:
+            db.close()
+    
+    def DeleteWarehouse(self, request, context):
+        db = SessionLocal()
+        try:
+            warehouse = db.query(Warehouse).filter(Warehouse.id == request.id).first()
+            if not warehouse:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Warehouse not found")
+                return inventory_pb2.DeleteWarehouseResponse(success=False, message="Warehouse not found")
+            
+            # Stock has to be moved out before a warehouse can be removed
+            stocked = db.query(InventoryItem).filter(
+                and_(InventoryItem.warehouse_id == warehouse.id,
+                     InventoryItem.quantity > 0)
+            ).count()
+            if stocked:
+                context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                context.set_details("Warehouse still holds stock")
+                return inventory_pb2.DeleteWarehouseResponse(success=False, message="Warehouse still holds stock")
+            
+            open_transfers = db.query(StockTransfer).filter(
+                and_(or_(StockTransfer.source_warehouse_id == warehouse.id,
+                         StockTransfer.destination_warehouse_id == warehouse.id),
+                     StockTransfer.status.in_(TRANSFER_TRANSITIONS.keys()))
+            ).count()
+            if open_transfers:
+                context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                context.set_details("Warehouse has open stock transfers")
+                return inventory_pb2.DeleteWarehouseResponse(success=False, message="Warehouse has open stock transfers")
+            
+            db.query(InventoryItem).filter(InventoryItem.warehouse_id == warehouse.id).delete()
+            db.delete(warehouse)
+            db.commit()
+            
+            return inventory_pb2.DeleteWarehouseResponse(success=True, message="Warehouse deleted successfully")
+        except Exception as e:
+            logger.error(f"Error deleting warehouse: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.DeleteWarehouseResponse(success=False, message=f"Error: {e}")
+        finally:
+            db.close()
+    
+    def ListWarehouses(self, request, context):
+        db = SessionLocal()
+        try:
+            query = db.query(Warehouse)
+            if not request.include_inactive:
+                query = query.filter(Warehouse.active == True)
+            warehouses = query.order_by(Warehouse.code).all()
+            
+            return inventory_pb2.ListWarehousesResponse(
+                warehouses=[warehouse_to_pb(warehouse) for warehouse in warehouses]
+            )
+        except Exception as e:
+            logger.error(f"Error listing warehouses: {e}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.ListWarehousesResponse()
+        finally:
+            db.close()
+    
+    def CreateTransfer(self, request, context):
+        db = SessionLocal()
+        try:
+            if request.quantity <= 0:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details("Quantity must be positive")
+                return inventory_pb2.TransferResponse(message="Quantity must be positive")
+            if request.source_warehouse_id == request.destination_warehouse_id:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details("Source and destination must be different warehouses")
+                return inventory_pb2.TransferResponse(message="Source and destination must be different warehouses")
+            
+            source = db.query(Warehouse).filter(Warehouse.id == request.source_warehouse_id).first()
+            destination = db.query(Warehouse).filter(Warehouse.id == request.destination_warehouse_id).first()
+            if not source or not destination:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Warehouse not found")
+                return inventory_pb2.TransferResponse(message="Warehouse not found")
+            if not destination.active:
+                context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                context.set_details(f"Warehouse {destination.code} is inactive")
+                return inventory_pb2.TransferResponse(message=f"Warehouse {destination.code} is inactive")
+            
+            # Hold the stock at the source until it is dispatched
+            source_item = stock_item(db, request.product_id, request.variant_id, source, request.actor)
+            if source_item.available_quantity() < request.quantity:
+                db.rollback()
+                context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                context.set_details(f"Insufficient stock in {source.code}. Available: {source_item.available_quantity()}, Required: {request.quantity}")
+                return inventory_pb2.TransferResponse(message="Insufficient stock at source warehouse")
+            transfer_id = str(uuid.uuid4())
+            record(db, source_item, "RESERVATION", "TRANSFER", request.actor,
+                   reserved_delta=request.quantity, reference=transfer_id)
+            
+            # Make the destination show up in stock views while the transfer is open
+            stock_item(db, request.product_id, request.variant_id, destination, request.actor)
+            
+            transfer = StockTransfer(
+                id=transfer_id,
+                product_id=request.product_id,
+                variant_id=request.variant_id,
+                quantity=request.quantity,
+                source_warehouse_id=source.id,
+                destination_warehouse_id=destination.id,
+                status="REQUESTED",
+                note=request.note
+            )
+            db.add(transfer)
+            db.add(StockTransferEvent(
+                transfer_id=transfer.id,
+                status="REQUESTED",
+                actor=request.actor,
+                note=request.note
+            ))
+            db.commit()
+            db.refresh(transfer)
+            
+            self._send_transfer_event(transfer, request.actor)
+            
+            return inventory_pb2.TransferResponse(
+                transfer=transfer_to_pb(transfer),
+                message="Transfer requested successfully"
+            )
+        except Exception as e:
+            logger.error(f"Error creating transfer: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.TransferResponse(message=f"Error: {e}")
+        finally:
+            db.close()
+    
+    def GetTransfer(self, request, context):
+        db = SessionLocal()
+        try:
+            transfer = db.query(StockTransfer).filter(StockTransfer.id == request.id).first()
+            if not transfer:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Transfer not found")
+                return inventory_pb2.TransferResponse(message="Transfer not found")
+            
+            return inventory_pb2.TransferResponse(
+                transfer=transfer_to_pb(transfer),
+                message="Transfer retrieved successfully"
+            )
+        except Exception as e:
+            logger.error(f"Error getting transfer: {e}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.TransferResponse(message=f"Error: {e}")
+        finally:
+            db.close()
+    
+    def ListTransfers(self, request, context):
+        db = SessionLocal()
+        try:
+            page = request.page if request.page > 0 else 1
+            limit = request.limit if request.limit > 0 else 10
+            
+            query = db.query(StockTransfer)
+            if request.has_status:
+                query = query.filter(StockTransfer.status == TRANSFER_STATUS_NAMES[request.status])
+            if request.warehouse_id:
+                query = query.filter(or_(StockTransfer.source_warehouse_id == request.warehouse_id,
+                                         StockTransfer.destination_warehouse_id == request.warehouse_id))
+            if request.product_id:
+                query = query.filter(StockTransfer.product_id == request.product_id)
+            
+            total = query.count()
+            transfers = query.order_by(StockTransfer.created_at.desc()).offset((page - 1) * limit).limit(limit).all()
+            
+            return inventory_pb2.ListTransfersResponse(
+                transfers=[transfer_to_pb(transfer) for transfer in transfers],
+                total=total,
+                page=page,
+                limit=limit
+            )
+        except Exception as e:
+            logger.error(f"Error listing transfers: {e}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.ListTransfersResponse()
+        finally:
+            db.close()
+    
+    def UpdateTransferStatus(self, request, context):
+        db = SessionLocal()
+        try:
+            transfer = db.query(StockTransfer).filter(StockTransfer.id == request.id).first()
+            if not transfer:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Transfer not found")
+                return inventory_pb2.TransferResponse(message="Transfer not found")
+            
+            status = TRANSFER_STATUS_NAMES[request.status]
+            if status not in TRANSFER_TRANSITIONS.get(transfer.status, ()):
+                context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                context.set_details(f"Cannot change transfer from {transfer.status} to {status}")
+                return inventory_pb2.TransferResponse(message=f"Cannot change transfer from {transfer.status} to {status}")
+            
+            source_item = stock_item(db, transfer.product_id, transfer.variant_id, transfer.source, request.actor)
+            if status == "IN_TRANSIT":
+                # Dispatch: the held stock leaves the source
+                record(db, source_item, "TRANSFER_OUT", "TRANSFER", request.actor,
+                       quantity_delta=-transfer.quantity,
+                       reserved_delta=-min(source_item.reserved_quantity, transfer.quantity),
+                       reference=transfer.id, note=request.note)
+            elif status == "RECEIVED":
+                destination_item = stock_item(db, transfer.product_id, transfer.variant_id, transfer.destination, request.actor)
+                record(db, destination_item, "TRANSFER_IN", "TRANSFER", request.actor,
+                       quantity_delta=transfer.quantity, reference=transfer.id, note=request.note)
+            elif transfer.status == "REQUESTED":
+                # Cancelled before dispatch: release the hold
+                record(db, source_item, "RELEASE", "TRANSFER_CANCELLED", request.actor,
+                       reserved_delta=-min(source_item.reserved_quantity, transfer.quantity),
+                       reference=transfer.id, note=request.note)
+            else:
+                # Cancelled in transit: the stock goes back to the source
+                record(db, source_item, "TRANSFER_IN", "TRANSFER_CANCELLED", request.actor,
+                       quantity_delta=transfer.quantity, reference=transfer.id, note=request.note)
+            
+            # Stock received at the destination, or returned to the source,
+            # goes to waiting backorders first
+            allocated = []
+            if status == "RECEIVED" or transfer.status == "IN_TRANSIT":
+                allocated = allocate_backorders(db, [(transfer.product_id, transfer.variant_id)])
+            
+            transfer.status = status
+            db.add(StockTransferEvent(
+                transfer_id=transfer.id,
+                status=status,
+                actor=request.actor,
+                note=request.note
+            ))
+            db.commit()
+            db.refresh(transfer)
+            
+            self._send_transfer_event(transfer, request.actor)
+            self._send_backorder_events(allocated)
+            
+            return inventory_pb2.TransferResponse(
+                transfer=transfer_to_pb(transfer),
+                message="Transfer status updated successfully"
+            )
+        except Exception as e:
+            logger.error(f"Error updating transfer status: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.TransferResponse(message=f"Error: {e}")
+        finally:
+            db.close()
+    
+    def _send_reserved_event(self, reservation_pb):
+        self.kafka_producer.send_inventory_event("STOCK_RESERVED", {
+            "product_id": reservation_pb.product_id,
+            "variant_id": reservation_pb.variant_id,
+            "reserved_quantity": reservation_pb.quantity,
+            "order_id": reservation_pb.order_id,
+            "reservation_id": reservation_pb.id,
+            "allocations": [
+                {"warehouse_id": a.warehouse_id, "quantity": a.quantity} for a in reservation_pb.allocations
+            ],
+            "expires_at": reservation_pb.expires_at,
+            "updated_at": datetime.utcnow().isoformat()
+        })
+    
+    def _send_backorder_events(self, allocated):
+        for line, reservation in allocated:
+            self.kafka_producer.send_order_event("BACKORDER_ALLOCATED", {
+                "order_id": line.order_id,
+                "product_id": line.product_id,
+                "variant_id": line.variant_id,
+                "quantity": line.quantity,
+                "reservation_id": reservation.id,
+                "updated_at": datetime.utcnow().isoformat()
+            })
+    
+    def _send_released_event(self, reservation, reason):
+        self.kafka_producer.send_inventory_event("STOCK_RELEASED", {
+            "product_id": reservation.product_id,
+            "variant_id": reservation.variant_id,
+            "released_quantity": reservation.quantity,
+            "order_id": reservation.order_id,
+            "reservation_id": reservation.id,
+            "reason": reason,
+            "updated_at": datetime.utcnow().isoformat()
+        })
+    
+    def _send_transfer_event(self, transfer, actor):
+        self.kafka_producer.send_inventory_event(f"TRANSFER_{transfer.status}", {
+            "transfer_id": transfer.id,
+            "product_id": transfer.product_id,
+            "variant_id": transfer.variant_id,
+            "quantity": transfer.quantity,
+            "source_warehouse_id": transfer.source_warehouse_id,
+            "destination_warehouse_id": transfer.destination_warehouse_id,
+            "status": transfer.status,
+            "actor": actor,
+            "updated_at": transfer.updated_at.isoformat()
+        })
+
+ORDER_STATUS_NAMES = {
+    inventory_pb2.OrderStatus.PENDING: "PENDING",
+    inventory_pb2.OrderStatus.CONFIRMED: "CONFIRMED",
+    inventory_pb2.OrderStatus.PROCESSING: "PROCESSING",
+    inventory_pb2.OrderStatus.SHIPPED: "SHIPPED",
+    inventory_pb2.OrderStatus.DELIVERED: "DELIVERED",
+    inventory_pb2.OrderStatus.CANCELLED: "CANCELLED",
+}
+
+def _money(amount, currency):
+    return inventory_pb2.Money(amount=amount or 0, currency=currency)
+
+def _minor(money, default=0):
+    return money.amount if money.currency else default
+
+def _parse_time(value):
+    """ISO 8601 timestamp as naive UTC, the way times are stored"""
+    parsed = datetime.fromisoformat(value.strip().replace("Z", "+00:00"))
+    if parsed.tzinfo is not None:
+        parsed = parsed.astimezone(timezone.utc).replace(tzinfo=None)
+    return parsed
+
+def line_label(item):
+    """How an order line is named in messages: its SKU when it has one"""
+    return f"SKU {item.sku}" if item.sku else f"product {item.product_id}"
+
+def order_to_pb(order):
+    return inventory_pb2.Order(
+        id=order.id,
+        user_id=order.user_id,
+        items=[
+            inventory_pb2.OrderItem(
+                product_id=item.product_id,
+                variant_id=item.variant_id,
+                sku=item.sku,
+                quantity=item.quantity,
+                price=item.price,
+                unit_price=_money(item.unit_price_minor, order.currency),
+                subtotal=_money(item.subtotal_minor, order.currency),
+                stock_policy=item.stock_policy,
+                expected_restock_date=item.expected_restock_date,
+                status=item.status,
+                price_version=item.price_version
+            ) for item in order.items
+        ],
+        total_amount=order.total_amount,
+        status=inventory_pb2.OrderStatus.Value(order.status),
+        created_at=order.created_at.isoformat(),
+        updated_at=order.updated_at.isoformat(),
+        currency=order.currency,
+        subtotal=_money(order.subtotal_minor, order.currency),
+        discount=_money(order.discount_minor, order.currency),
+        tax=_money(order.tax_minor, order.currency),
+        total=_money(order.total_minor, order.currency),
+        settlement_currency=order.settlement_currency,
+        exchange_rate=order.exchange_rate,
+        settlement_total=_money(order.settlement_total_minor, order.settlement_currency),
+        discounts=[
+            inventory_pb2.DiscountLine(
+                promotion_id=discount.promotion_id,
+                code=discount.code,
+                description=discount.description,
+                amount=_money(discount.amount_minor, order.currency)
+            ) for discount in order.discounts
+        ],
+        tax_region=order.tax_region,
+        prices_include_tax=order.prices_include_tax,
+        tax_lines=[
+            inventory_pb2.TaxLine(
+                region=line.region,
+                category=line.category,
+                name=line.name,
+                rate=line.rate,
+                taxable=_money(line.taxable_minor, order.currency),
+                amount=_money(line.amount_minor, order.currency)
+            ) for line in order.tax_lines
+        ],
+        archived_at=order.archived_at.isoformat() + "Z" if order.archived_at else ""
+    )
 
 class OrderServiceImpl(inventory_pb2_grpc.OrderServiceServicer):
     def __init__(self):
@@ -260,11 +1305,25 @@ class OrderServiceImpl(inventory_pb2_grpc.OrderServiceServicer):
             # Calculate total amount
             total_amount = sum(item.price * item.quantity for item in request.items)
             
+            # Totals computed by the gateway take precedence when present
+            currency = request.currency or "USD"
+            total_minor = _minor(request.total, round(total_amount * 100))
+            
             # Create order
             order = Order(
                 id=order_id,
                 user_id=request.user_id,
                 total_amount=total_amount,
+                currency=currency,
+                subtotal_minor=_minor(request.subtotal, total_minor),
+                discount_minor=_minor(request.discount),
+                tax_minor=_minor(request.tax),
+                total_minor=total_minor,
+                settlement_currency=request.settlement_currency or currency,
+                exchange_rate=request.exchange_rate or "1",
+                settlement_total_minor=_minor(request.settlement_total, total_minor),
+                tax_region=request.tax_region,
+                prices_include_tax=request.prices_include_tax,
                 status="PENDING"
             )
             
@@ -273,15 +1332,74 @@ class OrderServiceImpl(inventory_pb2_grpc.OrderServiceServicer):
             # Create order items
             order_items = []
             for item_req in request.items:
+                unit_price_minor = _minor(item_req.unit_price, round(item_req.price * 100))
                 order_item = OrderItem(
                     order_id=order_id,
                     product_id=item_req.product_id,
+                    variant_id=item_req.variant_id,
+                    sku=item_req.sku,
                     quantity=item_req.quantity,
-                    price=item_req.price
+                    price=item_req.price,
+                    unit_price_minor=unit_price_minor,
+                    subtotal_minor=_minor(item_req.subtotal, unit_price_minor * item_req.quantity),
+                    stock_policy=item_req.stock_policy or "none",
+                    expected_restock_date=item_req.expected_restock_date,
+                    price_version=item_req.price_version
                 )
                 db.add(order_item)
                 order_items.append(order_item)
             
+            # Hold stock for every line until the order ships. Lines of
+            # backorderable products wait for stock instead, behind any older
+            # waiting orders; other lines must be in stock.
+            shortfalls = []
+            for order_item in order_items:
+                backorderable = order_item.stock_policy in BACKORDER_POLICIES
+                if backorderable and waiting_backorders(db, order_item.product_id, order_item.variant_id):
+                    order_item.status = "BACKORDERED"
+                    continue
+                
+                reservation, available = reserve(
+                    db, order_item.product_id, order_item.quantity, order_id,
+                    inventory_pb2.AllocationStrategy.ALLOCATION_DEFAULT, ttl=None,
+                    variant_id=order_item.variant_id
+                )
+                if reservation is not None:
+                    order_item.status = "ALLOCATED"
+                elif backorderable:
+                    order_item.status = "BACKORDERED"
+                else:
+                    shortfalls.append(f"{line_label(order_item)} (available {available}, requested {order_item.quantity})")
+            
+            if shortfalls:
+                db.rollback()
+                message = f"Insufficient stock for {', '.join(shortfalls)}"
+                context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                context.set_details(message)
+                return inventory_pb2.OrderResponse(message=message)
+            
+            # Store the promotions that make up the discount
+            for discount_req in request.discounts:
+                db.add(OrderDiscount(
+                    order_id=order_id,
+                    promotion_id=discount_req.promotion_id,
+                    code=discount_req.code,
+                    description=discount_req.description,
+                    amount_minor=discount_req.amount.amount
+                ))
+            
+            # Store the tax breakdown
+            for tax_req in request.tax_lines:
+                db.add(OrderTaxLine(
+                    order_id=order_id,
+                    region=tax_req.region,
+                    category=tax_req.category,
+                    name=tax_req.name,
+                    rate=tax_req.rate,
+                    taxable_minor=tax_req.taxable.amount,
+                    amount_minor=tax_req.amount.amount
+                ))
+            
             db.commit()
             db.refresh(order)
             
@@ -289,39 +1407,35 @@ class OrderServiceImpl(inventory_pb2_grpc.OrderServiceServicer):
             order_data = {
                 "id": order.id,
                 "user_id": order.user_id,
+                "tenant_id": order.tenant_id,
                 "total_amount": order.total_amount,
+                "currency": order.currency,
+                "total_minor": order.total_minor,
+                "settlement_currency": order.settlement_currency,
+                "settlement_total_minor": order.settlement_total_minor,
+                "discount_minor": order.discount_minor,
+                "tax_minor": order.tax_minor,
+                "tax_region": order.tax_region,
+                "promotions": [discount.promotion_id for discount in request.discounts],
                 "status": order.status,
                 "created_at": order.created_at.isoformat(),
                 "items": [
                     {
                         "product_id": item.product_id,
+                        "variant_id": item.variant_id,
+                        "sku": item.sku,
                         "quantity": item.quantity,
-                        "price": item.price
+                        "price": item.price,
+                        "unit_price_minor": item.unit_price_minor,
+                        "status": item.status
                     } for item in order_items
                 ]
             }
             
             self.kafka_producer.send_order_event("ORDER_CREATED", order_data)
             
-            # Convert order items for response
-            response_items = [
-                inventory_pb2.OrderItem(
-                    product_id=item.product_id,
-                    quantity=item.quantity,
-                    price=item.price
-                ) for item in order_items
-            ]
-            
             return inventory_pb2.OrderResponse(
-                order=inventory_pb2.Order(
-                    id=order.id,
-                    user_id=order.user_id,
-                    items=response_items,
-                    total_amount=order.total_amount,
-                    status=inventory_pb2.OrderStatus.PENDING,
-                    created_at=order.created_at.isoformat(),
-                    updated_at=order.updated_at.isoformat()
-                ),
+                order=order_to_pb(order),
                 message="Order created successfully"
             )
         except Exception as e:
@@ -332,9 +1446,200 @@ class OrderServiceImpl(inventory_pb2_grpc.OrderServiceServicer):
             return inventory_pb2.OrderResponse(message=f"Error: {e}")
         finally:
             db.close()
+    
+    def GetOrder(self, request, context):
+        db = SessionLocal()
+        try:
+            order = db.query(Order).filter(Order.id == request.id).first()
+            if not order:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Order not found")
+                return inventory_pb2.OrderResponse(message="Order not found")
+            
+            return inventory_pb2.OrderResponse(
+                order=order_to_pb(order),
+                message="Order retrieved successfully"
+            )
+        except Exception as e:
+            logger.error(f"Error getting order: {e}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.OrderResponse(message=f"Error: {e}")
+        finally:
+            db.close()
+    
+    def ListOrders(self, request, context):
+        db = SessionLocal()
+        try:
+            page = request.page if request.page > 0 else 1
+            limit = request.limit if request.limit > 0 else 10
+            
+            query = db.query(Order)
+            if request.user_id:
+                query = query.filter(Order.user_id == request.user_id)
+            if not request.include_archived:
+                query = query.filter(Order.archived_at.is_(None))
+            
+            total = query.count()
+            orders = query.order_by(Order.created_at.desc()).offset((page - 1) * limit).limit(limit).all()
+            
+            return inventory_pb2.ListOrdersResponse(
+                orders=[order_to_pb(order) for order in orders],
+                total=total,
+                page=page,
+                limit=limit
+            )
+        except Exception as e:
+            logger.error(f"Error listing orders: {e}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.ListOrdersResponse()
+        finally:
+            db.close()
+    
+    def UpdateOrderStatus(self, request, context):
+        db = SessionLocal()
+        try:
+            order = db.query(Order).filter(Order.id == request.id).first()
+            if not order:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Order not found")
+                return inventory_pb2.OrderResponse(message="Order not found")
+            
+            status = ORDER_STATUS_NAMES[request.status]
+            if status == "SHIPPED" and any(item.status == "BACKORDERED" for item in order.items):
+                context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                context.set_details("Order has backordered lines waiting for stock")
+                return inventory_pb2.OrderResponse(message="Order has backordered lines waiting for stock")
+            
+            order.status = status
+            
+            # Shipping consumes the stock reserved for the order, cancelling frees it
+            if order.status in ("SHIPPED", "CANCELLED"):
+                reservations = db.query(StockReservation).filter(
+                    and_(StockReservation.order_id == order.id,
+                         StockReservation.is_active == True)
+                ).all()
+                for reservation in reservations:
+                    if order.status == "SHIPPED":
+                        fulfill_reservation(db, reservation)
+                    else:
+                        release_reservation(db, reservation, "ORDER_CANCELLED")
+            
+            db.commit()
+            db.refresh(order)
+            
+            self.kafka_producer.send_order_event(f"ORDER_{order.status}", {
+                "id": order.id,
+                "user_id": order.user_id,
+                "tenant_id": order.tenant_id,
+                "status": order.status,
+                "created_at": order.updated_at.isoformat()
+            })
+            
+            return inventory_pb2.OrderResponse(
+                order=order_to_pb(order),
+                message="Order status updated successfully"
+            )
+        except Exception as e:
+            logger.error(f"Error updating order status: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.OrderResponse(message=f"Error: {e}")
+        finally:
+            db.close()
+    
+    def ArchiveUserOrders(self, request, context):
+        db = SessionLocal()
+        try:
+            try:
+                archived_at = _parse_time(request.archived_at)
+            except ValueError:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(f"Invalid archived_at {request.archived_at}")
+                return inventory_pb2.UserOrdersResponse()
+            
+            orders = db.query(Order).filter(
+                Order.user_id == request.user_id,
+                Order.archived_at.is_(None)
+            ).all()
+            for order in orders:
+                order.archived_at = archived_at
+            db.commit()
+            
+            logger.info(f"Archived {len(orders)} orders of user {request.user_id}")
+            return inventory_pb2.UserOrdersResponse(count=len(orders))
+        except Exception as e:
+            logger.error(f"Error archiving orders of user: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.UserOrdersResponse()
+        finally:
+            db.close()
+    
+    def RestoreUserOrders(self, request, context):
+        db = SessionLocal()
+        try:
+            try:
+                archived_at = _parse_time(request.archived_at)
+            except ValueError:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(f"Invalid archived_at {request.archived_at}")
+                return inventory_pb2.UserOrdersResponse()
+            
+            orders = db.query(Order).filter(
+                Order.user_id == request.user_id,
+                Order.archived_at == archived_at
+            ).all()
+            for order in orders:
+                order.archived_at = None
+            db.commit()
+            
+            logger.info(f"Restored {len(orders)} orders of user {request.user_id}")
+            return inventory_pb2.UserOrdersResponse(count=len(orders))
+        except Exception as e:
+            logger.error(f"Error restoring orders of user: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.UserOrdersResponse()
+        finally:
+            db.close()
+    
+    def ReassignUserOrders(self, request, context):
+        db = SessionLocal()
+        try:
+            if request.new_user_id <= 0 or request.new_user_id == request.user_id:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details("Orders must be reassigned to another user")
+                return inventory_pb2.UserOrdersResponse()
+            
+            orders = db.query(Order).filter(
+                Order.user_id == request.user_id,
+                Order.archived_at.is_(None)
+            ).all()
+            for order in orders:
+                order.user_id = request.new_user_id
+            db.commit()
+            
+            logger.info(f"Reassigned {len(orders)} orders of user {request.user_id} to user {request.new_user_id}")
+            return inventory_pb2.UserOrdersResponse(count=len(orders))
+        except Exception as e:
+            logger.error(f"Error reassigning orders of user: {e}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
+            return inventory_pb2.UserOrdersResponse()
+        finally:
+            db.close()
 
 def serve():
-    server = grpc.server(futures.ThreadPoolExecutor(max_workers=10))
+    backfill_warehouses()
+    backfill_ledger()
+    
+    server = grpc.server(futures.ThreadPoolExecutor(max_workers=10), interceptors=[TenantInterceptor()])
     
     inventory_service = InventoryServiceImpl()
     order_service = OrderServiceImpl()
@@ -353,6 +1658,7 @@ def serve():
     except KeyboardInterrupt:
         logger.info("Shutting down Inventory Service")
         inventory_service.kafka_consumer.stop()
+        inventory_service.reservation_sweeper.stop()
         inventory_service.kafka_producer.close()
         order_service.kafka_producer.close()
         server.stop(0)
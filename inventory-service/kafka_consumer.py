@@ -1,16 +1,22 @@
 import json
 import logging
 import threading
+from datetime import datetime, timedelta
 from kafka import KafkaConsumer
 from kafka.errors import KafkaError
 import os
 from dotenv import load_dotenv
+from models import KafkaEvent, SessionLocal
+from tenancy import DEFAULT_TENANT
 
 load_dotenv()
 
 logging.basicConfig(level=logging.INFO)
 logger = logging.getLogger(__name__)
 
+# Consumed messages are kept this long for the API Gateway to relay
+EVENT_RETENTION = timedelta(days=7)
+
 class InventoryKafkaConsumer:
     def __init__(self, inventory_service=None):
         self.bootstrap_servers = os.getenv("KAFKA_BOOTSTRAP_SERVERS", "localhost:9092")
@@ -68,12 +74,35 @@ class InventoryKafkaConsumer:
         data = event_data.get('data', {})
         
         logger.info(f"Processing {event_type} from topic {topic}")
+        self._record_event(topic, message.key, event_type, data)
         
         if topic == 'order-events':
             self._handle_order_event(event_type, data)
         elif topic == 'inventory-events':
             self._handle_inventory_event(event_type, data)
     
+    def _record_event(self, topic, key, event_type, data):
+        """Keep the message for the API Gateway, which relays it to webhook
+        subscribers through ListKafkaEvents"""
+        db = SessionLocal()
+        try:
+            db.add(KafkaEvent(
+                tenant_id=data.get('tenant_id') or DEFAULT_TENANT,
+                topic=topic,
+                event_type=event_type or '',
+                key=key or '',
+                data=json.dumps(data)
+            ))
+            db.query(KafkaEvent).filter(
+                KafkaEvent.received_at < datetime.utcnow() - EVENT_RETENTION
+            ).delete(synchronize_session=False)
+            db.commit()
+        except Exception as e:
+            db.rollback()
+            logger.error(f"Error recording {event_type} from topic {topic}: {e}")
+        finally:
+            db.close()
+    
     def _handle_order_event(self, event_type, order_data):
         if not self.inventory_service:
             return
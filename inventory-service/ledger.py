@@ -0,0 +1,73 @@
+import logging
+
+from models import InventoryItem, InventoryLedgerEntry, SessionLocal
+
+logger = logging.getLogger(__name__)
+
+# Actor recorded for changes the service makes on its own
+SYSTEM_ACTOR = "system"
+
+# Reason codes accepted for manual adjustments and the entry type they
+# are recorded as
+ADJUSTMENT_REASONS = {
+    "RECEIVED": "ADJUSTMENT",
+    "CYCLE_COUNT": "ADJUSTMENT",
+    "DAMAGED": "ADJUSTMENT",
+    "LOST": "ADJUSTMENT",
+    "FOUND": "ADJUSTMENT",
+    "CORRECTION": "ADJUSTMENT",
+    "CUSTOMER_RETURN": "RETURN",
+}
+
+def record(db, item, entry_type, reason="", actor="", quantity_delta=0, reserved_delta=0, reference="", note=""):
+    """Apply a stock change to an inventory item and append it to the ledger.
+    All changes to quantity and reserved_quantity go through here."""
+    item.quantity += quantity_delta
+    item.reserved_quantity += reserved_delta
+    if item.id is None:
+        db.flush()
+    
+    entry = InventoryLedgerEntry(
+        inventory_item_id=item.id,
+        product_id=item.product_id,
+        variant_id=item.variant_id,
+        warehouse_id=item.warehouse_id,
+        type=entry_type,
+        reason=reason,
+        actor=actor or SYSTEM_ACTOR,
+        quantity_delta=quantity_delta,
+        reserved_delta=reserved_delta,
+        quantity_after=item.quantity,
+        reserved_after=item.reserved_quantity,
+        reference=reference,
+        note=note
+    )
+    db.add(entry)
+    return entry
+
+def backfill_ledger():
+    """Record the current balance of items that predate the ledger as an
+    opening entry, so every history adds up to the stored balance"""
+    db = SessionLocal()
+    try:
+        recorded = db.query(InventoryLedgerEntry.inventory_item_id)
+        items = db.query(InventoryItem).filter(~InventoryItem.id.in_(recorded)).all()
+        for item in items:
+            db.add(InventoryLedgerEntry(
+                inventory_item_id=item.id,
+                product_id=item.product_id,
+                variant_id=item.variant_id,
+                warehouse_id=item.warehouse_id,
+                type="CREATE",
+                reason="OPENING_BALANCE",
+                actor=SYSTEM_ACTOR,
+                quantity_delta=item.quantity,
+                reserved_delta=item.reserved_quantity,
+                quantity_after=item.quantity,
+                reserved_after=item.reserved_quantity
+            ))
+        db.commit()
+        if items:
+            logger.info(f"Recorded opening balances for {len(items)} inventory items")
+    finally:
+        db.close()
@@ -0,0 +1,128 @@
+"""Schema migrations. create_all only creates missing tables, so columns and
+indexes added to existing tables are applied here, once per database and in
+order; the versions applied are kept in schema_migrations. Tables that do not
+exist yet are skipped, since create_all builds them complete."""
+from datetime import datetime
+import logging
+
+from sqlalchemy import inspect, text
+
+logger = logging.getLogger(__name__)
+
+def add_column(table, column, ddl):
+    def step(conn):
+        tables = inspect(conn)
+        if not tables.has_table(table):
+            return
+        if column in (c["name"] for c in tables.get_columns(table)):
+            return
+        conn.execute(text(f"ALTER TABLE {table} ADD COLUMN {column} {ddl}"))
+    return step
+
+def add_index(name, table, columns):
+    def step(conn):
+        if inspect(conn).has_table(table):
+            conn.execute(text(f"CREATE INDEX IF NOT EXISTS {name} ON {table} ({columns})"))
+    return step
+
+def backfill(table, statement):
+    """Data update run once its table has the migration's columns"""
+    def step(conn):
+        if inspect(conn).has_table(table):
+            conn.execute(text(statement))
+    return step
+
+MIGRATIONS = [
+    # Orders from before multi-currency pricing were priced in USD
+    (1, "order totals in minor units", [
+        add_column("orders", "currency", "VARCHAR NOT NULL DEFAULT 'USD'"),
+        add_column("orders", "subtotal_minor", "INTEGER NOT NULL DEFAULT 0"),
+        add_column("orders", "discount_minor", "INTEGER NOT NULL DEFAULT 0"),
+        add_column("orders", "tax_minor", "INTEGER NOT NULL DEFAULT 0"),
+        add_column("orders", "total_minor", "INTEGER NOT NULL DEFAULT 0"),
+        add_column("orders", "settlement_currency", "VARCHAR NOT NULL DEFAULT 'USD'"),
+        add_column("orders", "exchange_rate", "VARCHAR NOT NULL DEFAULT '1'"),
+        add_column("orders", "settlement_total_minor", "INTEGER NOT NULL DEFAULT 0"),
+        add_column("order_items", "unit_price_minor", "INTEGER NOT NULL DEFAULT 0"),
+        add_column("order_items", "subtotal_minor", "INTEGER NOT NULL DEFAULT 0"),
+        backfill("orders",
+                 "UPDATE orders SET subtotal_minor = CAST(ROUND(total_amount * 100) AS INTEGER), "
+                 "total_minor = CAST(ROUND(total_amount * 100) AS INTEGER), "
+                 "settlement_total_minor = CAST(ROUND(total_amount * 100) AS INTEGER) "
+                 "WHERE total_minor = 0"),
+        backfill("order_items",
+                 "UPDATE order_items SET unit_price_minor = CAST(ROUND(price * 100) AS INTEGER), "
+                 "subtotal_minor = CAST(ROUND(price * quantity * 100) AS INTEGER) "
+                 "WHERE unit_price_minor = 0"),
+    ]),
+    (2, "order tax region", [
+        add_column("orders", "tax_region", "VARCHAR NOT NULL DEFAULT ''"),
+        add_column("orders", "prices_include_tax", "BOOLEAN NOT NULL DEFAULT 0"),
+    ]),
+    # backfill_warehouses links existing items to a warehouse on startup
+    (3, "multi-warehouse inventory", [
+        add_column("inventory_items", "warehouse_id", "INTEGER REFERENCES warehouses(id)"),
+        add_index("ix_inventory_items_warehouse_id", "inventory_items", "warehouse_id"),
+    ]),
+    (4, "reorder points", [
+        add_column("inventory_items", "reorder_point", "INTEGER NOT NULL DEFAULT 0"),
+        add_column("inventory_items", "reorder_quantity", "INTEGER NOT NULL DEFAULT 0"),
+    ]),
+    (5, "reservation status", [
+        add_column("stock_reservations", "status", "VARCHAR NOT NULL DEFAULT 'ACTIVE'"),
+        add_column("stock_reservations", "released_at", "DATETIME"),
+        add_index("ix_stock_reservations_status", "stock_reservations", "status"),
+        add_index("ix_stock_reservations_expires_at", "stock_reservations", "expires_at"),
+        backfill("stock_reservations",
+                 "UPDATE stock_reservations SET status = 'RELEASED' WHERE is_active = 0 AND status = 'ACTIVE'"),
+    ]),
+    (6, "backorders and pre-orders", [
+        add_column("order_items", "stock_policy", "VARCHAR NOT NULL DEFAULT 'none'"),
+        add_column("order_items", "expected_restock_date", "VARCHAR NOT NULL DEFAULT ''"),
+        add_column("order_items", "status", "VARCHAR NOT NULL DEFAULT 'ALLOCATED'"),
+        add_index("ix_order_items_status", "order_items", "status"),
+    ]),
+    (7, "product variants", [
+        add_column("inventory_items", "variant_id", "INTEGER NOT NULL DEFAULT 0"),
+        add_column("order_items", "variant_id", "INTEGER NOT NULL DEFAULT 0"),
+        add_column("order_items", "sku", "VARCHAR NOT NULL DEFAULT ''"),
+        add_column("stock_reservations", "variant_id", "INTEGER NOT NULL DEFAULT 0"),
+        add_index("ix_inventory_items_variant_id", "inventory_items", "variant_id"),
+    ]),
+    (8, "order item price versions", [
+        add_column("order_items", "price_version", "INTEGER NOT NULL DEFAULT 0"),
+    ]),
+    (9, "archived orders", [
+        add_column("orders", "archived_at", "DATETIME"),
+    ]),
+    (10, "storefront of inventory and orders", [
+        add_column("inventory_items", "tenant_id", "VARCHAR NOT NULL DEFAULT 'default'"),
+        add_column("orders", "tenant_id", "VARCHAR NOT NULL DEFAULT 'default'"),
+        add_column("stock_reservations", "tenant_id", "VARCHAR NOT NULL DEFAULT 'default'"),
+        add_index("ix_inventory_items_tenant_id", "inventory_items", "tenant_id"),
+        add_index("ix_orders_tenant_id", "orders", "tenant_id"),
+        add_index("ix_stock_reservations_tenant_id", "stock_reservations", "tenant_id"),
+    ]),
+]
+
+def migrate(engine):
+    """Apply the migrations the database has not seen, each in its own
+    transaction"""
+    with engine.begin() as conn:
+        conn.execute(text(
+            "CREATE TABLE IF NOT EXISTS schema_migrations "
+            "(version INTEGER PRIMARY KEY, description VARCHAR NOT NULL, applied_at DATETIME NOT NULL)"
+        ))
+        applied = {row[0] for row in conn.execute(text("SELECT version FROM schema_migrations"))}
+
+    for version, description, steps in MIGRATIONS:
+        if version in applied:
+            continue
+        with engine.begin() as conn:
+            for step in steps:
+                step(conn)
+            conn.execute(
+                text("INSERT INTO schema_migrations (version, description, applied_at) VALUES (:v, :d, :t)"),
+                {"v": version, "d": description, "t": datetime.utcnow()}
+            )
+        logger.info(f"Applied schema migration {version}: {description}")
@@ -1,9 +1,11 @@
-from sqlalchemy import create_engine, Column, Integer, String, DateTime, Float, Boolean, ForeignKey
+from sqlalchemy import create_engine, event, select, Column, Integer, String, Text, DateTime, Float, Boolean, ForeignKey, UniqueConstraint
 from sqlalchemy.ext.declarative import declarative_base
 from sqlalchemy.orm import sessionmaker, relationship
 from datetime import datetime
 import os
 from dotenv import load_dotenv
+from migrations import migrate
+from tenancy import new_row_tenant, scope_queries
 
 load_dotenv()
 
@@ -13,16 +15,43 @@ engine = create_engine(DATABASE_URL, echo=True)
 SessionLocal = sessionmaker(autocommit=False, autoflush=False, bind=engine)
 Base = declarative_base()
 
+class Warehouse(Base):
+    __tablename__ = "warehouses"
+    __table_args__ = (UniqueConstraint("tenant_id", "code"),)  # codes are unique per storefront
+    
+    id = Column(Integer, primary_key=True, index=True)
+    tenant_id = Column(String, nullable=False, default=new_row_tenant, index=True)
+    code = Column(String, nullable=False, index=True)
+    name = Column(String, nullable=False)
+    address = Column(String, nullable=False, default="")
+    latitude = Column(Float, nullable=True)
+    longitude = Column(Float, nullable=True)
+    active = Column(Boolean, nullable=False, default=True)
+    created_at = Column(DateTime, default=datetime.utcnow)
+    updated_at = Column(DateTime, default=datetime.utcnow, onupdate=datetime.utcnow)
+    
+    items = relationship("InventoryItem", back_populates="warehouse")
+
 class InventoryItem(Base):
     __tablename__ = "inventory_items"
     
     id = Column(Integer, primary_key=True, index=True)
+    tenant_id = Column(String, nullable=False, default=new_row_tenant, index=True)
     product_id = Column(Integer, nullable=False, index=True)
+    # Variant of the product stocked, 0 for products without variants
+    variant_id = Column(Integer, nullable=False, default=0, index=True)
     quantity = Column(Integer, nullable=False, default=0)
     reserved_quantity = Column(Integer, nullable=False, default=0)
+    # location mirrors the warehouse code for older clients
     location = Column(String, nullable=False, default="WAREHOUSE_A")
+    warehouse_id = Column(Integer, ForeignKey("warehouses.id"), nullable=True, index=True)
+    # Low-stock alerts fire when available stock falls to reorder_point (0 disables)
+    reorder_point = Column(Integer, nullable=False, default=0)
+    reorder_quantity = Column(Integer, nullable=False, default=0)
     created_at = Column(DateTime, default=datetime.utcnow)
     updated_at = Column(DateTime, default=datetime.utcnow, onupdate=datetime.utcnow)
+    
+    warehouse = relationship("Warehouse", back_populates="items")
 
     def available_quantity(self):
         return self.quantity - self.reserved_quantity
@@ -31,13 +60,32 @@ class Order(Base):
     __tablename__ = "orders"
     
     id = Column(String, primary_key=True, index=True)
+    tenant_id = Column(String, nullable=False, default=new_row_tenant, index=True)
     user_id = Column(Integer, nullable=False)
     total_amount = Column(Float, nullable=False, default=0.0)
+    # Server-computed totals in minor units of the order currency
+    currency = Column(String, nullable=False, default="USD")
+    subtotal_minor = Column(Integer, nullable=False, default=0)
+    discount_minor = Column(Integer, nullable=False, default=0)
+    tax_minor = Column(Integer, nullable=False, default=0)
+    total_minor = Column(Integer, nullable=False, default=0)
+    # Currency the customer pays in and the rate used to convert the total
+    settlement_currency = Column(String, nullable=False, default="USD")
+    exchange_rate = Column(String, nullable=False, default="1")
+    settlement_total_minor = Column(Integer, nullable=False, default=0)
+    # Region the order was taxed for; inclusive tax is part of the subtotal
+    tax_region = Column(String, nullable=False, default="")
+    prices_include_tax = Column(Boolean, nullable=False, default=False)
     status = Column(String, nullable=False, default="PENDING")
     created_at = Column(DateTime, default=datetime.utcnow)
     updated_at = Column(DateTime, default=datetime.utcnow, onupdate=datetime.utcnow)
+    # Set when the order is archived with its deleted user; archived orders
+    # are kept as records but left out of listings
+    archived_at = Column(DateTime, nullable=True)
     
     items = relationship("OrderItem", back_populates="order")
+    discounts = relationship("OrderDiscount", back_populates="order")
+    tax_lines = relationship("OrderTaxLine", back_populates="order")
 
 class OrderItem(Base):
     __tablename__ = "order_items"
@@ -45,23 +93,165 @@ class OrderItem(Base):
     id = Column(Integer, primary_key=True, index=True)
     order_id = Column(String, ForeignKey("orders.id"), nullable=False)
     product_id = Column(Integer, nullable=False)
+    variant_id = Column(Integer, nullable=False, default=0)
+    sku = Column(String, nullable=False, default="")
     quantity = Column(Integer, nullable=False)
     price = Column(Float, nullable=False)
+    unit_price_minor = Column(Integer, nullable=False, default=0)
+    subtotal_minor = Column(Integer, nullable=False, default=0)
+    price_version = Column(Integer, nullable=False, default=0)  # product price version priced at
+    # Lines beyond available stock wait as BACKORDERED until stock arrives
+    stock_policy = Column(String, nullable=False, default="none")  # none, backorder, preorder
+    expected_restock_date = Column(String, nullable=False, default="")
+    status = Column(String, nullable=False, default="ALLOCATED", index=True)  # ALLOCATED, BACKORDERED
     
     order = relationship("Order", back_populates="items")
 
+class OrderDiscount(Base):
+    __tablename__ = "order_discounts"
+    
+    id = Column(Integer, primary_key=True, index=True)
+    order_id = Column(String, ForeignKey("orders.id"), nullable=False)
+    promotion_id = Column(String, nullable=False)
+    code = Column(String, nullable=False, default="")
+    description = Column(String, nullable=False, default="")
+    amount_minor = Column(Integer, nullable=False, default=0)
+    
+    order = relationship("Order", back_populates="discounts")
+
+class OrderTaxLine(Base):
+    __tablename__ = "order_tax_lines"
+    
+    id = Column(Integer, primary_key=True, index=True)
+    order_id = Column(String, ForeignKey("orders.id"), nullable=False)
+    region = Column(String, nullable=False)
+    category = Column(String, nullable=False)
+    name = Column(String, nullable=False, default="")
+    rate = Column(String, nullable=False)
+    taxable_minor = Column(Integer, nullable=False, default=0)
+    amount_minor = Column(Integer, nullable=False, default=0)
+    
+    order = relationship("Order", back_populates="tax_lines")
+
 class StockReservation(Base):
     __tablename__ = "stock_reservations"
     
     id = Column(String, primary_key=True, index=True)
+    tenant_id = Column(String, nullable=False, default=new_row_tenant, index=True)
     product_id = Column(Integer, nullable=False)
+    variant_id = Column(Integer, nullable=False, default=0)
     quantity = Column(Integer, nullable=False)
     order_id = Column(String, nullable=False)
     is_active = Column(Boolean, default=True)
+    status = Column(String, nullable=False, default="ACTIVE", index=True)  # ACTIVE, RELEASED, EXPIRED, FULFILLED
+    created_at = Column(DateTime, default=datetime.utcnow)
+    expires_at = Column(DateTime, nullable=True, index=True)
+    released_at = Column(DateTime, nullable=True)
+    
+    allocations = relationship("ReservationAllocation", back_populates="reservation")
+
+class ReservationAllocation(Base):
+    __tablename__ = "reservation_allocations"
+    
+    id = Column(Integer, primary_key=True, index=True)
+    reservation_id = Column(String, ForeignKey("stock_reservations.id"), nullable=False, index=True)
+    inventory_item_id = Column(Integer, ForeignKey("inventory_items.id"), nullable=False)
+    warehouse_id = Column(Integer, nullable=True)
+    quantity = Column(Integer, nullable=False)
+    
+    reservation = relationship("StockReservation", back_populates="allocations")
+    item = relationship("InventoryItem")
+
+class StockTransfer(Base):
+    __tablename__ = "stock_transfers"
+    
+    id = Column(String, primary_key=True, index=True)
+    tenant_id = Column(String, nullable=False, default=new_row_tenant, index=True)
+    product_id = Column(Integer, nullable=False, index=True)
+    variant_id = Column(Integer, nullable=False, default=0)
+    quantity = Column(Integer, nullable=False)
+    source_warehouse_id = Column(Integer, ForeignKey("warehouses.id"), nullable=False, index=True)
+    destination_warehouse_id = Column(Integer, ForeignKey("warehouses.id"), nullable=False, index=True)
+    # REQUESTED, IN_TRANSIT, RECEIVED or CANCELLED
+    status = Column(String, nullable=False, default="REQUESTED")
+    note = Column(String, nullable=False, default="")
+    created_at = Column(DateTime, default=datetime.utcnow)
+    updated_at = Column(DateTime, default=datetime.utcnow, onupdate=datetime.utcnow)
+    
+    source = relationship("Warehouse", foreign_keys=[source_warehouse_id])
+    destination = relationship("Warehouse", foreign_keys=[destination_warehouse_id])
+    events = relationship("StockTransferEvent", back_populates="transfer", order_by="StockTransferEvent.id")
+
+class StockTransferEvent(Base):
+    """Audit trail of a transfer; rows are only ever appended"""
+    __tablename__ = "stock_transfer_events"
+    
+    id = Column(Integer, primary_key=True, index=True)
+    transfer_id = Column(String, ForeignKey("stock_transfers.id"), nullable=False, index=True)
+    status = Column(String, nullable=False)
+    actor = Column(String, nullable=False, default="")
+    note = Column(String, nullable=False, default="")
     created_at = Column(DateTime, default=datetime.utcnow)
-    expires_at = Column(DateTime, nullable=True)
+    
+    transfer = relationship("StockTransfer", back_populates="events")
+
+class InventoryLedgerEntry(Base):
+    """One change to the stock of an inventory item. Entries are immutable;
+    corrections are recorded as new entries."""
+    __tablename__ = "inventory_ledger"
+    
+    id = Column(Integer, primary_key=True, index=True)
+    inventory_item_id = Column(Integer, ForeignKey("inventory_items.id"), nullable=False, index=True)
+    product_id = Column(Integer, nullable=False, index=True)
+    variant_id = Column(Integer, nullable=False, default=0)
+    warehouse_id = Column(Integer, nullable=True)
+    # CREATE, ADJUSTMENT, RESERVATION, RELEASE, FULFILLMENT, RETURN, TRANSFER_OUT, TRANSFER_IN, MOVE
+    type = Column(String, nullable=False)
+    reason = Column(String, nullable=False, default="")
+    actor = Column(String, nullable=False, default="")
+    quantity_delta = Column(Integer, nullable=False, default=0)
+    reserved_delta = Column(Integer, nullable=False, default=0)
+    # Balances of the item after the change
+    quantity_after = Column(Integer, nullable=False)
+    reserved_after = Column(Integer, nullable=False)
+    # Order, reservation or transfer that caused the change
+    reference = Column(String, nullable=False, default="")
+    note = Column(String, nullable=False, default="")
+    created_at = Column(DateTime, default=datetime.utcnow)
+
+class KafkaEvent(Base):
+    """Message consumed from Kafka, kept for the API Gateway to relay to
+    webhook subscribers"""
+    __tablename__ = "kafka_events"
+    
+    id = Column(Integer, primary_key=True, index=True)
+    tenant_id = Column(String, nullable=False, default=new_row_tenant, index=True)
+    topic = Column(String, nullable=False)
+    event_type = Column(String, nullable=False)
+    key = Column(String, nullable=False, default="")
+    data = Column(Text, nullable=False, default="{}")  # JSON
+    received_at = Column(DateTime, default=datetime.utcnow, index=True)
+
+@event.listens_for(InventoryLedgerEntry, "before_update")
+@event.listens_for(InventoryLedgerEntry, "before_delete")
+def _reject_ledger_change(mapper, connection, target):
+    raise ValueError("Inventory ledger entries cannot be changed")
+
+# Warehouses, stock, reservations, transfers and orders belong to a
+# storefront; the stock ledger belongs to it through its inventory item
+scope_queries({
+    Warehouse: lambda tenant: Warehouse.tenant_id == tenant,
+    InventoryItem: lambda tenant: InventoryItem.tenant_id == tenant,
+    Order: lambda tenant: Order.tenant_id == tenant,
+    StockReservation: lambda tenant: StockReservation.tenant_id == tenant,
+    StockTransfer: lambda tenant: StockTransfer.tenant_id == tenant,
+    KafkaEvent: lambda tenant: KafkaEvent.tenant_id == tenant,
+    InventoryLedgerEntry: lambda tenant: InventoryLedgerEntry.inventory_item_id.in_(
+        select(InventoryItem.id).where(InventoryItem.tenant_id == tenant)),
+})
 
-# Create tables
+# Bring existing tables up to date, then create missing ones
+migrate(engine)
 Base.metadata.create_all(bind=engine)
 
 def get_db():
@@ -0,0 +1,206 @@
+import logging
+import os
+import threading
+import uuid
+from datetime import datetime, timedelta
+
+from sqlalchemy import and_
+
+import inventory_pb2
+from models import InventoryItem, Order, OrderItem, StockReservation, ReservationAllocation, SessionLocal
+from allocation import allocate, capacity
+from ledger import record
+
+logger = logging.getLogger(__name__)
+
+# How long a reservation holds stock when the caller does not say
+DEFAULT_TTL = timedelta(minutes=int(os.getenv("RESERVATION_TTL_MINUTES", "30")))
+
+# How often the sweeper looks for expired reservations
+SWEEP_INTERVAL_SECONDS = int(os.getenv("RESERVATION_SWEEP_INTERVAL_SECONDS", "60"))
+
+# Policies under which an order line may exceed the available stock
+BACKORDER_POLICIES = ("backorder", "preorder")
+
+def stock_items(db, product_id, variant_id=0):
+    """Inventory items stocking a product, or one variant of it"""
+    return db.query(InventoryItem).filter(
+        and_(InventoryItem.product_id == product_id,
+             InventoryItem.variant_id == variant_id)
+    ).all()
+
+def reserve(db, product_id, quantity, order_id, strategy, destination=None, ttl=DEFAULT_TTL, variant_id=0):
+    """Hold stock of one product or variant for an order in the warehouses
+    the strategy picks, for ttl or until released when ttl is None. Returns
+    the new reservation and the quantity the strategy could allocate; the
+    reservation is None when that does not cover the quantity."""
+    items = stock_items(db, product_id, variant_id)
+    available = capacity(items, strategy)
+    
+    allocations = allocate(items, quantity, strategy, destination)
+    if allocations is None:
+        return None, available
+    
+    reservation = StockReservation(
+        id=str(uuid.uuid4()),
+        product_id=product_id,
+        variant_id=variant_id,
+        quantity=quantity,
+        order_id=order_id,
+        status="ACTIVE",
+        expires_at=datetime.utcnow() + ttl if ttl else None
+    )
+    db.add(reservation)
+    
+    for item, amount in allocations:
+        record(db, item, "RESERVATION", "ORDER", reserved_delta=amount, reference=reservation.id)
+        reservation.allocations.append(ReservationAllocation(
+            item=item,
+            warehouse_id=item.warehouse_id,
+            quantity=amount
+        ))
+    return reservation, available
+
+def waiting_backorders(db, product_id, variant_id=0):
+    """Backordered lines of open orders for a product or variant, oldest
+    order first"""
+    return db.query(OrderItem).join(Order).filter(
+        and_(OrderItem.product_id == product_id,
+             OrderItem.variant_id == variant_id,
+             OrderItem.status == "BACKORDERED",
+             Order.status != "CANCELLED")
+    ).order_by(Order.created_at, OrderItem.id).all()
+
+def allocate_backorders(db, stock_keys):
+    """Reserve newly available stock for backordered lines, first come first
+    served. stock_keys are the (product_id, variant_id) pairs that gained
+    stock. Each stops at the first line its stock cannot cover, so a large
+    early order is not overtaken by smaller later ones. Returns the (line,
+    reservation) pairs allocated."""
+    allocated = []
+    for product_id, variant_id in set(stock_keys):
+        for line in waiting_backorders(db, product_id, variant_id):
+            reservation, _ = reserve(
+                db, product_id, line.quantity, line.order_id,
+                inventory_pb2.AllocationStrategy.ALLOCATION_DEFAULT, ttl=None,
+                variant_id=variant_id
+            )
+            if reservation is None:
+                break
+            line.status = "ALLOCATED"
+            allocated.append((line, reservation))
+    return allocated
+
+def reservation_parts(db, reservation):
+    """The (item, quantity) pairs an active reservation holds"""
+    if reservation.allocations:
+        return [(allocation.item, allocation.quantity) for allocation in reservation.allocations]
+    
+    # Reservations made before allocations were recorded
+    parts = []
+    items = stock_items(db, reservation.product_id, reservation.variant_id)
+    remaining = reservation.quantity
+    for item in items:
+        if remaining <= 0:
+            break
+        
+        amount = min(item.reserved_quantity, remaining)
+        if amount > 0:
+            parts.append((item, amount))
+        remaining -= amount
+    return parts
+
+def close_reservation(reservation, status):
+    reservation.is_active = False
+    reservation.status = status
+    reservation.released_at = datetime.utcnow()
+
+def release_reservation(db, reservation, reason, actor="", status="RELEASED"):
+    """Give the stock held by an active reservation back to the warehouses
+    it was drawn from and close the reservation"""
+    for item, quantity in reservation_parts(db, reservation):
+        record(db, item, "RELEASE", reason, actor,
+               reserved_delta=-min(item.reserved_quantity, quantity),
+               reference=reservation.id)
+    
+    close_reservation(reservation, status)
+
+def fulfill_reservation(db, reservation, actor=""):
+    """Ship the stock held by an active reservation: it leaves both the
+    reserved and the on-hand quantity of its warehouses"""
+    for item, quantity in reservation_parts(db, reservation):
+        record(db, item, "FULFILLMENT", "ORDER_SHIPPED", actor,
+               quantity_delta=-quantity,
+               reserved_delta=-min(item.reserved_quantity, quantity),
+               reference=reservation.order_id)
+    
+    close_reservation(reservation, "FULFILLED")
+
+def release_expired(db, now=None):
+    """Release every active reservation whose expiry has passed and return
+    them"""
+    now = now or datetime.utcnow()
+    reservations = db.query(StockReservation).filter(
+        and_(StockReservation.is_active == True,
+             StockReservation.expires_at != None,
+             StockReservation.expires_at <= now)
+    ).all()
+    for reservation in reservations:
+        release_reservation(db, reservation, "EXPIRED", status="EXPIRED")
+    return reservations
+
+class ReservationSweeper:
+    """Background thread that periodically releases expired reservations so
+    abandoned checkouts do not hold stock forever"""
+    
+    def __init__(self, kafka_producer, interval=SWEEP_INTERVAL_SECONDS):
+        self.kafka_producer = kafka_producer
+        self.interval = interval
+        self.stopped = threading.Event()
+        self.thread = None
+    
+    def start(self):
+        if self.interval <= 0:
+            logger.info("Reservation sweeper disabled")
+            return
+        
+        self.thread = threading.Thread(target=self._run)
+        self.thread.daemon = True
+        self.thread.start()
+        logger.info(f"Started reservation sweeper, checking every {self.interval}s")
+    
+    def stop(self):
+        self.stopped.set()
+    
+    def _run(self):
+        while not self.stopped.is_set():
+            try:
+                self.sweep()
+            except Exception as e:
+                logger.error(f"Error releasing expired reservations: {e}")
+            self.stopped.wait(self.interval)
+    
+    def sweep(self):
+        db = SessionLocal()
+        try:
+            expired = release_expired(db)
+            db.commit()
+            
+            for reservation in expired:
+                self.kafka_producer.send_inventory_event("STOCK_RELEASED", {
+                    "product_id": reservation.product_id,
+                    "variant_id": reservation.variant_id,
+                    "released_quantity": reservation.quantity,
+                    "order_id": reservation.order_id,
+                    "reservation_id": reservation.id,
+                    "reason": "EXPIRED",
+                    "updated_at": datetime.utcnow().isoformat()
+                })
+            if expired:
+                logger.info(f"Released {len(expired)} expired reservations")
+            return len(expired)
+        except Exception:
+            db.rollback()
+            raise
+        finally:
+            db.close()
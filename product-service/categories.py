@@ -0,0 +1,50 @@
+import re
+
+import product_pb2
+from models import Category
+
+def slugify(text):
+    """URL-friendly form of a category name: lowercase words joined by dashes"""
+    return re.sub(r"[^a-z0-9]+", "-", text.lower()).strip("-")
+
+def category_to_pb(category):
+    return product_pb2.Category(
+        id=category.id,
+        name=category.name,
+        slug=category.slug,
+        description=category.description or "",
+        parent_id=category.parent_id or 0,
+        created_at=category.created_at.isoformat(),
+        updated_at=category.updated_at.isoformat()
+    )
+
+def breadcrumbs(category):
+    """Path from the root down to the category, the category included"""
+    path = []
+    while category is not None:
+        path.append(category)
+        category = category.parent
+    return list(reversed(path))
+
+def descendant_ids(db, category_id):
+    """IDs of a category and every category below it"""
+    parents = {}
+    for child_id, parent_id in db.query(Category.id, Category.parent_id).all():
+        parents.setdefault(parent_id, []).append(child_id)
+    
+    ids = []
+    pending = [category_id]
+    while pending:
+        current = pending.pop()
+        ids.append(current)
+        pending.extend(parents.get(current, []))
+    return ids
+
+def category_response(category, message=""):
+    return product_pb2.CategoryResponse(
+        category=category_to_pb(category),
+        breadcrumbs=[category_to_pb(node) for node in breadcrumbs(category)],
+        children=[category_to_pb(child) for child in category.children],
+        success=True,
+        message=message
+    )
@@ -0,0 +1,42 @@
+import product_pb2
+
+def media_to_pb(media):
+    return product_pb2.ProductMedia(
+        id=media.id,
+        product_id=media.product_id,
+        storage_key=media.storage_key,
+        thumbnail_key=media.thumbnail_key or "",
+        content_type=media.content_type,
+        size=media.size,
+        width=media.width,
+        height=media.height,
+        position=media.position,
+        is_primary=media.is_primary,
+        alt_text=media.alt_text or "",
+        created_at=media.created_at.isoformat()
+    )
+
+def place(items, media, position):
+    """Move media to the 1-based position among items, clamped to the list,
+    and renumber the rest to keep positions contiguous"""
+    ordered = [item for item in items if item is not media]
+    position = max(1, min(position, len(ordered) + 1))
+    ordered.insert(position - 1, media)
+    renumber(ordered)
+    return ordered
+
+def renumber(items):
+    """Number items 1, 2, ... in their current order"""
+    for index, item in enumerate(items, start=1):
+        item.position = index
+
+def make_primary(items, media):
+    """Mark media as the only primary image among items"""
+    for item in items:
+        item.is_primary = item is media
+
+def ensure_primary(items):
+    """Promote the first image when none is primary, as after deleting the
+    primary one"""
+    if items and not any(item.is_primary for item in items):
+        make_primary(items, items[0])
@@ -0,0 +1,90 @@
+"""Schema migrations. create_all only creates missing tables, so columns and
+indexes added to existing tables are applied here, once per database and in
+order; the versions applied are kept in schema_migrations. Tables that do not
+exist yet are skipped, since create_all builds them complete."""
+from datetime import datetime, timezone
+import logging
+
+from sqlalchemy import inspect, text
+
+logger = logging.getLogger(__name__)
+
+def add_column(table, column, ddl):
+    def step(conn):
+        tables = inspect(conn)
+        if not tables.has_table(table):
+            return
+        if column in (c["name"] for c in tables.get_columns(table)):
+            return
+        conn.execute(text(f"ALTER TABLE {table} ADD COLUMN {column} {ddl}"))
+    return step
+
+def add_index(name, table, columns):
+    def step(conn):
+        if inspect(conn).has_table(table):
+            conn.execute(text(f"CREATE INDEX IF NOT EXISTS {name} ON {table} ({columns})"))
+    return step
+
+def widen_price(table, column):
+    """SQLite stores any precision in a DECIMAL column; other databases need
+    the declared scale widened to hold three-decimal currencies"""
+    def step(conn):
+        if conn.dialect.name == "sqlite" or not inspect(conn).has_table(table):
+            return
+        conn.execute(text(f"ALTER TABLE {table} ALTER COLUMN {column} TYPE NUMERIC(12, 3)"))
+    return step
+
+MIGRATIONS = [
+    (1, "product currency and three-decimal prices", [
+        add_column("products", "currency", "VARCHAR(3) NOT NULL DEFAULT 'USD'"),
+        widen_price("products", "price"),
+        widen_price("product_variants", "price"),
+        widen_price("price_changes", "price"),
+        widen_price("price_changes", "previous_price"),
+        widen_price("price_schedules", "price"),
+        widen_price("price_schedules", "previous_price"),
+    ]),
+    (2, "product tax category", [
+        add_column("products", "tax_category", "VARCHAR(50) NOT NULL DEFAULT 'standard'"),
+    ]),
+    (3, "backorders and pre-orders", [
+        add_column("products", "stock_policy", "VARCHAR(20) NOT NULL DEFAULT 'none'"),
+        add_column("products", "expected_restock_date", "DATE"),
+    ]),
+    (4, "product option axes", [
+        add_column("products", "options", "TEXT NOT NULL DEFAULT '[]'"),
+    ]),
+    (5, "product price versions", [
+        add_column("products", "price_version", "INTEGER NOT NULL DEFAULT 1"),
+    ]),
+    (6, "soft-deleted products", [
+        add_column("products", "deleted_at", "DATETIME"),
+        add_index("ix_products_deleted_at", "products", "deleted_at"),
+    ]),
+    (7, "storefront of products", [
+        add_column("products", "tenant_id", "VARCHAR(64) NOT NULL DEFAULT 'default'"),
+        add_index("ix_products_tenant_id", "products", "tenant_id"),
+    ]),
+]
+
+def migrate(engine):
+    """Apply the migrations the database has not seen, each in its own
+    transaction"""
+    with engine.begin() as conn:
+        conn.execute(text(
+            "CREATE TABLE IF NOT EXISTS schema_migrations "
+            "(version INTEGER PRIMARY KEY, description VARCHAR(255) NOT NULL, applied_at DATETIME NOT NULL)"
+        ))
+        applied = {row[0] for row in conn.execute(text("SELECT version FROM schema_migrations"))}
+
+    for version, description, steps in MIGRATIONS:
+        if version in applied:
+            continue
+        with engine.begin() as conn:
+            for step in steps:
+                step(conn)
+            conn.execute(
+                text("INSERT INTO schema_migrations (version, description, applied_at) VALUES (:v, :d, :t)"),
+                {"v": version, "d": description, "t": datetime.now(timezone.utc).replace(tzinfo=None)}
+            )
+        logger.info(f"Applied schema migration {version}: {description}")
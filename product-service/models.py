@@ -1,8 +1,11 @@
-from sqlalchemy import create_engine, Column, Integer, String, Text, DECIMAL, DateTime, ForeignKey
+from sqlalchemy import create_engine, select, Column, Integer, String, Text, Boolean, DECIMAL, Date, DateTime, ForeignKey, Table, UniqueConstraint
 from sqlalchemy.ext.declarative import declarative_base
-from sqlalchemy.orm import sessionmaker, Session
+from sqlalchemy.orm import relationship, sessionmaker, Session
 from datetime import datetime, timezone
+import json
 import os
+from migrations import migrate
+from tenancy import new_row_tenant, scope_queries
 
 # Database configuration
 DATABASE_URL = os.getenv("DATABASE_URL", "sqlite:///./products.db")
@@ -12,15 +15,48 @@ SessionLocal = sessionmaker(autocommit=False, autoflush=False, bind=engine)
 
 Base = declarative_base()
 
+# Many-to-many assignment of products to categories
+product_categories = Table(
+    "product_categories",
+    Base.metadata,
+    Column("product_id", Integer, ForeignKey("products.id"), primary_key=True),
+    Column("category_id", Integer, ForeignKey("categories.id"), primary_key=True, index=True)
+)
+
 class Product(Base):
     __tablename__ = "products"
 
     id = Column(Integer, primary_key=True, index=True)
     name = Column(String(255), nullable=False)
     description = Column(Text)
-    price = Column(DECIMAL(10, 2), nullable=False)
+    price = Column(DECIMAL(12, 3), nullable=False)
+    currency = Column(String(3), nullable=False, default="USD")  # ISO 4217 code
+    tax_category = Column(String(50), nullable=False, default="standard")
+    # none, backorder or preorder: whether orders may exceed available stock
+    stock_policy = Column(String(20), nullable=False, default="none")
+    expected_restock_date = Column(Date, nullable=True)
+    # Option axes as JSON: [{"name": "size", "values": ["S", "M", "L"]}, ...]
+    options = Column(Text, nullable=False, default="[]")
+    # Incremented by every price change of the product or its variants
+    price_version = Column(Integer, nullable=False, default=1)
     user_id = Column(Integer, nullable=False)  # Reference to user in user-service
+    tenant_id = Column(String(64), nullable=False, default=new_row_tenant, index=True)  # storefront
     created_at = Column(DateTime, default=lambda: datetime.now(timezone.utc))
+    # Set by soft delete (naive UTC); purged after the retention period
+    deleted_at = Column(DateTime, nullable=True, index=True)
+
+    variants = relationship("ProductVariant", back_populates="product", cascade="all, delete-orphan",
+                            order_by="ProductVariant.id")
+    categories = relationship("Category", secondary=product_categories, back_populates="products",
+                              order_by="Category.id")
+    media = relationship("ProductMedia", back_populates="product", cascade="all, delete-orphan",
+                         order_by="ProductMedia.position")
+    reviews = relationship("Review", back_populates="product", cascade="all, delete-orphan")
+    price_changes = relationship("PriceChange", back_populates="product", cascade="all, delete-orphan")
+    price_schedules = relationship("PriceSchedule", back_populates="product", cascade="all, delete-orphan")
+
+    def option_axes(self):
+        return json.loads(self.options or "[]")
 
     def to_dict(self):
         return {
@@ -28,10 +64,140 @@ class Product(Base):
             "name": self.name,
             "description": self.description,
             "price": float(self.price),
+            "currency": self.currency,
+            "tax_category": self.tax_category,
+            "stock_policy": self.stock_policy,
+            "expected_restock_date": self.expected_restock_date.isoformat() if self.expected_restock_date else None,
             "user_id": self.user_id,
+            "options": self.option_axes(),
+            "category_ids": [category.id for category in self.categories],
             "created_at": self.created_at.isoformat() if self.created_at else None
         }
 
+class ProductVariant(Base):
+    __tablename__ = "product_variants"
+
+    id = Column(Integer, primary_key=True, index=True)
+    product_id = Column(Integer, ForeignKey("products.id"), nullable=False, index=True)
+    sku = Column(String(64), nullable=False, unique=True, index=True)
+    # Option values as JSON: {"size": "M", "color": "red"}
+    options = Column(Text, nullable=False, default="{}")
+    # Overrides the product price when set
+    price = Column(DECIMAL(12, 3), nullable=True)
+    created_at = Column(DateTime, default=lambda: datetime.now(timezone.utc))
+    updated_at = Column(DateTime, default=lambda: datetime.now(timezone.utc), onupdate=lambda: datetime.now(timezone.utc))
+
+    product = relationship("Product", back_populates="variants")
+
+    def option_values(self):
+        return json.loads(self.options or "{}")
+
+class ProductMedia(Base):
+    """Uploaded product image; the files live in the API Gateway's blob storage"""
+    __tablename__ = "product_media"
+
+    id = Column(Integer, primary_key=True, index=True)
+    product_id = Column(Integer, ForeignKey("products.id"), nullable=False, index=True)
+    storage_key = Column(String(255), nullable=False)
+    thumbnail_key = Column(String(255), nullable=False, default="")
+    content_type = Column(String(100), nullable=False)
+    size = Column(Integer, nullable=False, default=0)  # bytes
+    width = Column(Integer, nullable=False, default=0)
+    height = Column(Integer, nullable=False, default=0)
+    position = Column(Integer, nullable=False, default=1)  # 1-based display order
+    is_primary = Column(Boolean, nullable=False, default=False)
+    alt_text = Column(String(255), nullable=False, default="")
+    created_at = Column(DateTime, default=lambda: datetime.now(timezone.utc))
+
+    product = relationship("Product", back_populates="media")
+
+class Review(Base):
+    """Customer rating of a delivered product; one per customer and product"""
+    __tablename__ = "reviews"
+    __table_args__ = (UniqueConstraint("product_id", "user_id"),)
+
+    id = Column(Integer, primary_key=True, index=True)
+    product_id = Column(Integer, ForeignKey("products.id"), nullable=False, index=True)
+    user_id = Column(Integer, nullable=False, index=True)  # Reference to user in user-service
+    rating = Column(Integer, nullable=False)  # 1 to 5 stars
+    title = Column(String(200), nullable=False, default="")
+    body = Column(Text, nullable=False, default="")
+    # PENDING, APPROVED or REJECTED; only approved reviews are public
+    status = Column(String(20), nullable=False, default="APPROVED", index=True)
+    moderation_note = Column(Text, nullable=False, default="")
+    order_id = Column(String(36), nullable=False, default="")  # delivered order of the purchase
+    created_at = Column(DateTime, default=lambda: datetime.now(timezone.utc))
+    updated_at = Column(DateTime, default=lambda: datetime.now(timezone.utc), onupdate=lambda: datetime.now(timezone.utc))
+
+    product = relationship("Product", back_populates="reviews")
+
+class PriceChange(Base):
+    """Price history entry, recorded whenever a product or variant price changes"""
+    __tablename__ = "price_changes"
+
+    id = Column(Integer, primary_key=True, index=True)
+    product_id = Column(Integer, ForeignKey("products.id"), nullable=False, index=True)
+    variant_id = Column(Integer, nullable=False, default=0)  # 0 for the product price
+    version = Column(Integer, nullable=False)  # product price_version after the change
+    price = Column(DECIMAL(12, 3), nullable=True)  # NULL when a variant override was removed
+    previous_price = Column(DECIMAL(12, 3), nullable=True)
+    currency = Column(String(3), nullable=False)
+    reason = Column(String(20), nullable=False)  # CREATE, MANUAL, SCHEDULE_START, SCHEDULE_END
+    schedule_id = Column(Integer, nullable=True)
+    changed_at = Column(DateTime, default=lambda: datetime.now(timezone.utc))
+
+    product = relationship("Product", back_populates="price_changes")
+
+class PriceSchedule(Base):
+    """Planned product price for a period, such as a sale"""
+    __tablename__ = "price_schedules"
+
+    id = Column(Integer, primary_key=True, index=True)
+    product_id = Column(Integer, ForeignKey("products.id"), nullable=False, index=True)
+    price = Column(DECIMAL(12, 3), nullable=False)
+    starts_at = Column(DateTime, nullable=False, index=True)  # UTC
+    ends_at = Column(DateTime, nullable=True)  # UTC; open-ended when NULL
+    # SCHEDULED, ACTIVE, COMPLETED or CANCELLED
+    status = Column(String(20), nullable=False, default="SCHEDULED", index=True)
+    previous_price = Column(DECIMAL(12, 3), nullable=True)  # restored when the schedule ends
+    note = Column(String(255), nullable=False, default="")
+    created_at = Column(DateTime, default=lambda: datetime.now(timezone.utc))
+
+    product = relationship("Product", back_populates="price_schedules")
+
+class Category(Base):
+    """Node of the category tree; root categories have no parent"""
+    __tablename__ = "categories"
+    __table_args__ = (UniqueConstraint("tenant_id", "slug"),)  # each storefront has its own tree
+
+    id = Column(Integer, primary_key=True, index=True)
+    tenant_id = Column(String(64), nullable=False, default=new_row_tenant, index=True)
+    name = Column(String(100), nullable=False)
+    slug = Column(String(100), nullable=False, index=True)
+    description = Column(Text, nullable=False, default="")
+    parent_id = Column(Integer, ForeignKey("categories.id"), nullable=True, index=True)
+    created_at = Column(DateTime, default=lambda: datetime.now(timezone.utc))
+    updated_at = Column(DateTime, default=lambda: datetime.now(timezone.utc), onupdate=lambda: datetime.now(timezone.utc))
+
+    parent = relationship("Category", remote_side=[id], back_populates="children")
+    children = relationship("Category", back_populates="parent", order_by="Category.name")
+    products = relationship("Product", secondary=product_categories, back_populates="categories")
+
+def _tenant_products(tenant):
+    return select(Product.id).where(Product.tenant_id == tenant)
+
+# Products and categories belong to a storefront; the rest of the catalog
+# belongs to it through its product
+scope_queries({
+    Product: lambda tenant: Product.tenant_id == tenant,
+    Category: lambda tenant: Category.tenant_id == tenant,
+    ProductVariant: lambda tenant: ProductVariant.product_id.in_(_tenant_products(tenant)),
+    ProductMedia: lambda tenant: ProductMedia.product_id.in_(_tenant_products(tenant)),
+    Review: lambda tenant: Review.product_id.in_(_tenant_products(tenant)),
+    PriceChange: lambda tenant: PriceChange.product_id.in_(_tenant_products(tenant)),
+    PriceSchedule: lambda tenant: PriceSchedule.product_id.in_(_tenant_products(tenant)),
+})
+
 def get_db() -> Session:
     db = SessionLocal()
     try:
@@ -39,5 +205,6 @@ def get_db() -> Session:
     finally:
         pass
 
-# Create tables
+# Bring existing tables up to date, then create missing ones
+migrate(engine)
 Base.metadata.create_all(bind=engine)
\ No newline at end of file
@@ -0,0 +1,120 @@
+from datetime import datetime, timezone
+from decimal import Decimal
+
+import product_pb2
+from models import PriceChange
+
+SCHEDULE_STATUSES = ("SCHEDULED", "ACTIVE", "COMPLETED", "CANCELLED")
+
+# Decimal places of currencies whose minor unit is not 1/100; matches the
+# API Gateway's money package
+MINOR_UNITS = {
+    "BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+    "CLP": 0, "ISK": 0, "JPY": 0, "KRW": 0, "PYG": 0, "UGX": 0, "VND": 0,
+}
+
+def parse_time(value):
+    """ISO 8601 timestamp as naive UTC, the way times are stored. Raises
+    ValueError for malformed input."""
+    parsed = datetime.fromisoformat(value.strip().replace("Z", "+00:00"))
+    if parsed.tzinfo is not None:
+        parsed = parsed.astimezone(timezone.utc).replace(tzinfo=None)
+    return parsed
+
+def format_time(value):
+    return value.isoformat() + "Z" if value else ""
+
+def to_price(value, currency):
+    """Request price rounded to the currency's minor unit, the way it is
+    stored, so it compares equal to an unchanged stored price"""
+    places = MINOR_UNITS.get((currency or "USD").upper(), 2)
+    return Decimal(str(value)).quantize(Decimal(1).scaleb(-places))
+
+def price_change_to_pb(change):
+    return product_pb2.PriceChange(
+        id=change.id,
+        product_id=change.product_id,
+        variant_id=change.variant_id,
+        version=change.version,
+        has_price=change.price is not None,
+        price=float(change.price) if change.price is not None else 0.0,
+        previous_price=float(change.previous_price) if change.previous_price is not None else 0.0,
+        currency=change.currency,
+        reason=change.reason,
+        schedule_id=change.schedule_id or 0,
+        changed_at=change.changed_at.isoformat()
+    )
+
+def schedule_to_pb(schedule):
+    return product_pb2.PriceSchedule(
+        id=schedule.id,
+        product_id=schedule.product_id,
+        price=float(schedule.price),
+        currency=schedule.product.currency,
+        starts_at=format_time(schedule.starts_at),
+        ends_at=format_time(schedule.ends_at),
+        status=schedule.status,
+        previous_price=float(schedule.previous_price) if schedule.previous_price is not None else 0.0,
+        note=schedule.note or "",
+        created_at=schedule.created_at.isoformat()
+    )
+
+def record_price_change(db, product, reason, price, previous_price, variant=None, schedule=None):
+    """Bump the product's price version and add the change to its history.
+    The caller has already applied the new price."""
+    product.price_version = (product.price_version or 1) + 1
+    change = PriceChange(
+        product_id=product.id,
+        variant_id=variant.id if variant is not None else 0,
+        version=product.price_version,
+        price=price,
+        previous_price=previous_price,
+        currency=product.currency,
+        reason=reason,
+        schedule_id=schedule.id if schedule is not None else None
+    )
+    db.add(change)
+    return change
+
+def record_initial_price(db, product):
+    """Open the price history of a new product at version 1"""
+    db.flush()
+    product.price_version = 1
+    db.add(PriceChange(
+        product_id=product.id,
+        variant_id=0,
+        version=1,
+        price=product.price,
+        previous_price=None,
+        currency=product.currency,
+        reason="CREATE"
+    ))
+
+def overlaps(schedule, starts_at, ends_at):
+    """Whether a pending or running schedule overlaps the period
+    [starts_at, ends_at); None means open-ended"""
+    if schedule.ends_at is not None and schedule.ends_at <= starts_at:
+        return False
+    if ends_at is not None and ends_at <= schedule.starts_at:
+        return False
+    return True
+
+def start_schedule(db, schedule):
+    """Put a due schedule's price into effect"""
+    product = schedule.product
+    schedule.previous_price = product.price
+    schedule.status = "ACTIVE"
+    if product.price != schedule.price:
+        previous = product.price
+        product.price = schedule.price
+        record_price_change(db, product, "SCHEDULE_START", schedule.price, previous, schedule=schedule)
+
+def end_schedule(db, schedule):
+    """Finish a running schedule, restoring the price it replaced unless the
+    price was changed by hand in the meantime"""
+    product = schedule.product
+    schedule.status = "COMPLETED"
+    if schedule.previous_price is not None and product.price == schedule.price and product.price != schedule.previous_price:
+        previous = product.price
+        product.price = schedule.previous_price
+        record_price_change(db, product, "SCHEDULE_END", schedule.previous_price, previous, schedule=schedule)
@@ -14,39 +14,176 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\rproduct.proto\x12\x07product\"l\n\x07Product\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\r\n\x05price\x18\x04 \x01(\x01\x12\x0f\n\x07user_id\x18\x05 \x01(\x05\x12\x12\n\ncreated_at\x18\x06 \x01(\t\"Y\n\x14\x43reateProductRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\r\n\x05price\x18\x03 \x01(\x01\x12\x0f\n\x07user_id\x18\x04 \x01(\x05\"\\\n\x15\x43reateProductResponse\x12!\n\x07product\x18\x01 \x01(\x0b\x32\x10.product.Product\x12\x0f\n\x07success\x18\x02 \x01(\x08\x12\x0f\n\x07message\x18\x03 \x01(\t\"\'\n\x11GetProductRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\"F\n\x12GetProductResponse\x12!\n\x07product\x18\x01 \x01(\x0b\x32\x10.product.Product\x12\r\n\x05\x66ound\x18\x02 \x01(\x08\"\\\n\x14UpdateProductRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\r\n\x05price\x18\x04 \x01(\x01\"\\\n\x15UpdateProductResponse\x12!\n\x07product\x18\x01 \x01(\x0b\x32\x10.product.Product\x12\x0f\n\x07success\x18\x02 \x01(\x08\x12\x0f\n\x07message\x18\x03 \x01(\t\"*\n\x14\x44\x65leteProductRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\"9\n\x15\x44\x65leteProductResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"2\n\x13ListProductsRequest\x12\x0c\n\x04page\x18\x01 \x01(\x05\x12\r\n\x05limit\x18\x02 \x01(\x05\"f\n\x14ListProductsResponse\x12\"\n\x08products\x18\x01 \x03(\x0b\x32\x10.product.Product\x12\r\n\x05total\x18\x02 \x01(\x05\x12\x0c\n\x04page\x18\x03 \x01(\x05\x12\r\n\x05limit\x18\x04 \x01(\x05\"+\n\x18GetProductsByUserRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\x05\"N\n\x19GetProductsByUserResponse\x12\"\n\x08products\x18\x01 \x03(\x0b\x32\x10.product.Product\x12\r\n\x05total\x18\x02 \x01(\x05\x32\xf0\x03\n\x0eProductService\x12N\n\rCreateProduct\x12\x1d.product.CreateProductRequest\x1a\x1e.product.CreateProductResponse\x12\x45\n\nGetProduct\x12\x1a.product.GetProductRequest\x1a\x1b.product.GetProductResponse\x12N\n\rUpdateProduct\x12\x1d.product.UpdateProductRequest\x1a\x1e.product.UpdateProductResponse\x12N\n\rDeleteProduct\x12\x1d.product.DeleteProductRequest\x1a\x1e.product.DeleteProductResponse\x12K\n\x0cListProducts\x12\x1c.product.ListProductsRequest\x1a\x1d.product.ListProductsResponse\x12Z\n\x11GetProductsByUser\x12!.product.GetProductsByUserRequest\x1a\".product.GetProductsByUserResponseb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\rproduct.proto\x12\x07product\"\xfd\x02\n\x07Product\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\r\n\x05price\x18\x04 \x01(\x01\x12\x0f\n\x07user_id\x18\x05 \x01(\x05\x12\x12\n\ncreated_at\x18\x06 \x01(\t\x12\x10\n\x08\x63urrency\x18\x07 \x01(\t\x12\x14\n\x0ctax_category\x18\x08 \x01(\t\x12\x14\n\x0cstock_policy\x18\t \x01(\t\x12\x1d\n\x15\x65xpected_restock_date\x18\n \x01(\t\x12\'\n\x07options\x18\x0b \x03(\x0b\x32\x16.product.ProductOption\x12\"\n\x08variants\x18\x0c \x03(\x0b\x32\x10.product.Variant\x12\x14\n\x0c\x63\x61tegory_ids\x18\r \x03(\x05\x12$\n\x05media\x18\x0e \x03(\x0b\x32\x15.product.ProductMedia\x12\x15\n\rprice_version\x18\x0f \x01(\x05\x12\x12\n\ndeleted_at\x18\x10 \x01(\t\"-\n\rProductOption\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06values\x18\x02 \x03(\t\"\xe0\x01\n\x07Variant\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x12\n\nproduct_id\x18\x02 \x01(\x05\x12\x0b\n\x03sku\x18\x03 \x01(\t\x12.\n\x07options\x18\x04 \x03(\x0b\x32\x1d.product.Variant.OptionsEntry\x12\x11\n\thas_price\x18\x05 \x01(\x08\x12\r\n\x05price\x18\x06 \x01(\x01\x12\x12\n\ncreated_at\x18\x07 \x01(\t\x12\x12\n\nupdated_at\x18\x08 \x01(\t\x1a.\n\x0cOptionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xf5\x01\n\x14\x43reateProductRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\r\n\x05price\x18\x03 \x01(\x01\x12\x0f\n\x07user_id\x18\x04 \x01(\x05\x12\x10\n\x08\x63urrency\x18\x05 \x01(\t\x12\x14\n\x0ctax_category\x18\x06 \x01(\t\x12\x14\n\x0cstock_policy\x18\x07 \x01(\t\x12\x1d\n\x15\x65xpected_restock_date\x18\x08 \x01(\t\x12\'\n\x07options\x18\t \x03(\x0b\x32\x16.product.ProductOption\x12\x14\n\x0c\x63\x61tegory_ids\x18\n \x03(\x05\"\\\n\x15\x43reateProductResponse\x12!\n\x07product\x18\x01 \x01(\x0b\x32\x10.product.Product\x12\x0f\n\x07success\x18\x02 \x01(\x08\x12\x0f\n\x07message\x18\x03 \x01(\t\"@\n\x11GetProductRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x17\n\x0finclude_deleted\x18\x02 \x01(\x08\"F\n\x12GetProductResponse\x12!\n\x07product\x18\x01 \x01(\x0b\x32\x10.product.Product\x12\r\n\x05\x66ound\x18\x02 \x01(\x08\"\xa5\x02\n\x14UpdateProductRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\r\n\x05price\x18\x04 \x01(\x01\x12\x10\n\x08\x63urrency\x18\x05 \x01(\t\x12\x14\n\x0ctax_category\x18\x06 \x01(\t\x12\x14\n\x0cstock_policy\x18\x07 \x01(\t\x12\x1d\n\x15\x65xpected_restock_date\x18\x08 \x01(\t\x12\x13\n\x0bset_options\x18\t \x01(\x08\x12\'\n\x07options\x18\n \x03(\x0b\x32\x16.product.ProductOption\x12\x16\n\x0eset_categories\x18\x0b \x01(\x08\x12\x14\n\x0c\x63\x61tegory_ids\x18\x0c \x03(\x05\"\\\n\x15UpdateProductResponse\x12!\n\x07product\x18\x01 \x01(\x0b\x32\x10.product.Product\x12\x0f\n\x07success\x18\x02 \x01(\x08\x12\x0f\n\x07message\x18\x03 \x01(\t\"*\n\x14\x44\x65leteProductRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\"9\n\x15\x44\x65leteProductResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"}\n\x13ListProductsRequest\x12\x0c\n\x04page\x18\x01 \x01(\x05\x12\r\n\x05limit\x18\x02 \x01(\x05\x12\x13\n\x0b\x63\x61tegory_id\x18\x03 \x01(\x05\x12\x1b\n\x13include_descendants\x18\x04 \x01(\x08\x12\x17\n\x0finclude_deleted\x18\x05 \x01(\x08\"f\n\x14ListProductsResponse\x12\"\n\x08products\x18\x01 \x03(\x0b\x32\x10.product.Product\x12\r\n\x05total\x18\x02 \x01(\x05\x12\x0c\n\x04page\x18\x03 \x01(\x05\x12\r\n\x05limit\x18\x04 \x01(\x05\"D\n\x18GetProductsByUserRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\x05\x12\x17\n\x0finclude_deleted\x18\x02 \x01(\x08\"N\n\x19GetProductsByUserResponse\x12\"\n\x08products\x18\x01 \x03(\x0b\x32\x10.product.Product\x12\r\n\x05total\x18\x02 \x01(\x05\"\xc6\x01\n\x14\x43reateVariantRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x0b\n\x03sku\x18\x02 \x01(\t\x12;\n\x07options\x18\x03 \x03(\x0b\x32*.product.CreateVariantRequest.OptionsEntry\x12\x11\n\thas_price\x18\x04 \x01(\x08\x12\r\n\x05price\x18\x05 \x01(\x01\x1a.\n\x0cOptionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\'\n\x11GetVariantRequest\x12\x12\n\nvariant_id\x18\x01 \x01(\x05\"%\n\x16GetVariantBySkuRequest\x12\x0b\n\x03sku\x18\x01 \x01(\t\"\xd9\x01\n\x14UpdateVariantRequest\x12\x12\n\nvariant_id\x18\x01 \x01(\x05\x12\x0b\n\x03sku\x18\x02 \x01(\t\x12;\n\x07options\x18\x03 \x03(\x0b\x32*.product.UpdateVariantRequest.OptionsEntry\x12\x11\n\tset_price\x18\x04 \x01(\x08\x12\x11\n\thas_price\x18\x05 \x01(\x08\x12\r\n\x05price\x18\x06 \x01(\x01\x1a.\n\x0cOptionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"V\n\x0fVariantResponse\x12!\n\x07variant\x18\x01 \x01(\x0b\x32\x10.product.Variant\x12\x0f\n\x07success\x18\x02 \x01(\x08\x12\x0f\n\x07message\x18\x03 \x01(\t\"*\n\x14\x44\x65leteVariantRequest\x12\x12\n\nvariant_id\x18\x01 \x01(\x05\"9\n\x15\x44\x65leteVariantResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\")\n\x13ListVariantsRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\":\n\x14ListVariantsResponse\x12\"\n\x08variants\x18\x01 \x03(\x0b\x32\x10.product.Variant\"\x82\x01\n\x08\x43\x61tegory\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04slug\x18\x03 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x04 \x01(\t\x12\x11\n\tparent_id\x18\x05 \x01(\x05\x12\x12\n\ncreated_at\x18\x06 \x01(\t\x12\x12\n\nupdated_at\x18\x07 \x01(\t\"[\n\x15\x43reateCategoryRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04slug\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\x11\n\tparent_id\x18\x04 \x01(\x05\")\n\x12GetCategoryRequest\x12\x13\n\x0b\x63\x61tegory_id\x18\x01 \x01(\x05\"]\n\x15UpdateCategoryRequest\x12\x13\n\x0b\x63\x61tegory_id\x18\x01 \x01(\x05\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04slug\x18\x03 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x04 \x01(\t\"=\n\x13MoveCategoryRequest\x12\x13\n\x0b\x63\x61tegory_id\x18\x01 \x01(\x05\x12\x11\n\tparent_id\x18\x02 \x01(\x05\"\xa6\x01\n\x10\x43\x61tegoryResponse\x12#\n\x08\x63\x61tegory\x18\x01 \x01(\x0b\x32\x11.product.Category\x12&\n\x0b\x62readcrumbs\x18\x02 \x03(\x0b\x32\x11.product.Category\x12#\n\x08\x63hildren\x18\x03 \x03(\x0b\x32\x11.product.Category\x12\x0f\n\x07success\x18\x04 \x01(\x08\x12\x0f\n\x07message\x18\x05 \x01(\t\",\n\x15\x44\x65leteCategoryRequest\x12\x13\n\x0b\x63\x61tegory_id\x18\x01 \x01(\x05\":\n\x16\x44\x65leteCategoryResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"\x17\n\x15ListCategoriesRequest\"?\n\x16ListCategoriesResponse\x12%\n\ncategories\x18\x01 \x03(\x0b\x32\x11.product.Category\"\xe9\x01\n\x0cProductMedia\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x12\n\nproduct_id\x18\x02 \x01(\x05\x12\x13\n\x0bstorage_key\x18\x03 \x01(\t\x12\x15\n\rthumbnail_key\x18\x04 \x01(\t\x12\x14\n\x0c\x63ontent_type\x18\x05 \x01(\t\x12\x0c\n\x04size\x18\x06 \x01(\x03\x12\r\n\x05width\x18\x07 \x01(\x05\x12\x0e\n\x06height\x18\x08 \x01(\x05\x12\x10\n\x08position\x18\t \x01(\x05\x12\x12\n\nis_primary\x18\n \x01(\x08\x12\x10\n\x08\x61lt_text\x18\x0b \x01(\t\x12\x12\n\ncreated_at\x18\x0c \x01(\t\"\xc1\x01\n\x16\x41\x64\x64ProductMediaRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x13\n\x0bstorage_key\x18\x02 \x01(\t\x12\x15\n\rthumbnail_key\x18\x03 \x01(\t\x12\x14\n\x0c\x63ontent_type\x18\x04 \x01(\t\x12\x0c\n\x04size\x18\x05 \x01(\x03\x12\r\n\x05width\x18\x06 \x01(\x05\x12\x0e\n\x06height\x18\x07 \x01(\x05\x12\x10\n\x08\x61lt_text\x18\x08 \x01(\t\x12\x12\n\nis_primary\x18\t \x01(\x08\"\x91\x01\n\x19UpdateProductMediaRequest\x12\x10\n\x08media_id\x18\x01 \x01(\x05\x12\x14\n\x0cset_position\x18\x02 \x01(\x08\x12\x10\n\x08position\x18\x03 \x01(\x05\x12\x12\n\nis_primary\x18\x04 \x01(\x08\x12\x14\n\x0cset_alt_text\x18\x05 \x01(\x08\x12\x10\n\x08\x61lt_text\x18\x06 \x01(\t\"-\n\x19\x44\x65leteProductMediaRequest\x12\x10\n\x08media_id\x18\x01 \x01(\x05\"^\n\x14ProductMediaResponse\x12$\n\x05media\x18\x01 \x01(\x0b\x32\x15.product.ProductMedia\x12\x0f\n\x07success\x18\x02 \x01(\x08\x12\x0f\n\x07message\x18\x03 \x01(\t\"-\n\x17ListProductMediaRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\"@\n\x18ListProductMediaResponse\x12$\n\x05media\x18\x01 \x03(\x0b\x32\x15.product.ProductMedia\"\xc9\x01\n\x06Review\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x12\n\nproduct_id\x18\x02 \x01(\x05\x12\x0f\n\x07user_id\x18\x03 \x01(\x05\x12\x0e\n\x06rating\x18\x04 \x01(\x05\x12\r\n\x05title\x18\x05 \x01(\t\x12\x0c\n\x04\x62ody\x18\x06 \x01(\t\x12\x0e\n\x06status\x18\x07 \x01(\t\x12\x17\n\x0fmoderation_note\x18\x08 \x01(\t\x12\x10\n\x08order_id\x18\t \x01(\t\x12\x12\n\ncreated_at\x18\n \x01(\t\x12\x12\n\nupdated_at\x18\x0b \x01(\t\"y\n\x13\x43reateReviewRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x0f\n\x07user_id\x18\x02 \x01(\x05\x12\x0e\n\x06rating\x18\x03 \x01(\x05\x12\r\n\x05title\x18\x04 \x01(\t\x12\x0c\n\x04\x62ody\x18\x05 \x01(\t\x12\x10\n\x08order_id\x18\x06 \x01(\t\"%\n\x10GetReviewRequest\x12\x11\n\treview_id\x18\x01 \x01(\x05\"y\n\x13UpdateReviewRequest\x12\x11\n\treview_id\x18\x01 \x01(\x05\x12\x0f\n\x07user_id\x18\x02 \x01(\x05\x12\x0e\n\x06rating\x18\x03 \x01(\x05\x12\r\n\x05title\x18\x04 \x01(\t\x12\x0c\n\x04\x62ody\x18\x05 \x01(\t\x12\x11\n\tmoderator\x18\x06 \x01(\x08\"S\n\x0eReviewResponse\x12\x1f\n\x06review\x18\x01 \x01(\x0b\x32\x0f.product.Review\x12\x0f\n\x07success\x18\x02 \x01(\x08\x12\x0f\n\x07message\x18\x03 \x01(\t\"L\n\x13\x44\x65leteReviewRequest\x12\x11\n\treview_id\x18\x01 \x01(\x05\x12\x0f\n\x07user_id\x18\x02 \x01(\x05\x12\x11\n\tmoderator\x18\x03 \x01(\x08\"8\n\x14\x44\x65leteReviewResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"t\n\x12ListReviewsRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x0c\n\x04page\x18\x02 \x01(\x05\x12\r\n\x05limit\x18\x03 \x01(\x05\x12\x0c\n\x04sort\x18\x04 \x01(\t\x12\x0e\n\x06status\x18\x05 \x01(\t\x12\x0f\n\x07user_id\x18\x06 \x01(\x05\"c\n\x13ListReviewsResponse\x12 \n\x07reviews\x18\x01 \x03(\x0b\x32\x0f.product.Review\x12\r\n\x05total\x18\x02 \x01(\x05\x12\x0c\n\x04page\x18\x03 \x01(\x05\x12\r\n\x05limit\x18\x04 \x01(\x05\"H\n\x15ModerateReviewRequest\x12\x11\n\treview_id\x18\x01 \x01(\x05\x12\x0e\n\x06status\x18\x02 \x01(\t\x12\x0c\n\x04note\x18\x03 \x01(\t\"-\n\x17GetReviewSummaryRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\"\xbd\x01\n\rReviewSummary\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x16\n\x0e\x61verage_rating\x18\x02 \x01(\x01\x12\x14\n\x0creview_count\x18\x03 \x01(\x05\x12\x38\n\thistogram\x18\x04 \x03(\x0b\x32%.product.ReviewSummary.HistogramEntry\x1a\x30\n\x0eHistogramEntry\x12\x0b\n\x03key\x18\x01 \x01(\x05\x12\r\n\x05value\x18\x02 \x01(\x05:\x02\x38\x01\"%\n\x12UserReviewsRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\x05\"$\n\x13UserReviewsResponse\x12\r\n\x05\x63ount\x18\x01 \x01(\x05\"\xd7\x01\n\x0bPriceChange\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x12\n\nproduct_id\x18\x02 \x01(\x05\x12\x12\n\nvariant_id\x18\x03 \x01(\x05\x12\x0f\n\x07version\x18\x04 \x01(\x05\x12\x11\n\thas_price\x18\x05 \x01(\x08\x12\r\n\x05price\x18\x06 \x01(\x01\x12\x16\n\x0eprevious_price\x18\x07 \x01(\x01\x12\x10\n\x08\x63urrency\x18\x08 \x01(\t\x12\x0e\n\x06reason\x18\t \x01(\t\x12\x13\n\x0bschedule_id\x18\n \x01(\x05\x12\x12\n\nchanged_at\x18\x0b \x01(\t\"J\n\x17ListPriceHistoryRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x0c\n\x04page\x18\x02 \x01(\x05\x12\r\n\x05limit\x18\x03 \x01(\x05\"m\n\x18ListPriceHistoryResponse\x12%\n\x07\x63hanges\x18\x01 \x03(\x0b\x32\x14.product.PriceChange\x12\r\n\x05total\x18\x02 \x01(\x05\x12\x0c\n\x04page\x18\x03 \x01(\x05\x12\r\n\x05limit\x18\x04 \x01(\x05\"\xbe\x01\n\rPriceSchedule\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x12\n\nproduct_id\x18\x02 \x01(\x05\x12\r\n\x05price\x18\x03 \x01(\x01\x12\x10\n\x08\x63urrency\x18\x04 \x01(\t\x12\x11\n\tstarts_at\x18\x05 \x01(\t\x12\x0f\n\x07\x65nds_at\x18\x06 \x01(\t\x12\x0e\n\x06status\x18\x07 \x01(\t\x12\x16\n\x0eprevious_price\x18\x08 \x01(\x01\x12\x0c\n\x04note\x18\t \x01(\t\x12\x12\n\ncreated_at\x18\n \x01(\t\"q\n\x1a\x43reatePriceScheduleRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\r\n\x05price\x18\x02 \x01(\x01\x12\x11\n\tstarts_at\x18\x03 \x01(\t\x12\x0f\n\x07\x65nds_at\x18\x04 \x01(\t\x12\x0c\n\x04note\x18\x05 \x01(\t\"c\n\x15PriceScheduleResponse\x12(\n\x08schedule\x18\x01 \x01(\x0b\x32\x16.product.PriceSchedule\x12\x0f\n\x07success\x18\x02 \x01(\x08\x12\x0f\n\x07message\x18\x03 \x01(\t\"I\n\x19ListPriceSchedulesRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\x12\x18\n\x10include_finished\x18\x02 \x01(\x08\"G\n\x1aListPriceSchedulesResponse\x12)\n\tschedules\x18\x01 \x03(\x0b\x32\x16.product.PriceSchedule\"1\n\x1a\x43\x61ncelPriceScheduleRequest\x12\x13\n\x0bschedule_id\x18\x01 \x01(\x05\",\n\x1d\x41pplyDuePriceSchedulesRequest\x12\x0b\n\x03now\x18\x01 \x01(\t\"p\n\x1e\x41pplyDuePriceSchedulesResponse\x12\'\n\x07started\x18\x01 \x03(\x0b\x32\x16.product.PriceSchedule\x12%\n\x05\x65nded\x18\x02 \x03(\x0b\x32\x16.product.PriceSchedule\"+\n\x15RestoreProductRequest\x12\x12\n\nproduct_id\x18\x01 \x01(\x05\"]\n\x16RestoreProductResponse\x12!\n\x07product\x18\x01 \x01(\x0b\x32\x10.product.Product\x12\x0f\n\x07success\x18\x02 \x01(\x08\x12\x0f\n\x07message\x18\x03 \x01(\t\":\n\x13UserProductsRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\x05\x12\x12\n\ndeleted_at\x18\x02 \x01(\t\"C\n\x1bReassignUserProductsRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\x05\x12\x13\n\x0bnew_user_id\x18\x02 \x01(\x05\"%\n\x14UserProductsResponse\x12\r\n\x05\x63ount\x18\x01 \x01(\x05\"5\n\x1bPurgeDeletedProductsRequest\x12\x16\n\x0e\x64\x65leted_before\x18\x01 \x01(\t\"I\n\x1cPurgeDeletedProductsResponse\x12\x13\n\x0bproduct_ids\x18\x01 \x03(\x05\x12\x14\n\x0cstorage_keys\x18\x02 \x03(\t2\xc7\x19\n\x0eProductService\x12N\n\rCreateProduct\x12\x1d.product.CreateProductRequest\x1a\x1e.product.CreateProductResponse\x12\x45\n\nGetProduct\x12\x1a.product.GetProductRequest\x1a\x1b.product.GetProductResponse\x12N\n\rUpdateProduct\x12\x1d.product.UpdateProductRequest\x1a\x1e.product.UpdateProductResponse\x12N\n\rDeleteProduct\x12\x1d.product.DeleteProductRequest\x1a\x1e.product.DeleteProductResponse\x12K\n\x0cListProducts\x12\x1c.product.ListProductsRequest\x1a\x1d.product.ListProductsResponse\x12Z\n\x11GetProductsByUser\x12!.product.GetProductsByUserRequest\x1a\".product.GetProductsByUserResponse\x12H\n\rCreateVariant\x12\x1d.product.CreateVariantRequest\x1a\x18.product.VariantResponse\x12\x42\n\nGetVariant\x12\x1a.product.GetVariantRequest\x1a\x18.product.VariantResponse\x12L\n\x0fGetVariantBySku\x12\x1f.product.GetVariantBySkuRequest\x1a\x18.product.VariantResponse\x12H\n\rUpdateVariant\x12\x1d.product.UpdateVariantRequest\x1a\x18.product.VariantResponse\x12N\n\rDeleteVariant\x12\x1d.product.DeleteVariantRequest\x1a\x1e.product.DeleteVariantResponse\x12K\n\x0cListVariants\x12\x1c.product.ListVariantsRequest\x1a\x1d.product.ListVariantsResponse\x12K\n\x0e\x43reateCategory\x12\x1e.product.CreateCategoryRequest\x1a\x19.product.CategoryResponse\x12\x45\n\x0bGetCategory\x12\x1b.product.GetCategoryRequest\x1a\x19.product.CategoryResponse\x12K\n\x0eUpdateCategory\x12\x1e.product.UpdateCategoryRequest\x1a\x19.product.CategoryResponse\x12G\n\x0cMoveCategory\x12\x1c.product.MoveCategoryRequest\x1a\x19.product.CategoryResponse\x12Q\n\x0e\x44\x65leteCategory\x12\x1e.product.DeleteCategoryRequest\x1a\x1f.product.DeleteCategoryResponse\x12Q\n\x0eListCategories\x12\x1e.product.ListCategoriesRequest\x1a\x1f.product.ListCategoriesResponse\x12Q\n\x0f\x41\x64\x64ProductMedia\x12\x1f.product.AddProductMediaRequest\x1a\x1d.product.ProductMediaResponse\x12W\n\x12UpdateProductMedia\x12\".product.UpdateProductMediaRequest\x1a\x1d.product.ProductMediaResponse\x12W\n\x12\x44\x65leteProductMedia\x12\".product.DeleteProductMediaRequest\x1a\x1d.product.ProductMediaResponse\x12W\n\x10ListProductMedia\x12 .product.ListProductMediaRequest\x1a!.product.ListProductMediaResponse\x12\x45\n\x0c\x43reateReview\x12\x1c.product.CreateReviewRequest\x1a\x17.product.ReviewResponse\x12?\n\tGetReview\x12\x19.product.GetReviewRequest\x1a\x17.product.ReviewResponse\x12\x45\n\x0cUpdateReview\x12\x1c.product.UpdateReviewRequest\x1a\x17.product.ReviewResponse\x12K\n\x0c\x44\x65leteReview\x12\x1c.product.DeleteReviewRequest\x1a\x1d.product.DeleteReviewResponse\x12H\n\x0bListReviews\x12\x1b.product.ListReviewsRequest\x1a\x1c.product.ListReviewsResponse\x12I\n\x0eModerateReview\x12\x1e.product.ModerateReviewRequest\x1a\x17.product.ReviewResponse\x12L\n\x10GetReviewSummary\x12 .product.GetReviewSummaryRequest\x1a\x16.product.ReviewSummary\x12N\n\x11\x44\x65leteUserReviews\x12\x1b.product.UserReviewsRequest\x1a\x1c.product.UserReviewsResponse\x12W\n\x10ListPriceHistory\x12 .product.ListPriceHistoryRequest\x1a!.product.ListPriceHistoryResponse\x12Z\n\x13\x43reatePriceSchedule\x12#.product.CreatePriceScheduleRequest\x1a\x1e.product.PriceScheduleResponse\x12]\n\x12ListPriceSchedules\x12\".product.ListPriceSchedulesRequest\x1a#.product.ListPriceSchedulesResponse\x12Z\n\x13\x43\x61ncelPriceSchedule\x12#.product.CancelPriceScheduleRequest\x1a\x1e.product.PriceScheduleResponse\x12i\n\x16\x41pplyDuePriceSchedules\x12&.product.ApplyDuePriceSchedulesRequest\x1a\'.product.ApplyDuePriceSchedulesResponse\x12Q\n\x0eRestoreProduct\x12\x1e.product.RestoreProductRequest\x1a\x1f.product.RestoreProductResponse\x12Q\n\x12\x44\x65leteUserProducts\x12\x1c.product.UserProductsRequest\x1a\x1d.product.UserProductsResponse\x12R\n\x13RestoreUserProducts\x12\x1c.product.UserProductsRequest\x1a\x1d.product.UserProductsResponse\x12[\n\x14ReassignUserProducts\x12$.product.ReassignUserProductsRequest\x1a\x1d.product.UserProductsResponse\x12\x63\n\x14PurgeDeletedProducts\x12$.product.PurgeDeletedProductsRequest\x1a%.product.PurgeDeletedProductsResponseB\x13Z\x11\x61pi-gateway/protob\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
 _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'product_pb2', _globals)
 if _descriptor._USE_C_DESCRIPTORS == False:
-  DESCRIPTOR._options = None
-  _globals['_PRODUCT']._serialized_start=26
-  _globals['_PRODUCT']._serialized_end=134
-  _globals['_CREATEPRODUCTREQUEST']._serialized_start=136
-  _globals['_CREATEPRODUCTREQUEST']._serialized_end=225
-  _globals['_CREATEPRODUCTRESPONSE']._serialized_start=227
-  _globals['_CREATEPRODUCTRESPONSE']._serialized_end=319
-  _globals['_GETPRODUCTREQUEST']._serialized_start=321
-  _globals['_GETPRODUCTREQUEST']._serialized_end=360
-  _globals['_GETPRODUCTRESPONSE']._serialized_start=362
-  _globals['_GETPRODUCTRESPONSE']._serialized_end=432
-  _globals['_UPDATEPRODUCTREQUEST']._serialized_start=434
-  _globals['_UPDATEPRODUCTREQUEST']._serialized_end=526
-  _globals['_UPDATEPRODUCTRESPONSE']._serialized_start=528
-  _globals['_UPDATEPRODUCTRESPONSE']._serialized_end=620
-  _globals['_DELETEPRODUCTREQUEST']._serialized_start=622
-  _globals['_DELETEPRODUCTREQUEST']._serialized_end=664
-  _globals['_DELETEPRODUCTRESPONSE']._serialized_start=666
-  _globals['_DELETEPRODUCTRESPONSE']._serialized_end=723
-  _globals['_LISTPRODUCTSREQUEST']._serialized_start=725
-  _globals['_LISTPRODUCTSREQUEST']._serialized_end=775
-  _globals['_LISTPRODUCTSRESPONSE']._serialized_start=777
-  _globals['_LISTPRODUCTSRESPONSE']._serialized_end=879
-  _globals['_GETPRODUCTSBYUSERREQUEST']._serialized_start=881
-  _globals['_GETPRODUCTSBYUSERREQUEST']._serialized_end=924
-  _globals['_GETPRODUCTSBYUSERRESPONSE']._serialized_start=926
-  _globals['_GETPRODUCTSBYUSERRESPONSE']._serialized_end=1004
-  _globals['_PRODUCTSERVICE']._serialized_start=1007
-  _globals['_PRODUCTSERVICE']._serialized_end=1503
+  _globals['DESCRIPTOR']._options = None
+  _globals['DESCRIPTOR']._serialized_options = b'Z\021api-gateway/proto'
+  _globals['_VARIANT_OPTIONSENTRY']._options = None
+  _globals['_VARIANT_OPTIONSENTRY']._serialized_options = b'8\001'
+  _globals['_CREATEVARIANTREQUEST_OPTIONSENTRY']._options = None
+  _globals['_CREATEVARIANTREQUEST_OPTIONSENTRY']._serialized_options = b'8\001'
+  _globals['_UPDATEVARIANTREQUEST_OPTIONSENTRY']._options = None
+  _globals['_UPDATEVARIANTREQUEST_OPTIONSENTRY']._serialized_options = b'8\001'
+  _globals['_REVIEWSUMMARY_HISTOGRAMENTRY']._options = None
+  _globals['_REVIEWSUMMARY_HISTOGRAMENTRY']._serialized_options = b'8\001'
+  _globals['_PRODUCT']._serialized_start=27
+  _globals['_PRODUCT']._serialized_end=408
+  _globals['_PRODUCTOPTION']._serialized_start=410
+  _globals['_PRODUCTOPTION']._serialized_end=455
+  _globals['_VARIANT']._serialized_start=458
+  _globals['_VARIANT']._serialized_end=682
+  _globals['_VARIANT_OPTIONSENTRY']._serialized_start=636
+  _globals['_VARIANT_OPTIONSENTRY']._serialized_end=682
+  _globals['_CREATEPRODUCTREQUEST']._serialized_start=685
+  _globals['_CREATEPRODUCTREQUEST']._serialized_end=930
+  _globals['_CREATEPRODUCTRESPONSE']._serialized_start=932
+  _globals['_CREATEPRODUCTRESPONSE']._serialized_end=1024
+  _globals['_GETPRODUCTREQUEST']._serialized_start=1026
+  _globals['_GETPRODUCTREQUEST']._serialized_end=1090
+  _globals['_GETPRODUCTRESPONSE']._serialized_start=1092
+  _globals['_GETPRODUCTRESPONSE']._serialized_end=1162
+  _globals['_UPDATEPRODUCTREQUEST']._serialized_start=1165
+  _globals['_UPDATEPRODUCTREQUEST']._serialized_end=1458
+  _globals['_UPDATEPRODUCTRESPONSE']._serialized_start=1460
+  _globals['_UPDATEPRODUCTRESPONSE']._serialized_end=1552
+  _globals['_DELETEPRODUCTREQUEST']._serialized_start=1554
+  _globals['_DELETEPRODUCTREQUEST']._serialized_end=1596
+  _globals['_DELETEPRODUCTRESPONSE']._serialized_start=1598
+  _globals['_DELETEPRODUCTRESPONSE']._serialized_end=1655
+  _globals['_LISTPRODUCTSREQUEST']._serialized_start=1657
+  _globals['_LISTPRODUCTSREQUEST']._serialized_end=1782
+  _globals['_LISTPRODUCTSRESPONSE']._serialized_start=1784
+  _globals['_LISTPRODUCTSRESPONSE']._serialized_end=1886
+  _globals['_GETPRODUCTSBYUSERREQUEST']._serialized_start=1888
+  _globals['_GETPRODUCTSBYUSERREQUEST']._serialized_end=1956
+  _globals['_GETPRODUCTSBYUSERRESPONSE']._serialized_start=1958
+  _globals['_GETPRODUCTSBYUSERRESPONSE']._serialized_end=2036
+  _globals['_CREATEVARIANTREQUEST']._serialized_start=2039
+  _globals['_CREATEVARIANTREQUEST']._serialized_end=2237
+  _globals['_CREATEVARIANTREQUEST_OPTIONSENTRY']._serialized_start=2191
+  _globals['_CREATEVARIANTREQUEST_OPTIONSENTRY']._serialized_end=2237
+  _globals['_GETVARIANTREQUEST']._serialized_start=2239
+  _globals['_GETVARIANTREQUEST']._serialized_end=2278
+  _globals['_GETVARIANTBYSKUREQUEST']._serialized_start=2280
+  _globals['_GETVARIANTBYSKUREQUEST']._serialized_end=2317
+  _globals['_UPDATEVARIANTREQUEST']._serialized_start=2320
+  _globals['_UPDATEVARIANTREQUEST']._serialized_end=2537
+  _globals['_UPDATEVARIANTREQUEST_OPTIONSENTRY']._serialized_start=2491
+  _globals['_UPDATEVARIANTREQUEST_OPTIONSENTRY']._serialized_end=2537
+  _globals['_VARIANTRESPONSE']._serialized_start=2539
+  _globals['_VARIANTRESPONSE']._serialized_end=2625
+  _globals['_DELETEVARIANTREQUEST']._serialized_start=2627
+  _globals['_DELETEVARIANTREQUEST']._serialized_end=2669
+  _globals['_DELETEVARIANTRESPONSE']._serialized_start=2671
+  _globals['_DELETEVARIANTRESPONSE']._serialized_end=2728
+  _globals['_LISTVARIANTSREQUEST']._serialized_start=2730
+  _globals['_LISTVARIANTSREQUEST']._serialized_end=2771
+  _globals['_LISTVARIANTSRESPONSE']._serialized_start=2773
+  _globals['_LISTVARIANTSRESPONSE']._serialized_end=2831
+  _globals['_CATEGORY']._serialized_start=2834
+  _globals['_CATEGORY']._serialized_end=2964
+  _globals['_CREATECATEGORYREQUEST']._serialized_start=2966
+  _globals['_CREATECATEGORYREQUEST']._serialized_end=3057
+  _globals['_GETCATEGORYREQUEST']._serialized_start=3059
+  _globals['_GETCATEGORYREQUEST']._serialized_end=3100
+  _globals['_UPDATECATEGORYREQUEST']._serialized_start=3102
+  _globals['_UPDATECATEGORYREQUEST']._serialized_end=3195
+  _globals['_MOVECATEGORYREQUEST']._serialized_start=3197
+  _globals['_MOVECATEGORYREQUEST']._serialized_end=3258
+  _globals['_CATEGORYRESPONSE']._serialized_start=3261
+  _globals['_CATEGORYRESPONSE']._serialized_end=3427
+  _globals['_DELETECATEGORYREQUEST']._serialized_start=3429
+  _globals['_DELETECATEGORYREQUEST']._serialized_end=3473
+  _globals['_DELETECATEGORYRESPONSE']._serialized_start=3475
+  _globals['_DELETECATEGORYRESPONSE']._serialized_end=3533
+  _globals['_LISTCATEGORIESREQUEST']._serialized_start=3535
+  _globals['_LISTCATEGORIESREQUEST']._serialized_end=3558
+  _globals['_LISTCATEGORIESRESPONSE']._serialized_start=3560
+  _globals['_LISTCATEGORIESRESPONSE']._serialized_end=3623
+  _globals['_PRODUCTMEDIA']._serialized_start=3626
+  _globals['_PRODUCTMEDIA']._serialized_end=3859
+  _globals['_ADDPRODUCTMEDIAREQUEST']._serialized_start=3862
+  _globals['_ADDPRODUCTMEDIAREQUEST']._serialized_end=4055
+  _globals['_UPDATEPRODUCTMEDIAREQUEST']._serialized_start=4058
+  _globals['_UPDATEPRODUCTMEDIAREQUEST']._serialized_end=4203
+  _globals['_DELETEPRODUCTMEDIAREQUEST']._serialized_start=4205
+  _globals['_DELETEPRODUCTMEDIAREQUEST']._serialized_end=4250
+  _globals['_PRODUCTMEDIARESPONSE']._serialized_start=4252
+  _globals['_PRODUCTMEDIARESPONSE']._serialized_end=4346
+  _globals['_LISTPRODUCTMEDIAREQUEST']._serialized_start=4348
+  _globals['_LISTPRODUCTMEDIAREQUEST']._serialized_end=4393
+  _globals['_LISTPRODUCTMEDIARESPONSE']._serialized_start=4395
+  _globals['_LISTPRODUCTMEDIARESPONSE']._serialized_end=4459
+  _globals['_REVIEW']._serialized_start=4462
+  _globals['_REVIEW']._serialized_end=4663
+  _globals['_CREATEREVIEWREQUEST']._serialized_start=4665
+  _globals['_CREATEREVIEWREQUEST']._serialized_end=4786
+  _globals['_GETREVIEWREQUEST']._serialized_start=4788
+  _globals['_GETREVIEWREQUEST']._serialized_end=4825
+  _globals['_UPDATEREVIEWREQUEST']._serialized_start=4827
+  _globals['_UPDATEREVIEWREQUEST']._serialized_end=4948
+  _globals['_REVIEWRESPONSE']._serialized_start=4950
+  _globals['_REVIEWRESPONSE']._serialized_end=5033
+  _globals['_DELETEREVIEWREQUEST']._serialized_start=5035
+  _globals['_DELETEREVIEWREQUEST']._serialized_end=5111
+  _globals['_DELETEREVIEWRESPONSE']._serialized_start=5113
+  _globals['_DELETEREVIEWRESPONSE']._serialized_end=5169
+  _globals['_LISTREVIEWSREQUEST']._serialized_start=5171
+  _globals['_LISTREVIEWSREQUEST']._serialized_end=5287
+  _globals['_LISTREVIEWSRESPONSE']._serialized_start=5289
+  _globals['_LISTREVIEWSRESPONSE']._serialized_end=5388
+  _globals['_MODERATEREVIEWREQUEST']._serialized_start=5390
+  _globals['_MODERATEREVIEWREQUEST']._serialized_end=5462
+  _globals['_GETREVIEWSUMMARYREQUEST']._serialized_start=5464
+  _globals['_GETREVIEWSUMMARYREQUEST']._serialized_end=5509
+  _globals['_REVIEWSUMMARY']._serialized_start=5512
+  _globals['_REVIEWSUMMARY']._serialized_end=5701
+  _globals['_REVIEWSUMMARY_HISTOGRAMENTRY']._serialized_start=5653
+  _globals['_REVIEWSUMMARY_HISTOGRAMENTRY']._serialized_end=5701
+  _globals['_USERREVIEWSREQUEST']._serialized_start=5703
+  _globals['_USERREVIEWSREQUEST']._serialized_end=5740
+  _globals['_USERREVIEWSRESPONSE']._serialized_start=5742
+  _globals['_USERREVIEWSRESPONSE']._serialized_end=5778
+  _globals['_PRICECHANGE']._serialized_start=5781
+  _globals['_PRICECHANGE']._serialized_end=5996
+  _globals['_LISTPRICEHISTORYREQUEST']._serialized_start=5998
+  _globals['_LISTPRICEHISTORYREQUEST']._serialized_end=6072
+  _globals['_LISTPRICEHISTORYRESPONSE']._serialized_start=6074
+  _globals['_LISTPRICEHISTORYRESPONSE']._serialized_end=6183
+  _globals['_PRICESCHEDULE']._serialized_start=6186
+  _globals['_PRICESCHEDULE']._serialized_end=6376
+  _globals['_CREATEPRICESCHEDULEREQUEST']._serialized_start=6378
+  _globals['_CREATEPRICESCHEDULEREQUEST']._serialized_end=6491
+  _globals['_PRICESCHEDULERESPONSE']._serialized_start=6493
+  _globals['_PRICESCHEDULERESPONSE']._serialized_end=6592
+  _globals['_LISTPRICESCHEDULESREQUEST']._serialized_start=6594
+  _globals['_LISTPRICESCHEDULESREQUEST']._serialized_end=6667
+  _globals['_LISTPRICESCHEDULESRESPONSE']._serialized_start=6669
+  _globals['_LISTPRICESCHEDULESRESPONSE']._serialized_end=6740
+  _globals['_CANCELPRICESCHEDULEREQUEST']._serialized_start=6742
+  _globals['_CANCELPRICESCHEDULEREQUEST']._serialized_end=6791
+  _globals['_APPLYDUEPRICESCHEDULESREQUEST']._serialized_start=6793
+  _globals['_APPLYDUEPRICESCHEDULESREQUEST']._serialized_end=6837
+  _globals['_APPLYDUEPRICESCHEDULESRESPONSE']._serialized_start=6839
+  _globals['_APPLYDUEPRICESCHEDULESRESPONSE']._serialized_end=6951
+  _globals['_RESTOREPRODUCTREQUEST']._serialized_start=6953
+  _globals['_RESTOREPRODUCTREQUEST']._serialized_end=6996
+  _globals['_RESTOREPRODUCTRESPONSE']._serialized_start=6998
+  _globals['_RESTOREPRODUCTRESPONSE']._serialized_end=7091
+  _globals['_USERPRODUCTSREQUEST']._serialized_start=7093
+  _globals['_USERPRODUCTSREQUEST']._serialized_end=7151
+  _globals['_REASSIGNUSERPRODUCTSREQUEST']._serialized_start=7153
+  _globals['_REASSIGNUSERPRODUCTSREQUEST']._serialized_end=7220
+  _globals['_USERPRODUCTSRESPONSE']._serialized_start=7222
+  _globals['_USERPRODUCTSRESPONSE']._serialized_end=7259
+  _globals['_PURGEDELETEDPRODUCTSREQUEST']._serialized_start=7261
+  _globals['_PURGEDELETEDPRODUCTSREQUEST']._serialized_end=7314
+  _globals['_PURGEDELETEDPRODUCTSRESPONSE']._serialized_start=7316
+  _globals['_PURGEDELETEDPRODUCTSRESPONSE']._serialized_end=7389
+  _globals['_PRODUCTSERVICE']._serialized_start=7392
+  _globals['_PRODUCTSERVICE']._serialized_end=10663
 # @@protoc_insertion_point(module_scope)
@@ -44,6 +44,176 @@ class ProductServiceStub(object):
                 request_serializer=product__pb2.GetProductsByUserRequest.SerializeToString,
                 response_deserializer=product__pb2.GetProductsByUserResponse.FromString,
                 )
+        self.CreateVariant = channel.unary_unary(
+                '/product.ProductService/CreateVariant',
+                request_serializer=product__pb2.CreateVariantRequest.SerializeToString,
+                response_deserializer=product__pb2.VariantResponse.FromString,
+                )
+        self.GetVariant = channel.unary_unary(
+                '/product.ProductService/GetVariant',
+                request_serializer=product__pb2.GetVariantRequest.SerializeToString,
+                response_deserializer=product__pb2.VariantResponse.FromString,
+                )
+        self.GetVariantBySku = channel.unary_unary(
+                '/product.ProductService/GetVariantBySku',
+                request_serializer=product__pb2.GetVariantBySkuRequest.SerializeToString,
+                response_deserializer=product__pb2.VariantResponse.FromString,
+                )
+        self.UpdateVariant = channel.unary_unary(
+                '/product.ProductService/UpdateVariant',
+                request_serializer=product__pb2.UpdateVariantRequest.SerializeToString,
+                response_deserializer=product__pb2.VariantResponse.FromString,
+                )
+        self.DeleteVariant = channel.unary_unary(
+                '/product.ProductService/DeleteVariant',
+                request_serializer=product__pb2.DeleteVariantRequest.SerializeToString,
+                response_deserializer=product__pb2.DeleteVariantResponse.FromString,
+                )
+        self.ListVariants = channel.unary_unary(
+                '/product.ProductService/ListVariants',
+                request_serializer=product__pb2.ListVariantsRequest.SerializeToString,
+                response_deserializer=product__pb2.ListVariantsResponse.FromString,
+                )
+        self.CreateCategory = channel.unary_unary(
+                '/product.ProductService/CreateCategory',
+                request_serializer=product__pb2.CreateCategoryRequest.SerializeToString,
+                response_deserializer=product__pb2.CategoryResponse.FromString,
+                )
+        self.GetCategory = channel.unary_unary(
+                '/product.ProductService/GetCategory',
+                request_serializer=product__pb2.GetCategoryRequest.SerializeToString,
+                response_deserializer=product__pb2.CategoryResponse.FromString,
+                )
+        self.UpdateCategory = channel.unary_unary(
+                '/product.ProductService/UpdateCategory',
+                request_serializer=product__pb2.UpdateCategoryRequest.SerializeToString,
+                response_deserializer=product__pb2.CategoryResponse.FromString,
+                )
+        self.MoveCategory = channel.unary_unary(
+                '/product.ProductService/MoveCategory',
+                request_serializer=product__pb2.MoveCategoryRequest.SerializeToString,
+                response_deserializer=product__pb2.CategoryResponse.FromString,
+                )
+        self.DeleteCategory = channel.unary_unary(
+                '/product.ProductService/DeleteCategory',
+                request_serializer=product__pb2.DeleteCategoryRequest.SerializeToString,
+                response_deserializer=product__pb2.DeleteCategoryResponse.FromString,
+                )
+        self.ListCategories = channel.unary_unary(
+                '/product.ProductService/ListCategories',
+                request_serializer=product__pb2.ListCategoriesRequest.SerializeToString,
+                response_deserializer=product__pb2.ListCategoriesResponse.FromString,
+                )
+        self.AddProductMedia = channel.unary_unary(
+                '/product.ProductService/AddProductMedia',
+                request_serializer=product__pb2.AddProductMediaRequest.SerializeToString,
+                response_deserializer=product__pb2.ProductMediaResponse.FromString,
+                )
+        self.UpdateProductMedia = channel.unary_unary(
+                '/product.ProductService/UpdateProductMedia',
+                request_serializer=product__pb2.UpdateProductMediaRequest.SerializeToString,
+                response_deserializer=product__pb2.ProductMediaResponse.FromString,
+                )
+        self.DeleteProductMedia = channel.unary_unary(
+                '/product.ProductService/DeleteProductMedia',
+                request_serializer=product__pb2.DeleteProductMediaRequest.SerializeToString,
+                response_deserializer=product__pb2.ProductMediaResponse.FromString,
+                )
+        self.ListProductMedia = channel.unary_unary(
+                '/product.ProductService/ListProductMedia',
+                request_serializer=product__pb2.ListProductMediaRequest.SerializeToString,
+                response_deserializer=product__pb2.ListProductMediaResponse.FromString,
+                )
+        self.CreateReview = channel.unary_unary(
+                '/product.ProductService/CreateReview',
+                request_serializer=product__pb2.CreateReviewRequest.SerializeToString,
+                response_deserializer=product__pb2.ReviewResponse.FromString,
+                )
+        self.GetReview = channel.unary_unary(
+                '/product.ProductService/GetReview',
+                request_serializer=product__pb2.GetReviewRequest.SerializeToString,
+                response_deserializer=product__pb2.ReviewResponse.FromString,
+                )
+        self.UpdateReview = channel.unary_unary(
+                '/product.ProductService/UpdateReview',
+                request_serializer=product__pb2.UpdateReviewRequest.SerializeToString,
+                response_deserializer=product__pb2.ReviewResponse.FromString,
+                )
+        self.DeleteReview = channel.unary_unary(
+                '/product.ProductService/DeleteReview',
+                request_serializer=product__pb2.DeleteReviewRequest.SerializeToString,
+                response_deserializer=product__pb2.DeleteReviewResponse.FromString,
+                )
+        self.ListReviews = channel.unary_unary(
+                '/product.ProductService/ListReviews',
+                request_serializer=product__pb2.ListReviewsRequest.SerializeToString,
+                response_deserializer=product__pb2.ListReviewsResponse.FromString,
+                )
+        self.ModerateReview = channel.unary_unary(
+                '/product.ProductService/ModerateReview',
+                request_serializer=product__pb2.ModerateReviewRequest.SerializeToString,
+                response_deserializer=product__pb2.ReviewResponse.FromString,
+                )
+        self.GetReviewSummary = channel.unary_unary(
+                '/product.ProductService/GetReviewSummary',
+                request_serializer=product__pb2.GetReviewSummaryRequest.SerializeToString,
+                response_deserializer=product__pb2.ReviewSummary.FromString,
+                )
+        self.DeleteUserReviews = channel.unary_unary(
+                '/product.ProductService/DeleteUserReviews',
+                request_serializer=product__pb2.UserReviewsRequest.SerializeToString,
+                response_deserializer=product__pb2.UserReviewsResponse.FromString,
+                )
+        self.ListPriceHistory = channel.unary_unary(
+                '/product.ProductService/ListPriceHistory',
+                request_serializer=product__pb2.ListPriceHistoryRequest.SerializeToString,
+                response_deserializer=product__pb2.ListPriceHistoryResponse.FromString,
+                )
+        self.CreatePriceSchedule = channel.unary_unary(
+                '/product.ProductService/CreatePriceSchedule',
+                request_serializer=product__pb2.CreatePriceScheduleRequest.SerializeToString,
+                response_deserializer=product__pb2.PriceScheduleResponse.FromString,
+                )
+        self.ListPriceSchedules = channel.unary_unary(
+                '/product.ProductService/ListPriceSchedules',
+                request_serializer=product__pb2.ListPriceSchedulesRequest.SerializeToString,
+                response_deserializer=product__pb2.ListPriceSchedulesResponse.FromString,
+                )
+        self.CancelPriceSchedule = channel.unary_unary(
+                '/product.ProductService/CancelPriceSchedule',
+                request_serializer=product__pb2.CancelPriceScheduleRequest.SerializeToString,
+                response_deserializer=product__pb2.PriceScheduleResponse.FromString,
+                )
+        self.ApplyDuePriceSchedules = channel.unary_unary(
+                '/product.ProductService/ApplyDuePriceSchedules',
+                request_serializer=product__pb2.ApplyDuePriceSchedulesRequest.SerializeToString,
+                response_deserializer=product__pb2.ApplyDuePriceSchedulesResponse.FromString,
+                )
+        self.RestoreProduct = channel.unary_unary(
+                '/product.ProductService/RestoreProduct',
+                request_serializer=product__pb2.RestoreProductRequest.SerializeToString,
+                response_deserializer=product__pb2.RestoreProductResponse.FromString,
+                )
+        self.DeleteUserProducts = channel.unary_unary(
+                '/product.ProductService/DeleteUserProducts',
+                request_serializer=product__pb2.UserProductsRequest.SerializeToString,
+                response_deserializer=product__pb2.UserProductsResponse.FromString,
+                )
+        self.RestoreUserProducts = channel.unary_unary(
+                '/product.ProductService/RestoreUserProducts',
+                request_serializer=product__pb2.UserProductsRequest.SerializeToString,
+                response_deserializer=product__pb2.UserProductsResponse.FromString,
+                )
+        self.ReassignUserProducts = channel.unary_unary(
+                '/product.ProductService/ReassignUserProducts',
+                request_serializer=product__pb2.ReassignUserProductsRequest.SerializeToString,
+                response_deserializer=product__pb2.UserProductsResponse.FromString,
+                )
+        self.PurgeDeletedProducts = channel.unary_unary(
+                '/product.ProductService/PurgeDeletedProducts',
+                request_serializer=product__pb2.PurgeDeletedProductsRequest.SerializeToString,
+                response_deserializer=product__pb2.PurgeDeletedProductsResponse.FromString,
+                )
 
 
 class ProductServiceServicer(object):
@@ -85,6 +255,210 @@ class ProductServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def CreateVariant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetVariant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetVariantBySku(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateVariant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteVariant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListVariants(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateCategory(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetCategory(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateCategory(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MoveCategory(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteCategory(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListCategories(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AddProductMedia(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateProductMedia(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteProductMedia(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListProductMedia(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateReview(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetReview(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateReview(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteReview(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListReviews(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ModerateReview(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetReviewSummary(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteUserReviews(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListPriceHistory(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreatePriceSchedule(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListPriceSchedules(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CancelPriceSchedule(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ApplyDuePriceSchedules(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RestoreProduct(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteUserProducts(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RestoreUserProducts(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReassignUserProducts(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def PurgeDeletedProducts(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_ProductServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -118,6 +492,176 @@ def add_ProductServiceServicer_to_server(servicer, server):
                     request_deserializer=product__pb2.GetProductsByUserRequest.FromString,
                     response_serializer=product__pb2.GetProductsByUserResponse.SerializeToString,
             ),
+            'CreateVariant': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateVariant,
+                    request_deserializer=product__pb2.CreateVariantRequest.FromString,
+                    response_serializer=product__pb2.VariantResponse.SerializeToString,
+            ),
+            'GetVariant': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetVariant,
+                    request_deserializer=product__pb2.GetVariantRequest.FromString,
+                    response_serializer=product__pb2.VariantResponse.SerializeToString,
+            ),
+            'GetVariantBySku': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetVariantBySku,
+                    request_deserializer=product__pb2.GetVariantBySkuRequest.FromString,
+                    response_serializer=product__pb2.VariantResponse.SerializeToString,
+            ),
+            'UpdateVariant': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateVariant,
+                    request_deserializer=product__pb2.UpdateVariantRequest.FromString,
+                    response_serializer=product__pb2.VariantResponse.SerializeToString,
+            ),
+            'DeleteVariant': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteVariant,
+                    request_deserializer=product__pb2.DeleteVariantRequest.FromString,
+                    response_serializer=product__pb2.DeleteVariantResponse.SerializeToString,
+            ),
+            'ListVariants': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListVariants,
+                    request_deserializer=product__pb2.ListVariantsRequest.FromString,
+                    response_serializer=product__pb2.ListVariantsResponse.SerializeToString,
+            ),
+            'CreateCategory': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateCategory,
+                    request_deserializer=product__pb2.CreateCategoryRequest.FromString,
+                    response_serializer=product__pb2.CategoryResponse.SerializeToString,
+            ),
+            'GetCategory': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetCategory,
+                    request_deserializer=product__pb2.GetCategoryRequest.FromString,
+                    response_serializer=product__pb2.CategoryResponse.SerializeToString,
+            ),
+            'UpdateCategory': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateCategory,
+                    request_deserializer=product__pb2.UpdateCategoryRequest.FromString,
+                    response_serializer=product__pb2.CategoryResponse.SerializeToString,
+            ),
+            'MoveCategory': grpc.unary_unary_rpc_method_handler(
+                    servicer.MoveCategory,
+                    request_deserializer=product__pb2.MoveCategoryRequest.FromString,
+                    response_serializer=product__pb2.CategoryResponse.SerializeToString,
+            ),
+            'DeleteCategory': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteCategory,
+                    request_deserializer=product__pb2.DeleteCategoryRequest.FromString,
+                    response_serializer=product__pb2.DeleteCategoryResponse.SerializeToString,
+            ),
+            'ListCategories': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListCategories,
+                    request_deserializer=product__pb2.ListCategoriesRequest.FromString,
+                    response_serializer=product__pb2.ListCategoriesResponse.SerializeToString,
+            ),
+            'AddProductMedia': grpc.unary_unary_rpc_method_handler(
+                    servicer.AddProductMedia,
+                    request_deserializer=product__pb2.AddProductMediaRequest.FromString,
+                    response_serializer=product__pb2.ProductMediaResponse.SerializeToString,
+            ),
+            'UpdateProductMedia': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateProductMedia,
+                    request_deserializer=product__pb2.UpdateProductMediaRequest.FromString,
+                    response_serializer=product__pb2.ProductMediaResponse.SerializeToString,
+            ),
+            'DeleteProductMedia': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteProductMedia,
+                    request_deserializer=product__pb2.DeleteProductMediaRequest.FromString,
+                    response_serializer=product__pb2.ProductMediaResponse.SerializeToString,
+            ),
+            'ListProductMedia': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListProductMedia,
+                    request_deserializer=product__pb2.ListProductMediaRequest.FromString,
+                    response_serializer=product__pb2.ListProductMediaResponse.SerializeToString,
+            ),
+            'CreateReview': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateReview,
+                    request_deserializer=product__pb2.CreateReviewRequest.FromString,
+                    response_serializer=product__pb2.ReviewResponse.SerializeToString,
+            ),
+            'GetReview': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetReview,
+                    request_deserializer=product__pb2.GetReviewRequest.FromString,
+                    response_serializer=product__pb2.ReviewResponse.SerializeToString,
+            ),
+            'UpdateReview': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateReview,
+                    request_deserializer=product__pb2.UpdateReviewRequest.FromString,
+                    response_serializer=product__pb2.ReviewResponse.SerializeToString,
+            ),
+            'DeleteReview': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteReview,
+                    request_deserializer=product__pb2.DeleteReviewRequest.FromString,
+                    response_serializer=product__pb2.DeleteReviewResponse.SerializeToString,
+            ),
+            'ListReviews': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListReviews,
+                    request_deserializer=product__pb2.ListReviewsRequest.FromString,
+                    response_serializer=product__pb2.ListReviewsResponse.SerializeToString,
+            ),
+            'ModerateReview': grpc.unary_unary_rpc_method_handler(
+                    servicer.ModerateReview,
+                    request_deserializer=product__pb2.ModerateReviewRequest.FromString,
+                    response_serializer=product__pb2.ReviewResponse.SerializeToString,
+            ),
+            'GetReviewSummary': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetReviewSummary,
+                    request_deserializer=product__pb2.GetReviewSummaryRequest.FromString,
+                    response_serializer=product__pb2.ReviewSummary.SerializeToString,
+            ),
+            'DeleteUserReviews': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteUserReviews,
+                    request_deserializer=product__pb2.UserReviewsRequest.FromString,
+                    response_serializer=product__pb2.UserReviewsResponse.SerializeToString,
+            ),
+            'ListPriceHistory': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListPriceHistory,
+                    request_deserializer=product__pb2.ListPriceHistoryRequest.FromString,
+                    response_serializer=product__pb2.ListPriceHistoryResponse.SerializeToString,
+            ),
+            'CreatePriceSchedule': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreatePriceSchedule,
+                    request_deserializer=product__pb2.CreatePriceScheduleRequest.FromString,
+                    response_serializer=product__pb2.PriceScheduleResponse.SerializeToString,
+            ),
+            'ListPriceSchedules': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListPriceSchedules,
+                    request_deserializer=product__pb2.ListPriceSchedulesRequest.FromString,
+                    response_serializer=product__pb2.ListPriceSchedulesResponse.SerializeToString,
+            ),
+            'CancelPriceSchedule': grpc.unary_unary_rpc_method_handler(
+                    servicer.CancelPriceSchedule,
+                    request_deserializer=product__pb2.CancelPriceScheduleRequest.FromString,
+                    response_serializer=product__pb2.PriceScheduleResponse.SerializeToString,
+            ),
+            'ApplyDuePriceSchedules': grpc.unary_unary_rpc_method_handler(
+                    servicer.ApplyDuePriceSchedules,
+                    request_deserializer=product__pb2.ApplyDuePriceSchedulesRequest.FromString,
+                    response_serializer=product__pb2.ApplyDuePriceSchedulesResponse.SerializeToString,
+            ),
+            'RestoreProduct': grpc.unary_unary_rpc_method_handler(
+                    servicer.RestoreProduct,
+                    request_deserializer=product__pb2.RestoreProductRequest.FromString,
+                    response_serializer=product__pb2.RestoreProductResponse.SerializeToString,
+            ),
+            'DeleteUserProducts': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteUserProducts,
+                    request_deserializer=product__pb2.UserProductsRequest.FromString,
+                    response_serializer=product__pb2.UserProductsResponse.SerializeToString,
+            ),
+            'RestoreUserProducts': grpc.unary_unary_rpc_method_handler(
+                    servicer.RestoreUserProducts,
+                    request_deserializer=product__pb2.UserProductsRequest.FromString,
+                    response_serializer=product__pb2.UserProductsResponse.SerializeToString,
+            ),
+            'ReassignUserProducts': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReassignUserProducts,
+                    request_deserializer=product__pb2.ReassignUserProductsRequest.FromString,
+                    response_serializer=product__pb2.UserProductsResponse.SerializeToString,
+            ),
+            'PurgeDeletedProducts': grpc.unary_unary_rpc_method_handler(
+                    servicer.PurgeDeletedProducts,
+                    request_deserializer=product__pb2.PurgeDeletedProductsRequest.FromString,
+                    response_serializer=product__pb2.PurgeDeletedProductsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'product.ProductService', rpc_method_handlers)
@@ -229,3 +773,581 @@ class ProductService(object):
             product__pb2.GetProductsByUserResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CreateVariant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/CreateVariant',
+            product__pb2.CreateVariantRequest.SerializeToString,
+            product__pb2.VariantResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetVariant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/GetVariant',
+            product__pb2.GetVariantRequest.SerializeToString,
+            product__pb2.VariantResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetVariantBySku(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/GetVariantBySku',
+            product__pb2.GetVariantBySkuRequest.SerializeToString,
+            product__pb2.VariantResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateVariant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/UpdateVariant',
+            product__pb2.UpdateVariantRequest.SerializeToString,
+            product__pb2.VariantResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteVariant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/DeleteVariant',
+            product__pb2.DeleteVariantRequest.SerializeToString,
+            product__pb2.DeleteVariantResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListVariants(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/ListVariants',
+            product__pb2.ListVariantsRequest.SerializeToString,
+            product__pb2.ListVariantsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CreateCategory(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/CreateCategory',
+            product__pb2.CreateCategoryRequest.SerializeToString,
+            product__pb2.CategoryResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetCategory(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/GetCategory',
+            product__pb2.GetCategoryRequest.SerializeToString,
+            product__pb2.CategoryResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateCategory(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/UpdateCategory',
+            product__pb2.UpdateCategoryRequest.SerializeToString,
+            product__pb2.CategoryResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def MoveCategory(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/MoveCategory',
+            product__pb2.MoveCategoryRequest.SerializeToString,
+            product__pb2.CategoryResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteCategory(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/DeleteCategory',
+            product__pb2.DeleteCategoryRequest.SerializeToString,
+            product__pb2.DeleteCategoryResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListCategories(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/ListCategories',
+            product__pb2.ListCategoriesRequest.SerializeToString,
+            product__pb2.ListCategoriesResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def AddProductMedia(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/AddProductMedia',
+            product__pb2.AddProductMediaRequest.SerializeToString,
+            product__pb2.ProductMediaResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateProductMedia(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/UpdateProductMedia',
+            product__pb2.UpdateProductMediaRequest.SerializeToString,
+            product__pb2.ProductMediaResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteProductMedia(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/DeleteProductMedia',
+            product__pb2.DeleteProductMediaRequest.SerializeToString,
+            product__pb2.ProductMediaResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListProductMedia(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/ListProductMedia',
+            product__pb2.ListProductMediaRequest.SerializeToString,
+            product__pb2.ListProductMediaResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CreateReview(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/CreateReview',
+            product__pb2.CreateReviewRequest.SerializeToString,
+            product__pb2.ReviewResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetReview(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/GetReview',
+            product__pb2.GetReviewRequest.SerializeToString,
+            product__pb2.ReviewResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateReview(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/UpdateReview',
+            product__pb2.UpdateReviewRequest.SerializeToString,
+            product__pb2.ReviewResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteReview(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/DeleteReview',
+            product__pb2.DeleteReviewRequest.SerializeToString,
+            product__pb2.DeleteReviewResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListReviews(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/ListReviews',
+            product__pb2.ListReviewsRequest.SerializeToString,
+            product__pb2.ListReviewsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ModerateReview(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/ModerateReview',
+            product__pb2.ModerateReviewRequest.SerializeToString,
+            product__pb2.ReviewResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetReviewSummary(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/GetReviewSummary',
+            product__pb2.GetReviewSummaryRequest.SerializeToString,
+            product__pb2.ReviewSummary.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteUserReviews(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/DeleteUserReviews',
+            product__pb2.UserReviewsRequest.SerializeToString,
+            product__pb2.UserReviewsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListPriceHistory(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/ListPriceHistory',
+            product__pb2.ListPriceHistoryRequest.SerializeToString,
+            product__pb2.ListPriceHistoryResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CreatePriceSchedule(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/CreatePriceSchedule',
+            product__pb2.CreatePriceScheduleRequest.SerializeToString,
+            product__pb2.PriceScheduleResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListPriceSchedules(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/ListPriceSchedules',
+            product__pb2.ListPriceSchedulesRequest.SerializeToString,
+            product__pb2.ListPriceSchedulesResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CancelPriceSchedule(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/CancelPriceSchedule',
+            product__pb2.CancelPriceScheduleRequest.SerializeToString,
+            product__pb2.PriceScheduleResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ApplyDuePriceSchedules(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/ApplyDuePriceSchedules',
+            product__pb2.ApplyDuePriceSchedulesRequest.SerializeToString,
+            product__pb2.ApplyDuePriceSchedulesResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def RestoreProduct(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/RestoreProduct',
+            product__pb2.RestoreProductRequest.SerializeToString,
+            product__pb2.RestoreProductResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteUserProducts(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/DeleteUserProducts',
+            product__pb2.UserProductsRequest.SerializeToString,
+            product__pb2.UserProductsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def RestoreUserProducts(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/RestoreUserProducts',
+            product__pb2.UserProductsRequest.SerializeToString,
+            product__pb2.UserProductsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ReassignUserProducts(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/ReassignUserProducts',
+            product__pb2.ReassignUserProductsRequest.SerializeToString,
+            product__pb2.UserProductsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def PurgeDeletedProducts(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/product.ProductService/PurgeDeletedProducts',
+            product__pb2.PurgeDeletedProductsRequest.SerializeToString,
+            product__pb2.PurgeDeletedProductsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -2,8 +2,15 @@ import grpc
 from concurrent import futures
 import product_pb2
 import product_pb2_grpc
-from models import Product, SessionLocal
-from datetime import datetime
+from models import Category, PriceChange, PriceSchedule, Product, ProductMedia, ProductVariant, Review, SessionLocal, product_categories
+from categories import category_response, category_to_pb, descendant_ids, slugify
+from media import ensure_primary, make_primary, media_to_pb, place, renumber
+from reviews import REVIEW_STATUSES, SORT_ORDERS, check_rating, review_summary, review_to_pb
+from prices import (end_schedule, format_time, overlaps, parse_time, price_change_to_pb,
+                    record_initial_price, record_price_change, schedule_to_pb, start_schedule, to_price)
+from tenancy import TenantInterceptor
+from datetime import date, datetime
+import json
 import logging
 import os
 from dotenv import load_dotenv
@@ -15,6 +22,115 @@ load_dotenv()
 logging.basicConfig(level=logging.INFO)
 logger = logging.getLogger(__name__)
 
+STOCK_POLICIES = ("none", "backorder", "preorder")
+
+def parse_stock_settings(request):
+    """Validated stock policy and expected restock date of a create or update
+    request; empty values mean not given. Raises ValueError when invalid."""
+    policy = request.stock_policy.strip().lower()
+    if policy and policy not in STOCK_POLICIES:
+        raise ValueError(f"Invalid stock policy {request.stock_policy}: use none, backorder or preorder")
+    
+    restock_date = None
+    if request.expected_restock_date:
+        try:
+            restock_date = date.fromisoformat(request.expected_restock_date)
+        except ValueError:
+            raise ValueError(f"Invalid expected restock date {request.expected_restock_date}: use YYYY-MM-DD")
+    return policy, restock_date
+
+def variant_to_pb(variant):
+    return product_pb2.Variant(
+        id=variant.id,
+        product_id=variant.product_id,
+        sku=variant.sku,
+        options=variant.option_values(),
+        has_price=variant.price is not None,
+        price=float(variant.price) if variant.price is not None else 0.0,
+        created_at=variant.created_at.isoformat(),
+        updated_at=variant.updated_at.isoformat()
+    )
+
+def product_to_pb(product):
+    return product_pb2.Product(
+        id=product.id,
+        name=product.name,
+        description=product.description,
+        price=float(product.price),
+        currency=product.currency,
+        tax_category=product.tax_category,
+        stock_policy=product.stock_policy,
+        expected_restock_date=product.expected_restock_date.isoformat() if product.expected_restock_date else "",
+        user_id=product.user_id,
+        created_at=product.created_at.isoformat(),
+        options=[
+            product_pb2.ProductOption(name=axis["name"], values=axis["values"])
+            for axis in product.option_axes()
+        ],
+        variants=[variant_to_pb(variant) for variant in product.variants],
+        category_ids=[category.id for category in product.categories],
+        media=[media_to_pb(media) for media in product.media],
+        price_version=product.price_version or 1,
+        deleted_at=format_time(product.deleted_at)
+    )
+
+def live_products(db):
+    """Query of the products that are not soft deleted"""
+    return db.query(Product).filter(Product.deleted_at.is_(None))
+
+def parse_option_axes(options):
+    """Validated option axes of a request as stored JSON. Raises ValueError
+    when a name is missing or repeated or an axis has no values."""
+    axes = []
+    for option in options:
+        name = option.name.strip().lower()
+        values = [value.strip() for value in option.values if value.strip()]
+        if not name:
+            raise ValueError("Option names cannot be empty")
+        if any(axis["name"] == name for axis in axes):
+            raise ValueError(f"Option {name} is listed twice")
+        if not values or len(set(values)) != len(values):
+            raise ValueError(f"Option {name} needs distinct values")
+        axes.append({"name": name, "values": values})
+    return json.dumps(axes)
+
+def check_variant_options(axes, options):
+    """Raise ValueError unless options pick exactly one allowed value for
+    every axis of the product"""
+    names = {axis["name"] for axis in axes}
+    unknown = set(options) - names
+    if unknown:
+        raise ValueError(f"Unknown options: {', '.join(sorted(unknown))}")
+    for axis in axes:
+        value = options.get(axis["name"])
+        if value is None:
+            raise ValueError(f"Missing value for option {axis['name']}")
+        if value not in axis["values"]:
+            raise ValueError(f"Invalid {axis['name']} {value}: use {', '.join(axis['values'])}")
+
+def find_variant_conflict(db, product, options, sku, variant_id=None):
+    """Message for a SKU or option combination already taken, or None. SKUs
+    are unique across storefronts."""
+    taken = db.query(ProductVariant).filter(ProductVariant.sku == sku).execution_options(all_tenants=True).first()
+    if taken and taken.id != variant_id:
+        return f"SKU {sku} is already in use"
+    for other in product.variants:
+        if other.id != variant_id and other.option_values() == options:
+            return f"Product already has variant {other.sku} with these options"
+    return None
+
+def load_categories(db, category_ids):
+    """Categories with the given IDs. Raises ValueError naming any that do
+    not exist."""
+    wanted = set(category_ids)
+    if not wanted:
+        return []
+    categories = db.query(Category).filter(Category.id.in_(wanted)).all()
+    missing = wanted - {category.id for category in categories}
+    if missing:
+        raise ValueError(f"Unknown categories: {', '.join(str(i) for i in sorted(missing))}")
+    return categories
+
 class ProductService(product_pb2_grpc.ProductServiceServicer):
     
     def CreateProduct(self, request, context):
@@ -23,32 +139,41 @@ class ProductService(product_pb2_grpc.ProductServiceServicer):
             # TODO: Validate user exists via gRPC call to user service
             # For now, we'll assume the user_id is valid
             
+            try:
+                policy, restock_date = parse_stock_settings(request)
+                options = parse_option_axes(request.options)
+                categories = load_categories(db, request.category_ids)
+            except ValueError as e:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(str(e))
+                return product_pb2.CreateProductResponse(success=False, message=str(e))
+            
             # Create new product
             product = Product(
                 name=request.name,
                 description=request.description,
-                price=request.price,
+                price=to_price(request.price, request.currency or "USD"),
+                currency=request.currency or "USD",
+                tax_category=request.tax_category or "standard",
+                stock_policy=policy or "none",
+                expected_restock_date=restock_date,
+                options=options,
+                categories=categories,
                 user_id=request.user_id
             )
             db.add(product)
+            record_initial_price(db, product)
             db.commit()
             db.refresh(product)
             
             logger.info(f"Created product: {product.id}")
             
             return product_pb2.CreateProductResponse(
-                product=product_pb2.Product(
-                    id=product.id,
-                    name=product.name,
-                    description=product.description,
-                    price=float(product.price),
-                    user_id=product.user_id,
-                    created_at=product.created_at.isoformat()
-                ),
+                product=product_to_pb(product),
                 success=True,
                 message="Product created successfully"
             )
-            
+        
         except Exception as e:
             logger.error(f"Error creating product: {str(e)}")
             context.set_code(grpc.StatusCode.INTERNAL)
@@ -60,23 +185,17 @@ class ProductService(product_pb2_grpc.ProductServiceServicer):
     def GetProduct(self, request, context):
         db = SessionLocal()
         try:
-            product = db.query(Product).filter(Product.id == request.product_id).first()
+            query = db.query(Product) if request.include_deleted else live_products(db)
+            product = query.filter(Product.id == request.product_id).first()
             
             if not product:
                 return product_pb2.GetProductResponse(found=False)
             
             return product_pb2.GetProductResponse(
-                product=product_pb2.Product(
-                    id=product.id,
-                    name=product.name,
-                    description=product.description,
-                    price=float(product.price),
-                    user_id=product.user_id,
-                    created_at=product.created_at.isoformat()
-                ),
+                product=product_to_pb(product),
                 found=True
             )
-            
+        
         except Exception as e:
             logger.error(f"Error getting product: {str(e)}")
             context.set_code(grpc.StatusCode.INTERNAL)
@@ -88,7 +207,7 @@ class ProductService(product_pb2_grpc.ProductServiceServicer):
     def UpdateProduct(self, request, context):
         db = SessionLocal()
         try:
-            product = db.query(Product).filter(Product.id == request.product_id).first()
+            product = live_products(db).filter(Product.id == request.product_id).first()
             
             if not product:
                 context.set_code(grpc.StatusCode.NOT_FOUND)
@@ -98,13 +217,48 @@ class ProductService(product_pb2_grpc.ProductServiceServicer):
                     message="Product not found"
                 )
             
+            try:
+                policy, restock_date = parse_stock_settings(request)
+                options = parse_option_axes(request.options) if request.set_options else None
+                categories = load_categories(db, request.category_ids) if request.set_categories else None
+            except ValueError as e:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(str(e))
+                return product_pb2.UpdateProductResponse(success=False, message=str(e))
+            
+            # Existing variants must still fit the new axes
+            if options is not None:
+                for variant in product.variants:
+                    try:
+                        check_variant_options(json.loads(options), variant.option_values())
+                    except ValueError as e:
+                        message = f"Variant {variant.sku} no longer fits the options: {e}"
+                        context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                        context.set_details(message)
+                        return product_pb2.UpdateProductResponse(success=False, message=message)
+            
             # Update product fields
             if request.name:
                 product.name = request.name
             if request.description:
                 product.description = request.description
+            previous_price, previous_currency = product.price, product.currency
+            if request.currency:
+                product.currency = request.currency
             if request.price > 0:
-                product.price = request.price
+                product.price = to_price(request.price, product.currency)
+            if product.price != previous_price or product.currency != previous_currency:
+                record_price_change(db, product, "MANUAL", product.price, previous_price)
+            if request.tax_category:
+                product.tax_category = request.tax_category
+            if policy:
+                product.stock_policy = policy
+            if restock_date:
+                product.expected_restock_date = restock_date
+            if options is not None:
+                product.options = options
+            if categories is not None:
+                product.categories = categories
             
             db.commit()
             db.refresh(product)
@@ -112,18 +266,11 @@ class ProductService(product_pb2_grpc.ProductServiceServicer):
             logger.info(f"Updated product: {product.id}")
             
             return product_pb2.UpdateProductResponse(
-                product=product_pb2.Product(
-                    id=product.id,
-                    name=product.name,
-                    description=product.description,
-                    price=float(product.price),
-                    user_id=product.user_id,
-                    created_at=product.created_at.isoformat()
-                ),
+                product=product_to_pb(product),
                 success=True,
                 message="Product updated successfully"
             )
-            
+        
         except Exception as e:
             logger.error(f"Error updating product: {str(e)}")
             context.set_code(grpc.StatusCode.INTERNAL)
@@ -135,7 +282,7 @@ class ProductService(product_pb2_grpc.ProductServiceServicer):
     def DeleteProduct(self, request, context):
         db = SessionLocal()
         try:
-            product = db.query(Product).filter(Product.id == request.product_id).first()
+            product = live_products(db).filter(Product.id == request.product_id).first()
             
             if not product:
                 context.set_code(grpc.StatusCode.NOT_FOUND)
@@ -145,7 +292,8 @@ class ProductService(product_pb2_grpc.ProductServiceServicer):
                     message="Product not found"
                 )
             
-            db.delete(product)
+            # Soft delete; the purge job removes the product after the retention period
+            product.deleted_at = datetime.utcnow()
             db.commit()
             
             logger.info(f"Deleted product: {request.product_id}")
@@ -154,7 +302,7 @@ class ProductService(product_pb2_grpc.ProductServiceServicer):
                 success=True,
                 message="Product deleted successfully"
             )
-            
+        
         except Exception as e:
             logger.error(f"Error deleting product: {str(e)}")
             context.set_code(grpc.StatusCode.INTERNAL)
@@ -170,20 +318,20 @@ class ProductService(product_pb2_grpc.ProductServiceServicer):
             limit = min(100, max(1, request.limit or 10))
             offset = (page - 1) * limit
             
-            products = db.query(Product).offset(offset).limit(limit).all()
-            total = db.query(Product).count()
-            
-            product_list = [
-                product_pb2.Product(
-                    id=product.id,
-                    name=product.name,
-                    description=product.description,
-                    price=float(product.price),
-                    user_id=product.user_id,
-                    created_at=product.created_at.isoformat()
+            query = db.query(Product) if request.include_deleted else live_products(db)
+            if request.category_id:
+                category_ids = [request.category_id]
+                if request.include_descendants:
+                    category_ids = descendant_ids(db, request.category_id)
+                in_categories = db.query(product_categories.c.product_id).filter(
+                    product_categories.c.category_id.in_(category_ids)
                 )
-                for product in products
-            ]
+                query = query.filter(Product.id.in_(in_categories))
+            
+            products = query.order_by(Product.id).offset(offset).limit(limit).all()
+            total = query.count()
+            
+            product_list = [product_to_pb(product) for product in products]
             
             return product_pb2.ListProductsResponse(
                 products=product_list,
@@ -191,7 +339,7 @@ class ProductService(product_pb2_grpc.ProductServiceServicer):
                 page=page,
                 limit=limit
             )
-            
+        
         except Exception as e:
             logger.error(f"Error listing products: {str(e)}")
             context.set_code(grpc.StatusCode.INTERNAL)
@@ -203,25 +351,16 @@ class ProductService(product_pb2_grpc.ProductServiceServicer):
     def GetProductsByUser(self, request, context):
         db = SessionLocal()
         try:
-            products = db.query(Product).filter(Product.user_id == request.user_id).all()
+            query = db.query(Product) if request.include_deleted else live_products(db)
+            products = query.filter(Product.user_id == request.user_id).all()
             
-            product_list = [
-                product_pb2.Product(
-                    id=product.id,
-                    name=product.name,
-                    description=product.description,
-                    price=float(product.price),
-                    user_id=product.user_id,
-                    created_at=product.created_at.isoformat()
-                )
-                for product in products
-            ]
+            product_list = [product_to_pb(product) for product in products]
             
             return product_pb2.GetProductsByUserResponse(
                 products=product_list,
                 total=len(product_list)
             )
-            
+        
         except Exception as e:
             logger.error(f"Error getting products by user: {str(e)}")
             context.set_code(grpc.StatusCode.INTERNAL)
@@ -229,10 +368,1227 @@ class ProductService(product_pb2_grpc.ProductServiceServicer):
             return product_pb2.GetProductsByUserResponse(products=[], total=0)
         finally:
             db.close()
+    
+    def CreateVariant(self, request, context):
+        db = SessionLocal()
+        try:
+            product = live_products(db).filter(Product.id == request.product_id).first()
+            
+            if not product:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Product not found")
+                return product_pb2.VariantResponse(success=False, message="Product not found")
+            
+            sku = request.sku.strip()
+            options = {name.strip().lower(): value.strip() for name, value in request.options.items()}
+            try:
+                if not sku:
+                    raise ValueError("SKU is required")
+                if request.has_price and request.price <= 0:
+                    raise ValueError("Variant price must be positive")
+                check_variant_options(product.option_axes(), options)
+            except ValueError as e:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(str(e))
+                return product_pb2.VariantResponse(success=False, message=str(e))
+            
+            conflict = find_variant_conflict(db, product, options, sku)
+            if conflict:
+                context.set_code(grpc.StatusCode.ALREADY_EXISTS)
+                context.set_details(conflict)
+                return product_pb2.VariantResponse(success=False, message=conflict)
+            
+            variant = ProductVariant(
+                product_id=product.id,
+                sku=sku,
+                options=json.dumps(options, sort_keys=True),
+                price=to_price(request.price, product.currency) if request.has_price else None
+            )
+            db.add(variant)
+            if variant.price is not None:
+                db.flush()
+                record_price_change(db, product, "MANUAL", variant.price, product.price, variant=variant)
+            db.commit()
+            db.refresh(variant)
+            
+            logger.info(f"Created variant {variant.sku} of product {product.id}")
+            
+            return product_pb2.VariantResponse(
+                variant=variant_to_pb(variant),
+                success=True,
+                message="Variant created successfully"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error creating variant: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.VariantResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def GetVariant(self, request, context):
+        db = SessionLocal()
+        try:
+            variant = db.query(ProductVariant).filter(ProductVariant.id == request.variant_id).first()
+            
+            if not variant:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Variant not found")
+                return product_pb2.VariantResponse(success=False, message="Variant not found")
+            
+            return product_pb2.VariantResponse(variant=variant_to_pb(variant), success=True)
+        
+        except Exception as e:
+            logger.error(f"Error getting variant: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.VariantResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def GetVariantBySku(self, request, context):
+        db = SessionLocal()
+        try:
+            variant = db.query(ProductVariant).filter(ProductVariant.sku == request.sku.strip()).first()
+            
+            if not variant:
+                message = f"No variant with SKU {request.sku}"
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details(message)
+                return product_pb2.VariantResponse(success=False, message=message)
+            
+            return product_pb2.VariantResponse(variant=variant_to_pb(variant), success=True)
+        
+        except Exception as e:
+            logger.error(f"Error getting variant by SKU: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.VariantResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def UpdateVariant(self, request, context):
+        db = SessionLocal()
+        try:
+            variant = db.query(ProductVariant).filter(ProductVariant.id == request.variant_id).first()
+            
+            if not variant:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Variant not found")
+                return product_pb2.VariantResponse(success=False, message="Variant not found")
+            
+            sku = request.sku.strip() or variant.sku
+            options = variant.option_values()
+            if request.options:
+                options = {name.strip().lower(): value.strip() for name, value in request.options.items()}
+            try:
+                if request.set_price and request.has_price and request.price <= 0:
+                    raise ValueError("Variant price must be positive")
+                check_variant_options(variant.product.option_axes(), options)
+            except ValueError as e:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(str(e))
+                return product_pb2.VariantResponse(success=False, message=str(e))
+            
+            conflict = find_variant_conflict(db, variant.product, options, sku, variant.id)
+            if conflict:
+                context.set_code(grpc.StatusCode.ALREADY_EXISTS)
+                context.set_details(conflict)
+                return product_pb2.VariantResponse(success=False, message=conflict)
+            
+            variant.sku = sku
+            variant.options = json.dumps(options, sort_keys=True)
+            if request.set_price:
+                previous_price = variant.price
+                variant.price = to_price(request.price, variant.product.currency) if request.has_price else None
+                if variant.price != previous_price:
+                    record_price_change(db, variant.product, "MANUAL", variant.price, previous_price, variant=variant)
+            
+            db.commit()
+            db.refresh(variant)
+            
+            logger.info(f"Updated variant {variant.sku} of product {variant.product_id}")
+            
+            return product_pb2.VariantResponse(
+                variant=variant_to_pb(variant),
+                success=True,
+                message="Variant updated successfully"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error updating variant: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.VariantResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def DeleteVariant(self, request, context):
+        db = SessionLocal()
+        try:
+            variant = db.query(ProductVariant).filter(ProductVariant.id == request.variant_id).first()
+            
+            if not variant:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Variant not found")
+                return product_pb2.DeleteVariantResponse(success=False, message="Variant not found")
+            
+            db.delete(variant)
+            db.commit()
+            
+            logger.info(f"Deleted variant: {request.variant_id}")
+            
+            return product_pb2.DeleteVariantResponse(
+                success=True,
+                message="Variant deleted successfully"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error deleting variant: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.DeleteVariantResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def ListVariants(self, request, context):
+        db = SessionLocal()
+        try:
+            product = db.query(Product).filter(Product.id == request.product_id).first()
+            
+            if not product:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Product not found")
+                return product_pb2.ListVariantsResponse(variants=[])
+            
+            return product_pb2.ListVariantsResponse(
+                variants=[variant_to_pb(variant) for variant in product.variants]
+            )
+        
+        except Exception as e:
+            logger.error(f"Error listing variants: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ListVariantsResponse(variants=[])
+        finally:
+            db.close()
+    
+    def CreateCategory(self, request, context):
+        db = SessionLocal()
+        try:
+            name = request.name.strip()
+            slug = slugify(request.slug or name)
+            if not name or not slug:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details("Category name is required")
+                return product_pb2.CategoryResponse(success=False, message="Category name is required")
+            
+            if request.parent_id and not db.query(Category).filter(Category.id == request.parent_id).first():
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Parent category not found")
+                return product_pb2.CategoryResponse(success=False, message="Parent category not found")
+            
+            if db.query(Category).filter(Category.slug == slug).first():
+                message = f"Category slug {slug} is already in use"
+                context.set_code(grpc.StatusCode.ALREADY_EXISTS)
+                context.set_details(message)
+                return product_pb2.CategoryResponse(success=False, message=message)
+            
+            category = Category(
+                name=name,
+                slug=slug,
+                description=request.description,
+                parent_id=request.parent_id or None
+            )
+            db.add(category)
+            db.commit()
+            db.refresh(category)
+            
+            logger.info(f"Created category: {category.id}")
+            
+            return category_response(category, "Category created successfully")
+        
+        except Exception as e:
+            logger.error(f"Error creating category: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.CategoryResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def GetCategory(self, request, context):
+        db = SessionLocal()
+        try:
+            category = db.query(Category).filter(Category.id == request.category_id).first()
+            
+            if not category:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Category not found")
+                return product_pb2.CategoryResponse(success=False, message="Category not found")
+            
+            return category_response(category)
+        
+        except Exception as e:
+            logger.error(f"Error getting category: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.CategoryResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def UpdateCategory(self, request, context):
+        db = SessionLocal()
+        try:
+            category = db.query(Category).filter(Category.id == request.category_id).first()
+            
+            if not category:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Category not found")
+                return product_pb2.CategoryResponse(success=False, message="Category not found")
+            
+            if request.slug:
+                slug = slugify(request.slug)
+                taken = db.query(Category).filter(Category.slug == slug).first()
+                if not slug or (taken and taken.id != category.id):
+                    message = f"Category slug {slug} is already in use" if slug else "Invalid category slug"
+                    context.set_code(grpc.StatusCode.ALREADY_EXISTS if slug else grpc.StatusCode.INVALID_ARGUMENT)
+                    context.set_details(message)
+                    return product_pb2.CategoryResponse(success=False, message=message)
+                category.slug = slug
+            if request.name.strip():
+                category.name = request.name.strip()
+            if request.description:
+                category.description = request.description
+            
+            db.commit()
+            db.refresh(category)
+            
+            logger.info(f"Updated category: {category.id}")
+            
+            return category_response(category, "Category updated successfully")
+        
+        except Exception as e:
+            logger.error(f"Error updating category: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.CategoryResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def MoveCategory(self, request, context):
+        db = SessionLocal()
+        try:
+            category = db.query(Category).filter(Category.id == request.category_id).first()
+            
+            if not category:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Category not found")
+                return product_pb2.CategoryResponse(success=False, message="Category not found")
+            
+            if request.parent_id:
+                if not db.query(Category).filter(Category.id == request.parent_id).first():
+                    context.set_code(grpc.StatusCode.NOT_FOUND)
+                    context.set_details("Parent category not found")
+                    return product_pb2.CategoryResponse(success=False, message="Parent category not found")
+                # A category cannot move below itself
+                if request.parent_id in descendant_ids(db, category.id):
+                    message = "Cannot move a category into its own subtree"
+                    context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                    context.set_details(message)
+                    return product_pb2.CategoryResponse(success=False, message=message)
+            
+            # The subtree follows since children only point at their parent
+            category.parent_id = request.parent_id or None
+            db.commit()
+            db.refresh(category)
+            
+            logger.info(f"Moved category {category.id} under {request.parent_id or 'the root'}")
+            
+            return category_response(category, "Category moved successfully")
+        
+        except Exception as e:
+            logger.error(f"Error moving category: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.CategoryResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def DeleteCategory(self, request, context):
+        db = SessionLocal()
+        try:
+            category = db.query(Category).filter(Category.id == request.category_id).first()
+            
+            if not category:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Category not found")
+                return product_pb2.DeleteCategoryResponse(success=False, message="Category not found")
+            
+            if category.children:
+                message = "Category has subcategories: move or delete them first"
+                context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                context.set_details(message)
+                return product_pb2.DeleteCategoryResponse(success=False, message=message)
+            
+            # Products stay, only their assignment to the category goes
+            category.products = []
+            db.delete(category)
+            db.commit()
+            
+            logger.info(f"Deleted category: {request.category_id}")
+            
+            return product_pb2.DeleteCategoryResponse(
+                success=True,
+                message="Category deleted successfully"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error deleting category: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.DeleteCategoryResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def ListCategories(self, request, context):
+        db = SessionLocal()
+        try:
+            categories = db.query(Category).order_by(Category.name).all()
+            
+            return product_pb2.ListCategoriesResponse(
+                categories=[category_to_pb(category) for category in categories]
+            )
+        
+        except Exception as e:
+            logger.error(f"Error listing categories: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ListCategoriesResponse(categories=[])
+        finally:
+            db.close()
+    
+    def AddProductMedia(self, request, context):
+        db = SessionLocal()
+        try:
+            product = live_products(db).filter(Product.id == request.product_id).first()
+            
+            if not product:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Product not found")
+                return product_pb2.ProductMediaResponse(success=False, message="Product not found")
+            
+            if not request.storage_key or not request.content_type:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details("Storage key and content type are required")
+                return product_pb2.ProductMediaResponse(success=False, message="Storage key and content type are required")
+            
+            media = ProductMedia(
+                product_id=product.id,
+                storage_key=request.storage_key,
+                thumbnail_key=request.thumbnail_key,
+                content_type=request.content_type,
+                size=request.size,
+                width=request.width,
+                height=request.height,
+                position=len(product.media) + 1,
+                is_primary=False,
+                alt_text=request.alt_text
+            )
+            product.media.append(media)
+            if request.is_primary:
+                make_primary(product.media, media)
+            ensure_primary(product.media)
+            
+            db.commit()
+            db.refresh(media)
+            
+            logger.info(f"Added media {media.id} to product {product.id}")
+            
+            return product_pb2.ProductMediaResponse(
+                media=media_to_pb(media),
+                success=True,
+                message="Media added successfully"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error adding product media: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ProductMediaResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def UpdateProductMedia(self, request, context):
+        db = SessionLocal()
+        try:
+            media = db.query(ProductMedia).filter(ProductMedia.id == request.media_id).first()
+            
+            if not media:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Media not found")
+                return product_pb2.ProductMediaResponse(success=False, message="Media not found")
+            
+            if request.set_position and request.position < 1:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details("Position must be at least 1")
+                return product_pb2.ProductMediaResponse(success=False, message="Position must be at least 1")
+            
+            siblings = list(media.product.media)
+            if request.set_position:
+                place(siblings, media, request.position)
+            if request.is_primary:
+                make_primary(siblings, media)
+            if request.set_alt_text:
+                media.alt_text = request.alt_text
+            
+            db.commit()
+            db.refresh(media)
+            
+            logger.info(f"Updated media {media.id} of product {media.product_id}")
+            
+            return product_pb2.ProductMediaResponse(
+                media=media_to_pb(media),
+                success=True,
+                message="Media updated successfully"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error updating product media: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ProductMediaResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def DeleteProductMedia(self, request, context):
+        db = SessionLocal()
+        try:
+            media = db.query(ProductMedia).filter(ProductMedia.id == request.media_id).first()
+            
+            if not media:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Media not found")
+                return product_pb2.ProductMediaResponse(success=False, message="Media not found")
+            
+            # The caller removes the stored files using the returned keys
+            removed = media_to_pb(media)
+            product = media.product
+            product.media.remove(media)
+            renumber(product.media)
+            ensure_primary(product.media)
+            db.commit()
+            
+            logger.info(f"Deleted media {request.media_id} of product {product.id}")
+            
+            return product_pb2.ProductMediaResponse(
+                media=removed,
+                success=True,
+                message="Media deleted successfully"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error deleting product media: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ProductMediaResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def ListProductMedia(self, request, context):
+        db = SessionLocal()
+        try:
+            product = db.query(Product).filter(Product.id == request.product_id).first()
+            
+            if not product:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Product not found")
+                return product_pb2.ListProductMediaResponse(media=[])
+            
+            return product_pb2.ListProductMediaResponse(
+                media=[media_to_pb(media) for media in product.media]
+            )
+        
+        except Exception as e:
+            logger.error(f"Error listing product media: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ListProductMediaResponse(media=[])
+        finally:
+            db.close()
+    
+    def CreateReview(self, request, context):
+        db = SessionLocal()
+        try:
+            product = live_products(db).filter(Product.id == request.product_id).first()
+            
+            if not product:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Product not found")
+                return product_pb2.ReviewResponse(success=False, message="Product not found")
+            
+            try:
+                check_rating(request.rating)
+            except ValueError as e:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(str(e))
+                return product_pb2.ReviewResponse(success=False, message=str(e))
+            
+            existing = db.query(Review).filter(
+                Review.product_id == product.id,
+                Review.user_id == request.user_id
+            ).first()
+            if existing:
+                message = f"User {request.user_id} already reviewed this product: update review {existing.id} instead"
+                context.set_code(grpc.StatusCode.ALREADY_EXISTS)
+                context.set_details(message)
+                return product_pb2.ReviewResponse(success=False, message=message)
+            
+            review = Review(
+                product_id=product.id,
+                user_id=request.user_id,
+                rating=request.rating,
+                title=request.title.strip(),
+                body=request.body.strip(),
+                status="APPROVED",
+                order_id=request.order_id
+            )
+            db.add(review)
+            db.commit()
+            db.refresh(review)
+            
+            logger.info(f"Created review {review.id} of product {product.id}")
+            
+            return product_pb2.ReviewResponse(
+                review=review_to_pb(review),
+                success=True,
+                message="Review created successfully"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error creating review: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ReviewResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def GetReview(self, request, context):
+        db = SessionLocal()
+        try:
+            review = db.query(Review).filter(Review.id == request.review_id).first()
+            
+            if not review:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Review not found")
+                return product_pb2.ReviewResponse(success=False, message="Review not found")
+            
+            return product_pb2.ReviewResponse(review=review_to_pb(review), success=True)
+        
+        except Exception as e:
+            logger.error(f"Error getting review: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ReviewResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def UpdateReview(self, request, context):
+        db = SessionLocal()
+        try:
+            review = db.query(Review).filter(Review.id == request.review_id).first()
+            
+            if not review:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Review not found")
+                return product_pb2.ReviewResponse(success=False, message="Review not found")
+            
+            if review.user_id != request.user_id and not request.moderator:
+                context.set_code(grpc.StatusCode.PERMISSION_DENIED)
+                context.set_details("Only the author can update a review")
+                return product_pb2.ReviewResponse(success=False, message="Only the author can update a review")
+            
+            if request.rating:
+                try:
+                    check_rating(request.rating)
+                except ValueError as e:
+                    context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                    context.set_details(str(e))
+                    return product_pb2.ReviewResponse(success=False, message=str(e))
+                review.rating = request.rating
+            if request.title:
+                review.title = request.title.strip()
+            if request.body:
+                review.body = request.body.strip()
+            # A rejected review the author rewrote goes back to the moderators
+            if review.status == "REJECTED" and not request.moderator:
+                review.status = "PENDING"
+            
+            db.commit()
+            db.refresh(review)
+            
+            logger.info(f"Updated review {review.id} as user {request.user_id}")
+            
+            return product_pb2.ReviewResponse(
+                review=review_to_pb(review),
+                success=True,
+                message="Review updated successfully"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error updating review: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ReviewResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def DeleteReview(self, request, context):
+        db = SessionLocal()
+        try:
+            review = db.query(Review).filter(Review.id == request.review_id).first()
+            
+            if not review:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Review not found")
+                return product_pb2.DeleteReviewResponse(success=False, message="Review not found")
+            
+            if review.user_id != request.user_id and not request.moderator:
+                context.set_code(grpc.StatusCode.PERMISSION_DENIED)
+                context.set_details("Only the author can delete a review")
+                return product_pb2.DeleteReviewResponse(success=False, message="Only the author can delete a review")
+            
+            db.delete(review)
+            db.commit()
+            
+            logger.info(f"Deleted review {request.review_id} as user {request.user_id}")
+            
+            return product_pb2.DeleteReviewResponse(
+                success=True,
+                message="Review deleted successfully"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error deleting review: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.DeleteReviewResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def ListReviews(self, request, context):
+        db = SessionLocal()
+        try:
+            page = request.page if request.page > 0 else 1
+            limit = request.limit if request.limit > 0 else 10
+            sort = request.sort or "newest"
+            # Only approved reviews unless the gateway let a moderator ask
+            # for others
+            status = (request.status or "APPROVED").upper()
+            
+            if sort not in SORT_ORDERS:
+                message = f"Invalid sort {sort}: use {', '.join(SORT_ORDERS)}"
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(message)
+                return product_pb2.ListReviewsResponse(reviews=[])
+            if status != "ALL" and status not in REVIEW_STATUSES:
+                message = f"Invalid review status {status}: use {', '.join(REVIEW_STATUSES)} or ALL"
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(message)
+                return product_pb2.ListReviewsResponse(reviews=[])
+            
+            query = db.query(Review)
+            if request.product_id or not request.user_id:
+                query = query.filter(Review.product_id == request.product_id)
+            if request.user_id:
+                query = query.filter(Review.user_id == request.user_id)
+            if status != "ALL":
+                query = query.filter(Review.status == status)
+            
+            total = query.count()
+            reviews = query.order_by(*SORT_ORDERS[sort]).offset((page - 1) * limit).limit(limit).all()
+            
+            return product_pb2.ListReviewsResponse(
+                reviews=[review_to_pb(review) for review in reviews],
+                total=total,
+                page=page,
+                limit=limit
+            )
+        
+        except Exception as e:
+            logger.error(f"Error listing reviews: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ListReviewsResponse(reviews=[])
+        finally:
+            db.close()
+    
+    def ModerateReview(self, request, context):
+        db = SessionLocal()
+        try:
+            review = db.query(Review).filter(Review.id == request.review_id).first()
+            
+            if not review:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Review not found")
+                return product_pb2.ReviewResponse(success=False, message="Review not found")
+            
+            status = request.status.upper()
+            if status not in REVIEW_STATUSES:
+                message = f"Invalid review status {request.status}: use {', '.join(REVIEW_STATUSES)}"
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(message)
+                return product_pb2.ReviewResponse(success=False, message=message)
+            
+            review.status = status
+            review.moderation_note = request.note
+            db.commit()
+            db.refresh(review)
+            
+            logger.info(f"Review {review.id} moderated to {status}")
+            
+            return product_pb2.ReviewResponse(
+                review=review_to_pb(review),
+                success=True,
+                message=f"Review {status.lower()}"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error moderating review: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ReviewResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def GetReviewSummary(self, request, context):
+        db = SessionLocal()
+        try:
+            return review_summary(db, request.product_id)
+        except Exception as e:
+            logger.error(f"Error summarizing reviews: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ReviewSummary(product_id=request.product_id)
+        finally:
+            db.close()
+    
+    def DeleteUserReviews(self, request, context):
+        db = SessionLocal()
+        try:
+            reviews = db.query(Review).filter(Review.user_id == request.user_id).all()
+            for review in reviews:
+                db.delete(review)
+            db.commit()
+            
+            logger.info(f"Deleted {len(reviews)} reviews of user {request.user_id}")
+            
+            return product_pb2.UserReviewsResponse(count=len(reviews))
+        
+        except Exception as e:
+            logger.error(f"Error deleting reviews of user: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.UserReviewsResponse()
+        finally:
+            db.close()
+    
+    def ListPriceHistory(self, request, context):
+        db = SessionLocal()
+        try:
+            page = request.page if request.page > 0 else 1
+            limit = request.limit if request.limit > 0 else 20
+            
+            if not db.query(Product).filter(Product.id == request.product_id).first():
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Product not found")
+                return product_pb2.ListPriceHistoryResponse(changes=[])
+            
+            query = db.query(PriceChange).filter(PriceChange.product_id == request.product_id)
+            total = query.count()
+            changes = query.order_by(PriceChange.version.desc()).offset((page - 1) * limit).limit(limit).all()
+            
+            return product_pb2.ListPriceHistoryResponse(
+                changes=[price_change_to_pb(change) for change in changes],
+                total=total,
+                page=page,
+                limit=limit
+            )
+        
+        except Exception as e:
+            logger.error(f"Error listing price history: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ListPriceHistoryResponse(changes=[])
+        finally:
+            db.close()
+    
+    def CreatePriceSchedule(self, request, context):
+        db = SessionLocal()
+        try:
+            product = live_products(db).filter(Product.id == request.product_id).first()
+            
+            if not product:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Product not found")
+                return product_pb2.PriceScheduleResponse(success=False, message="Product not found")
+            
+            try:
+                if request.price <= 0:
+                    raise ValueError("Scheduled price must be positive")
+                if not request.starts_at:
+                    raise ValueError("starts_at is required")
+                try:
+                    starts_at = parse_time(request.starts_at)
+                    ends_at = parse_time(request.ends_at) if request.ends_at else None
+                except ValueError:
+                    raise ValueError("Invalid schedule time: use ISO 8601, such as 2024-11-29T00:00:00Z")
+                if ends_at is not None and ends_at <= starts_at:
+                    raise ValueError("ends_at must be after starts_at")
+                if ends_at is not None and ends_at <= datetime.utcnow():
+                    raise ValueError("ends_at is in the past")
+            except ValueError as e:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(str(e))
+                return product_pb2.PriceScheduleResponse(success=False, message=str(e))
+            
+            pending = db.query(PriceSchedule).filter(
+                PriceSchedule.product_id == product.id,
+                PriceSchedule.status.in_(["SCHEDULED", "ACTIVE"])
+            ).all()
+            for other in pending:
+                if overlaps(other, starts_at, ends_at):
+                    message = f"Overlaps price schedule {other.id}: cancel it first"
+                    context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                    context.set_details(message)
+                    return product_pb2.PriceScheduleResponse(success=False, message=message)
+            
+            schedule = PriceSchedule(
+                product_id=product.id,
+                price=to_price(request.price, product.currency),
+                starts_at=starts_at,
+                ends_at=ends_at,
+                status="SCHEDULED",
+                note=request.note
+            )
+            db.add(schedule)
+            db.commit()
+            db.refresh(schedule)
+            
+            logger.info(f"Scheduled price {schedule.price} for product {product.id} from {starts_at}")
+            
+            return product_pb2.PriceScheduleResponse(
+                schedule=schedule_to_pb(schedule),
+                success=True,
+                message="Price change scheduled"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error scheduling price: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.PriceScheduleResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def ListPriceSchedules(self, request, context):
+        db = SessionLocal()
+        try:
+            query = db.query(PriceSchedule).filter(PriceSchedule.product_id == request.product_id)
+            if not request.include_finished:
+                query = query.filter(PriceSchedule.status.in_(["SCHEDULED", "ACTIVE"]))
+            schedules = query.order_by(PriceSchedule.starts_at).all()
+            
+            return product_pb2.ListPriceSchedulesResponse(
+                schedules=[schedule_to_pb(schedule) for schedule in schedules]
+            )
+        
+        except Exception as e:
+            logger.error(f"Error listing price schedules: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ListPriceSchedulesResponse(schedules=[])
+        finally:
+            db.close()
+    
+    def CancelPriceSchedule(self, request, context):
+        db = SessionLocal()
+        try:
+            schedule = db.query(PriceSchedule).filter(PriceSchedule.id == request.schedule_id).first()
+            
+            if not schedule:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Price schedule not found")
+                return product_pb2.PriceScheduleResponse(success=False, message="Price schedule not found")
+            
+            if schedule.status not in ("SCHEDULED", "ACTIVE"):
+                message = f"Price schedule is already {schedule.status.lower()}"
+                context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                context.set_details(message)
+                return product_pb2.PriceScheduleResponse(success=False, message=message)
+            
+            # A running schedule ends early and restores the earlier price
+            if schedule.status == "ACTIVE":
+                end_schedule(db, schedule)
+            schedule.status = "CANCELLED"
+            db.commit()
+            db.refresh(schedule)
+            
+            logger.info(f"Cancelled price schedule {schedule.id}")
+            
+            return product_pb2.PriceScheduleResponse(
+                schedule=schedule_to_pb(schedule),
+                success=True,
+                message="Price schedule cancelled"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error cancelling price schedule: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.PriceScheduleResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def ApplyDuePriceSchedules(self, request, context):
+        db = SessionLocal()
+        try:
+            try:
+                now = parse_time(request.now) if request.now else datetime.utcnow()
+            except ValueError:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(f"Invalid time {request.now}")
+                return product_pb2.ApplyDuePriceSchedulesResponse()
+            
+            # End running schedules first so a follow-up schedule starting
+            # at the same moment takes over from the restored price
+            ended = db.query(PriceSchedule).filter(
+                PriceSchedule.status == "ACTIVE",
+                PriceSchedule.ends_at.isnot(None),
+                PriceSchedule.ends_at <= now
+            ).all()
+            for schedule in ended:
+                end_schedule(db, schedule)
+            
+            started = []
+            due = db.query(PriceSchedule).filter(
+                PriceSchedule.status == "SCHEDULED",
+                PriceSchedule.starts_at <= now
+            ).order_by(PriceSchedule.starts_at).all()
+            for schedule in due:
+                if schedule.ends_at is not None and schedule.ends_at <= now:
+                    # The whole period passed while nothing applied it
+                    schedule.status = "COMPLETED"
+                    ended.append(schedule)
+                    continue
+                start_schedule(db, schedule)
+                started.append(schedule)
+            
+            db.commit()
+            
+            if started or ended:
+                logger.info(f"Price schedules: {len(started)} started, {len(ended)} ended")
+            
+            return product_pb2.ApplyDuePriceSchedulesResponse(
+                started=[schedule_to_pb(schedule) for schedule in started],
+                ended=[schedule_to_pb(schedule) for schedule in ended]
+            )
+        
+        except Exception as e:
+            logger.error(f"Error applying price schedules: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.ApplyDuePriceSchedulesResponse()
+        finally:
+            db.close()
+    
+    def RestoreProduct(self, request, context):
+        db = SessionLocal()
+        try:
+            product = db.query(Product).filter(Product.id == request.product_id).first()
+            if not product:
+                context.set_code(grpc.StatusCode.NOT_FOUND)
+                context.set_details("Product not found")
+                return product_pb2.RestoreProductResponse(success=False, message="Product not found")
+            if product.deleted_at is None:
+                context.set_code(grpc.StatusCode.FAILED_PRECONDITION)
+                context.set_details("Product is not deleted")
+                return product_pb2.RestoreProductResponse(success=False, message="Product is not deleted")
+            
+            product.deleted_at = None
+            db.commit()
+            db.refresh(product)
+            
+            logger.info(f"Restored product: {product.id}")
+            
+            return product_pb2.RestoreProductResponse(
+                product=product_to_pb(product),
+                success=True,
+                message="Product restored successfully"
+            )
+        
+        except Exception as e:
+            logger.error(f"Error restoring product: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.RestoreProductResponse(success=False, message="Internal error")
+        finally:
+            db.close()
+    
+    def DeleteUserProducts(self, request, context):
+        db = SessionLocal()
+        try:
+            try:
+                deleted_at = parse_time(request.deleted_at)
+            except ValueError:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(f"Invalid deleted_at {request.deleted_at}")
+                return product_pb2.UserProductsResponse()
+            
+            products = live_products(db).filter(Product.user_id == request.user_id).all()
+            for product in products:
+                product.deleted_at = deleted_at
+            db.commit()
+            
+            logger.info(f"Deleted {len(products)} products of user {request.user_id}")
+            
+            return product_pb2.UserProductsResponse(count=len(products))
+        
+        except Exception as e:
+            logger.error(f"Error deleting products of user: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.UserProductsResponse()
+        finally:
+            db.close()
+    
+    def RestoreUserProducts(self, request, context):
+        db = SessionLocal()
+        try:
+            try:
+                deleted_at = parse_time(request.deleted_at)
+            except ValueError:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(f"Invalid deleted_at {request.deleted_at}")
+                return product_pb2.UserProductsResponse()
+            
+            # only the products deleted together with the user, not the ones
+            # deleted on their own before
+            products = db.query(Product).filter(
+                Product.user_id == request.user_id,
+                Product.deleted_at == deleted_at
+            ).all()
+            for product in products:
+                product.deleted_at = None
+            db.commit()
+            
+            logger.info(f"Restored {len(products)} products of user {request.user_id}")
+            
+            return product_pb2.UserProductsResponse(count=len(products))
+        
+        except Exception as e:
+            logger.error(f"Error restoring products of user: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.UserProductsResponse()
+        finally:
+            db.close()
+    
+    def ReassignUserProducts(self, request, context):
+        db = SessionLocal()
+        try:
+            if request.new_user_id <= 0 or request.new_user_id == request.user_id:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details("Products must be reassigned to another user")
+                return product_pb2.UserProductsResponse()
+            
+            products = live_products(db).filter(Product.user_id == request.user_id).all()
+            for product in products:
+                product.user_id = request.new_user_id
+            db.commit()
+            
+            logger.info(f"Reassigned {len(products)} products of user {request.user_id} to user {request.new_user_id}")
+            
+            return product_pb2.UserProductsResponse(count=len(products))
+        
+        except Exception as e:
+            logger.error(f"Error reassigning products of user: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.UserProductsResponse()
+        finally:
+            db.close()
+    
+    def PurgeDeletedProducts(self, request, context):
+        db = SessionLocal()
+        try:
+            try:
+                deleted_before = parse_time(request.deleted_before)
+            except ValueError:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(f"Invalid deleted_before {request.deleted_before}")
+                return product_pb2.PurgeDeletedProductsResponse()
+            
+            products = db.query(Product).filter(
+                Product.deleted_at.isnot(None),
+                Product.deleted_at < deleted_before
+            ).all()
+            
+            product_ids = []
+            storage_keys = []
+            for product in products:
+                product_ids.append(product.id)
+                for media in product.media:
+                    storage_keys.append(media.storage_key)
+                    if media.thumbnail_key:
+                        storage_keys.append(media.thumbnail_key)
+                # variants, media, reviews and price history go with the product
+                product.categories = []
+                db.delete(product)
+            db.commit()
+            
+            if product_ids:
+                logger.info(f"Purged deleted products: {product_ids}")
+            
+            return product_pb2.PurgeDeletedProductsResponse(
+                product_ids=product_ids,
+                storage_keys=storage_keys
+            )
+        
+        except Exception as e:
+            logger.error(f"Error purging deleted products: {str(e)}")
+            db.rollback()
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f"Internal error: {str(e)}")
+            return product_pb2.PurgeDeletedProductsResponse()
+        finally:
+            db.close()
 
 def serve():
     port = os.getenv("GRPC_PORT", "50052")
-    server = grpc.server(futures.ThreadPoolExecutor(max_workers=10))
+    server = grpc.server(futures.ThreadPoolExecutor(max_workers=10), interceptors=[TenantInterceptor()])
     product_pb2_grpc.add_ProductServiceServicer_to_server(ProductService(), server)
     
     listen_addr = f"[::]:{port}"
@@ -0,0 +1,51 @@
+from sqlalchemy import func
+
+import product_pb2
+from models import Review
+
+REVIEW_STATUSES = ("PENDING", "APPROVED", "REJECTED")
+
+# Orderings accepted by ListReviews
+SORT_ORDERS = {
+    "newest": (Review.created_at.desc(), Review.id.desc()),
+    "oldest": (Review.created_at.asc(), Review.id.asc()),
+    "highest": (Review.rating.desc(), Review.created_at.desc()),
+    "lowest": (Review.rating.asc(), Review.created_at.desc()),
+}
+
+def review_to_pb(review):
+    return product_pb2.Review(
+        id=review.id,
+        product_id=review.product_id,
+        user_id=review.user_id,
+        rating=review.rating,
+        title=review.title or "",
+        body=review.body or "",
+        status=review.status,
+        moderation_note=review.moderation_note or "",
+        order_id=review.order_id or "",
+        created_at=review.created_at.isoformat(),
+        updated_at=review.updated_at.isoformat()
+    )
+
+def check_rating(rating):
+    if rating < 1 or rating > 5:
+        raise ValueError("Rating must be between 1 and 5")
+
+def review_summary(db, product_id):
+    """Average rating and histogram of the approved reviews of a product"""
+    counts = dict(
+        db.query(Review.rating, func.count(Review.id))
+        .filter(Review.product_id == product_id, Review.status == "APPROVED")
+        .group_by(Review.rating)
+        .all()
+    )
+    histogram = {stars: counts.get(stars, 0) for stars in range(1, 6)}
+    count = sum(histogram.values())
+    average = sum(stars * n for stars, n in histogram.items()) / count if count else 0.0
+    return product_pb2.ReviewSummary(
+        product_id=product_id,
+        average_rating=round(average, 2),
+        review_count=count,
+        histogram=histogram
+    )
@@ -0,0 +1,64 @@
+"""Storefront scoping. The API Gateway names the tenant of every call in the
+x-tenant-id metadata; queries of tenant-owned models made while handling the
+call only see that tenant's rows. Calls without a tenant, such as the
+gateway's background jobs, see every tenant."""
+import contextvars
+
+import grpc
+from sqlalchemy import event
+from sqlalchemy.orm import Session, with_loader_criteria
+
+TENANT_METADATA_KEY = "x-tenant-id"
+DEFAULT_TENANT = "default"
+
+_current_tenant = contextvars.ContextVar("tenant", default=None)
+
+def current_tenant():
+    """Tenant of the call being handled, None when unscoped"""
+    return _current_tenant.get()
+
+def new_row_tenant():
+    """Tenant new rows belong to; the default of tenant_id columns"""
+    return _current_tenant.get() or DEFAULT_TENANT
+
+class TenantInterceptor(grpc.ServerInterceptor):
+    """Runs unary calls with the tenant from their metadata"""
+
+    def intercept_service(self, continuation, handler_call_details):
+        handler = continuation(handler_call_details)
+        if handler is None or handler.unary_unary is None:
+            return handler
+
+        tenant = None
+        for key, value in handler_call_details.invocation_metadata or ():
+            if key == TENANT_METADATA_KEY and value:
+                tenant = value
+        behavior = handler.unary_unary
+
+        def scoped(request, context):
+            token = _current_tenant.set(tenant)
+            try:
+                return behavior(request, context)
+            finally:
+                _current_tenant.reset(token)
+
+        return grpc.unary_unary_rpc_method_handler(
+            scoped,
+            request_deserializer=handler.request_deserializer,
+            response_serializer=handler.response_serializer
+        )
+
+def scope_queries(criteria):
+    """Filter ORM selects by tenant. criteria maps each tenant-owned model to
+    a function of the tenant returning the filter its rows must match.
+    Queries run with the all_tenants execution option are not filtered."""
+    @event.listens_for(Session, "do_orm_execute")
+    def _scope_to_tenant(state):
+        tenant = _current_tenant.get()
+        if (tenant is None or not state.is_select or state.is_column_load
+                or state.is_relationship_load or state.execution_options.get("all_tenants")):
+            return
+        state.statement = state.statement.options(*[
+            with_loader_criteria(model, where(tenant), include_aliases=True)
+            for model, where in criteria.items()
+        ])
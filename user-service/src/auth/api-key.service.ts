@@ -0,0 +1,224 @@
+import { Injectable, Logger } from "@nestjs/common";
+import { InjectRepository } from "@nestjs/typeorm";
+import { IsNull, Repository } from "typeorm";
+import { randomBytes } from "crypto";
+import { User } from "@/database/user.entity";
+import { ApiKey } from "@/database/api-key.entity";
+import { hashToken } from "./auth.service";
+import { toUserMessage } from "@/user/user.service";
+import { tenantScope } from "@/tenant/tenant";
+import {
+  ApiKey as ApiKeyMessage,
+  CreateApiKeyRequest,
+  ApiKeyResponse,
+  ListApiKeysRequest,
+  ListApiKeysResponse,
+  RevokeApiKeyRequest,
+  AuthenticateApiKeyRequest,
+  AuthenticateApiKeyResponse,
+} from "@/proto/user.pb";
+
+// Marks keys so they are recognizable in configuration and secret scanners
+const KEY_PREFIX = "mk_";
+// Characters of the key kept in clear to tell keys apart
+const SHOWN_LENGTH = KEY_PREFIX.length + 8;
+// last_used_at is refreshed at most this often, so busy scanners do not
+// write on every request
+const LAST_USED_RESOLUTION_MS = 60 * 1000;
+
+export function toApiKeyMessage(apiKey: ApiKey): ApiKeyMessage {
+  return {
+    id: apiKey.id,
+    userId: apiKey.userId,
+    name: apiKey.name,
+    prefix: apiKey.prefix,
+    scopes: apiKey.scopes ?? [],
+    createdAt: apiKey.createdAt.toISOString(),
+    expiresAt: apiKey.expiresAt ? apiKey.expiresAt.toISOString() : "",
+    lastUsedAt: apiKey.lastUsedAt ? apiKey.lastUsedAt.toISOString() : "",
+    revokedAt: apiKey.revokedAt ? apiKey.revokedAt.toISOString() : "",
+  };
+}
+
+function failedKey(message: string): ApiKeyResponse {
+  return { success: false, message, apiKey: undefined, key: "" };
+}
+
+@Injectable()
+export class ApiKeyService {
+  private readonly logger = new Logger(ApiKeyService.name);
+
+  constructor(
+    @InjectRepository(User)
+    private readonly userRepository: Repository<User>,
+    @InjectRepository(ApiKey)
+    private readonly apiKeyRepository: Repository<ApiKey>
+  ) {}
+
+  async createApiKey(request: CreateApiKeyRequest): Promise<ApiKeyResponse> {
+    try {
+      const name = (request.name ?? "").trim();
+      if (!name) {
+        return failedKey("Name is required");
+      }
+      if (!request.scopes || request.scopes.length === 0) {
+        return failedKey("At least one scope is required");
+      }
+
+      let expiresAt: Date | null = null;
+      if (request.expiresAt) {
+        expiresAt = new Date(request.expiresAt);
+        if (isNaN(expiresAt.getTime())) {
+          return failedKey("Invalid expires_at: use an RFC 3339 time");
+        }
+        if (expiresAt.getTime() <= Date.now()) {
+          return failedKey("expires_at must be in the future");
+        }
+      }
+
+      const user = await this.userRepository.findOne({
+        where: { id: request.userId, ...tenantScope() },
+      });
+      if (!user) {
+        return failedKey("User not found");
+      }
+
+      const key = KEY_PREFIX + randomBytes(32).toString("base64url");
+      const apiKey = await this.apiKeyRepository.save(
+        this.apiKeyRepository.create({
+          userId: user.id,
+          name,
+          prefix: key.slice(0, SHOWN_LENGTH),
+          keyHash: hashToken(key),
+          scopes: [...new Set(request.scopes)],
+          expiresAt,
+        })
+      );
+      this.logger.log(`Created API key ${apiKey.id} for user: ${user.id}`);
+
+      return {
+        success: true,
+        message: "API key created; store it now, it is not shown again",
+        apiKey: toApiKeyMessage(apiKey),
+        key,
+      };
+    } catch (error) {
+      this.logger.error(`Error creating API key: ${error.message}`);
+      return failedKey("Internal error");
+    }
+  }
+
+  // ownerInTenant reports whether the user belongs to the current tenant,
+  // whose calls see only their own users' keys
+  private async ownerInTenant(userId: number): Promise<boolean> {
+    const count = await this.userRepository.count({
+      where: { id: userId, ...tenantScope() },
+      withDeleted: true,
+    });
+    return count > 0;
+  }
+
+  async listApiKeys(
+    request: ListApiKeysRequest
+  ): Promise<ListApiKeysResponse> {
+    try {
+      if (!(await this.ownerInTenant(request.userId))) {
+        return { apiKeys: [] };
+      }
+      const apiKeys = await this.apiKeyRepository.find({
+        where: request.includeRevoked
+          ? { userId: request.userId }
+          : { userId: request.userId, revokedAt: IsNull() },
+        order: { id: "ASC" },
+      });
+      return { apiKeys: apiKeys.map(toApiKeyMessage) };
+    } catch (error) {
+      this.logger.error(`Error listing API keys: ${error.message}`);
+      return { apiKeys: [] };
+    }
+  }
+
+  async revokeApiKey(request: RevokeApiKeyRequest): Promise<ApiKeyResponse> {
+    try {
+      const apiKey = await this.apiKeyRepository.findOne({
+        where: { id: request.id, userId: request.userId },
+      });
+      if (!apiKey || !(await this.ownerInTenant(apiKey.userId))) {
+        return failedKey("API key not found");
+      }
+      if (apiKey.revokedAt) {
+        return {
+          success: true,
+          message: "API key already revoked",
+          apiKey: toApiKeyMessage(apiKey),
+          key: "",
+        };
+      }
+
+      apiKey.revokedAt = new Date();
+      await this.apiKeyRepository.save(apiKey);
+      this.logger.log(`Revoked API key ${apiKey.id} of user: ${apiKey.userId}`);
+
+      return {
+        success: true,
+        message: "API key revoked successfully",
+        apiKey: toApiKeyMessage(apiKey),
+        key: "",
+      };
+    } catch (error) {
+      this.logger.error(`Error revoking API key: ${error.message}`);
+      return failedKey("Internal error");
+    }
+  }
+
+  async authenticateApiKey(
+    request: AuthenticateApiKeyRequest
+  ): Promise<AuthenticateApiKeyResponse> {
+    const invalid = (message: string) => ({
+      user: undefined,
+      apiKey: undefined,
+      valid: false,
+      message,
+    });
+    try {
+      if (!request.key) {
+        return invalid("Invalid API key");
+      }
+      const apiKey = await this.apiKeyRepository.findOne({
+        where: { keyHash: hashToken(request.key) },
+      });
+      if (!apiKey || apiKey.revokedAt) {
+        return invalid("Invalid API key");
+      }
+      if (apiKey.expiresAt && apiKey.expiresAt.getTime() <= Date.now()) {
+        return invalid("API key expired");
+      }
+
+      const user = await this.userRepository.findOne({
+        where: { id: apiKey.userId, ...tenantScope() },
+      });
+      if (!user) {
+        return invalid("User not found");
+      }
+
+      const now = new Date();
+      if (
+        !apiKey.lastUsedAt ||
+        now.getTime() - apiKey.lastUsedAt.getTime() >= LAST_USED_RESOLUTION_MS
+      ) {
+        apiKey.lastUsedAt = now;
+        await this.apiKeyRepository.update(apiKey.id, { lastUsedAt: now });
+      }
+
+      return {
+        user: toUserMessage(user),
+        apiKey: toApiKeyMessage(apiKey),
+        valid: true,
+        message: "",
+      };
+    } catch (error) {
+      this.logger.error(`Error authenticating API key: ${error.message}`);
+      return invalid("Internal error");
+    }
+  }
+}
@@ -0,0 +1,21 @@
+import { Module } from "@nestjs/common";
+import { ConfigModule } from "@nestjs/config";
+import { TypeOrmModule } from "@nestjs/typeorm";
+import { AuthService } from "./auth.service";
+import { ApiKeyService } from "./api-key.service";
+import { User } from "@/database/user.entity";
+import { AuthToken } from "@/database/auth-token.entity";
+import { ApiKey } from "@/database/api-key.entity";
+import { ExternalIdentity } from "@/database/external-identity.entity";
+import { MailModule } from "@/mail/mail.module";
+
+@Module({
+  imports: [
+    ConfigModule,
+    MailModule,
+    TypeOrmModule.forFeature([User, AuthToken, ApiKey, ExternalIdentity]),
+  ],
+  providers: [AuthService, ApiKeyService],
+  exports: [AuthService, ApiKeyService],
+})
+export class AuthModule {}
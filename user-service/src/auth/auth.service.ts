@@ -0,0 +1,634 @@
+import { Injectable, Logger } from "@nestjs/common";
+import { ConfigService } from "@nestjs/config";
+import { InjectRepository } from "@nestjs/typeorm";
+import { IsNull, LessThan, Repository } from "typeorm";
+import * as bcrypt from "bcrypt";
+import { createHash, randomBytes, randomUUID } from "crypto";
+import { User } from "@/database/user.entity";
+import { AuthToken, TokenKind } from "@/database/auth-token.entity";
+import { ExternalIdentity } from "@/database/external-identity.entity";
+import { Mailer } from "@/mail/mailer";
+import { tenantScope } from "@/tenant/tenant";
+import { toUserMessage } from "@/user/user.service";
+import {
+  SignupRequest,
+  SignupResponse,
+  LoginRequest,
+  AuthTokensResponse,
+  RefreshTokenRequest,
+  LogoutRequest,
+  LogoutResponse,
+  AuthenticateTokenRequest,
+  AuthenticateTokenResponse,
+  RequestEmailVerificationRequest,
+  VerifyEmailRequest,
+  RequestPasswordResetRequest,
+  ResetPasswordRequest,
+  AuthActionResponse,
+  LoginExternalRequest,
+} from "@/proto/user.pb";
+
+const MIN_PASSWORD_LENGTH = 8;
+// bcrypt ignores everything after the first 72 bytes
+const MAX_PASSWORD_BYTES = 72;
+
+export function hashToken(token: string): string {
+  return createHash("sha256").update(token).digest("hex");
+}
+
+function checkPassword(password: string): string | null {
+  if (!password || password.length < MIN_PASSWORD_LENGTH) {
+    return `Password must be at least ${MIN_PASSWORD_LENGTH} characters`;
+  }
+  if (Buffer.byteLength(password) > MAX_PASSWORD_BYTES) {
+    return `Password must be at most ${MAX_PASSWORD_BYTES} bytes`;
+  }
+  return null;
+}
+
+function failedTokens(message: string): AuthTokensResponse {
+  return {
+    user: undefined,
+    accessToken: "",
+    accessTokenExpiresAt: "",
+    refreshToken: "",
+    refreshTokenExpiresAt: "",
+    success: false,
+    message,
+  };
+}
+
+@Injectable()
+export class AuthService {
+  private readonly logger = new Logger(AuthService.name);
+
+  private readonly bcryptRounds: number;
+  private readonly accessTokenTtl: number;
+  private readonly refreshTokenTtl: number;
+  private readonly verificationTtl: number;
+  private readonly resetTtl: number;
+  private readonly linkBaseUrl: string;
+  // Compared against when the email is unknown, so a login takes as long
+  // whether or not the user exists
+  private readonly dummyHash: string;
+
+  constructor(
+    @InjectRepository(User)
+    private readonly userRepository: Repository<User>,
+    @InjectRepository(AuthToken)
+    private readonly tokenRepository: Repository<AuthToken>,
+    @InjectRepository(ExternalIdentity)
+    private readonly identityRepository: Repository<ExternalIdentity>,
+    private readonly mailer: Mailer,
+    configService: ConfigService
+  ) {
+    // Lifetimes are in seconds
+    this.bcryptRounds = Number(configService.get("BCRYPT_ROUNDS", 12));
+    this.accessTokenTtl = Number(configService.get("ACCESS_TOKEN_TTL", 900));
+    this.refreshTokenTtl = Number(
+      configService.get("REFRESH_TOKEN_TTL", 30 * 24 * 3600)
+    );
+    this.verificationTtl = Number(
+      configService.get("EMAIL_VERIFICATION_TTL", 24 * 3600)
+    );
+    this.resetTtl = Number(configService.get("PASSWORD_RESET_TTL", 3600));
+    this.linkBaseUrl = configService.get<string>(
+      "AUTH_LINK_BASE_URL",
+      "http://localhost:8000/api/auth"
+    );
+    this.dummyHash = bcrypt.hashSync(randomUUID(), this.bcryptRounds);
+  }
+
+  // issueToken stores the hash of a new random token and returns the token
+  private async issueToken(
+    userId: number,
+    kind: TokenKind,
+    ttlSeconds: number,
+    sessionId: string | null = null
+  ): Promise<{ token: string; expiresAt: Date }> {
+    const token = randomBytes(32).toString("base64url");
+    const expiresAt = new Date(Date.now() + ttlSeconds * 1000);
+    await this.tokenRepository.save(
+      this.tokenRepository.create({
+        userId,
+        kind,
+        tokenHash: hashToken(token),
+        sessionId,
+        expiresAt,
+      })
+    );
+    return { token, expiresAt };
+  }
+
+  // findToken returns the stored token of a kind, whether usable or not
+  private async findToken(
+    token: string,
+    kind: TokenKind
+  ): Promise<AuthToken | null> {
+    if (!token) {
+      return null;
+    }
+    return this.tokenRepository.findOne({
+      where: { tokenHash: hashToken(token), kind },
+    });
+  }
+
+  private usable(token: AuthToken | null): boolean {
+    return (
+      !!token &&
+      !token.usedAt &&
+      !token.revokedAt &&
+      token.expiresAt.getTime() > Date.now()
+    );
+  }
+
+  private async revokeSession(sessionId: string): Promise<number> {
+    const result = await this.tokenRepository.update(
+      { sessionId, revokedAt: IsNull() },
+      { revokedAt: new Date() }
+    );
+    return result.affected ?? 0;
+  }
+
+  // revokeUserTokens revokes every session and one-time token of a user
+  async revokeUserTokens(userId: number): Promise<number> {
+    const result = await this.tokenRepository.update(
+      { userId, revokedAt: IsNull() },
+      { revokedAt: new Date() }
+    );
+    return result.affected ?? 0;
+  }
+
+  // startSession issues the access and refresh token of a new or rotated
+  // session
+  private async startSession(
+    user: User,
+    sessionId: string,
+    message: string
+  ): Promise<AuthTokensResponse> {
+    const access = await this.issueToken(
+      user.id,
+      TokenKind.Access,
+      this.accessTokenTtl,
+      sessionId
+    );
+    const refresh = await this.issueToken(
+      user.id,
+      TokenKind.Refresh,
+      this.refreshTokenTtl,
+      sessionId
+    );
+    return {
+      user: toUserMessage(user),
+      accessToken: access.token,
+      accessTokenExpiresAt: access.expiresAt.toISOString(),
+      refreshToken: refresh.token,
+      refreshTokenExpiresAt: refresh.expiresAt.toISOString(),
+      success: true,
+      message,
+    };
+  }
+
+  private async sendVerificationMail(user: User): Promise<void> {
+    const { token } = await this.issueToken(
+      user.id,
+      TokenKind.EmailVerification,
+      this.verificationTtl
+    );
+    await this.mailer.send({
+      to: user.email,
+      subject: "Verify your email address",
+      text: [
+        `Hello ${user.name},`,
+        "",
+        "Confirm your email address by opening this link:",
+        `${this.linkBaseUrl}/verify-email?token=${token}`,
+        "",
+        `The link expires in ${Math.round(this.verificationTtl / 3600)} hours.`,
+      ].join("\n"),
+    });
+  }
+
+  async signup(request: SignupRequest): Promise<SignupResponse> {
+    try {
+      const problem = checkPassword(request.password);
+      if (problem) {
+        return { user: undefined, success: false, message: problem };
+      }
+
+      const existingUser = await this.userRepository.findOne({
+        where: { email: request.email, ...tenantScope() },
+        withDeleted: true,
+      });
+      if (existingUser) {
+        return {
+          user: undefined,
+          success: false,
+          message: "Email already exists",
+        };
+      }
+
+      const user = await this.userRepository.save(
+        this.userRepository.create({
+          name: request.name,
+          email: request.email,
+          age: request.age,
+          roles: ["customer"],
+          passwordHash: await bcrypt.hash(request.password, this.bcryptRounds),
+          ...tenantScope(),
+        })
+      );
+      this.logger.log(`Signed up user: ${user.id}`);
+
+      await this.sendVerificationMail(user);
+
+      return {
+        user: toUserMessage(user),
+        success: true,
+        message: "Signed up; check your email to verify your address",
+      };
+    } catch (error) {
+      this.logger.error(`Error signing up: ${error.message}`);
+      return { user: undefined, success: false, message: "Internal error" };
+    }
+  }
+
+  async login(request: LoginRequest): Promise<AuthTokensResponse> {
+    try {
+      const user = await this.userRepository.findOne({
+        where: { email: request.email, ...tenantScope() },
+      });
+
+      const hash = user?.passwordHash ?? this.dummyHash;
+      const matches = await bcrypt.compare(request.password ?? "", hash);
+      if (!user || !user.passwordHash || !matches) {
+        return failedTokens("Invalid email or password");
+      }
+
+      // Expired tokens of the user are no longer needed for reuse detection
+      await this.tokenRepository.delete({
+        userId: user.id,
+        expiresAt: LessThan(new Date()),
+      });
+
+      this.logger.log(`User logged in: ${user.id}`);
+      return this.startSession(user, randomUUID(), "Logged in successfully");
+    } catch (error) {
+      this.logger.error(`Error logging in: ${error.message}`);
+      return failedTokens("Internal error");
+    }
+  }
+
+  async refreshToken(
+    request: RefreshTokenRequest
+  ): Promise<AuthTokensResponse> {
+    try {
+      const stored = await this.findToken(
+        request.refreshToken,
+        TokenKind.Refresh
+      );
+      if (!stored || stored.revokedAt) {
+        return failedTokens("Invalid refresh token");
+      }
+      if (stored.usedAt) {
+        // A rotated token came back: someone holds a copy of it. Revoke the
+        // session so neither copy can be refreshed again.
+        await this.revokeSession(stored.sessionId);
+        this.logger.warn(
+          `Refresh token reuse for user ${stored.userId}; revoked session ${stored.sessionId}`
+        );
+        return failedTokens("Refresh token reuse detected; session revoked");
+      }
+      if (stored.expiresAt.getTime() <= Date.now()) {
+        return failedTokens("Refresh token expired");
+      }
+
+      const user = await this.userRepository.findOne({
+        where: { id: stored.userId, ...tenantScope() },
+      });
+      if (!user) {
+        return failedTokens("Invalid refresh token");
+      }
+
+      stored.usedAt = new Date();
+      await this.tokenRepository.save(stored);
+
+      return this.startSession(
+        user,
+        stored.sessionId,
+        "Tokens refreshed successfully"
+      );
+    } catch (error) {
+      this.logger.error(`Error refreshing token: ${error.message}`);
+      return failedTokens("Internal error");
+    }
+  }
+
+  async logout(request: LogoutRequest): Promise<LogoutResponse> {
+    try {
+      const stored =
+        (await this.findToken(request.token, TokenKind.Access)) ??
+        (await this.findToken(request.token, TokenKind.Refresh));
+      if (!stored) {
+        return { success: false, message: "Invalid token", revoked: 0 };
+      }
+
+      const revoked = request.allSessions
+        ? await this.revokeUserTokens(stored.userId)
+        : await this.revokeSession(stored.sessionId);
+      this.logger.log(`User logged out: ${stored.userId}`);
+
+      return { success: true, message: "Logged out successfully", revoked };
+    } catch (error) {
+      this.logger.error(`Error logging out: ${error.message}`);
+      return { success: false, message: "Internal error", revoked: 0 };
+    }
+  }
+
+  async authenticateToken(
+    request: AuthenticateTokenRequest
+  ): Promise<AuthenticateTokenResponse> {
+    try {
+      const stored = await this.findToken(
+        request.accessToken,
+        TokenKind.Access
+      );
+      if (!this.usable(stored)) {
+        return {
+          user: undefined,
+          valid: false,
+          message: "Invalid or expired access token",
+        };
+      }
+
+      const user = await this.userRepository.findOne({
+        where: { id: stored.userId, ...tenantScope() },
+      });
+      if (!user) {
+        return { user: undefined, valid: false, message: "User not found" };
+      }
+
+      return { user: toUserMessage(user), valid: true, message: "" };
+    } catch (error) {
+      this.logger.error(`Error authenticating token: ${error.message}`);
+      return { user: undefined, valid: false, message: "Internal error" };
+    }
+  }
+
+  async requestEmailVerification(
+    request: RequestEmailVerificationRequest
+  ): Promise<AuthActionResponse> {
+    const response = {
+      success: true,
+      message:
+        "If the email belongs to an unverified user, a link is on its way",
+      user: undefined,
+    };
+    try {
+      const user = await this.userRepository.findOne({
+        where: { email: request.email, ...tenantScope() },
+      });
+      if (user && !user.emailVerifiedAt) {
+        await this.sendVerificationMail(user);
+      }
+      return response;
+    } catch (error) {
+      this.logger.error(`Error sending verification mail: ${error.message}`);
+      return { success: false, message: "Internal error", user: undefined };
+    }
+  }
+
+  async verifyEmail(request: VerifyEmailRequest): Promise<AuthActionResponse> {
+    try {
+      const stored = await this.findToken(
+        request.token,
+        TokenKind.EmailVerification
+      );
+      if (!this.usable(stored)) {
+        return {
+          success: false,
+          message: "Invalid or expired verification link",
+          user: undefined,
+        };
+      }
+
+      const user = await this.userRepository.findOne({
+        where: { id: stored.userId, ...tenantScope() },
+      });
+      if (!user) {
+        return { success: false, message: "User not found", user: undefined };
+      }
+
+      stored.usedAt = new Date();
+      await this.tokenRepository.save(stored);
+      if (!user.emailVerifiedAt) {
+        user.emailVerifiedAt = new Date();
+        await this.userRepository.save(user);
+      }
+      this.logger.log(`Verified email of user: ${user.id}`);
+
+      return {
+        success: true,
+        message: "Email verified successfully",
+        user: toUserMessage(user),
+      };
+    } catch (error) {
+      this.logger.error(`Error verifying email: ${error.message}`);
+      return { success: false, message: "Internal error", user: undefined };
+    }
+  }
+
+  async requestPasswordReset(
+    request: RequestPasswordResetRequest
+  ): Promise<AuthActionResponse> {
+    const response = {
+      success: true,
+      message: "If the email belongs to a user, a reset link is on its way",
+      user: undefined,
+    };
+    try {
+      const user = await this.userRepository.findOne({
+        where: { email: request.email, ...tenantScope() },
+      });
+      if (!user) {
+        return response;
+      }
+
+      const { token } = await this.issueToken(
+        user.id,
+        TokenKind.PasswordReset,
+        this.resetTtl
+      );
+      await this.mailer.send({
+        to: user.email,
+        subject: "Reset your password",
+        text: [
+          `Hello ${user.name},`,
+          "",
+          "Someone asked to reset the password of your account. If it was you,",
+          "send this token with your new password to",
+          `${this.linkBaseUrl}/password-reset/confirm:`,
+          "",
+          token,
+          "",
+          `The token expires in ${Math.round(this.resetTtl / 60)} minutes.`,
+          "If you did not ask for a reset, ignore this email.",
+        ].join("\n"),
+      });
+      this.logger.log(`Sent password reset to user: ${user.id}`);
+      return response;
+    } catch (error) {
+      this.logger.error(`Error requesting password reset: ${error.message}`);
+      return { success: false, message: "Internal error", user: undefined };
+    }
+  }
+
+  async resetPassword(
+    request: ResetPasswordRequest
+  ): Promise<AuthActionResponse> {
+    try {
+      const problem = checkPassword(request.newPassword);
+      if (problem) {
+        return { success: false, message: problem, user: undefined };
+      }
+
+      const stored = await this.findToken(
+        request.token,
+        TokenKind.PasswordReset
+      );
+      if (!this.usable(stored)) {
+        return {
+          success: false,
+          message: "Invalid or expired reset token",
+          user: undefined,
+        };
+      }
+
+      const user = await this.userRepository.findOne({
+        where: { id: stored.userId, ...tenantScope() },
+      });
+      if (!user) {
+        return { success: false, message: "User not found", user: undefined };
+      }
+
+      user.passwordHash = await bcrypt.hash(
+        request.newPassword,
+        this.bcryptRounds
+      );
+      // The reset link reached the user's inbox, which proves the address
+      user.emailVerifiedAt = user.emailVerifiedAt ?? new Date();
+      await this.userRepository.save(user);
+
+      // Sign out everywhere: whoever knew the old password loses access
+      await this.revokeUserTokens(user.id);
+      this.logger.log(`Reset password of user: ${user.id}`);
+
+      return {
+        success: true,
+        message: "Password reset successfully",
+        user: toUserMessage(user),
+      };
+    } catch (error) {
+      this.logger.error(`Error resetting password: ${error.message}`);
+      return { success: false, message: "Internal error", user: undefined };
+    }
+  }
+
+  async loginExternal(
+    request: LoginExternalRequest
+  ): Promise<AuthTokensResponse> {
+    try {
+      if (!request.provider || !request.subject) {
+        return failedTokens("Provider and subject are required");
+      }
+
+      let identity = await this.identityRepository.findOne({
+        where: {
+          provider: request.provider,
+          subject: request.subject,
+          ...tenantScope(),
+        },
+      });
+      let user: User | null;
+      if (identity) {
+        user = await this.userRepository.findOne({
+          where: { id: identity.userId, ...tenantScope() },
+        });
+        if (!user) {
+          return failedTokens("The linked user has been deleted");
+        }
+      } else {
+        if (!request.email) {
+          return failedTokens("The provider did not share an email address");
+        }
+        user = await this.userRepository.findOne({
+          where: { email: request.email, ...tenantScope() },
+          withDeleted: true,
+        });
+        if (user) {
+          // Linking trusts the provider with the account, so it needs the
+          // provider to vouch for the address and to be allowed to link
+          if (!request.linkByEmail || !request.emailVerified) {
+            return failedTokens(
+              "Email already belongs to an account that is not linked to this provider"
+            );
+          }
+          if (user.deletedAt) {
+            return failedTokens("The matching user has been deleted");
+          }
+        } else {
+          user = await this.userRepository.save(
+            this.userRepository.create({
+              name: request.name || request.email.split("@")[0],
+              email: request.email,
+              age: 0,
+              roles: ["customer"],
+              emailVerifiedAt: request.emailVerified ? new Date() : null,
+              ...tenantScope(),
+            })
+          );
+          this.logger.log(
+            `Created user ${user.id} from ${request.provider} login`
+          );
+        }
+        identity = this.identityRepository.create({
+          userId: user.id,
+          provider: request.provider,
+          subject: request.subject,
+          roles: [],
+          ...tenantScope(),
+        });
+      }
+
+      // Roles follow the provider's groups: grant the mapped roles and revoke
+      // those an earlier login granted that the groups no longer map to
+      const granted = [...new Set(request.roles ?? [])].sort();
+      const dropped = (identity.roles ?? []).filter(
+        (role) => !granted.includes(role)
+      );
+      const roles = new Set(
+        (user.roles ?? []).filter((role) => !dropped.includes(role))
+      );
+      granted.forEach((role) => roles.add(role));
+      if (roles.size === 0) {
+        roles.add("customer");
+      }
+      user.roles = [...roles].sort();
+      if (request.emailVerified && user.email === request.email) {
+        user.emailVerifiedAt = user.emailVerifiedAt ?? new Date();
+      }
+      user = await this.userRepository.save(user);
+
+      identity.email = request.email || null;
+      identity.roles = granted;
+      identity.lastLoginAt = new Date();
+      await this.identityRepository.save(identity);
+
+      this.logger.log(`User logged in via ${request.provider}: ${user.id}`);
+      return this.startSession(user, randomUUID(), "Logged in successfully");
+    } catch (error) {
+      this.logger.error(`Error logging in externally: ${error.message}`);
+      return failedTokens("Internal error");
+    }
+  }
+}
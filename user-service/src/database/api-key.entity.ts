@@ -0,0 +1,46 @@
+import {
+  Entity,
+  PrimaryGeneratedColumn,
+  Column,
+  CreateDateColumn,
+  Index,
+} from 'typeorm';
+
+// Keys machine clients such as scanners and ERP integrations authenticate
+// with. Like auth tokens, only a SHA-256 hash of the key is stored.
+@Entity('api_keys')
+export class ApiKey {
+  @PrimaryGeneratedColumn()
+  id: number;
+
+  // The key acts as this user, limited to its scopes
+  @Index()
+  @Column({ name: 'user_id' })
+  userId: number;
+
+  @Column()
+  name: string;
+
+  // Start of the key, shown in listings so keys can be told apart
+  @Column()
+  prefix: string;
+
+  @Index({ unique: true })
+  @Column({ name: 'key_hash' })
+  keyHash: string;
+
+  @Column({ type: 'simple-array' })
+  scopes: string[];
+
+  @Column({ name: 'expires_at', type: 'datetime', nullable: true })
+  expiresAt: Date | null;
+
+  @Column({ name: 'last_used_at', type: 'datetime', nullable: true })
+  lastUsedAt: Date | null;
+
+  @Column({ name: 'revoked_at', type: 'datetime', nullable: true })
+  revokedAt: Date | null;
+
+  @CreateDateColumn({ name: 'created_at' })
+  createdAt: Date;
+}
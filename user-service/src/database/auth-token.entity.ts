@@ -0,0 +1,52 @@
+import {
+  Entity,
+  PrimaryGeneratedColumn,
+  Column,
+  CreateDateColumn,
+  Index,
+} from 'typeorm';
+
+export enum TokenKind {
+  Access = 'access',
+  Refresh = 'refresh',
+  EmailVerification = 'email_verification',
+  PasswordReset = 'password_reset',
+}
+
+// Tokens handed out to users. Only a SHA-256 hash is stored, so a leaked
+// database does not leak usable tokens.
+@Entity('auth_tokens')
+export class AuthToken {
+  @PrimaryGeneratedColumn()
+  id: number;
+
+  @Index()
+  @Column({ name: 'user_id' })
+  userId: number;
+
+  @Column({ type: 'varchar' })
+  kind: TokenKind;
+
+  @Index({ unique: true })
+  @Column({ name: 'token_hash' })
+  tokenHash: string;
+
+  // Access and refresh tokens issued by one login share a session, so a
+  // detected refresh token reuse can revoke all of them
+  @Index()
+  @Column({ name: 'session_id', type: 'varchar', nullable: true })
+  sessionId: string | null;
+
+  @Column({ name: 'expires_at', type: 'datetime' })
+  expiresAt: Date;
+
+  // Set when a refresh token is rotated or a one-time token is redeemed
+  @Column({ name: 'used_at', type: 'datetime', nullable: true })
+  usedAt: Date | null;
+
+  @Column({ name: 'revoked_at', type: 'datetime', nullable: true })
+  revokedAt: Date | null;
+
+  @CreateDateColumn({ name: 'created_at' })
+  createdAt: Date;
+}
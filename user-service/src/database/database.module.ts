@@ -2,6 +2,9 @@ import { Module } from '@nestjs/common';
 import { TypeOrmModule } from '@nestjs/typeorm';
 import { ConfigModule, ConfigService } from '@nestjs/config';
 import { User } from './user.entity';
+import { AuthToken } from './auth-token.entity';
+import { ApiKey } from './api-key.entity';
+import { ExternalIdentity } from './external-identity.entity';
 
 @Module({
   imports: [
@@ -10,13 +13,13 @@ import { User } from './user.entity';
       useFactory: (configService: ConfigService) => ({
         type: 'sqlite',
         database: configService.get<string>('DATABASE_URL', 'users.db'),
-        entities: [User],
+        entities: [User, AuthToken, ApiKey, ExternalIdentity],
         synchronize: true,
         logging: false,
       }),
       inject: [ConfigService],
     }),
-    TypeOrmModule.forFeature([User]),
+    TypeOrmModule.forFeature([User, AuthToken, ApiKey, ExternalIdentity]),
   ],
   exports: [TypeOrmModule],
 })
@@ -0,0 +1,46 @@
+import {
+  Entity,
+  PrimaryGeneratedColumn,
+  Column,
+  CreateDateColumn,
+  Index,
+} from 'typeorm';
+
+// Links a user to their account at an external identity provider; an account
+// can sign in to each storefront, as a user of that storefront
+@Entity('external_identities')
+@Index(['tenantId', 'provider', 'subject'], { unique: true })
+export class ExternalIdentity {
+  @PrimaryGeneratedColumn()
+  id: number;
+
+  @Index()
+  @Column({ name: 'user_id' })
+  userId: number;
+
+  @Column({ name: 'tenant_id', default: 'default' })
+  tenantId: string;
+
+  // Name of the provider in the gateway's configuration
+  @Column()
+  provider: string;
+
+  // The user's stable ID at the provider
+  @Column()
+  subject: string;
+
+  // Email the provider last reported, for reference
+  @Column({ type: 'varchar', nullable: true })
+  email: string | null;
+
+  // Roles granted from the provider's groups at the last login, so roles
+  // the provider stops granting can be revoked
+  @Column({ type: 'simple-array', default: '' })
+  roles: string[];
+
+  @Column({ name: 'last_login_at', type: 'datetime', nullable: true })
+  lastLoginAt: Date | null;
+
+  @CreateDateColumn({ name: 'created_at' })
+  createdAt: Date;
+}
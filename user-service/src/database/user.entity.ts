@@ -3,9 +3,13 @@ import {
   PrimaryGeneratedColumn,
   Column,
   CreateDateColumn,
+  DeleteDateColumn,
+  Index,
 } from 'typeorm';
 
+// Emails are unique per storefront, so one person can shop at several
 @Entity('users')
+@Index(['tenantId', 'email'], { unique: true })
 export class User {
   @PrimaryGeneratedColumn()
   id: number;
@@ -13,7 +17,7 @@ export class User {
   @Column()
   name: string;
 
-  @Column({ unique: true })
+  @Column()
   email: string;
 
   @Column()
@@ -21,4 +25,29 @@ export class User {
 
   @CreateDateColumn({ name: 'created_at' })
   createdAt: Date;
+
+  // Set by soft delete; soft-deleted users are hidden from queries unless
+  // they ask for deleted rows
+  @DeleteDateColumn({ name: 'deleted_at', nullable: true })
+  deletedAt: Date | null;
+
+  // Set once the personal fields have been anonymized on request of the user
+  @Column({ name: 'erased_at', type: 'datetime', nullable: true })
+  erasedAt: Date | null;
+
+  // Role names, stored comma separated; what each role may do is decided by
+  // the API Gateway's policy
+  @Column({ type: 'simple-array', default: 'customer' })
+  roles: string[];
+
+  // bcrypt hash; users created without a password set one by password reset
+  @Column({ name: 'password_hash', type: 'varchar', nullable: true })
+  passwordHash: string | null;
+
+  @Column({ name: 'email_verified_at', type: 'datetime', nullable: true })
+  emailVerifiedAt: Date | null;
+
+  // Storefront the user signed up at
+  @Column({ name: 'tenant_id', default: 'default' })
+  tenantId: string;
 }
\ No newline at end of file
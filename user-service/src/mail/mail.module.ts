@@ -0,0 +1,32 @@
+import { Module } from "@nestjs/common";
+import { ConfigModule, ConfigService } from "@nestjs/config";
+import { ConsoleMailer, FileMailer, Mailer } from "./mailer";
+
+@Module({
+  imports: [ConfigModule],
+  providers: [
+    {
+      provide: Mailer,
+      useFactory: (configService: ConfigService): Mailer => {
+        const from = configService.get<string>(
+          "MAIL_FROM",
+          "no-reply@example.com"
+        );
+        switch (configService.get<string>("MAILER", "console")) {
+          case "file":
+            return new FileMailer(
+              from,
+              configService.get<string>("MAIL_DIR", "mail")
+            );
+          case "console":
+            return new ConsoleMailer(from);
+          default:
+            throw new Error("MAILER must be console or file");
+        }
+      },
+      inject: [ConfigService],
+    },
+  ],
+  exports: [Mailer],
+})
+export class MailModule {}
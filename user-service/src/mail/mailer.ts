@@ -0,0 +1,60 @@
+import { Logger } from "@nestjs/common";
+import { promises as fs } from "fs";
+import { join } from "path";
+
+export interface MailMessage {
+  to: string;
+  subject: string;
+  text: string;
+}
+
+// Delivers account emails such as verification and password reset links.
+// Implementations for a real mail provider plug in through MailModule.
+export abstract class Mailer {
+  abstract send(message: MailMessage): Promise<void>;
+}
+
+// ConsoleMailer logs emails instead of sending them, for development
+export class ConsoleMailer extends Mailer {
+  private readonly logger = new Logger(ConsoleMailer.name);
+
+  constructor(private readonly from: string) {
+    super();
+  }
+
+  async send(message: MailMessage): Promise<void> {
+    this.logger.log(
+      `Mail from ${this.from} to ${message.to}: ${message.subject}\n${message.text}`
+    );
+  }
+}
+
+// FileMailer writes each email to a file in a directory, for development and
+// tests that need to read the links back
+export class FileMailer extends Mailer {
+  private readonly logger = new Logger(FileMailer.name);
+
+  constructor(
+    private readonly from: string,
+    private readonly dir: string
+  ) {
+    super();
+  }
+
+  async send(message: MailMessage): Promise<void> {
+    await fs.mkdir(this.dir, { recursive: true });
+    const recipient = message.to.replace(/[^a-zA-Z0-9@._-]/g, "_");
+    const path = join(this.dir, `${Date.now()}-${recipient}.eml`);
+    const content = [
+      `From: ${this.from}`,
+      `To: ${message.to}`,
+      `Subject: ${message.subject}`,
+      `Date: ${new Date().toUTCString()}`,
+      "",
+      message.text,
+      "",
+    ].join("\r\n");
+    await fs.writeFile(path, content);
+    this.logger.log(`Wrote mail to ${message.to} to ${path}`);
+  }
+}
@@ -1,6 +1,7 @@
 import { NestFactory } from "@nestjs/core";
 import { MicroserviceOptions, Transport } from "@nestjs/microservices";
 import { AppModule } from "./app.module";
+import { TenantInterceptor } from "./tenant/tenant";
 import { Logger } from "@nestjs/common";
 import { join } from "path";
 
@@ -21,6 +22,9 @@ async function bootstrap() {
     grpcConfig as MicroserviceOptions
   );
 
+  // Scope every call to the storefront the API Gateway names
+  app.useGlobalInterceptors(new TenantInterceptor());
+
   await app.listen();
   logger.log(
     `User Service is listening on port ${process.env.GRPC_PORT || 50051}`
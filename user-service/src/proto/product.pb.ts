@@ -17,6 +17,8 @@ export interface Product {
   price: number;
   userId: number;
   createdAt: string;
+  currency: string;
+  taxCategory: string;
 }
 
 export interface CreateProductRequest {
@@ -24,6 +26,8 @@ export interface CreateProductRequest {
   description: string;
   price: number;
   userId: number;
+  currency: string;
+  taxCategory: string;
 }
 
 export interface CreateProductResponse {
@@ -46,6 +50,8 @@ export interface UpdateProductRequest {
   name: string;
   description: string;
   price: number;
+  currency: string;
+  taxCategory: string;
 }
 
 export interface UpdateProductResponse {
@@ -16,6 +16,19 @@ export interface User {
   email: string;
   age: number;
   createdAt: string;
+  /** Set while the user is soft deleted */
+  deletedAt: string;
+  /** Set once the user's personal data has been erased */
+  erasedAt: string;
+  /**
+   * Roles such as customer, seller or admin; the API Gateway maps them to
+   * permissions
+   */
+  roles: string[];
+  /** Set once the user has confirmed their email address */
+  emailVerifiedAt: string;
+  /** Storefront the user belongs to; users sign in to their own storefront only */
+  tenantId: string;
 }
 
 export interface CreateUserRequest {
@@ -32,6 +45,7 @@ export interface CreateUserResponse {
 
 export interface GetUserRequest {
   userId: number;
+  includeDeleted: boolean;
 }
 
 export interface GetUserResponse {
@@ -59,11 +73,17 @@ export interface DeleteUserRequest {
 export interface DeleteUserResponse {
   success: boolean;
   message: string;
+  /**
+   * The deleted user, carrying the deleted_at its products and orders are
+   * cascaded with
+   */
+  user: User | undefined;
 }
 
 export interface ListUsersRequest {
   page: number;
   limit: number;
+  includeDeleted: boolean;
 }
 
 export interface ListUsersResponse {
@@ -73,6 +93,245 @@ export interface ListUsersResponse {
   limit: number;
 }
 
+export interface RestoreUserRequest {
+  userId: number;
+}
+
+export interface RestoreUserResponse {
+  user: User | undefined;
+  success: boolean;
+  message: string;
+}
+
+/** Hard-deletes users soft deleted before the given RFC 3339 time */
+export interface PurgeDeletedUsersRequest {
+  deletedBefore: string;
+}
+
+export interface PurgeDeletedUsersResponse {
+  userIds: number[];
+}
+
+/**
+ * Anonymizes the personal fields of a user, deleted or not. The row and its
+ * ID stay so orders keep pointing at it.
+ */
+export interface EraseUserRequest {
+  userId: number;
+}
+
+export interface EraseUserResponse {
+  user: User | undefined;
+  success: boolean;
+  message: string;
+}
+
+/**
+ * Grants and revokes roles of a user in one step. Role names are validated by
+ * the caller; revoking a role the user does not hold is not an error.
+ */
+export interface UpdateUserRolesRequest {
+  userId: number;
+  grant: string[];
+  revoke: string[];
+}
+
+export interface UpdateUserRolesResponse {
+  user: User | undefined;
+  success: boolean;
+  message: string;
+}
+
+/** Creates a user with a password and mails them an email verification link */
+export interface SignupRequest {
+  name: string;
+  email: string;
+  age: number;
+  password: string;
+}
+
+export interface SignupResponse {
+  user: User | undefined;
+  success: boolean;
+  message: string;
+}
+
+export interface LoginRequest {
+  email: string;
+  password: string;
+}
+
+/**
+ * Tokens of a session. The access token authenticates requests until it
+ * expires; the refresh token is exchanged once for a new pair.
+ */
+export interface AuthTokensResponse {
+  user: User | undefined;
+  accessToken: string;
+  accessTokenExpiresAt: string;
+  refreshToken: string;
+  refreshTokenExpiresAt: string;
+  success: boolean;
+  message: string;
+}
+
+/**
+ * Rotates a refresh token. Presenting a refresh token that was already
+ * rotated revokes the whole session, as it has probably been stolen.
+ */
+export interface RefreshTokenRequest {
+  refreshToken: string;
+}
+
+/**
+ * Revokes the session of the given access or refresh token, or every session
+ * of its user with all_sessions
+ */
+export interface LogoutRequest {
+  token: string;
+  allSessions: boolean;
+}
+
+export interface LogoutResponse {
+  success: boolean;
+  message: string;
+  /** Number of tokens revoked */
+  revoked: number;
+}
+
+export interface AuthenticateTokenRequest {
+  accessToken: string;
+}
+
+export interface AuthenticateTokenResponse {
+  user: User | undefined;
+  valid: boolean;
+  message: string;
+}
+
+/**
+ * Mails a new verification link. The response does not reveal whether the
+ * email belongs to a user.
+ */
+export interface RequestEmailVerificationRequest {
+  email: string;
+}
+
+export interface VerifyEmailRequest {
+  token: string;
+}
+
+/**
+ * Mails a password reset link. The response does not reveal whether the
+ * email belongs to a user.
+ */
+export interface RequestPasswordResetRequest {
+  email: string;
+}
+
+/** Sets a new password and revokes every session of the user */
+export interface ResetPasswordRequest {
+  token: string;
+  newPassword: string;
+}
+
+export interface AuthActionResponse {
+  success: boolean;
+  message: string;
+  user: User | undefined;
+}
+
+/**
+ * An API key lets a machine client act as its user, limited to the key's
+ * scopes. The key itself is only returned when it is created.
+ */
+export interface ApiKey {
+  id: number;
+  userId: number;
+  name: string;
+  /** First characters of the key, to tell keys apart */
+  prefix: string;
+  /**
+   * Scopes such as inventory:write or orders:read; the API Gateway enforces
+   * them
+   */
+  scopes: string[];
+  createdAt: string;
+  /** Empty for keys that never expire */
+  expiresAt: string;
+  lastUsedAt: string;
+  revokedAt: string;
+}
+
+export interface CreateApiKeyRequest {
+  userId: number;
+  name: string;
+  scopes: string[];
+  /** RFC 3339 time; empty for a key that never expires */
+  expiresAt: string;
+}
+
+export interface ApiKeyResponse {
+  success: boolean;
+  message: string;
+  apiKey:
+    | ApiKey
+    | undefined;
+  /** The full key, set only by CreateApiKey */
+  key: string;
+}
+
+export interface ListApiKeysRequest {
+  userId: number;
+  includeRevoked: boolean;
+}
+
+export interface ListApiKeysResponse {
+  apiKeys: ApiKey[];
+}
+
+export interface RevokeApiKeyRequest {
+  userId: number;
+  id: number;
+}
+
+/** Resolves a key to its user and records that it was used */
+export interface AuthenticateApiKeyRequest {
+  key: string;
+}
+
+export interface AuthenticateApiKeyResponse {
+  user: User | undefined;
+  apiKey: ApiKey | undefined;
+  valid: boolean;
+  message: string;
+}
+
+/**
+ * Signs in a user verified by an external identity provider, linking the
+ * provider's subject to a user on first login and creating the user when
+ * none can be linked. The caller has already verified the identity.
+ */
+export interface LoginExternalRequest {
+  /** Name of the provider in the gateway's configuration */
+  provider: string;
+  /** The user's stable ID at the provider */
+  subject: string;
+  email: string;
+  emailVerified: boolean;
+  name: string;
+  /**
+   * Roles the provider's groups map to. Roles granted by an earlier login
+   * and missing now are revoked.
+   */
+  roles: string[];
+  /**
+   * Link to an existing user with the same email when the provider
+   * verified it
+   */
+  linkByEmail: boolean;
+}
+
 export const USER_PACKAGE_NAME = "user";
 
 export interface UserServiceClient {
@@ -85,6 +344,42 @@ export interface UserServiceClient {
   deleteUser(request: DeleteUserRequest): Observable<DeleteUserResponse>;
 
   listUsers(request: ListUsersRequest): Observable<ListUsersResponse>;
+
+  restoreUser(request: RestoreUserRequest): Observable<RestoreUserResponse>;
+
+  purgeDeletedUsers(request: PurgeDeletedUsersRequest): Observable<PurgeDeletedUsersResponse>;
+
+  eraseUser(request: EraseUserRequest): Observable<EraseUserResponse>;
+
+  updateUserRoles(request: UpdateUserRolesRequest): Observable<UpdateUserRolesResponse>;
+
+  signup(request: SignupRequest): Observable<SignupResponse>;
+
+  login(request: LoginRequest): Observable<AuthTokensResponse>;
+
+  refreshToken(request: RefreshTokenRequest): Observable<AuthTokensResponse>;
+
+  logout(request: LogoutRequest): Observable<LogoutResponse>;
+
+  authenticateToken(request: AuthenticateTokenRequest): Observable<AuthenticateTokenResponse>;
+
+  requestEmailVerification(request: RequestEmailVerificationRequest): Observable<AuthActionResponse>;
+
+  verifyEmail(request: VerifyEmailRequest): Observable<AuthActionResponse>;
+
+  requestPasswordReset(request: RequestPasswordResetRequest): Observable<AuthActionResponse>;
+
+  resetPassword(request: ResetPasswordRequest): Observable<AuthActionResponse>;
+
+  createApiKey(request: CreateApiKeyRequest): Observable<ApiKeyResponse>;
+
+  listApiKeys(request: ListApiKeysRequest): Observable<ListApiKeysResponse>;
+
+  revokeApiKey(request: RevokeApiKeyRequest): Observable<ApiKeyResponse>;
+
+  authenticateApiKey(request: AuthenticateApiKeyRequest): Observable<AuthenticateApiKeyResponse>;
+
+  loginExternal(request: LoginExternalRequest): Observable<AuthTokensResponse>;
 }
 
 export interface UserServiceController {
@@ -103,11 +398,95 @@ export interface UserServiceController {
   ): Promise<DeleteUserResponse> | Observable<DeleteUserResponse> | DeleteUserResponse;
 
   listUsers(request: ListUsersRequest): Promise<ListUsersResponse> | Observable<ListUsersResponse> | ListUsersResponse;
+
+  restoreUser(
+    request: RestoreUserRequest,
+  ): Promise<RestoreUserResponse> | Observable<RestoreUserResponse> | RestoreUserResponse;
+
+  purgeDeletedUsers(
+    request: PurgeDeletedUsersRequest,
+  ): Promise<PurgeDeletedUsersResponse> | Observable<PurgeDeletedUsersResponse> | PurgeDeletedUsersResponse;
+
+  eraseUser(request: EraseUserRequest): Promise<EraseUserResponse> | Observable<EraseUserResponse> | EraseUserResponse;
+
+  updateUserRoles(
+    request: UpdateUserRolesRequest,
+  ): Promise<UpdateUserRolesResponse> | Observable<UpdateUserRolesResponse> | UpdateUserRolesResponse;
+
+  signup(request: SignupRequest): Promise<SignupResponse> | Observable<SignupResponse> | SignupResponse;
+
+  login(request: LoginRequest): Promise<AuthTokensResponse> | Observable<AuthTokensResponse> | AuthTokensResponse;
+
+  refreshToken(
+    request: RefreshTokenRequest,
+  ): Promise<AuthTokensResponse> | Observable<AuthTokensResponse> | AuthTokensResponse;
+
+  logout(request: LogoutRequest): Promise<LogoutResponse> | Observable<LogoutResponse> | LogoutResponse;
+
+  authenticateToken(
+    request: AuthenticateTokenRequest,
+  ): Promise<AuthenticateTokenResponse> | Observable<AuthenticateTokenResponse> | AuthenticateTokenResponse;
+
+  requestEmailVerification(
+    request: RequestEmailVerificationRequest,
+  ): Promise<AuthActionResponse> | Observable<AuthActionResponse> | AuthActionResponse;
+
+  verifyEmail(
+    request: VerifyEmailRequest,
+  ): Promise<AuthActionResponse> | Observable<AuthActionResponse> | AuthActionResponse;
+
+  requestPasswordReset(
+    request: RequestPasswordResetRequest,
+  ): Promise<AuthActionResponse> | Observable<AuthActionResponse> | AuthActionResponse;
+
+  resetPassword(
+    request: ResetPasswordRequest,
+  ): Promise<AuthActionResponse> | Observable<AuthActionResponse> | AuthActionResponse;
+
+  createApiKey(request: CreateApiKeyRequest): Promise<ApiKeyResponse> | Observable<ApiKeyResponse> | ApiKeyResponse;
+
+  listApiKeys(
+    request: ListApiKeysRequest,
+  ): Promise<ListApiKeysResponse> | Observable<ListApiKeysResponse> | ListApiKeysResponse;
+
+  revokeApiKey(request: RevokeApiKeyRequest): Promise<ApiKeyResponse> | Observable<ApiKeyResponse> | ApiKeyResponse;
+
+  authenticateApiKey(
+    request: AuthenticateApiKeyRequest,
+  ): Promise<AuthenticateApiKeyResponse> | Observable<AuthenticateApiKeyResponse> | AuthenticateApiKeyResponse;
+
+  loginExternal(
+    request: LoginExternalRequest,
+  ): Promise<AuthTokensResponse> | Observable<AuthTokensResponse> | AuthTokensResponse;
 }
 
 export function UserServiceControllerMethods() {
   return function (constructor: Function) {
-    const grpcMethods: string[] = ["createUser", "getUser", "updateUser", "deleteUser", "listUsers"];
+    const grpcMethods: string[] = [
+      "createUser",
+      "getUser",
+      "updateUser",
+      "deleteUser",
+      "listUsers",
+      "restoreUser",
+      "purgeDeletedUsers",
+      "eraseUser",
+      "updateUserRoles",
+      "signup",
+      "login",
+      "refreshToken",
+      "logout",
+      "authenticateToken",
+      "requestEmailVerification",
+      "verifyEmail",
+      "requestPasswordReset",
+      "resetPassword",
+      "createApiKey",
+      "listApiKeys",
+      "revokeApiKey",
+      "authenticateApiKey",
+      "loginExternal",
+    ];
     for (const method of grpcMethods) {
       const descriptor: any = Reflect.getOwnPropertyDescriptor(constructor.prototype, method);
       GrpcMethod("UserService", method)(constructor.prototype[method], method, descriptor);
@@ -0,0 +1,39 @@
+import {
+  CallHandler,
+  ExecutionContext,
+  Injectable,
+  NestInterceptor,
+} from "@nestjs/common";
+import { Metadata } from "@grpc/grpc-js";
+import { AsyncLocalStorage } from "async_hooks";
+import { Observable } from "rxjs";
+
+// The API Gateway names the storefront of every call in this metadata key
+export const TENANT_METADATA_KEY = "x-tenant-id";
+
+const storage = new AsyncLocalStorage<string>();
+
+// currentTenant returns the storefront of the call being handled. Calls
+// without one, such as the API Gateway's background jobs, see every tenant.
+export function currentTenant(): string | undefined {
+  return storage.getStore() || undefined;
+}
+
+// tenantScope narrows a where clause to the current tenant
+export function tenantScope(): { tenantId?: string } {
+  const tenantId = currentTenant();
+  return tenantId ? { tenantId } : {};
+}
+
+// TenantInterceptor runs each gRPC call with the tenant from its metadata
+@Injectable()
+export class TenantInterceptor implements NestInterceptor {
+  intercept(context: ExecutionContext, next: CallHandler): Observable<unknown> {
+    const metadata = context.switchToRpc().getContext<Metadata>();
+    const [value] = metadata?.get?.(TENANT_METADATA_KEY) ?? [];
+    const tenantId = value ? value.toString() : "";
+    return new Observable((subscriber) =>
+      storage.run(tenantId, () => next.handle().subscribe(subscriber))
+    );
+  }
+}
@@ -1,5 +1,7 @@
 import { Controller } from "@nestjs/common";
 import { UserService } from "./user.service";
+import { AuthService } from "@/auth/auth.service";
+import { ApiKeyService } from "@/auth/api-key.service";
 import {
   CreateUserRequest,
   CreateUserResponse,
@@ -11,13 +13,47 @@ import {
   DeleteUserResponse,
   ListUsersRequest,
   ListUsersResponse,
+  RestoreUserRequest,
+  RestoreUserResponse,
+  PurgeDeletedUsersRequest,
+  PurgeDeletedUsersResponse,
+  EraseUserRequest,
+  EraseUserResponse,
+  UpdateUserRolesRequest,
+  UpdateUserRolesResponse,
+  SignupRequest,
+  SignupResponse,
+  LoginRequest,
+  AuthTokensResponse,
+  RefreshTokenRequest,
+  LogoutRequest,
+  LogoutResponse,
+  AuthenticateTokenRequest,
+  AuthenticateTokenResponse,
+  RequestEmailVerificationRequest,
+  VerifyEmailRequest,
+  RequestPasswordResetRequest,
+  ResetPasswordRequest,
+  AuthActionResponse,
+  CreateApiKeyRequest,
+  ApiKeyResponse,
+  ListApiKeysRequest,
+  ListApiKeysResponse,
+  RevokeApiKeyRequest,
+  AuthenticateApiKeyRequest,
+  AuthenticateApiKeyResponse,
+  LoginExternalRequest,
   UserServiceControllerMethods,
 } from "@/proto/user.pb";
 
 @Controller()
 @UserServiceControllerMethods()
 export class UserController {
-  constructor(private readonly userService: UserService) {}
+  constructor(
+    private readonly userService: UserService,
+    private readonly authService: AuthService,
+    private readonly apiKeyService: ApiKeyService
+  ) {}
 
   async createUser(request: CreateUserRequest): Promise<CreateUserResponse> {
     return this.userService.createUser(request);
@@ -38,4 +74,96 @@ export class UserController {
   async listUsers(request: ListUsersRequest): Promise<ListUsersResponse> {
     return this.userService.listUsers(request);
   }
+
+  async restoreUser(request: RestoreUserRequest): Promise<RestoreUserResponse> {
+    return this.userService.restoreUser(request);
+  }
+
+  async purgeDeletedUsers(
+    request: PurgeDeletedUsersRequest
+  ): Promise<PurgeDeletedUsersResponse> {
+    return this.userService.purgeDeletedUsers(request);
+  }
+
+  async eraseUser(request: EraseUserRequest): Promise<EraseUserResponse> {
+    return this.userService.eraseUser(request);
+  }
+
+  async updateUserRoles(
+    request: UpdateUserRolesRequest
+  ): Promise<UpdateUserRolesResponse> {
+    return this.userService.updateUserRoles(request);
+  }
+
+  async signup(request: SignupRequest): Promise<SignupResponse> {
+    return this.authService.signup(request);
+  }
+
+  async login(request: LoginRequest): Promise<AuthTokensResponse> {
+    return this.authService.login(request);
+  }
+
+  async refreshToken(
+    request: RefreshTokenRequest
+  ): Promise<AuthTokensResponse> {
+    return this.authService.refreshToken(request);
+  }
+
+  async logout(request: LogoutRequest): Promise<LogoutResponse> {
+    return this.authService.logout(request);
+  }
+
+  async authenticateToken(
+    request: AuthenticateTokenRequest
+  ): Promise<AuthenticateTokenResponse> {
+    return this.authService.authenticateToken(request);
+  }
+
+  async requestEmailVerification(
+    request: RequestEmailVerificationRequest
+  ): Promise<AuthActionResponse> {
+    return this.authService.requestEmailVerification(request);
+  }
+
+  async verifyEmail(request: VerifyEmailRequest): Promise<AuthActionResponse> {
+    return this.authService.verifyEmail(request);
+  }
+
+  async requestPasswordReset(
+    request: RequestPasswordResetRequest
+  ): Promise<AuthActionResponse> {
+    return this.authService.requestPasswordReset(request);
+  }
+
+  async resetPassword(
+    request: ResetPasswordRequest
+  ): Promise<AuthActionResponse> {
+    return this.authService.resetPassword(request);
+  }
+
+  async createApiKey(request: CreateApiKeyRequest): Promise<ApiKeyResponse> {
+    return this.apiKeyService.createApiKey(request);
+  }
+
+  async listApiKeys(
+    request: ListApiKeysRequest
+  ): Promise<ListApiKeysResponse> {
+    return this.apiKeyService.listApiKeys(request);
+  }
+
+  async revokeApiKey(request: RevokeApiKeyRequest): Promise<ApiKeyResponse> {
+    return this.apiKeyService.revokeApiKey(request);
+  }
+
+  async authenticateApiKey(
+    request: AuthenticateApiKeyRequest
+  ): Promise<AuthenticateApiKeyResponse> {
+    return this.apiKeyService.authenticateApiKey(request);
+  }
+
+  async loginExternal(
+    request: LoginExternalRequest
+  ): Promise<AuthTokensResponse> {
+    return this.authService.loginExternal(request);
+  }
 }
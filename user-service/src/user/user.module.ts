@@ -3,9 +3,16 @@ import { TypeOrmModule } from "@nestjs/typeorm";
 import { UserController } from "./user.controller";
 import { UserService } from "./user.service";
 import { User } from "@/database/user.entity";
+import { AuthToken } from "@/database/auth-token.entity";
+import { ApiKey } from "@/database/api-key.entity";
+import { ExternalIdentity } from "@/database/external-identity.entity";
+import { AuthModule } from "@/auth/auth.module";
 
 @Module({
-  imports: [TypeOrmModule.forFeature([User])],
+  imports: [
+    TypeOrmModule.forFeature([User, AuthToken, ApiKey, ExternalIdentity]),
+    AuthModule,
+  ],
   controllers: [UserController],
   providers: [UserService],
 })
@@ -1,7 +1,11 @@
 import { Injectable, Logger } from "@nestjs/common";
 import { InjectRepository } from "@nestjs/typeorm";
-import { Repository } from "typeorm";
+import { In, IsNull, LessThan, Repository } from "typeorm";
 import { User } from "@/database/user.entity";
+import { AuthToken } from "@/database/auth-token.entity";
+import { ApiKey } from "@/database/api-key.entity";
+import { ExternalIdentity } from "@/database/external-identity.entity";
+import { tenantScope } from "@/tenant/tenant";
 import {
   CreateUserRequest,
   CreateUserResponse,
@@ -13,29 +17,64 @@ import {
   DeleteUserResponse,
   ListUsersRequest,
   ListUsersResponse,
+  RestoreUserRequest,
+  RestoreUserResponse,
+  PurgeDeletedUsersRequest,
+  PurgeDeletedUsersResponse,
+  EraseUserRequest,
+  EraseUserResponse,
+  UpdateUserRolesRequest,
+  UpdateUserRolesResponse,
+  User as UserMessage,
 } from "@/proto/user.pb";
 
+export function toUserMessage(user: User): UserMessage {
+  return {
+    id: user.id,
+    name: user.name,
+    email: user.email,
+    age: user.age,
+    createdAt: user.createdAt.toISOString(),
+    deletedAt: user.deletedAt ? user.deletedAt.toISOString() : "",
+    erasedAt: user.erasedAt ? user.erasedAt.toISOString() : "",
+    roles: user.roles ?? [],
+    emailVerifiedAt: user.emailVerifiedAt
+      ? user.emailVerifiedAt.toISOString()
+      : "",
+    tenantId: user.tenantId,
+  };
+}
+
 @Injectable()
 export class UserService {
   private readonly logger = new Logger(UserService.name);
 
   constructor(
     @InjectRepository(User)
-    private readonly userRepository: Repository<User>
+    private readonly userRepository: Repository<User>,
+    @InjectRepository(AuthToken)
+    private readonly tokenRepository: Repository<AuthToken>,
+    @InjectRepository(ApiKey)
+    private readonly apiKeyRepository: Repository<ApiKey>,
+    @InjectRepository(ExternalIdentity)
+    private readonly identityRepository: Repository<ExternalIdentity>
   ) {}
 
   async createUser(request: CreateUserRequest): Promise<CreateUserResponse> {
     try {
-      // Check if email already exists
+      // Check if email already exists, soft-deleted users keep theirs until purged
       const existingUser = await this.userRepository.findOne({
-        where: { email: request.email },
+        where: { email: request.email, ...tenantScope() },
+        withDeleted: true,
       });
 
       if (existingUser) {
         return {
           user: undefined,
           success: false,
-          message: "Email already exists",
+          message: existingUser.deletedAt
+            ? "Email belongs to a deleted user; restore it instead"
+            : "Email already exists",
         };
       }
 
@@ -44,19 +83,15 @@ export class UserService {
         name: request.name,
         email: request.email,
         age: request.age,
+        roles: ["customer"],
+        ...tenantScope(),
       });
 
       const savedUser = await this.userRepository.save(user);
       this.logger.log(`Created user: ${savedUser.id}`);
 
       return {
-        user: {
-          id: savedUser.id,
-          name: savedUser.name,
-          email: savedUser.email,
-          age: savedUser.age,
-          createdAt: savedUser.createdAt.toISOString(),
-        },
+        user: toUserMessage(savedUser),
         success: true,
         message: "User created successfully",
       };
@@ -73,7 +108,8 @@ export class UserService {
   async getUser(request: GetUserRequest): Promise<GetUserResponse> {
     try {
       const user = await this.userRepository.findOne({
-        where: { id: request.userId },
+        where: { id: request.userId, ...tenantScope() },
+        withDeleted: request.includeDeleted,
       });
 
       if (!user) {
@@ -84,13 +120,7 @@ export class UserService {
       }
 
       return {
-        user: {
-          id: user.id,
-          name: user.name,
-          email: user.email,
-          age: user.age,
-          createdAt: user.createdAt.toISOString(),
-        },
+        user: toUserMessage(user),
         found: true,
       };
     } catch (error) {
@@ -105,7 +135,7 @@ export class UserService {
   async updateUser(request: UpdateUserRequest): Promise<UpdateUserResponse> {
     try {
       const user = await this.userRepository.findOne({
-        where: { id: request.userId },
+        where: { id: request.userId, ...tenantScope() },
       });
 
       if (!user) {
@@ -125,13 +155,7 @@ export class UserService {
       this.logger.log(`Updated user: ${savedUser.id}`);
 
       return {
-        user: {
-          id: savedUser.id,
-          name: savedUser.name,
-          email: savedUser.email,
-          age: savedUser.age,
-          createdAt: savedUser.createdAt.toISOString(),
-        },
+        user: toUserMessage(savedUser),
         success: true,
         message: "User updated successfully",
       };
@@ -148,28 +172,73 @@ export class UserService {
   async deleteUser(request: DeleteUserRequest): Promise<DeleteUserResponse> {
     try {
       const user = await this.userRepository.findOne({
-        where: { id: request.userId },
+        where: { id: request.userId, ...tenantScope() },
       });
 
       if (!user) {
         return {
           success: false,
           message: "User not found",
+          user: undefined,
         };
       }
 
-      await this.userRepository.remove(user);
+      // Soft delete: the row stays until the retention period has passed
+      const deletedUser = await this.userRepository.softRemove(user);
       this.logger.log(`Deleted user: ${request.userId}`);
 
       return {
         success: true,
         message: "User deleted successfully",
+        user: toUserMessage(deletedUser),
       };
     } catch (error) {
       this.logger.error(`Error deleting user: ${error.message}`);
       return {
         success: false,
         message: "Internal error",
+        user: undefined,
+      };
+    }
+  }
+
+  async restoreUser(request: RestoreUserRequest): Promise<RestoreUserResponse> {
+    try {
+      const user = await this.userRepository.findOne({
+        where: { id: request.userId, ...tenantScope() },
+        withDeleted: true,
+      });
+
+      if (!user) {
+        return {
+          user: undefined,
+          success: false,
+          message: "User not found",
+        };
+      }
+      if (!user.deletedAt) {
+        return {
+          user: toUserMessage(user),
+          success: false,
+          message: "User is not deleted",
+        };
+      }
+
+      await this.userRepository.restore(user.id);
+      user.deletedAt = null;
+      this.logger.log(`Restored user: ${user.id}`);
+
+      return {
+        user: toUserMessage(user),
+        success: true,
+        message: "User restored successfully",
+      };
+    } catch (error) {
+      this.logger.error(`Error restoring user: ${error.message}`);
+      return {
+        user: undefined,
+        success: false,
+        message: "Internal error",
       };
     }
   }
@@ -183,16 +252,12 @@ export class UserService {
       const [users, total] = await this.userRepository.findAndCount({
         skip,
         take: limit,
+        where: tenantScope(),
         order: { createdAt: "DESC" },
+        withDeleted: request.includeDeleted,
       });
 
-      const userList = users.map((user) => ({
-        id: user.id,
-        name: user.name,
-        email: user.email,
-        age: user.age,
-        createdAt: user.createdAt.toISOString(),
-      }));
+      const userList = users.map(toUserMessage);
 
       return {
         users: userList,
@@ -210,4 +275,139 @@ export class UserService {
       };
     }
   }
+
+  async purgeDeletedUsers(
+    request: PurgeDeletedUsersRequest
+  ): Promise<PurgeDeletedUsersResponse> {
+    try {
+      const deletedBefore = new Date(request.deletedBefore);
+      if (isNaN(deletedBefore.getTime())) {
+        this.logger.error(`Invalid purge cutoff: ${request.deletedBefore}`);
+        return { userIds: [] };
+      }
+
+      const users = await this.userRepository.find({
+        where: { deletedAt: LessThan(deletedBefore), ...tenantScope() },
+        withDeleted: true,
+      });
+      const userIds = users.map((user) => user.id);
+
+      if (users.length > 0) {
+        await this.userRepository.remove(users);
+        // Without the user, their links and credentials are dead weight
+        await this.identityRepository.delete({ userId: In(userIds) });
+        await this.tokenRepository.delete({ userId: In(userIds) });
+        await this.apiKeyRepository.delete({ userId: In(userIds) });
+        this.logger.log(`Purged deleted users: ${userIds.join(", ")}`);
+      }
+
+      return { userIds };
+    } catch (error) {
+      this.logger.error(`Error purging deleted users: ${error.message}`);
+      return { userIds: [] };
+    }
+  }
+
+  async eraseUser(request: EraseUserRequest): Promise<EraseUserResponse> {
+    try {
+      const user = await this.userRepository.findOne({
+        where: { id: request.userId, ...tenantScope() },
+        withDeleted: true,
+      });
+
+      if (!user) {
+        return {
+          user: undefined,
+          success: false,
+          message: "User not found",
+        };
+      }
+      if (user.erasedAt) {
+        return {
+          user: toUserMessage(user),
+          success: true,
+          message: "User already erased",
+        };
+      }
+
+      // Replace every personal field; the email stays unique per user
+      user.name = "Erased user";
+      user.email = `erased-${user.id}@erased.invalid`;
+      user.age = 0;
+      user.passwordHash = null;
+      user.erasedAt = new Date();
+
+      const savedUser = await this.userRepository.save(user);
+      // An erased user can no longer sign in, nor can their API keys
+      await this.tokenRepository.update(
+        { userId: savedUser.id, revokedAt: IsNull() },
+        { revokedAt: new Date() }
+      );
+      await this.apiKeyRepository.update(
+        { userId: savedUser.id, revokedAt: IsNull() },
+        { revokedAt: new Date() }
+      );
+      // Links to identity providers are personal data too
+      await this.identityRepository.delete({ userId: savedUser.id });
+      this.logger.log(`Erased personal data of user: ${savedUser.id}`);
+
+      return {
+        user: toUserMessage(savedUser),
+        success: true,
+        message: "User erased successfully",
+      };
+    } catch (error) {
+      this.logger.error(`Error erasing user: ${error.message}`);
+      return {
+        user: undefined,
+        success: false,
+        message: "Internal error",
+      };
+    }
+  }
+
+  async updateUserRoles(
+    request: UpdateUserRolesRequest
+  ): Promise<UpdateUserRolesResponse> {
+    try {
+      const user = await this.userRepository.findOne({
+        where: { id: request.userId, ...tenantScope() },
+      });
+
+      if (!user) {
+        return {
+          user: undefined,
+          success: false,
+          message: "User not found",
+        };
+      }
+
+      const roles = new Set(user.roles ?? []);
+      for (const role of request.grant ?? []) {
+        roles.add(role);
+      }
+      for (const role of request.revoke ?? []) {
+        roles.delete(role);
+      }
+      user.roles = [...roles].sort();
+
+      const savedUser = await this.userRepository.save(user);
+      this.logger.log(
+        `Updated roles of user ${savedUser.id}: ${savedUser.roles.join(", ")}`
+      );
+
+      return {
+        user: toUserMessage(savedUser),
+        success: true,
+        message: "Roles updated successfully",
+      };
+    } catch (error) {
+      this.logger.error(`Error updating user roles: ${error.message}`);
+      return {
+        user: undefined,
+        success: false,
+        message: "Internal error",
+      };
+    }
+  }
 }